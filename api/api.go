@@ -8,6 +8,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
@@ -80,6 +81,7 @@ func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, pro
 	}
 	ws = gin.Default()
 	ws.Use(cors.Default())
+	ws.Use(middleware.TraceRequests())
 	ws.Use(middleware.WithFacade(elrondFacade))
 	for _, proc := range processors {
 		if check.IfNil(proc) {
@@ -96,54 +98,62 @@ func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, pro
 
 	RegisterRoutes(ws, routesConfig, elrondFacade)
 
-	return ws.Run(elrondFacade.RestApiInterface())
+	tlsConfig := routesConfig.TLS
+	if !tlsConfig.Enabled {
+		return ws.Run(elrondFacade.RestApiInterface())
+	}
+	if len(tlsConfig.CertificateFile) == 0 || len(tlsConfig.KeyFile) == 0 {
+		return apiErrors.ErrInvalidTLSConfig
+	}
+
+	return ws.RunTLS(elrondFacade.RestApiInterface(), tlsConfig.CertificateFile, tlsConfig.KeyFile)
 }
 
 // RegisterRoutes will register all routes available on the web server
 func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondFacade middleware.Handler) {
-	nodeRoutes := ws.Group("/node")
+	nodeRoutes := createRouterGroup(ws, "node", routesConfig)
 	wrappedNodeRouter, err := wrapper.NewRouterWrapper("node", nodeRoutes, routesConfig)
 	if err == nil {
 		node.Routes(wrappedNodeRouter)
 	}
 
-	addressRoutes := ws.Group("/address")
+	addressRoutes := createRouterGroup(ws, "address", routesConfig)
 	wrappedAddressRouter, err := wrapper.NewRouterWrapper("address", addressRoutes, routesConfig)
 	if err == nil {
 		address.Routes(wrappedAddressRouter)
 	}
 
-	networkRoutes := ws.Group("/network")
+	networkRoutes := createRouterGroup(ws, "network", routesConfig)
 	wrappedNetworkRoutes, err := wrapper.NewRouterWrapper("network", networkRoutes, routesConfig)
 	if err == nil {
 		network.Routes(wrappedNetworkRoutes)
 	}
 
-	txRoutes := ws.Group("/transaction")
+	txRoutes := createRouterGroup(ws, "transaction", routesConfig)
 	wrappedTransactionRouter, err := wrapper.NewRouterWrapper("transaction", txRoutes, routesConfig)
 	if err == nil {
 		transaction.Routes(wrappedTransactionRouter)
 	}
 
-	vmValuesRoutes := ws.Group("/vm-values")
+	vmValuesRoutes := createRouterGroup(ws, "vm-values", routesConfig)
 	wrappedVmValuesRouter, err := wrapper.NewRouterWrapper("vm-values", vmValuesRoutes, routesConfig)
 	if err == nil {
 		vmValues.Routes(wrappedVmValuesRouter)
 	}
 
-	validatorRoutes := ws.Group("/validator")
+	validatorRoutes := createRouterGroup(ws, "validator", routesConfig)
 	wrappedValidatorsRouter, err := wrapper.NewRouterWrapper("validator", validatorRoutes, routesConfig)
 	if err == nil {
 		valStats.Routes(wrappedValidatorsRouter)
 	}
 
-	hardforkRoutes := ws.Group("/hardfork")
+	hardforkRoutes := createRouterGroup(ws, "hardfork", routesConfig)
 	wrappedHardforkRouter, err := wrapper.NewRouterWrapper("hardfork", hardforkRoutes, routesConfig)
 	if err == nil {
 		hardfork.Routes(wrappedHardforkRouter)
 	}
 
-	blockRoutes := ws.Group("/block")
+	blockRoutes := createRouterGroup(ws, "block", routesConfig)
 	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
 	if err == nil {
 		block.Routes(wrappedBlockRouter)
@@ -156,10 +166,18 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 
 	if isLogRouteEnabled(routesConfig) {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
-		registerLoggerWsRoute(ws, marshalizerForLogs)
+		registerLoggerWsRoute(ws, marshalizerForLogs, routesConfig.APIPackages["log"].Roles)
 	}
 }
 
+// createRouterGroup creates the gin group of an API package, restricted to the API keys holding one of
+// the roles configured for that package, if any
+func createRouterGroup(ws *gin.Engine, packageName string, routesConfig config.ApiRoutesConfig) *gin.RouterGroup {
+	roles := routesConfig.APIPackages[packageName].Roles
+
+	return ws.Group("/"+packageName, middleware.RequireRoles(roles))
+}
+
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	logConfig, ok := routesConfig.APIPackages["log"]
 	if !ok {
@@ -191,10 +209,10 @@ func RegisterDefaultValidators() error {
 	return nil
 }
 
-func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer, roles []string) {
 	upgrader := websocket.Upgrader{}
 
-	ws.GET("/log", func(c *gin.Context) {
+	ws.GET("/log", middleware.RequireRoles(roles), func(c *gin.Context) {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
 			return true
 		}
@@ -78,3 +78,30 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidTLSConfig signals that TLS was enabled without providing both the certificate and the key files
+var ErrInvalidTLSConfig = errors.New("invalid TLS config, both certificate and key files are required")
+
+// ErrNodeNotAlive signals that at least one of the liveness checks of the node failed
+var ErrNodeNotAlive = errors.New("node is not alive")
+
+// ErrNodeNotReady signals that at least one of the readiness checks of the node failed
+var ErrNodeNotReady = errors.New("node is not ready")
+
+// ErrGetLogProfile signals an error in retrieving the log profile
+var ErrGetLogProfile = errors.New("get log profile error")
+
+// ErrSetLogProfile signals an error in changing the log profile
+var ErrSetLogProfile = errors.New("set log profile error")
+
+// ErrCaptureProfiles signals an error in starting a capture of the runtime profiles
+var ErrCaptureProfiles = errors.New("capture profiles error")
+
+// ErrGetProfiles signals an error in retrieving the runtime profiles snapshots
+var ErrGetProfiles = errors.New("get profiles error")
+
+// ErrGetTrieStatistics signals an error in computing the statistics of a trie
+var ErrGetTrieStatistics = errors.New("get trie statistics error")
+
+// ErrGetStateRetention signals an error in computing the disk usage of the retained states
+var ErrGetStateRetention = errors.New("get state retention error")
@@ -96,6 +96,10 @@ func (ls *logSender) waitForProfile() error {
 		return err
 	}
 
+	return ls.applyProfile(message)
+}
+
+func (ls *logSender) applyProfile(message []byte) error {
 	if bytes.Equal(message, []byte(core.DefaultLogProfileIdentifier)) {
 		return nil
 	}
@@ -116,16 +120,15 @@ func (ls *logSender) waitForProfile() error {
 	return nil
 }
 
+// monitorConnection watches the connection until it is closed. Profiles received after the handshake are applied
+// so the log viewer can change the log levels without reconnecting
 func (ls *logSender) monitorConnection() {
-	var err error
-	var mt int
-
 	defer func() {
 		_ = ls.writer.Close()
 	}()
 
 	for {
-		mt, _, err = ls.conn.ReadMessage()
+		mt, message, err := ls.conn.ReadMessage()
 		ls.log.Trace("message type", "value", mt)
 		if mt == websocket.CloseMessage || mt == disconnectMessage {
 			return
@@ -133,6 +136,14 @@ func (ls *logSender) monitorConnection() {
 		if err != nil {
 			return
 		}
+		if mt != websocket.TextMessage || len(message) == 0 {
+			continue
+		}
+
+		err = ls.applyProfile(message)
+		if err != nil {
+			ls.log.Warn("can not apply websocket log profile", "error", err.Error())
+		}
 	}
 }
 
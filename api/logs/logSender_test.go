@@ -3,6 +3,7 @@ package logs_test
 import (
 	"errors"
 	"io"
+	"sync"
 	"testing"
 	"time"
 
@@ -203,3 +204,52 @@ func TestLogSender_StartSendingBlockingConnWriteFailsShouldStop(t *testing.T) {
 
 	assert.True(t, closeCalled)
 }
+
+func TestLogSender_StartSendingBlockingAppliesProfilesReceivedAfterHandshake(t *testing.T) {
+	t.Parallel()
+
+	profiles := []logger.Profile{
+		{LogLevelPatterns: "*:INFO"},
+		{LogLevelPatterns: "*:DEBUG", WithLoggerName: true},
+	}
+	mutReceived := sync.Mutex{}
+	receivedProfiles := make([]string, 0)
+	log := &mock.LoggerStub{
+		LogCalled: func(level string, message string, args ...interface{}) {
+			if message != "websocket log profile received" {
+				return
+			}
+
+			mutReceived.Lock()
+			receivedProfiles = append(receivedProfiles, args[1].(string))
+			mutReceived.Unlock()
+		},
+	}
+
+	numReads := 0
+	conn := &mock.WsConnStub{}
+	conn.SetCloseHandler(func() error {
+		return nil
+	})
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		defer func() {
+			numReads++
+		}()
+
+		if numReads >= len(profiles) {
+			return websocket.CloseMessage, []byte(""), nil
+		}
+
+		profileJson, _ := profiles[numReads].Marshal()
+		return websocket.TextMessage, profileJson, nil
+	})
+
+	ls, _ := logs.NewLogSender(&mock.MarshalizerStub{}, conn, log)
+	removeWriterFromLogSubsystem(ls.Writer())
+
+	ls.StartSendingBlocking()
+
+	mutReceived.Lock()
+	defer mutReceived.Unlock()
+	assert.Equal(t, []string{profiles[0].String(), profiles[1].String()}, receivedProfiles)
+}
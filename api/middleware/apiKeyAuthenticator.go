@@ -0,0 +1,203 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const authorizationHeader = "Authorization"
+const bearerPrefix = "Bearer "
+
+// ApiKeyContextKey is the gin context key under which the name of the authenticated API key is stored
+const ApiKeyContextKey = "apiKey"
+
+const apiKeyRolesContextKey = "apiKeyRoles"
+
+type apiKey struct {
+	name           string
+	keyHash        []byte
+	roles          map[string]struct{}
+	maxNumRequests uint32
+}
+
+// apiKeyAuthenticator is a middleware which authenticates the bearer API keys of the requests and limits
+// the number of requests done with each key
+type apiKeyAuthenticator struct {
+	keys        []*apiKey
+	mutRequests sync.Mutex
+	keyRequests map[string]uint32
+}
+
+// NewAPIKeyAuthenticator creates a new instance of an apiKeyAuthenticator
+func NewAPIKeyAuthenticator(authConfig config.APIAuthConfig) (*apiKeyAuthenticator, error) {
+	keys := make([]*apiKey, 0, len(authConfig.Keys))
+	names := make(map[string]struct{})
+	for _, keyConfig := range authConfig.Keys {
+		key, err := newAPIKey(keyConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		_, exists := names[key.name]
+		if exists {
+			return nil, fmt.Errorf("%w, duplicated name %s", ErrInvalidAPIKeyConfig, key.name)
+		}
+		names[key.name] = struct{}{}
+
+		keys = append(keys, key)
+	}
+
+	return &apiKeyAuthenticator{
+		keys:        keys,
+		keyRequests: make(map[string]uint32),
+	}, nil
+}
+
+func newAPIKey(keyConfig config.APIKeyConfig) (*apiKey, error) {
+	if len(keyConfig.Name) == 0 {
+		return nil, fmt.Errorf("%w, empty name", ErrInvalidAPIKeyConfig)
+	}
+	keyHash, err := hex.DecodeString(keyConfig.KeyHash)
+	if err != nil || len(keyHash) != sha256.Size {
+		return nil, fmt.Errorf("%w, key hash for %s is not a hex encoded sha256 hash", ErrInvalidAPIKeyConfig, keyConfig.Name)
+	}
+	if len(keyConfig.Roles) == 0 {
+		return nil, fmt.Errorf("%w, no roles for %s", ErrInvalidAPIKeyConfig, keyConfig.Name)
+	}
+
+	roles := make(map[string]struct{})
+	for _, role := range keyConfig.Roles {
+		roles[role] = struct{}{}
+	}
+
+	return &apiKey{
+		name:           keyConfig.Name,
+		keyHash:        keyHash,
+		roles:          roles,
+		maxNumRequests: keyConfig.MaxNumRequests,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests.
+// Requests without an Authorization header pass through as anonymous, the route groups requiring roles
+// rejecting them afterwards
+func (aka *apiKeyAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(authorizationHeader)
+		if len(header) == 0 {
+			c.Next()
+			return
+		}
+
+		if !strings.HasPrefix(header, bearerPrefix) {
+			abortUnauthorized(c, http.StatusUnauthorized, ErrInvalidAPIKey)
+			return
+		}
+
+		key := aka.findKey(strings.TrimPrefix(header, bearerPrefix))
+		if key == nil {
+			abortUnauthorized(c, http.StatusUnauthorized, ErrInvalidAPIKey)
+			return
+		}
+
+		aka.mutRequests.Lock()
+		requests := aka.keyRequests[key.name]
+		isQuotaReached := key.maxNumRequests > 0 && requests >= key.maxNumRequests
+		aka.keyRequests[key.name]++
+		aka.mutRequests.Unlock()
+
+		if isQuotaReached {
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for API key %s", ErrTooManyRequests.Error(), key.name),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		c.Set(ApiKeyContextKey, key.name)
+		c.Set(apiKeyRolesContextKey, key.roles)
+		c.Next()
+	}
+}
+
+func (aka *apiKeyAuthenticator) findKey(providedKey string) *apiKey {
+	providedHash := sha256.Sum256([]byte(providedKey))
+
+	var found *apiKey
+	for _, key := range aka.keys {
+		if subtle.ConstantTimeCompare(providedHash[:], key.keyHash) == 1 {
+			found = key
+		}
+	}
+
+	return found
+}
+
+// Reset resets all accumulated counters
+func (aka *apiKeyAuthenticator) Reset() {
+	aka.mutRequests.Lock()
+	aka.keyRequests = make(map[string]uint32)
+	aka.mutRequests.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aka *apiKeyAuthenticator) IsInterfaceNil() bool {
+	return aka == nil
+}
+
+// RequireRoles returns a handler func which only lets through the requests authenticated with an API key
+// having at least one of the provided roles. An empty roles slice does not restrict the access
+func RequireRoles(roles []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(roles) == 0 {
+			c.Next()
+			return
+		}
+
+		value, exists := c.Get(apiKeyRolesContextKey)
+		if !exists {
+			abortUnauthorized(c, http.StatusUnauthorized, ErrMissingAPIKey)
+			return
+		}
+
+		keyRoles, ok := value.(map[string]struct{})
+		if !ok {
+			abortUnauthorized(c, http.StatusUnauthorized, ErrInvalidAPIKey)
+			return
+		}
+
+		for _, role := range roles {
+			_, hasRole := keyRoles[role]
+			if hasRole {
+				c.Next()
+				return
+			}
+		}
+
+		abortUnauthorized(c, http.StatusForbidden, ErrAPIKeyRoleNotAllowed)
+	}
+}
+
+func abortUnauthorized(c *gin.Context, status int, err error) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeUnauthorized,
+		},
+	)
+}
@@ -0,0 +1,162 @@
+package middleware_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminKey = "admin secret key"
+const readerKey = "reader secret key"
+
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func createAPIAuthConfig() config.APIAuthConfig {
+	return config.APIAuthConfig{
+		Keys: []config.APIKeyConfig{
+			{Name: "admin", KeyHash: hashAPIKey(adminKey), Roles: []string{"admin"}, MaxNumRequests: 2},
+			{Name: "reader", KeyHash: hashAPIKey(readerKey), Roles: []string{"reader"}},
+		},
+	}
+}
+
+func startServerWithAPIKeyAuthenticator(t *testing.T) (*gin.Engine, reseter) {
+	authenticator, err := middleware.NewAPIKeyAuthenticator(createAPIAuthConfig())
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(middleware.ApiKeyContextKey))
+	}
+	ws.Group("/open", middleware.RequireRoles(nil)).GET("/status", handler)
+	ws.Group("/admin", middleware.RequireRoles([]string{"admin"})).POST("/trigger", handler)
+
+	return ws, authenticator
+}
+
+func doRequestWithAPIKey(ws *gin.Engine, method string, path string, key string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(key) > 0 {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewAPIKeyAuthenticator_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createAPIAuthConfig()
+	cfg.Keys[0].Name = ""
+	aka, err := middleware.NewAPIKeyAuthenticator(cfg)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyConfig))
+
+	cfg = createAPIAuthConfig()
+	cfg.Keys[0].KeyHash = "not a hash"
+	aka, err = middleware.NewAPIKeyAuthenticator(cfg)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyConfig))
+
+	cfg = createAPIAuthConfig()
+	cfg.Keys[0].Roles = nil
+	aka, err = middleware.NewAPIKeyAuthenticator(cfg)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyConfig))
+
+	cfg = createAPIAuthConfig()
+	cfg.Keys[1].Name = cfg.Keys[0].Name
+	aka, err = middleware.NewAPIKeyAuthenticator(cfg)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyConfig))
+}
+
+func TestNewAPIKeyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	aka, err := middleware.NewAPIKeyAuthenticator(config.APIAuthConfig{})
+	assert.False(t, check.IfNil(aka))
+	assert.Nil(t, err)
+}
+
+func TestAPIKeyAuthenticator_OpenGroupShouldNotRequireKey(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startServerWithAPIKeyAuthenticator(t)
+
+	resp := doRequestWithAPIKey(ws, "GET", "/open/status", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doRequestWithAPIKey(ws, "GET", "/open/status", readerKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "reader", resp.Body.String())
+}
+
+func TestAPIKeyAuthenticator_InvalidKeyShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startServerWithAPIKeyAuthenticator(t)
+
+	resp := doRequestWithAPIKey(ws, "GET", "/open/status", "wrong key")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	req, _ := http.NewRequest("GET", "/open/status", nil)
+	req.Header.Set("Authorization", "Basic "+adminKey)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestAPIKeyAuthenticator_RestrictedGroupShouldCheckRoles(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startServerWithAPIKeyAuthenticator(t)
+
+	resp := doRequestWithAPIKey(ws, "POST", "/admin/trigger", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = doRequestWithAPIKey(ws, "POST", "/admin/trigger", readerKey)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	resp = doRequestWithAPIKey(ws, "POST", "/admin/trigger", adminKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "admin", resp.Body.String())
+}
+
+func TestAPIKeyAuthenticator_KeyQuotaShouldBeEnforcedUntilReset(t *testing.T) {
+	t.Parallel()
+
+	ws, authenticator := startServerWithAPIKeyAuthenticator(t)
+
+	for i := 0; i < 2; i++ {
+		resp := doRequestWithAPIKey(ws, "POST", "/admin/trigger", adminKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp := doRequestWithAPIKey(ws, "POST", "/admin/trigger", adminKey)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	for i := 0; i < 5; i++ {
+		resp = doRequestWithAPIKey(ws, "GET", "/open/status", readerKey)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	authenticator.Reset()
+	resp = doRequestWithAPIKey(ws, "POST", "/admin/trigger", adminKey)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
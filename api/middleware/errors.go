@@ -7,3 +7,15 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidAPIKeyConfig signals that an invalid API key configuration has been provided
+var ErrInvalidAPIKeyConfig = errors.New("invalid API key config")
+
+// ErrInvalidAPIKey signals that the provided API key is not valid
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// ErrMissingAPIKey signals that the request was not authenticated with an API key
+var ErrMissingAPIKey = errors.New("missing API key")
+
+// ErrAPIKeyRoleNotAllowed signals that the API key used does not have any of the roles required by the route
+var ErrAPIKeyRoleNotAllowed = errors.New("API key role not allowed")
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+const traceParentHeader = "traceparent"
+
+// TraceRequests returns the handler func which records a span for each request handled by the gin server.
+// A W3C traceparent header sent by the client is used as the parent of the recorded span
+func TraceRequests() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !tracing.IsEnabled() {
+			c.Next()
+			return
+		}
+
+		ctx := tracing.ContextWithRemoteParent(c.Request.Context(), c.GetHeader(traceParentHeader))
+		ctx, span := tracing.StartSpan(ctx, c.Request.Method+" "+c.FullPath())
+		span.SetKind(tracing.SpanKindServer)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttribute("http.method", c.Request.Method)
+		span.SetAttribute("http.route", c.FullPath())
+		span.SetAttribute("http.status_code", status)
+		if status >= http.StatusInternalServerError {
+			span.SetError(errors.New(http.StatusText(status)))
+		}
+		span.End()
+	}
+}
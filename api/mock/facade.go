@@ -5,16 +5,21 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 )
 
 // Facade is the mock implementation of a node router handler
@@ -53,8 +58,19 @@ type Facade struct {
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetTotalStakedValueHandler              func() (*api.StakeValues, error)
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
+	GetESDTTokenPropertiesCalled            func(tokenID string) (*api.ESDTTokenProperties, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetLivenessReportCalled                 func() health.Report
+	GetReadinessReportCalled                func() health.Report
+	GetAlertsCalled                         func() []alerting.Alert
+	GetLogProfileCalled                     func() (logging.ProfileState, error)
+	SetLogProfileCalled                     func(update logging.ProfileUpdate) (logging.ProfileState, error)
+	TriggerProfilesCaptureCalled            func() (string, error)
+	GetProfilesSnapshotsCalled              func() ([]profiling.SnapshotInfo, error)
+	GetProfileFilePathCalled                func(name string, file string) (string, error)
+	GetTrieStatisticsCalled                 func(trieID string, rootHash []byte) (*inspector.TrieReport, error)
+	GetStateRetentionReportCalled           func() (*inspector.StateRetentionReport, error)
 }
 
 // GetUsername -
@@ -153,6 +169,15 @@ func (f *Facade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return make([]string, 0), nil
 }
 
+// GetESDTTokenProperties -
+func (f *Facade) GetESDTTokenProperties(tokenID string) (*api.ESDTTokenProperties, error) {
+	if f.GetESDTTokenPropertiesCalled != nil {
+		return f.GetESDTTokenPropertiesCalled(tokenID)
+	}
+
+	return &api.ESDTTokenProperties{}, nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
@@ -289,6 +314,96 @@ func (f *Facade) GetNumCheckpointsFromPeerState() uint32 {
 	return 0
 }
 
+// GetLivenessReport -
+func (f *Facade) GetLivenessReport() health.Report {
+	if f.GetLivenessReportCalled != nil {
+		return f.GetLivenessReportCalled()
+	}
+
+	return health.Report{Healthy: true}
+}
+
+// GetReadinessReport -
+func (f *Facade) GetReadinessReport() health.Report {
+	if f.GetReadinessReportCalled != nil {
+		return f.GetReadinessReportCalled()
+	}
+
+	return health.Report{Healthy: true}
+}
+
+// GetAlerts -
+func (f *Facade) GetAlerts() []alerting.Alert {
+	if f.GetAlertsCalled != nil {
+		return f.GetAlertsCalled()
+	}
+
+	return make([]alerting.Alert, 0)
+}
+
+// GetLogProfile -
+func (f *Facade) GetLogProfile() (logging.ProfileState, error) {
+	if f.GetLogProfileCalled != nil {
+		return f.GetLogProfileCalled()
+	}
+
+	return logging.ProfileState{}, nil
+}
+
+// SetLogProfile -
+func (f *Facade) SetLogProfile(update logging.ProfileUpdate) (logging.ProfileState, error) {
+	if f.SetLogProfileCalled != nil {
+		return f.SetLogProfileCalled(update)
+	}
+
+	return logging.ProfileState{}, nil
+}
+
+// TriggerProfilesCapture -
+func (f *Facade) TriggerProfilesCapture() (string, error) {
+	if f.TriggerProfilesCaptureCalled != nil {
+		return f.TriggerProfilesCaptureCalled()
+	}
+
+	return "", nil
+}
+
+// GetProfilesSnapshots -
+func (f *Facade) GetProfilesSnapshots() ([]profiling.SnapshotInfo, error) {
+	if f.GetProfilesSnapshotsCalled != nil {
+		return f.GetProfilesSnapshotsCalled()
+	}
+
+	return make([]profiling.SnapshotInfo, 0), nil
+}
+
+// GetProfileFilePath -
+func (f *Facade) GetProfileFilePath(name string, file string) (string, error) {
+	if f.GetProfileFilePathCalled != nil {
+		return f.GetProfileFilePathCalled(name, file)
+	}
+
+	return "", nil
+}
+
+// GetTrieStatistics -
+func (f *Facade) GetTrieStatistics(trieID string, rootHash []byte) (*inspector.TrieReport, error) {
+	if f.GetTrieStatisticsCalled != nil {
+		return f.GetTrieStatisticsCalled(trieID, rootHash)
+	}
+
+	return &inspector.TrieReport{}, nil
+}
+
+// GetStateRetentionReport -
+func (f *Facade) GetStateRetentionReport() (*inspector.StateRetentionReport, error) {
+	if f.GetStateRetentionReportCalled != nil {
+		return f.GetStateRetentionReportCalled()
+	}
+
+	return &inspector.StateRetentionReport{}, nil
+}
+
 // GetBlockByNonce -
 func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByNonceCalled(nonce, withTxs)
@@ -1,7 +1,9 @@
 package network
 
 import (
+	errs "errors"
 	"net/http"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -20,6 +22,8 @@ const (
 	getFFTsPath          = "/esdt/fungible-tokens"
 	getSFTsPath          = "/esdt/semi-fungible-tokens"
 	getNFTsPath          = "/esdt/non-fungible-tokens"
+	getESDTTokenPath     = "/esdt/:token"
+	esdtPathPrefix       = "/esdt/"
 	directStakedInfoPath = "/direct-staked-info"
 	delegatedInfoPath    = "/delegated-info"
 )
@@ -31,6 +35,7 @@ type FacadeHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
+	GetESDTTokenProperties(tokenID string) (*api.ESDTTokenProperties, error)
 	IsInterfaceNil() bool
 }
 
@@ -40,13 +45,37 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
 	router.RegisterHandler(http.MethodGet, getESDTsPath, getHandlerFuncForEsdt(""))
-	router.RegisterHandler(http.MethodGet, getFFTsPath, getHandlerFuncForEsdt(core.FungibleESDT))
-	router.RegisterHandler(http.MethodGet, getSFTsPath, getHandlerFuncForEsdt(core.SemiFungibleESDT))
-	router.RegisterHandler(http.MethodGet, getNFTsPath, getHandlerFuncForEsdt(core.NonFungibleESDT))
+	registerESDTRoutes(router)
 	router.RegisterHandler(http.MethodGet, directStakedInfoPath, DirectStakedInfo)
 	router.RegisterHandler(http.MethodGet, delegatedInfoPath, DelegatedInfo)
 }
 
+// registerESDTRoutes registers the token lists and the token properties routes. The gin router does not allow static
+// and wildcard routes on the same path segment, so, when the token properties route is open, the token lists are
+// served by the wildcard route handler
+func registerESDTRoutes(router *wrapper.RouterWrapper) {
+	tokenListsPaths := map[string]string{
+		getFFTsPath: core.FungibleESDT,
+		getSFTsPath: core.SemiFungibleESDT,
+		getNFTsPath: core.NonFungibleESDT,
+	}
+
+	if !router.IsEndpointActive(getESDTTokenPath) {
+		for path, tokenType := range tokenListsPaths {
+			router.RegisterHandler(http.MethodGet, path, getHandlerFuncForEsdt(tokenType))
+		}
+		return
+	}
+
+	tokenListsHandlers := make(map[string]gin.HandlerFunc)
+	for path, tokenType := range tokenListsPaths {
+		if router.IsEndpointActive(path) {
+			tokenListsHandlers[strings.TrimPrefix(path, esdtPathPrefix)] = getHandlerFuncForEsdt(tokenType)
+		}
+	}
+	router.RegisterHandler(http.MethodGet, getESDTTokenPath, getHandlerFuncForEsdtToken(tokenListsHandlers))
+}
+
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	facadeObj, ok := c.Get("facade")
 	if !ok {
@@ -178,6 +207,55 @@ func getHandlerFuncForEsdt(tokenType string) func(c *gin.Context) {
 	}
 }
 
+func getHandlerFuncForEsdtToken(tokenListsHandlers map[string]gin.HandlerFunc) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		tokenID := c.Param("token")
+		listHandler, isTokenList := tokenListsHandlers[tokenID]
+		if isTokenList {
+			listHandler(c)
+			return
+		}
+
+		facade, ok := getFacade(c)
+		if !ok {
+			return
+		}
+
+		tokenProperties, err := facade.GetESDTTokenProperties(tokenID)
+		if errs.Is(err, api.ErrTokenNotFound) {
+			c.JSON(
+				http.StatusNotFound,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+		if err != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"token": tokenProperties},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	}
+}
+
 // DirectStakedInfo is the endpoint that will return the directed staked info list
 func DirectStakedInfo(c *gin.Context) {
 	facade, ok := getFacade(c)
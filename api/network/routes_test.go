@@ -37,6 +37,16 @@ type esdtTokensResponse struct {
 	Code  string
 }
 
+type esdtTokenPropertiesResponseData struct {
+	Token api.ESDTTokenProperties `json:"token"`
+}
+
+type esdtTokenPropertiesResponse struct {
+	Data  esdtTokenPropertiesResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string
+}
+
 func TestNetworkConfigMetrics_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -252,6 +262,93 @@ func TestGetAllIssuedESDTs_Error(t *testing.T) {
 	assert.Equal(t, resp.Code, http.StatusInternalServerError)
 }
 
+func TestGetAllIssuedESDTsOfType_ShouldWork(t *testing.T) {
+	tokens := []string{"tokenA"}
+	facade := mock.Facade{
+		GetAllIssuedESDTsCalled: func(tokenType string) ([]string, error) {
+			assert.Equal(t, core.FungibleESDT, tokenType)
+			return tokens, nil
+		},
+		GetESDTTokenPropertiesCalled: func(_ string) (*api.ESDTTokenProperties, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/fungible-tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.Equal(t, tokens, response.Data.Tokens)
+}
+
+func TestGetESDTTokenProperties_ShouldWork(t *testing.T) {
+	tokenProperties := &api.ESDTTokenProperties{
+		TokenIdentifier: "TCK-01a2b3",
+		TokenName:       "token",
+		Website:         "https://elrond.com",
+		SocialLinks:     []string{"https://twitter.com/elrond"},
+	}
+	facade := mock.Facade{
+		GetESDTTokenPropertiesCalled: func(tokenID string) (*api.ESDTTokenProperties, error) {
+			assert.Equal(t, tokenProperties.TokenIdentifier, tokenID)
+			return tokenProperties, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/TCK-01a2b3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenPropertiesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.Equal(t, *tokenProperties, response.Data.Token)
+}
+
+func TestGetESDTTokenProperties_TokenNotFoundShouldReturnNotFound(t *testing.T) {
+	facade := mock.Facade{
+		GetESDTTokenPropertiesCalled: func(_ string) (*api.ESDTTokenProperties, error) {
+			return nil, api.ErrTokenNotFound
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/TCK-01a2b3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenPropertiesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusNotFound)
+	assert.Equal(t, api.ErrTokenNotFound.Error(), response.Error)
+	assert.Equal(t, string(shared.ReturnCodeRequestError), response.Code)
+}
+
+func TestGetESDTTokenProperties_Error(t *testing.T) {
+	localErr := fmt.Errorf("%s", "local error")
+	facade := mock.Facade{
+		GetESDTTokenPropertiesCalled: func(_ string) (*api.ESDTTokenProperties, error) {
+			return nil, localErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/TCK-01a2b3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenPropertiesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.Equal(t, localErr.Error(), response.Error)
+}
+
 func TestDirectStakedInfo_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/network/direct-staked-info", nil)
@@ -472,6 +569,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/esdts", Open: true},
+					{Name: "/esdt/fungible-tokens", Open: true},
+					{Name: "/esdt/:token", Open: true},
 					{Name: "/total-staked", Open: true},
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
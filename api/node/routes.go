@@ -1,30 +1,47 @@
 package node
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 	"github.com/gin-gonic/gin"
 )
 
 const (
 	pidQueryParam       = "pid"
+	rootHashQueryParam  = "rootHash"
+	alertsPath          = "/alerts"
 	debugPath           = "/debug"
 	heartbeatStatusPath = "/heartbeatstatus"
+	livenessPath        = "/health/live"
+	logLevelPath        = "/log-level"
+	readinessPath       = "/health/ready"
 	metricsPath         = "/metrics"
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
+	profilesPath        = "/profiles"
+	profileFilePath     = "/profiles/:name/:file"
+	stateRetentionPath  = "/state-retention"
 	statisticsPath      = "/statistics"
 	statusPath          = "/status"
+	trieStatisticsPath  = "/trie-statistics/:trie"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -42,6 +59,16 @@ type FacadeHandler interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetLivenessReport() health.Report
+	GetReadinessReport() health.Report
+	GetAlerts() []alerting.Alert
+	GetLogProfile() (logging.ProfileState, error)
+	SetLogProfile(update logging.ProfileUpdate) (logging.ProfileState, error)
+	TriggerProfilesCapture() (string, error)
+	GetProfilesSnapshots() ([]profiling.SnapshotInfo, error)
+	GetProfileFilePath(name string, file string) (string, error)
+	GetTrieStatistics(trieID string, rootHash []byte) (*inspector.TrieReport, error)
+	GetStateRetentionReport() (*inspector.StateRetentionReport, error)
 	IsInterfaceNil() bool
 }
 
@@ -51,6 +78,15 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// LogLevelRequest represents the structure of a request changing the log profile of the node. The missing fields
+// keep their current values and a TTL reverts the change once expired
+type LogLevelRequest struct {
+	LogLevelPatterns *string `json:"logLevelPatterns"`
+	WithCorrelation  *bool   `json:"withCorrelation"`
+	WithLoggerName   *bool   `json:"withLoggerName"`
+	TTLInSeconds     uint32  `json:"ttlInSeconds"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -84,6 +120,16 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, livenessPath, Liveness)
+	router.RegisterHandler(http.MethodGet, readinessPath, Readiness)
+	router.RegisterHandler(http.MethodGet, alertsPath, Alerts)
+	router.RegisterHandler(http.MethodGet, logLevelPath, GetLogLevel)
+	router.RegisterHandler(http.MethodPut, logLevelPath, SetLogLevel)
+	router.RegisterHandler(http.MethodGet, profilesPath, ProfilesSnapshots)
+	router.RegisterHandler(http.MethodPost, profilesPath, CaptureProfiles)
+	router.RegisterHandler(http.MethodGet, profileFilePath, ProfileFile)
+	router.RegisterHandler(http.MethodGet, trieStatisticsPath, TrieStatistics)
+	router.RegisterHandler(http.MethodGet, stateRetentionPath, StateRetention)
 	// placeholder for custom routes
 }
 
@@ -321,8 +367,311 @@ func PrometheusMetrics(c *gin.Context) {
 	}
 
 	metrics := facade.StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
-	c.String(
+	metrics += prometheus.DefaultRegistry().String()
+	c.Data(
+		http.StatusOK,
+		prometheus.ContentType,
+		[]byte(metrics),
+	)
+}
+
+// Liveness returns the result of the liveness checks, responding with 503 if the node should be restarted
+func Liveness(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetLivenessReport(), errors.ErrNodeNotAlive)
+}
+
+// Readiness returns the result of the readiness checks, responding with 503 if the node is not ready to serve
+// requests, for example while it is still syncing
+func Readiness(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetReadinessReport(), errors.ErrNodeNotReady)
+}
+
+// Alerts returns the current state of the configured alerting rules
+func Alerts(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"alerts": facade.GetAlerts()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetLogLevel returns the current log profile of the node
+func GetLogLevel(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	state, err := facade.GetLogProfile()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLogProfile.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"profile": state},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// SetLogLevel changes the log profile of the node, until changed again or, if provided, until the TTL expires
+func SetLogLevel(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = LogLevelRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	state, err := facade.SetLogProfile(logging.ProfileUpdate{
+		LogLevelPatterns: request.LogLevelPatterns,
+		WithCorrelation:  request.WithCorrelation,
+		WithLoggerName:   request.WithLoggerName,
+		TTL:              time.Duration(request.TTLInSeconds) * time.Second,
+	})
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSetLogProfile.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"profile": state},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// ProfilesSnapshots returns the stored runtime profiles snapshots, newest first
+func ProfilesSnapshots(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	snapshots, err := facade.GetProfilesSnapshots()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProfiles.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"snapshots": snapshots},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// CaptureProfiles starts capturing the runtime profiles of the node, returning the name of the snapshot which
+// will hold them once the capture ends
+func CaptureProfiles(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	name, err := facade.TriggerProfilesCapture()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCaptureProfiles.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
 		http.StatusOK,
-		metrics,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"snapshot": name},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// ProfileFile downloads a profile file of a stored snapshot
+func ProfileFile(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	name := c.Param("name")
+	file := c.Param("file")
+	path, err := facade.GetProfileFilePath(name, file)
+	if err != nil {
+		c.JSON(
+			http.StatusNotFound,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProfiles.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.FileAttachment(path, name+"__"+file)
+}
+
+// TrieStatistics computes the statistics of a node trie for the hex encoded root hash provided as query parameter,
+// or for the current root hash if none is provided. It walks the whole trie so it can take a while
+func TrieStatistics(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rootHash, err := hex.DecodeString(c.Query(rootHashQueryParam))
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	report, err := facade.GetTrieStatistics(c.Param("trie"), rootHash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTrieStatistics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// StateRetention returns the state retention policy and the disk usage of the tries, the snapshots being reported
+// per epoch
+func StateRetention(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	report, err := facade.GetStateRetentionReport()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStateRetention.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"stateRetention": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithHealthReport(c *gin.Context, report health.Report, errUnhealthy error) {
+	if !report.Healthy {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"report": report},
+				Error: errUnhealthy.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"report": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
 	)
 }
@@ -2,6 +2,7 @@ package node_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	errs "errors"
 	"fmt"
@@ -9,6 +10,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -21,11 +24,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	dataCore "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -497,6 +508,554 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 
 	keyAndValueFoundInResponse := strings.Contains(respStr, key) && strings.Contains(respStr, fmt.Sprintf("%d", value))
 	assert.True(t, keyAndValueFoundInResponse)
+	assert.True(t, strings.Contains(respStr, "# TYPE go_goroutines gauge"))
+	assert.Equal(t, prometheus.ContentType, resp.Header().Get("Content-Type"))
+}
+
+type healthReportResponseData struct {
+	Report health.Report `json:"report"`
+}
+
+type healthReportResponse struct {
+	Data  healthReportResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+func TestLiveness_HealthyShouldReturnOk(t *testing.T) {
+	t.Parallel()
+
+	report := health.Report{
+		Healthy: true,
+		Checks:  []health.CheckResult{{Name: "watchdog", Healthy: true}},
+	}
+	facade := mock.Facade{
+		GetLivenessReportCalled: func() health.Report {
+			return report
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, report, response.Data.Report)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+}
+
+func TestReadiness_NotReadyShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	report := health.Report{
+		Healthy: false,
+		Checks: []health.CheckResult{
+			{Name: "watchdog", Healthy: true},
+			{Name: "sync state", Healthy: false, Error: "node is not synchronized"},
+		},
+	}
+	facade := mock.Facade{
+		GetReadinessReportCalled: func() health.Report {
+			return report
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, report, response.Data.Report)
+	assert.Equal(t, errors.ErrNodeNotReady.Error(), response.Error)
+}
+
+type alertsResponseData struct {
+	Alerts []alerting.Alert `json:"alerts"`
+}
+
+type alertsResponse struct {
+	Data  alertsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestAlerts_ShouldReturnTheAlerts(t *testing.T) {
+	t.Parallel()
+
+	alerts := []alerting.Alert{
+		{
+			Name:        "LowPeerCount",
+			Severity:    "warning",
+			Condition:   "value(erd_num_connected_peers) < 3",
+			State:       alerting.StateFiring,
+			Value:       1,
+			Threshold:   3,
+			ActiveSince: 1000,
+		},
+	}
+	facade := mock.Facade{
+		GetAlertsCalled: func() []alerting.Alert {
+			return alerts
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/alerts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := alertsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, alerts, response.Data.Alerts)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+}
+
+type logProfileResponseData struct {
+	Profile logging.ProfileState `json:"profile"`
+}
+
+type logProfileResponse struct {
+	Data  logProfileResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetLogLevel_ShouldReturnTheProfile(t *testing.T) {
+	t.Parallel()
+
+	state := logging.ProfileState{
+		Current: logging.ProfileData{
+			LogLevelPatterns: "*:INFO",
+			WithLoggerName:   true,
+		},
+	}
+	facade := mock.Facade{
+		GetLogProfileCalled: func() (logging.ProfileState, error) {
+			return state, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/log-level", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logProfileResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, state, response.Data.Profile)
+}
+
+func TestGetLogLevel_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetLogProfileCalled: func() (logging.ProfileState, error) {
+			return logging.ProfileState{}, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/log-level", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logProfileResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSetLogLevel_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("PUT", "/node/log-level", bytes.NewBuffer([]byte("not json")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logProfileResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestSetLogLevel_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		SetLogProfileCalled: func(update logging.ProfileUpdate) (logging.ProfileState, error) {
+			return logging.ProfileState{}, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("PUT", "/node/log-level", bytes.NewBuffer([]byte(`{"logLevelPatterns":"*:BAD"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logProfileResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrSetLogProfile.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSetLogLevel_ShouldForwardTheUpdate(t *testing.T) {
+	t.Parallel()
+
+	var receivedUpdate logging.ProfileUpdate
+	facade := mock.Facade{
+		SetLogProfileCalled: func(update logging.ProfileUpdate) (logging.ProfileState, error) {
+			receivedUpdate = update
+			return logging.ProfileState{
+				Current: logging.ProfileData{LogLevelPatterns: *update.LogLevelPatterns},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	body := `{"logLevelPatterns":"*:INFO,process/sync:DEBUG","withCorrelation":true,"ttlInSeconds":600}`
+	req, _ := http.NewRequest("PUT", "/node/log-level", bytes.NewBuffer([]byte(body)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logProfileResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "*:INFO,process/sync:DEBUG", response.Data.Profile.Current.LogLevelPatterns)
+	require.NotNil(t, receivedUpdate.LogLevelPatterns)
+	assert.Equal(t, "*:INFO,process/sync:DEBUG", *receivedUpdate.LogLevelPatterns)
+	require.NotNil(t, receivedUpdate.WithCorrelation)
+	assert.True(t, *receivedUpdate.WithCorrelation)
+	assert.Nil(t, receivedUpdate.WithLoggerName)
+	assert.Equal(t, time.Minute*10, receivedUpdate.TTL)
+}
+
+func TestSetLogLevel_RouteRolesShouldRequireAPIKey(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getRoutesConfig()
+	nodeConfig := routesConfig.APIPackages["node"]
+	nodeConfig.Routes = []config.RouteConfig{
+		{Name: "/log-level", Open: true, Roles: []string{"admin"}},
+		{Name: "/alerts", Open: true},
+	}
+	routesConfig.APIPackages["node"] = nodeConfig
+
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", &mock.Facade{})
+	})
+	nodeRoutes, _ := wrapper.NewRouterWrapper("node", ws.Group("/node"), routesConfig)
+	node.Routes(nodeRoutes)
+
+	req, _ := http.NewRequest("PUT", "/node/log-level", bytes.NewBuffer([]byte(`{"ttlInSeconds":60}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/node/alerts", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+type profilesResponseData struct {
+	Snapshots []profiling.SnapshotInfo `json:"snapshots"`
+	Snapshot  string                   `json:"snapshot"`
+}
+
+type profilesResponse struct {
+	Data  profilesResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+func TestProfilesSnapshots_ShouldReturnTheSnapshots(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []profiling.SnapshotInfo{
+		{
+			Name:        "20201231_235959_123__on-demand",
+			Trigger:     profiling.TriggerOnDemand,
+			Timestamp:   1609451999,
+			Files:       []string{"cpu.pprof", "heap.pprof"},
+			SizeInBytes: 1024,
+		},
+	}
+	facade := mock.Facade{
+		GetProfilesSnapshotsCalled: func() ([]profiling.SnapshotInfo, error) {
+			return snapshots, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/profiles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := profilesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, snapshots, response.Data.Snapshots)
+}
+
+func TestCaptureProfiles_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		TriggerProfilesCaptureCalled: func() (string, error) {
+			return "", profiling.ErrCaptureInProgress
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/profiles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := profilesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrCaptureProfiles.Error()))
+	assert.True(t, strings.Contains(response.Error, profiling.ErrCaptureInProgress.Error()))
+}
+
+func TestCaptureProfiles_ShouldReturnTheSnapshotName(t *testing.T) {
+	t.Parallel()
+
+	name := "20201231_235959_123__on-demand"
+	facade := mock.Facade{
+		TriggerProfilesCaptureCalled: func() (string, error) {
+			return name, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("POST", "/node/profiles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := profilesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, name, response.Data.Snapshot)
+}
+
+func TestProfileFile_NotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetProfileFilePathCalled: func(name string, file string) (string, error) {
+			return "", profiling.ErrSnapshotNotFound
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/profiles/missing/cpu.pprof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := profilesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, profiling.ErrSnapshotNotFound.Error()))
+}
+
+func TestProfileFile_ShouldDownloadTheFile(t *testing.T) {
+	t.Parallel()
+
+	folder, err := ioutil.TempDir("", "profiles")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	content := []byte("profile content")
+	path := filepath.Join(folder, "cpu.pprof")
+	err = ioutil.WriteFile(path, content, os.ModePerm)
+	require.Nil(t, err)
+
+	name := "20201231_235959_123__on-demand"
+	facade := mock.Facade{
+		GetProfileFilePathCalled: func(providedName string, providedFile string) (string, error) {
+			assert.Equal(t, name, providedName)
+			assert.Equal(t, "cpu.pprof", providedFile)
+			return path, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/profiles/"+name+"/cpu.pprof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, content, resp.Body.Bytes())
+	assert.True(t, strings.Contains(resp.Header().Get("Content-Disposition"), name+"__cpu.pprof"))
+}
+
+type trieStatisticsResponseData struct {
+	Statistics *inspector.TrieReport `json:"statistics"`
+}
+
+type trieStatisticsResponse struct {
+	Data  trieStatisticsResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestTrieStatistics_InvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTrieStatisticsCalled: func(trieID string, rootHash []byte) (*inspector.TrieReport, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics/userAccount?rootHash=not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := trieStatisticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestTrieStatistics_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetTrieStatisticsCalled: func(trieID string, rootHash []byte) (*inspector.TrieReport, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics/userAccount", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := trieStatisticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetTrieStatistics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestTrieStatistics_ShouldReturnTheReport(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	report := &inspector.TrieReport{
+		Trie: &trie.TrieStatistics{
+			RootHash:       rootHash,
+			NumBranches:    1,
+			NumLeaves:      2,
+			MaxDepth:       1,
+			LeavesPerDepth: map[uint32]uint64{1: 2},
+		},
+		NumAccounts: 2,
+	}
+	facade := mock.Facade{
+		GetTrieStatisticsCalled: func(trieID string, providedRootHash []byte) (*inspector.TrieReport, error) {
+			assert.Equal(t, "peerAccount", trieID)
+			assert.Equal(t, rootHash, providedRootHash)
+			return report, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics/peerAccount?rootHash="+hex.EncodeToString(rootHash), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := trieStatisticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, report, response.Data.Statistics)
+}
+
+type stateRetentionResponseData struct {
+	StateRetention *inspector.StateRetentionReport `json:"stateRetention"`
+}
+
+type stateRetentionResponse struct {
+	Data  stateRetentionResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestStateRetention_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := mock.Facade{
+		GetStateRetentionReportCalled: func() (*inspector.StateRetentionReport, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/state-retention", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateRetentionResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetStateRetention.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStateRetention_ShouldReturnTheReport(t *testing.T) {
+	t.Parallel()
+
+	report := &inspector.StateRetentionReport{
+		Policy:                trie.LastEpochsStateRetention,
+		NumEpochsToKeep:       2,
+		AreSnapshotsQueryable: true,
+		Tries: map[string]*inspector.TrieRetentionReport{
+			"userAccount": {
+				MainDbSizeInBytes:    100,
+				SnapshotsSizeInBytes: 30,
+				Snapshots: []dataCore.SnapshotInfo{
+					{ID: 1, Epoch: 4, IsEpochKnown: true, SizeInBytes: 10},
+					{ID: 2, Epoch: 5, IsEpochKnown: true, SizeInBytes: 20},
+				},
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetStateRetentionReportCalled: func() (*inspector.StateRetentionReport, error) {
+			return report, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/state-retention", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateRetentionResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, report, response.Data.StateRetention)
 }
 
 func loadResponse(rsp io.Reader, destination interface{}) {
@@ -559,6 +1118,14 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/health/live", Open: true},
+					{Name: "/health/ready", Open: true},
+					{Name: "/alerts", Open: true},
+					{Name: "/log-level", Open: true},
+					{Name: "/profiles", Open: true},
+					{Name: "/profiles/:name/:file", Open: true},
+					{Name: "/trie-statistics/:trie", Open: true},
+					{Name: "/state-retention", Open: true},
 				},
 			},
 		},
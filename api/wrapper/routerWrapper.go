@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 )
@@ -32,22 +33,36 @@ func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig
 	}, nil
 }
 
-// RegisterHandler will register the handler for the given method and path
+// RegisterHandler will register the handler for the given method and path, guarded by the roles configured
+// for that route, if any
 func (rw *RouterWrapper) RegisterHandler(method string, path string, handlers ...gin.HandlerFunc) {
-	if rw.isEndpointActive(path) {
-		rw.router.Handle(method, path, handlers...)
+	routeConfig, isActive := rw.getActiveRouteConfig(path)
+	if !isActive {
+		return
 	}
+
+	if len(routeConfig.Roles) > 0 {
+		handlers = append([]gin.HandlerFunc{middleware.RequireRoles(routeConfig.Roles)}, handlers...)
+	}
+	rw.router.Handle(method, path, handlers...)
+}
+
+// IsEndpointActive returns true if the given endpoint is open in the routes config
+func (rw *RouterWrapper) IsEndpointActive(endpointToCheck string) bool {
+	_, isActive := rw.getActiveRouteConfig(endpointToCheck)
+
+	return isActive
 }
 
-func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
+func (rw *RouterWrapper) getActiveRouteConfig(endpointToCheck string) (config.RouteConfig, bool) {
 	rw.mutRoutesConfig.RLock()
 	routesConfig := rw.routesConfig
 	rw.mutRoutesConfig.RUnlock()
 	for _, endpoint := range routesConfig.Routes {
 		if endpoint.Name == endpointToCheck && endpoint.Open {
-			return true
+			return endpoint, true
 		}
 	}
 
-	return false
+	return config.RouteConfig{}, false
 }
@@ -6,6 +6,8 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForTrieInspect
+    generateForStateVerifier
 }
 
 generateForNode() {
@@ -78,6 +80,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForTrieInspect() {
+    HELP="
+# Trie inspection Tool CLI
+
+The **Trie inspection Tool** exposes the following Command Line Interface:
+$(code)
+\$ trieinspect --help
+
+$(./trieinspect/trieinspect --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trieinspect/CLI.md
+}
+
+generateForStateVerifier() {
+    HELP="
+# State verification Tool CLI
+
+The **State verification Tool** exposes the following Command Line Interface:
+$(code)
+\$ stateverifier --help
+
+$(./stateverifier/stateverifier --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./stateverifier/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
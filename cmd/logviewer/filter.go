@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	anyValue          = "*"
+	anyNumericValue   = -1
+	loggerNamesSplit  = ","
+	argsKeyValueSplit = " = "
+)
+
+// filterArgs holds the client side filters as provided on the command line
+type filterArgs struct {
+	loggerNames string
+	shard       string
+	epoch       int64
+	round       int64
+	subRound    string
+	pattern     string
+}
+
+// logLineFilter decides which of the received (or read) log lines are displayed. Empty string filters and negative
+// numeric filters match everything
+type logLineFilter struct {
+	loggerNamePatterns []string
+	shard              string
+	epoch              int64
+	round              int64
+	subRound           string
+	regex              *regexp.Regexp
+}
+
+func newLogLineFilter(args filterArgs) (*logLineFilter, error) {
+	llf := &logLineFilter{
+		loggerNamePatterns: make([]string, 0),
+		shard:              args.shard,
+		epoch:              args.epoch,
+		round:              args.round,
+		subRound:           args.subRound,
+	}
+
+	for _, name := range strings.Split(args.loggerNames, loggerNamesSplit) {
+		name = strings.TrimSpace(name)
+		if len(name) > 0 {
+			llf.loggerNamePatterns = append(llf.loggerNamePatterns, name)
+		}
+	}
+
+	if len(args.pattern) > 0 {
+		regex, err := regexp.Compile(args.pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter regex: %w", err)
+		}
+		llf.regex = regex
+	}
+
+	return llf, nil
+}
+
+func (llf *logLineFilter) matches(line *logger.LogLineWrapper) bool {
+	if !llf.matchesLoggerName(line.LoggerName) {
+		return false
+	}
+
+	correlation := line.Correlation
+	if len(llf.shard) > 0 && llf.shard != correlation.Shard {
+		return false
+	}
+	if llf.epoch > anyNumericValue && llf.epoch != int64(correlation.Epoch) {
+		return false
+	}
+	if llf.round > anyNumericValue && llf.round != correlation.Round {
+		return false
+	}
+	if len(llf.subRound) > 0 && llf.subRound != correlation.SubRound {
+		return false
+	}
+
+	if llf.regex == nil {
+		return true
+	}
+
+	return llf.regex.MatchString(messageWithArgs(line))
+}
+
+// matchesLoggerName accepts exact names, the * wildcard and prefixes ending in *, as in "process/*"
+func (llf *logLineFilter) matchesLoggerName(loggerName string) bool {
+	if len(llf.loggerNamePatterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range llf.loggerNamePatterns {
+		if pattern == anyValue || pattern == loggerName {
+			return true
+		}
+		if strings.HasSuffix(pattern, anyValue) && strings.HasPrefix(loggerName, strings.TrimSuffix(pattern, anyValue)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// messageWithArgs renders the message and its arguments the same way the text output does, so a regex written
+// while looking at the displayed logs matches the same lines
+func messageWithArgs(line *logger.LogLineWrapper) string {
+	builder := strings.Builder{}
+	builder.WriteString(line.Message)
+	for i := 1; i < len(line.Args); i += 2 {
+		builder.WriteString(" ")
+		builder.WriteString(line.Args[i-1])
+		builder.WriteString(argsKeyValueSplit)
+		builder.WriteString(line.Args[i])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go-logger/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogLine() *logger.LogLineWrapper {
+	return &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			LoggerName: "process/block",
+			Correlation: proto.LogCorrelationMessage{
+				Shard:    "0",
+				Epoch:    2,
+				Round:    150,
+				SubRound: "(END_ROUND)",
+			},
+			Message:  "block committed",
+			LogLevel: int32(logger.LogDebug),
+			Args:     []string{"nonce", "148", "hash", "aabbcc"},
+		},
+	}
+}
+
+func createAllPassingFilterArgs() filterArgs {
+	return filterArgs{
+		epoch: anyNumericValue,
+		round: anyNumericValue,
+	}
+}
+
+func TestNewLogLineFilter_InvalidRegexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createAllPassingFilterArgs()
+	args.pattern = "nonce = ("
+
+	llf, err := newLogLineFilter(args)
+
+	assert.Nil(t, llf)
+	assert.NotNil(t, err)
+}
+
+func TestLogLineFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	line := createLogLine()
+	testCases := []struct {
+		name          string
+		changeArgs    func(args *filterArgs)
+		shouldMatch bool
+	}{
+		{"no filters", func(args *filterArgs) {}, true},
+		{"exact logger name", func(args *filterArgs) { args.loggerNames = "api, process/block" }, true},
+		{"logger name prefix", func(args *filterArgs) { args.loggerNames = "process/*" }, true},
+		{"other logger name", func(args *filterArgs) { args.loggerNames = "process/bl" }, false},
+		{"shard", func(args *filterArgs) { args.shard = "0" }, true},
+		{"other shard", func(args *filterArgs) { args.shard = "metachain" }, false},
+		{"epoch", func(args *filterArgs) { args.epoch = 2 }, true},
+		{"other epoch", func(args *filterArgs) { args.epoch = 0 }, false},
+		{"round", func(args *filterArgs) { args.round = 150 }, true},
+		{"other round", func(args *filterArgs) { args.round = 151 }, false},
+		{"subround", func(args *filterArgs) { args.subRound = "(END_ROUND)" }, true},
+		{"other subround", func(args *filterArgs) { args.subRound = "(BLOCK)" }, false},
+		{"regex on arguments", func(args *filterArgs) { args.pattern = `nonce = 14[0-9]` }, true},
+		{"regex not matching", func(args *filterArgs) { args.pattern = `nonce = 15[0-9]` }, false},
+	}
+
+	for _, tc := range testCases {
+		args := createAllPassingFilterArgs()
+		tc.changeArgs(&args)
+
+		llf, err := newLogLineFilter(args)
+		require.Nil(t, err)
+		assert.Equal(t, tc.shouldMatch, llf.matches(line), tc.name)
+	}
+}
+
+func TestJsonLinesFormatter_OutputCanBeReadBack(t *testing.T) {
+	t.Parallel()
+
+	line := createLogLine()
+	line.Timestamp = 1600000000123456789
+
+	data := (&jsonLinesFormatter{}).Output(line)
+	require.Equal(t, byte('\n'), data[len(data)-1])
+
+	recovered, ok := parseJsonLogLine(string(data[:len(data)-1]))
+	require.True(t, ok)
+	assert.Equal(t, line.Correlation, recovered.Correlation)
+	assert.Equal(t, line.LoggerName, recovered.LoggerName)
+	assert.Equal(t, line.Message, recovered.Message)
+	assert.Equal(t, line.LogLevel, recovered.LogLevel)
+	assert.Equal(t, line.Timestamp, recovered.Timestamp)
+	assert.Equal(t, []string{"hash", "aabbcc", "nonce", "148"}, recovered.Args)
+}
+
+func TestParsePlainLogLine(t *testing.T) {
+	t.Parallel()
+
+	text := "DEBUG[2020-09-13 12:26:40.123] [process/block]      [0/2/150/(END_ROUND)] block committed                          nonce = 148 "
+	line, ok := parsePlainLogLine(text)
+	require.True(t, ok)
+	assert.Equal(t, "process/block", line.LoggerName)
+	assert.Equal(t, createLogLine().Correlation, line.Correlation)
+	assert.Equal(t, "block committed                          nonce = 148", line.Message)
+	assert.Equal(t, int32(logger.LogDebug), line.LogLevel)
+
+	text = "INFO [2020-09-13 12:26:40.123]   starting node "
+	line, ok = parsePlainLogLine(text)
+	require.True(t, ok)
+	assert.Equal(t, "", line.LoggerName)
+	assert.Equal(t, "starting node", line.Message)
+
+	_, ok = parsePlainLogLine("not a log line")
+	assert.False(t, ok)
+}
+
+func TestProfileHolder_ApplyCommand(t *testing.T) {
+	t.Parallel()
+
+	holder := newProfileHolder(logger.Profile{LogLevelPatterns: "*:INFO"}, false)
+
+	_, err := holder.applyCommand("level")
+	assert.NotNil(t, err)
+	_, err = holder.applyCommand("correlation maybe")
+	assert.NotNil(t, err)
+	_, err = holder.applyCommand("unknown value")
+	assert.NotNil(t, err)
+	_, isCustom := holder.get()
+	assert.False(t, isCustom)
+
+	_, err = holder.applyCommand("level *:DEBUG,api:TRACE")
+	assert.Nil(t, err)
+	_, err = holder.applyCommand("correlation on")
+	assert.Nil(t, err)
+	profile, err := holder.applyCommand("logger-name ON")
+	assert.Nil(t, err)
+
+	expectedProfile := logger.Profile{
+		LogLevelPatterns: "*:DEBUG,api:TRACE",
+		WithCorrelation:  true,
+		WithLoggerName:   true,
+	}
+	assert.Equal(t, expectedProfile, profile)
+	profile, isCustom = holder.get()
+	assert.True(t, isCustom)
+	assert.Equal(t, expectedProfile, profile)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go-logger/proto"
+)
+
+// jsonLogLine is the representation of a log line in the JSON lines output, one object per line
+type jsonLogLine struct {
+	Timestamp string            `json:"timestamp"`
+	Level     string            `json:"level"`
+	Logger    string            `json:"logger"`
+	Shard     string            `json:"shard"`
+	Epoch     uint32            `json:"epoch"`
+	Round     int64             `json:"round"`
+	SubRound  string            `json:"subround"`
+	Message   string            `json:"message"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// jsonLinesFormatter implements the logger.Formatter interface and outputs each log line as a JSON object
+// followed by a new line. Unlike the text formatters, the logger name and the correlation elements are always
+// written as they are needed when ingesting the logs
+type jsonLinesFormatter struct {
+}
+
+// Output converts the provided LogLineHandler into a JSON object terminated by a new line
+func (jlf *jsonLinesFormatter) Output(line logger.LogLineHandler) []byte {
+	if line == nil {
+		return nil
+	}
+
+	correlation := line.GetCorrelation()
+	jll := &jsonLogLine{
+		Timestamp: time.Unix(0, line.GetTimestamp()).UTC().Format(time.RFC3339Nano),
+		Level:     strings.TrimSpace(logger.LogLevel(line.GetLogLevel()).String()),
+		Logger:    line.GetLoggerName(),
+		Shard:     correlation.Shard,
+		Epoch:     correlation.Epoch,
+		Round:     correlation.Round,
+		SubRound:  correlation.SubRound,
+		Message:   line.GetMessage(),
+	}
+
+	args := line.GetArgs()
+	if len(args) > 1 {
+		jll.Args = make(map[string]string, len(args)/2)
+		for i := 1; i < len(args); i += 2 {
+			jll.Args[args[i-1]] = args[i]
+		}
+	}
+
+	data, err := json.Marshal(jll)
+	if err != nil {
+		return nil
+	}
+
+	return append(data, '\n')
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jlf *jsonLinesFormatter) IsInterfaceNil() bool {
+	return jlf == nil
+}
+
+// unmarshalJsonLogLine recovers a log line written by the jsonLinesFormatter. The arguments are sorted by name as
+// their original order is not kept in the JSON object
+func unmarshalJsonLogLine(data []byte) (*logger.LogLineWrapper, error) {
+	jll := &jsonLogLine{}
+	err := json.Unmarshal(data, jll)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, jll.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	logLevel, err := logger.GetLogLevel(jll.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	argNames := make([]string, 0, len(jll.Args))
+	for name := range jll.Args {
+		argNames = append(argNames, name)
+	}
+	sort.Strings(argNames)
+
+	args := make([]string, 0, len(jll.Args)*2)
+	for _, name := range argNames {
+		args = append(args, name, jll.Args[name])
+	}
+
+	return &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			LoggerName: jll.Logger,
+			Correlation: proto.LogCorrelationMessage{
+				Shard:    jll.Shard,
+				Epoch:    jll.Epoch,
+				Round:    jll.Round,
+				SubRound: jll.SubRound,
+			},
+			Message:   jll.Message,
+			LogLevel:  int32(logLevel),
+			Args:      args,
+			Timestamp: timestamp.UnixNano(),
+		},
+	}, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go-logger/proto"
+)
+
+const (
+	jsonLinesExtension   = ".jsonl"
+	plainTimestampLayout = "2006-01-02 15:04:05.000"
+	maxLineSizeInBytes   = 10 * 1024 * 1024
+)
+
+// plainLineRegex splits a line written by the logger.PlainFormatter into the level, the timestamp and the rest
+var plainLineRegex = regexp.MustCompile(`^(TRACE|DEBUG|INFO |WARN |ERROR|NONE )\[([0-9-]+ [0-9:.]+)\] (.*)$`)
+
+// correlationRegex matches the [shard/epoch/round/subround] correlation elements
+var correlationRegex = regexp.MustCompile(`^\[([^/\]]*)/([0-9]+)/(-?[0-9]+)/([^\]]*)\]`)
+
+var bracketsRegex = regexp.MustCompile(`^\[([^\]]*)\]`)
+
+// searchLogFile reads a previously saved log file and calls the handler for each recognized log line. Files with the
+// .jsonl extension are expected to be written by the jsonLinesFormatter, the others by the logger.PlainFormatter.
+// It returns the number of lines that could not be parsed
+func searchLogFile(path string, handler func(line *logger.LogLineWrapper)) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	parseLine := parsePlainLogLine
+	if strings.EqualFold(filepath.Ext(path), jsonLinesExtension) {
+		parseLine = parseJsonLogLine
+	}
+
+	numUnrecognized := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSizeInBytes)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if len(strings.TrimSpace(text)) == 0 {
+			continue
+		}
+
+		line, ok := parseLine(text)
+		if !ok {
+			numUnrecognized++
+			continue
+		}
+
+		handler(line)
+	}
+
+	return numUnrecognized, scanner.Err()
+}
+
+func parseJsonLogLine(text string) (*logger.LogLineWrapper, bool) {
+	line, err := unmarshalJsonLogLine([]byte(text))
+	if err != nil {
+		return nil, false
+	}
+
+	return line, true
+}
+
+// parsePlainLogLine does a best effort parsing of a text log line: the logger name and the correlation elements are
+// recovered only if they were enabled when the file was written, and the arguments remain part of the message as the
+// text format does not delimit them
+func parsePlainLogLine(text string) (*logger.LogLineWrapper, bool) {
+	parts := plainLineRegex.FindStringSubmatch(text)
+	if parts == nil {
+		return nil, false
+	}
+
+	logLevel, err := logger.GetLogLevel(parts[1])
+	if err != nil {
+		return nil, false
+	}
+	timestamp, err := time.ParseInLocation(plainTimestampLayout, parts[2], time.Local)
+	if err != nil {
+		return nil, false
+	}
+
+	message := parts[3]
+	loggerName := ""
+	correlation := proto.LogCorrelationMessage{}
+
+	message = strings.TrimLeft(message, " ")
+	if !correlationRegex.MatchString(message) {
+		name := bracketsRegex.FindStringSubmatch(message)
+		if name != nil {
+			loggerName = name[1]
+			message = strings.TrimLeft(message[len(name[0]):], " ")
+		}
+	}
+
+	elements := correlationRegex.FindStringSubmatch(message)
+	if elements != nil {
+		epoch, errEpoch := strconv.ParseUint(elements[2], 10, 32)
+		round, errRound := strconv.ParseInt(elements[3], 10, 64)
+		if errEpoch == nil && errRound == nil {
+			correlation = proto.LogCorrelationMessage{
+				Shard:    elements[1],
+				Epoch:    uint32(epoch),
+				Round:    round,
+				SubRound: elements[4],
+			}
+			message = message[len(elements[0]):]
+		}
+	}
+
+	return &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			LoggerName:  loggerName,
+			Correlation: correlation,
+			Message:     strings.TrimSpace(message),
+			LogLevel:    int32(logLevel),
+			Timestamp:   timestamp.UnixNano(),
+		},
+	}, true
+}
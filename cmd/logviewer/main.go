@@ -8,6 +8,8 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -23,6 +25,10 @@ const (
 	wsLogPath      = "/log"
 	ws             = "ws"
 	wss            = "wss"
+
+	outputFormatText      = "text"
+	outputFormatJsonLines = "jsonl"
+	inputFilesSplit       = ","
 )
 
 type config struct {
@@ -33,6 +39,9 @@ type config struct {
 	useWss             bool
 	logWithCorrelation bool
 	logWithLoggerName  bool
+	outputFormat       string
+	inputFiles         string
+	filter             filterArgs
 }
 
 var (
@@ -92,6 +101,61 @@ VERSION:
 		Usage:       "Boolean option for logger name in the logs.",
 		Destination: &argsConfig.logWithLoggerName,
 	}
+	// outputFormat defines the format of the displayed and saved log lines
+	outputFormat = cli.StringFlag{
+		Name: "output-format",
+		Usage: "The `format` of the displayed and saved log lines: " + outputFormatText + " or " + outputFormatJsonLines +
+			". The " + outputFormatJsonLines + " format writes one JSON object per line, always containing the logger name and " +
+			"the correlation elements.",
+		Value:       outputFormatText,
+		Destination: &argsConfig.outputFormat,
+	}
+	// inputFiles defines the previously saved log files to be searched instead of connecting to a node
+	inputFiles = cli.StringFlag{
+		Name: "input-files",
+		Usage: "Comma-separated `paths` of previously saved log files to be searched with the filter flags instead of " +
+			"connecting to a node. Files with the " + jsonLinesExtension + " extension are read as JSON lines, the others as text logs.",
+		Destination: &argsConfig.inputFiles,
+	}
+	// filterLogger defines the logger names filter
+	filterLogger = cli.StringFlag{
+		Name: "filter-logger",
+		Usage: "Only display the lines of these comma-separated logger `names`. A name ending in * matches all the " +
+			"loggers with that prefix, as in process/*",
+		Destination: &argsConfig.filter.loggerNames,
+	}
+	// filterShard defines the shard correlation filter
+	filterShard = cli.StringFlag{
+		Name:        "filter-shard",
+		Usage:       "Only display the lines having this `shard` correlation element, as in 0 or metachain",
+		Destination: &argsConfig.filter.shard,
+	}
+	// filterEpoch defines the epoch correlation filter
+	filterEpoch = cli.Int64Flag{
+		Name:        "filter-epoch",
+		Usage:       "Only display the lines having this `epoch` correlation element. Negative values disable the filter",
+		Value:       anyNumericValue,
+		Destination: &argsConfig.filter.epoch,
+	}
+	// filterRound defines the round correlation filter
+	filterRound = cli.Int64Flag{
+		Name:        "filter-round",
+		Usage:       "Only display the lines having this `round` correlation element. Negative values disable the filter",
+		Value:       anyNumericValue,
+		Destination: &argsConfig.filter.round,
+	}
+	// filterSubRound defines the subround correlation filter
+	filterSubRound = cli.StringFlag{
+		Name:        "filter-subround",
+		Usage:       "Only display the lines having this `subround` correlation element, as in (START_ROUND)",
+		Destination: &argsConfig.filter.subRound,
+	}
+	// filterRegex defines the regular expression filter
+	filterRegex = cli.StringFlag{
+		Name:        "filter-regex",
+		Usage:       "Only display the lines whose message and arguments match this regular `expression`",
+		Destination: &argsConfig.filter.pattern,
+	}
 	// workingDirectory defines a flag for the path for the working directory.
 	workingDirectory = cli.StringFlag{
 		Name:        "working-directory",
@@ -105,6 +169,8 @@ VERSION:
 	log           = logger.GetOrCreate("logviewer")
 	cliApp        *cli.App
 	webSocket     *websocket.Conn
+	mutWebSocket  sync.Mutex
+	lineFilter    *logLineFilter
 	fileForLogs   *os.File
 	marshalizer   marshal.Marshalizer
 	retryDuration = time.Second * 10
@@ -139,6 +205,14 @@ func initCliFlags() {
 		useWss,
 		logWithCorrelation,
 		logWithLoggerName,
+		outputFormat,
+		inputFiles,
+		filterLogger,
+		filterShard,
+		filterEpoch,
+		filterRound,
+		filterSubRound,
+		filterRegex,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -165,8 +239,18 @@ func startLogViewer(ctx *cli.Context) error {
 		}
 	}
 
+	lineFilter, err = newLogLineFilter(argsConfig.filter)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := prepareOutput(argsConfig.outputFormat)
+	if err != nil {
+		return err
+	}
+
 	if argsConfig.logSave {
-		err = prepareLogFile()
+		err = prepareLogFile(formatter)
 		if err != nil {
 			return err
 		}
@@ -176,7 +260,7 @@ func startLogViewer(ctx *cli.Context) error {
 		}()
 	}
 
-	profile := &logger.Profile{
+	profile := logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
 		WithLoggerName:   argsConfig.logWithLoggerName,
@@ -187,23 +271,35 @@ func startLogViewer(ctx *cli.Context) error {
 		log.LogIfError(err)
 	}
 
+	if len(argsConfig.inputFiles) > 0 {
+		return searchLogFiles(argsConfig.inputFiles)
+	}
+
+	holder := newProfileHolder(profile, customLogProfile)
+	go readProfileCommands(os.Stdin, holder, changeProfile)
+	log.Info("the log profile can be changed by typing commands followed by enter", "usage", profileCommandsUsage)
+
 	go func() {
 		for {
-			webSocket, err = openWebSocket(argsConfig.address)
-			if err != nil {
-				log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "error", err.Error())
+			conn, errOpen := openWebSocket(argsConfig.address)
+			if errOpen != nil {
+				log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "error", errOpen.Error())
 				time.Sleep(retryDuration)
 				continue
 			}
 
-			if customLogProfile {
-				err = sendProfile(webSocket, profile)
+			currentProfile, isCustom := holder.get()
+			mutWebSocket.Lock()
+			webSocket = conn
+			if isCustom {
+				errOpen = sendProfile(conn, &currentProfile)
 			} else {
-				err = sendDefaultProfileIdentifier(webSocket)
+				errOpen = sendDefaultProfileIdentifier(conn)
 			}
-			log.LogIfError(err)
+			mutWebSocket.Unlock()
+			log.LogIfError(errOpen)
 
-			listeningOnWebSocket()
+			listeningOnWebSocket(conn)
 			time.Sleep(retryDuration)
 		}
 	}()
@@ -212,7 +308,7 @@ func startLogViewer(ctx *cli.Context) error {
 	lowestLogLevel := getLowestLogLevel(logLevels)
 	log.SetLevel(lowestLogLevel)
 
-	waitForUserToTerminateApp(webSocket)
+	waitForUserToTerminateApp()
 
 	return nil
 }
@@ -228,20 +324,69 @@ func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
 	return lowest
 }
 
-func prepareLogFile() error {
+// prepareOutput replaces the console output with the JSON lines one when required and returns the formatter to be
+// used for the saved logs
+func prepareOutput(format string) (logger.Formatter, error) {
+	switch format {
+	case outputFormatText:
+		return &logger.PlainFormatter{}, nil
+	case outputFormatJsonLines:
+		formatter := &jsonLinesFormatter{}
+		logger.ClearLogObservers()
+		err := logger.AddLogObserver(os.Stdout, formatter)
+		if err != nil {
+			return nil, err
+		}
+
+		return formatter, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+func prepareLogFile(formatter logger.Formatter) error {
 	logDirectory := filepath.Join(argsConfig.workingDir, defaultLogPath)
 	logsFile, err := core.CreateFile(
 		core.ArgCreateFileArgument{
 			Prefix:        "logviewer",
 			Directory:     logDirectory,
-			FileExtension: "log",
+			FileExtension: fileExtension(formatter),
 		},
 	)
 	if err != nil {
 		return err
 	}
+	fileForLogs = logsFile
+
+	return logger.AddLogObserver(logsFile, formatter)
+}
+
+func fileExtension(formatter logger.Formatter) string {
+	_, isJsonLines := formatter.(*jsonLinesFormatter)
+	if isJsonLines {
+		return strings.TrimPrefix(jsonLinesExtension, ".")
+	}
 
-	return logger.AddLogObserver(logsFile, &logger.PlainFormatter{})
+	return "log"
+}
+
+func searchLogFiles(paths string) error {
+	for _, path := range strings.Split(paths, inputFilesSplit) {
+		path = strings.TrimSpace(path)
+		if len(path) == 0 {
+			continue
+		}
+
+		numUnrecognized, err := searchLogFile(path, outputLogLine)
+		if err != nil {
+			return fmt.Errorf("%w while searching %s", err, path)
+		}
+		if numUnrecognized > 0 {
+			log.Debug("skipped unrecognized lines", "file", path, "num lines", numUnrecognized)
+		}
+	}
+
+	return nil
 }
 
 func openWebSocket(address string) (*websocket.Conn, error) {
@@ -278,9 +423,31 @@ func sendDefaultProfileIdentifier(conn *websocket.Conn) error {
 	return conn.WriteMessage(websocket.TextMessage, []byte(core.DefaultLogProfileIdentifier))
 }
 
-func listeningOnWebSocket() {
+// changeProfile applies the profile locally, so the logger name and the correlation elements are displayed as
+// requested, and sends it to the node, if connected
+func changeProfile(profile logger.Profile) {
+	err := profile.Apply()
+	log.LogIfError(err)
+
+	mutWebSocket.Lock()
+	defer mutWebSocket.Unlock()
+
+	if webSocket == nil {
+		return
+	}
+
+	err = sendProfile(webSocket, &profile)
+	if err != nil {
+		log.Warn("can not send the log profile", "error", err.Error())
+		return
+	}
+
+	log.Info("log profile sent", "profile", profile.String())
+}
+
+func listeningOnWebSocket(conn *websocket.Conn) {
 	for {
-		msgType, message, err := webSocket.ReadMessage()
+		msgType, message, err := conn.ReadMessage()
 		if msgType == websocket.CloseMessage {
 			return
 		}
@@ -299,16 +466,21 @@ func listeningOnWebSocket() {
 	}
 }
 
-func waitForUserToTerminateApp(conn *websocket.Conn) {
+func waitForUserToTerminateApp() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigs
 
 	log.Info("terminating logviewer app at user's signal...")
+	mutWebSocket.Lock()
+	conn := webSocket
 	if conn != nil {
 		err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
 		log.LogIfError(err)
+	}
+	mutWebSocket.Unlock()
+	if conn != nil {
 		time.Sleep(time.Second)
 	}
 
@@ -324,6 +496,14 @@ func outputMessage(message []byte) {
 		return
 	}
 
+	outputLogLine(logLine)
+}
+
+func outputLogLine(logLine *logger.LogLineWrapper) {
+	if !lineFilter.matches(logLine) {
+		return
+	}
+
 	recoveredLogLine := &logger.LogLine{
 		LoggerName:  logLine.LoggerName,
 		Correlation: logLine.Correlation,
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const (
+	commandLevel       = "level"
+	commandCorrelation = "correlation"
+	commandLoggerName  = "logger-name"
+	valueOn            = "on"
+	valueOff           = "off"
+)
+
+var profileCommandsUsage = fmt.Sprintf("available commands: %s <patterns>, %s %s|%s, %s %s|%s",
+	commandLevel, commandCorrelation, valueOn, valueOff, commandLoggerName, valueOn, valueOff)
+
+// profileHolder keeps the log profile requested from the node, as it can be changed while connected and has to be
+// sent again after a reconnect
+type profileHolder struct {
+	mut      sync.RWMutex
+	profile  logger.Profile
+	isCustom bool
+}
+
+func newProfileHolder(profile logger.Profile, isCustom bool) *profileHolder {
+	return &profileHolder{
+		profile:  profile,
+		isCustom: isCustom,
+	}
+}
+
+// get returns the current profile and whether it differs from the node's own profile
+func (ph *profileHolder) get() (logger.Profile, bool) {
+	ph.mut.RLock()
+	defer ph.mut.RUnlock()
+
+	return ph.profile, ph.isCustom
+}
+
+// applyCommand changes the profile as instructed by a command line such as "level *:DEBUG,process:TRACE",
+// "correlation on" or "logger-name off"
+func (ph *profileHolder) applyCommand(command string) (logger.Profile, error) {
+	fields := strings.Fields(command)
+	if len(fields) != 2 {
+		return logger.Profile{}, fmt.Errorf("invalid command %q, %s", command, profileCommandsUsage)
+	}
+
+	ph.mut.Lock()
+	defer ph.mut.Unlock()
+
+	newProfile := ph.profile
+	switch fields[0] {
+	case commandLevel:
+		_, _, err := logger.ParseLogLevelAndMatchingString(fields[1])
+		if err != nil {
+			return logger.Profile{}, err
+		}
+		newProfile.LogLevelPatterns = fields[1]
+	case commandCorrelation:
+		enabled, err := parseOnOff(fields[1])
+		if err != nil {
+			return logger.Profile{}, err
+		}
+		newProfile.WithCorrelation = enabled
+	case commandLoggerName:
+		enabled, err := parseOnOff(fields[1])
+		if err != nil {
+			return logger.Profile{}, err
+		}
+		newProfile.WithLoggerName = enabled
+	default:
+		return logger.Profile{}, fmt.Errorf("unknown command %q, %s", fields[0], profileCommandsUsage)
+	}
+
+	ph.profile = newProfile
+	ph.isCustom = true
+
+	return newProfile, nil
+}
+
+func parseOnOff(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case valueOn:
+		return true, nil
+	case valueOff:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid value %q, expected %s or %s", value, valueOn, valueOff)
+	}
+}
+
+// readProfileCommands reads commands, one per line, until the reader is exhausted. Each new profile is handed to
+// the onChange function
+func readProfileCommands(reader io.Reader, holder *profileHolder, onChange func(profile logger.Profile)) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		command := strings.TrimSpace(scanner.Text())
+		if len(command) == 0 {
+			continue
+		}
+
+		profile, err := holder.applyCommand(command)
+		if err != nil {
+			log.Warn("log profile not changed", "error", err.Error())
+			continue
+		}
+
+		onChange(profile)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 )
 
 // HeaderSigVerifierHandler is the interface needed to check that a header's signature is correct
@@ -40,3 +41,9 @@ type FileLoggingHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// AlertsEngineHandler defines the alerting rules engine evaluated over the status metrics
+type AlertsEngineHandler interface {
+	GetAlerts() []alerting.Alert
+	IsInterfaceNil() bool
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	storageResolversContainers "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/storageResolversContainer"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersLatency"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
@@ -58,6 +59,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/scheduledTxs"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -1152,6 +1154,7 @@ func newShardInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
+		PeersLatencyHandler:       peersLatency.NewDisabledPeersLatencyTracker(),
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1214,6 +1217,7 @@ func newMetaInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
+		PeersLatencyHandler:       peersLatency.NewDisabledPeersLatencyTracker(),
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1540,6 +1544,9 @@ func newShardBlockProcessor(
 		Marshalizer:      core.InternalMarshalizer,
 		Accounts:         stateComponents.AccountsAdapter,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    epochNotifier,
+
+		ESDTNFTRoyaltiesPaymentEnableEpoch: generalConfig.GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1874,6 +1881,9 @@ func newMetaBlockProcessor(
 		Marshalizer:      core.InternalMarshalizer,
 		Accounts:         stateComponents.AccountsAdapter,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    epochNotifier,
+
+		ESDTNFTRoyaltiesPaymentEnableEpoch: generalConfig.GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -2270,12 +2280,31 @@ func newMetaBlockProcessor(
 		CorrectLastUnJailEnableEpoch:           systemSCConfig.StakingSystemSCConfig.CorrectLastUnjailedEpoch,
 		ESDTOwnerAddressBytes:                  esdtOwnerAddress,
 		ESDTEnableEpoch:                        systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
+		ScheduledTxEnableEpoch:                 systemSCConfig.ScheduledTxSystemSCConfig.EnabledEpoch,
 	}
 	epochStartSystemSCProcessor, err := metachainEpochStart.NewSystemSCProcessor(argsEpochSystemSC)
 	if err != nil {
 		return nil, err
 	}
 
+	argsDueTxsExecutor := scheduledTxs.ArgsDueTxsExecutor{
+		SystemVM:             systemVM,
+		AccountsDB:           stateComponents.AccountsAdapter,
+		ShardCoordinator:     shardCoordinator,
+		ScrForwarder:         scForwarder,
+		FeeHandler:           txFeeHandler,
+		Marshalizer:          core.InternalMarshalizer,
+		Hasher:               core.Hasher,
+		EndOfEpochAddress:    vm.EndOfEpochAddress,
+		ScheduledTxSCAddress: vm.ScheduledTxSCAddress,
+		EnableEpoch:          systemSCConfig.ScheduledTxSystemSCConfig.EnabledEpoch,
+		EpochNotifier:        epochNotifier,
+	}
+	dueTxsExecutor, err := scheduledTxs.NewDueTxsExecutor(argsDueTxsExecutor)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCToProtocol:                 smartContractToProtocol,
@@ -2286,6 +2315,7 @@ func newMetaBlockProcessor(
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+		ScheduledTxsExecutor:         dueTxsExecutor,
 		RewardsV2EnableEpoch:         systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
 	}
 
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
@@ -15,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/persister"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
@@ -33,6 +35,7 @@ type ArgStatusHandlers struct {
 	Uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
 	ChanStartViews               chan struct{}
 	ChanLogRewrite               chan struct{}
+	AlertingConfig               config.AlertingConfig
 }
 
 // StatusHandlersInfo is struct that stores all components that are returned when status handlers are created
@@ -42,6 +45,7 @@ type statusHandlersInfo struct {
 	StatusMetrics            external.StatusMetricsHandler
 	PersistentHandler        *persister.PersistentStatusHandler
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	AlertsEngine             AlertsEngineHandler
 }
 
 // NewStatusHandlersFactoryArgs will return arguments for status handlers
@@ -52,6 +56,7 @@ func NewStatusHandlersFactoryArgs(
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
 	chanStartViews chan struct{},
 	chanLogRewrite chan struct{},
+	alertingConfig config.AlertingConfig,
 ) (*ArgStatusHandlers, error) {
 	baseErrMessage := "error creating status handler factory arguments"
 	if ctx == nil {
@@ -77,6 +82,7 @@ func NewStatusHandlersFactoryArgs(
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		ChanStartViews:           chanStartViews,
 		ChanLogRewrite:           chanLogRewrite,
+		AlertingConfig:           alertingConfig,
 	}, nil
 }
 
@@ -132,6 +138,15 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	}
 	appStatusHandlers = append(appStatusHandlers, persistentHandler)
 
+	var alertsEngine alertingEngineHandler
+	if arguments.AlertingConfig.Enabled {
+		alertsEngine, err = createAlertsEngine(arguments.AlertingConfig)
+		if err != nil {
+			return nil, err
+		}
+		appStatusHandlers = append(appStatusHandlers, alertsEngine)
+	}
+
 	if len(appStatusHandlers) > 0 {
 		handler, err = statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
 		if err != nil {
@@ -147,9 +162,40 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	statusHandlersInfoObject.UseTermUI = useTermui
 	statusHandlersInfoObject.StatusMetrics = statusMetrics
 	statusHandlersInfoObject.PersistentHandler = persistentHandler
+
+	if alertsEngine != nil {
+		err = alertsEngine.SetStatusHandler(handler)
+		if err != nil {
+			return nil, err
+		}
+		alertsEngine.StartEvaluation()
+		statusHandlersInfoObject.AlertsEngine = alertsEngine
+	}
+
 	return statusHandlersInfoObject, nil
 }
 
+type alertingEngineHandler interface {
+	core.AppStatusHandler
+	GetAlerts() []alerting.Alert
+	SetStatusHandler(handler core.AppStatusHandler) error
+	StartEvaluation()
+}
+
+func createAlertsEngine(alertingConfig config.AlertingConfig) (alertingEngineHandler, error) {
+	sinks, err := alerting.CreateSinks(alertingConfig.Sinks)
+	if err != nil {
+		return nil, err
+	}
+
+	args := alerting.ArgsAlertsEngine{
+		Config: alertingConfig,
+		Sinks:  sinks,
+	}
+
+	return alerting.NewAlertsEngine(args)
+}
+
 // UpdateStorerAndMetricsForPersistentHandler will set storer for persistent status handler
 func (shi *statusHandlersInfo) UpdateStorerAndMetricsForPersistentHandler(store storage.Storer) error {
 	err := shi.PersistentHandler.SetStorage(store)
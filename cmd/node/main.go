@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -34,7 +35,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -43,8 +47,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -62,13 +70,16 @@ import (
 	trieIteratorsFactory "github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -102,8 +113,14 @@ const (
 	secondsToWaitForP2PBootstrap = 20
 	maxTimeToClose               = 10 * time.Second
 	maxMachineIDLen              = 10
+	numTopDataTriesInStatistics  = 20
 )
 
+type closableProfilesCapturer interface {
+	facade.ProfilesCapturer
+	io.Closer
+}
+
 var (
 	nodeHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
@@ -381,6 +398,31 @@ VERSION:
 		Usage: "This flag specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)",
 		Value: 0,
 	}
+
+	// importBootstrapSnapshot defines a flag for the optional directory holding a snapshot of the epoch start data used
+	// by the start in epoch bootstrap instead of requesting it from the network
+	importBootstrapSnapshot = cli.StringFlag{
+		Name: "import-bootstrap-snapshot",
+		Usage: "This flag, if set, will make the node start in epoch using the headers, mini blocks and trie nodes from " +
+			"the provided snapshot directory. The data missing from the snapshot is requested from the network",
+		Value: "",
+	}
+	// bootstrapSnapshotTrustedHash defines a flag for the optional hex encoded epoch start meta block hash the bootstrap
+	// snapshot is trusted for
+	bootstrapSnapshotTrustedHash = cli.StringFlag{
+		Name: "bootstrap-snapshot-trusted-hash",
+		Usage: "This flag, if set, will make the node use the epoch start meta block from the bootstrap snapshot if it " +
+			"matches the provided hex encoded hash, without requesting it from the network. Can be used only if the " +
+			"import-bootstrap-snapshot was set",
+		Value: "",
+	}
+
+	// checkConfig defines a flag for validating the configuration files without starting the node
+	checkConfig = cli.BoolFlag{
+		Name: "check-config",
+		Usage: "Boolean option for validating the configuration files, reporting all the errors found, without starting " +
+			"the node. The process exits with a non-zero code if the configuration is invalid.",
+	}
 )
 
 // appVersion should be populated at build time using ldflags
@@ -449,6 +491,9 @@ func main() {
 		importDbDirectory,
 		importDbNoSigCheck,
 		redundancyLevel,
+		importBootstrapSnapshot,
+		bootstrapSnapshotTrustedHash,
+		checkConfig,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -458,6 +503,10 @@ func main() {
 	}
 
 	app.Action = func(c *cli.Context) error {
+		if c.GlobalBool(checkConfig.Name) {
+			return checkConfigFiles(c, log)
+		}
+
 		return startNode(c, log, app.Version)
 	}
 
@@ -589,6 +638,26 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		p2pConfig.Node.Port = ctx.GlobalString(port.Name)
 	}
 
+	err = config.Validate(config.NodeConfigs{
+		General:   generalConfig,
+		ApiRoutes: apiRoutesConfig,
+		Economics: economicsConfig,
+		SystemSC:  systemSCConfig,
+		Ratings:   &ratingsConfig,
+		P2P:       p2pConfig,
+		FileNames: config.ConfigFileNames{
+			General:   configurationFileName,
+			ApiRoutes: configurationApiFileName,
+			Economics: configurationEconomicsFileName,
+			SystemSC:  configurationSystemSCConfigFileName,
+			Ratings:   configurationRatingsFileName,
+			P2P:       p2pConfigurationFileName,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.ChangeFileLifeSpan(time.Second * time.Duration(generalConfig.Logs.LogFileLifeSpanInSec))
 		if err != nil {
@@ -658,6 +727,21 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		}
 	}
 
+	bootstrapSnapshotDirectory := ctx.GlobalString(importBootstrapSnapshot.Name)
+	trustedEpochStartMetaHash, err := hex.DecodeString(ctx.GlobalString(bootstrapSnapshotTrustedHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the %s flag", err, bootstrapSnapshotTrustedHash.Name)
+	}
+	if len(trustedEpochStartMetaHash) > 0 && len(bootstrapSnapshotDirectory) == 0 {
+		return fmt.Errorf("the %s flag can be used only if the %s flag is set",
+			bootstrapSnapshotTrustedHash.Name, importBootstrapSnapshot.Name)
+	}
+	if len(bootstrapSnapshotDirectory) > 0 {
+		log.Info("start in epoch from bootstrap snapshot", "directory", bootstrapSnapshotDirectory,
+			"trusted epoch start meta hash", trustedEpochStartMetaHash)
+		generalConfig.GeneralSettings.StartInEpochEnabled = true
+	}
+
 	//TODO: The next 5 lines should be deleted when we are done testing from a precalculated (not hard coded) timestamp
 	if genesisNodesConfig.StartTime == 0 {
 		time.Sleep(1000 * time.Millisecond)
@@ -788,6 +872,18 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if ctx.IsSet(useHealthService.Name) {
 		healthService.Start()
 	}
+	trieSyncCheck := health.NewTrieSyncCheck()
+	healthService.RegisterComponent(trieSyncCheck)
+
+	tracingCloser, err := tracing.Start(generalConfig.Tracing, workingDir)
+	if err != nil {
+		return fmt.Errorf("%w while starting the tracing", err)
+	}
+
+	profiler, err := createProfiler(generalConfig, workingDir)
+	if err != nil {
+		return fmt.Errorf("%w while creating the profiler", err)
+	}
 
 	coreArgs := mainFactory.CoreComponentsFactoryArgs{
 		Config:                *generalConfig,
@@ -810,6 +906,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		coreComponents.Uint64ByteSliceConverter,
 		chanCreateViews,
 		chanLogRewrite,
+		generalConfig.Alerting,
 	)
 	if err != nil {
 		return err
@@ -991,6 +1088,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	trieNodesCache, err := trieFactory.CreateTrieNodesCache(generalConfig.TrieNodesCache)
+	if err != nil {
+		return err
+	}
+
 	epochStartBootstrapArgs := bootstrap.ArgsEpochStartBootstrap{
 		PublicKey:                  cryptoParams.PublicKey,
 		Marshalizer:                coreComponents.InternalMarshalizer,
@@ -1023,6 +1125,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		HeaderIntegrityVerifier:    headerIntegrityVerifier,
 		TxSignHasher:               coreComponents.TxSignHasher,
 		EpochNotifier:              epochNotifier,
+		TrieNodesCache:             trieNodesCache,
+		ImportSnapshotDirectory:    bootstrapSnapshotDirectory,
+		TrustedEpochStartMetaHash:  trustedEpochStartMetaHash,
 	}
 	bootstrapper, err := bootstrap.NewEpochStartBootstrap(epochStartBootstrapArgs)
 	if err != nil {
@@ -1035,6 +1140,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		log.Error("bootstrap return error", "error", err)
 		return err
 	}
+	trieSyncCheck.SetTrieSyncFinished()
 
 	trieContainer, trieStorageManager := bootstrapper.GetTriesComponents()
 	triesComponents := &mainFactory.TriesComponents{
@@ -1068,6 +1174,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	log.Trace("creating data components")
 	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	registerTrieStorageManagersForEpochStart(epochStartNotifier, triesComponents, currentEpoch)
 
 	dataArgs := mainFactory.DataComponentsFactoryArgs{
 		Config:             *generalConfig,
@@ -1090,6 +1197,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	healthService.RegisterComponent(dataComponents.Datapool.Transactions())
 	healthService.RegisterComponent(dataComponents.Datapool.UnsignedTransactions())
 	healthService.RegisterComponent(dataComponents.Datapool.RewardTransactions())
+	registerTxPoolMetrics(dataComponents.Datapool, shardCoordinator)
 
 	log.Trace("initializing metrics")
 	err = metrics.InitMetrics(
@@ -1440,6 +1548,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = registerHealthChecks(
+		healthService,
+		generalConfig.Health,
+		currentNode,
+		statusHandlersInfo.StatusMetrics,
+		networkComponents.NetMessenger,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating software checker structure")
 	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler, generalConfig.SoftwareVersionConfig)
 	if err != nil {
@@ -1490,6 +1609,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		networkComponents,
 		processComponents,
 		shardCoordinator,
+		cryptoParams.PublicKeyString,
 	)
 	if err != nil {
 		return err
@@ -1501,6 +1621,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = metrics.StartTrieNodesCachePolling(coreComponents.StatusHandler, trieNodesCache, statusPollingInterval)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
 
@@ -1526,6 +1651,48 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	ef.SetSyncer(syncer)
 	ef.SetTpsBenchmark(tpsBenchmark)
+	ef.SetHealthService(healthService)
+	ef.SetAlertsProvider(statusHandlersInfo.AlertsEngine)
+	ef.SetLogProfileManager(logging.NewLogProfileManager())
+	if !check.IfNil(profiler) {
+		ef.SetProfilesCapturer(profiler)
+	}
+
+	stateRetentionPolicy, err := trie.NewStateRetentionPolicy(generalConfig.StateTriesConfig.StateRetention)
+	if err != nil {
+		return err
+	}
+
+	trieStatisticsProvider, err := createTrieStatisticsProvider(triesComponents, stateComponents, coreComponents, stateRetentionPolicy)
+	if err != nil {
+		return err
+	}
+	ef.SetTrieStatisticsProvider(trieStatisticsProvider)
+
+	stateRetentionReporter, err := createStateRetentionReporter(generalConfig, triesComponents, pathManager, shardIdString, stateRetentionPolicy)
+	if err != nil {
+		return err
+	}
+	ef.SetStateRetentionReporter(stateRetentionReporter)
+
+	txPoolPersister, err := createTxPoolPersister(
+		generalConfig,
+		pathManager,
+		shardIdString,
+		shardCoordinator,
+		coreComponents,
+		stateComponents,
+		dataComponents,
+		cryptoComponents,
+		processComponents,
+		economicsData,
+		whiteListRequest,
+		whiteListerVerifiedTxs,
+		epochNotifier,
+	)
+	if err != nil {
+		return err
+	}
 
 	log.Trace("starting background services")
 	ef.StartBackgroundServices()
@@ -1537,6 +1704,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	txPoolPersister.LoadTransactions()
+	txPoolPersister.StartPeriodicSave()
+
 	log.Info("application is now running")
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -1550,7 +1720,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, txPoolPersister, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1559,6 +1729,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		log.Warn("force closing the node", "error", "closeAllComponents did not finished on time")
 	}
 
+	log.Debug("closing tracing")
+	err = tracingCloser.Close()
+	log.LogIfError(err)
+
+	if !check.IfNil(profiler) {
+		log.Debug("closing profiler")
+		err = profiler.Close()
+		log.LogIfError(err)
+	}
+
 	log.Debug("closing node")
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
@@ -1609,9 +1789,30 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 	storageConfig.DB.MaxBatchSize = storageConfig.DB.MaxBatchSize * int(alterCoefficient)
 }
 
+// createProfiler creates and starts the profiler storing its snapshots next to the health service records, returning
+// nil if the profiling is disabled
+func createProfiler(generalConfig *config.Config, workingDir string) (closableProfilesCapturer, error) {
+	if !generalConfig.Profiling.Enabled {
+		return nil, nil
+	}
+
+	argsProfiler := profiling.ArgsProfiler{
+		Config: generalConfig.Profiling,
+		Folder: filepath.Join(workingDir, generalConfig.Health.FolderPath, generalConfig.Profiling.FolderName),
+	}
+	profiler, err := profiling.NewProfiler(argsProfiler)
+	if err != nil {
+		return nil, err
+	}
+	profiler.Start()
+
+	return profiler, nil
+}
+
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	txPoolPersister dataRetriever.TxPoolPersister,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1621,6 +1822,10 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("saving and closing the transactions pool persister...")
+	err = txPoolPersister.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1773,6 +1978,73 @@ func enableGopsIfNeeded(ctx *cli.Context, log logger.Logger) {
 	log.Trace("gops", "enabled", gopsEnabled)
 }
 
+// checkConfigFiles loads and validates the configuration files, reporting all the errors found at once
+func checkConfigFiles(ctx *cli.Context, log logger.Logger) error {
+	configs := config.NodeConfigs{
+		General:   &config.Config{},
+		ApiRoutes: &config.ApiRoutesConfig{},
+		Economics: &config.EconomicsConfig{},
+		SystemSC:  &config.SystemSmartContractsConfig{},
+		Ratings:   &config.RatingsConfig{},
+		P2P:       &config.P2PConfig{},
+		FileNames: config.ConfigFileNames{
+			General:   ctx.GlobalString(configurationFile.Name),
+			ApiRoutes: ctx.GlobalString(configurationApiFile.Name),
+			Economics: ctx.GlobalString(configurationEconomicsFile.Name),
+			SystemSC:  ctx.GlobalString(configurationSystemSCFile.Name),
+			Ratings:   ctx.GlobalString(configurationRatingsFile.Name),
+			P2P:       ctx.GlobalString(p2pConfigurationFile.Name),
+		},
+	}
+
+	loadErrors := make(config.ValidationErrors, 0)
+	isLoaded := func(cfg interface{}, fileName string) bool {
+		err := core.LoadTomlFile(cfg, fileName)
+		if err != nil {
+			loadErrors = append(loadErrors, config.ValidationError{File: fileName, Message: err.Error()})
+			return false
+		}
+
+		log.Debug("config", "file", fileName)
+		return true
+	}
+
+	if !isLoaded(configs.General, configs.FileNames.General) {
+		configs.General = nil
+	}
+	if !isLoaded(configs.ApiRoutes, configs.FileNames.ApiRoutes) {
+		configs.ApiRoutes = nil
+	}
+	if !isLoaded(configs.Economics, configs.FileNames.Economics) {
+		configs.Economics = nil
+	}
+	if !isLoaded(configs.SystemSC, configs.FileNames.SystemSC) {
+		configs.SystemSC = nil
+	}
+	if !isLoaded(configs.Ratings, configs.FileNames.Ratings) {
+		configs.Ratings = nil
+	}
+	if !isLoaded(configs.P2P, configs.FileNames.P2P) {
+		configs.P2P = nil
+	}
+	_ = isLoaded(&config.Preferences{}, ctx.GlobalString(configurationPreferencesFile.Name))
+	_ = isLoaded(&config.ExternalConfig{}, ctx.GlobalString(externalConfigFile.Name))
+
+	err := config.Validate(configs)
+	validationErrors, ok := err.(config.ValidationErrors)
+	if err != nil && !ok {
+		return err
+	}
+
+	allErrors := append(loadErrors, validationErrors...)
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+
+	log.Info("the configuration files are valid")
+	return nil
+}
+
 func loadMainConfig(filepath string) (*config.Config, error) {
 	cfg := &config.Config{}
 	err := core.LoadTomlFile(cfg, filepath)
@@ -2449,6 +2721,117 @@ func createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func registerHealthChecks(
+	healthService health.ComponentsRegistrar,
+	healthConfig config.HealthServiceConfig,
+	currentNode *node.Node,
+	statusMetrics external.StatusMetricsHandler,
+	messenger p2p.Messenger,
+) error {
+	healthService.RegisterComponent(currentNode.GetWatchdog())
+
+	syncStateCheck, err := health.NewSyncStateCheck(currentNode)
+	if err != nil {
+		return err
+	}
+	healthService.RegisterComponent(syncStateCheck)
+
+	nonceLagCheck, err := health.NewNonceLagCheck(statusMetrics, healthConfig.MaxNonceLagForReadiness)
+	if err != nil {
+		return err
+	}
+	healthService.RegisterComponent(nonceLagCheck)
+
+	connectedPeersCheck, err := health.NewConnectedPeersCheck(messenger, healthConfig.MinConnectedPeersForReadiness)
+	if err != nil {
+		return err
+	}
+	healthService.RegisterComponent(connectedPeersCheck)
+
+	return nil
+}
+
+func registerTxPoolMetrics(dataPool dataRetriever.PoolsHolder, shardCoordinator sharding.Coordinator) {
+	registry := prometheus.DefaultRegistry()
+	registry.SetGaugeVecFunc(
+		"elrond_txpool_size",
+		"Number of transactions held in the pools.",
+		"cache",
+		func() map[string]float64 {
+			return map[string]float64{
+				"transactions":          float64(dataPool.Transactions().GetCounts().GetTotal()),
+				"unsigned_transactions": float64(dataPool.UnsignedTransactions().GetCounts().GetTotal()),
+				"reward_transactions":   float64(dataPool.RewardTransactions().GetCounts().GetTotal()),
+			}
+		},
+	)
+
+	cacheIDs := createTxPoolCacheIDs(shardCoordinator)
+	registry.SetGaugeVecFunc(
+		"elrond_txpool_transactions_shard_size",
+		"Number of transactions held in each shard cache of the transactions pool.",
+		"cache",
+		shardCacheSizesFunc(dataPool.Transactions(), cacheIDs),
+	)
+	registry.SetGaugeVecFunc(
+		"elrond_txpool_unsigned_transactions_shard_size",
+		"Number of unsigned transactions held in each shard cache of the unsigned transactions pool.",
+		"cache",
+		shardCacheSizesFunc(dataPool.UnsignedTransactions(), cacheIDs),
+	)
+	registry.SetGaugeVecFunc(
+		"elrond_txpool_reward_transactions_shard_size",
+		"Number of reward transactions held in each shard cache of the reward transactions pool.",
+		"cache",
+		shardCacheSizesFunc(dataPool.RewardTransactions(), cacheIDs),
+	)
+}
+
+// createTxPoolCacheIDs returns the identifiers of the caches a pool can hold: the intra shard cache, then the caches
+// from and to each of the other shards, including the metachain
+func createTxPoolCacheIDs(shardCoordinator sharding.Coordinator) []string {
+	selfShardID := shardCoordinator.SelfId()
+	cacheIDs := []string{process.ShardCacherIdentifier(selfShardID, selfShardID)}
+
+	for shardID := uint32(0); shardID < shardCoordinator.NumberOfShards(); shardID++ {
+		if shardID == selfShardID {
+			continue
+		}
+
+		cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(shardID, selfShardID))
+		cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(selfShardID, shardID))
+	}
+	if selfShardID != core.MetachainShardId {
+		cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(core.MetachainShardId, selfShardID))
+		cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(selfShardID, core.MetachainShardId))
+	}
+
+	return cacheIDs
+}
+
+// shardCacheSizesFunc returns the function computing the size of each existing shard cache of the pool. The transactions
+// pool routes all the caches having the self shard as source to the same cache, which is reported only once
+func shardCacheSizesFunc(pool dataRetriever.ShardedDataCacherNotifier, cacheIDs []string) func() map[string]float64 {
+	return func() map[string]float64 {
+		sizes := make(map[string]float64, len(cacheIDs))
+		reportedCaches := make(map[storage.Cacher]struct{}, len(cacheIDs))
+		for _, cacheID := range cacheIDs {
+			cache := pool.ShardDataStore(cacheID)
+			if check.IfNil(cache) {
+				continue
+			}
+			if _, ok := reportedCaches[cache]; ok {
+				continue
+			}
+
+			reportedCaches[cache] = struct{}{}
+			sizes[cacheID] = float64(cache.Len())
+		}
+
+		return sizes
+	}
+}
+
 func initStatsFileMonitor(
 	config *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -2487,6 +2870,161 @@ func startStatisticsMonitor(
 	return nil
 }
 
+// registerTrieStorageManagersForEpochStart makes the trie storage managers tag the epoch start snapshots with the
+// epoch they are taken for, as the state retention reports the disk usage per epoch
+func registerTrieStorageManagersForEpochStart(
+	epochStartNotifier epochStart.RegistrationHandler,
+	triesComponents *mainFactory.TriesComponents,
+	currentEpoch uint32,
+) {
+	setEpochForSnapshots := func(epoch uint32) {
+		for _, storageManager := range triesComponents.TrieStorageManagers {
+			storageManager.SetEpochForSnapshots(epoch)
+		}
+	}
+	setEpochForSnapshots(currentEpoch)
+
+	epochStartNotifier.RegisterHandler(notifier.NewHandlerForEpochStart(
+		func(_ data.HeaderHandler) {},
+		func(metaHdr data.HeaderHandler) {
+			setEpochForSnapshots(metaHdr.GetEpoch())
+		},
+		core.StateTriesOrder,
+	))
+}
+
+func createTrieStatisticsProvider(
+	triesComponents *mainFactory.TriesComponents,
+	stateComponents *mainFactory.StateComponents,
+	coreComponents *mainFactory.CoreComponents,
+	stateRetentionPolicy data.StateRetentionPolicyHandler,
+) (facade.TrieStatisticsProvider, error) {
+	argsTrieStatisticsProvider := inspector.ArgsTrieStatisticsProvider{
+		Tries: map[string]inspector.InspectedTrie{
+			trieFactory.UserAccountTrie: {
+				StorageManager: triesComponents.TrieStorageManagers[trieFactory.UserAccountTrie],
+				Accounts:       stateComponents.AccountsAdapter,
+				HasDataTries:   true,
+			},
+			trieFactory.PeerAccountTrie: {
+				StorageManager: triesComponents.TrieStorageManagers[trieFactory.PeerAccountTrie],
+				Accounts:       stateComponents.PeerAccounts,
+			},
+		},
+		Marshalizer:          coreComponents.InternalMarshalizer,
+		Hasher:               coreComponents.Hasher,
+		NumTopDataTries:      numTopDataTriesInStatistics,
+		StateRetentionPolicy: stateRetentionPolicy,
+	}
+
+	return inspector.NewTrieStatisticsProvider(argsTrieStatisticsProvider)
+}
+
+func createStateRetentionReporter(
+	generalConfig *config.Config,
+	triesComponents *mainFactory.TriesComponents,
+	pathManager storage.PathManagerHandler,
+	shardIdString string,
+	stateRetentionPolicy data.StateRetentionPolicyHandler,
+) (facade.StateRetentionReporter, error) {
+	argsStateRetentionReporter := inspector.ArgsStateRetentionReporter{
+		Tries: map[string]inspector.RetainedTrie{
+			trieFactory.UserAccountTrie: {
+				StorageManager: triesComponents.TrieStorageManagers[trieFactory.UserAccountTrie],
+				MainDbPath:     pathManager.PathForStatic(shardIdString, generalConfig.AccountsTrieStorage.DB.FilePath),
+			},
+			trieFactory.PeerAccountTrie: {
+				StorageManager: triesComponents.TrieStorageManagers[trieFactory.PeerAccountTrie],
+				MainDbPath:     pathManager.PathForStatic(shardIdString, generalConfig.PeerAccountsTrieStorage.DB.FilePath),
+			},
+		},
+		StateRetentionPolicy: stateRetentionPolicy,
+	}
+
+	return inspector.NewStateRetentionReporter(argsStateRetentionReporter)
+}
+
+// createTxPoolPersister creates the component saving the transactions pool, which is reloaded after a restart, or a
+// disabled one if the persistence is not enabled
+func createTxPoolPersister(
+	generalConfig *config.Config,
+	pathManager storage.PathManagerHandler,
+	shardIdString string,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	dataComponents *mainFactory.DataComponents,
+	cryptoComponents *mainFactory.CryptoComponents,
+	processComponents *factory.Process,
+	economicsData process.FeeHandler,
+	whiteListRequest process.WhiteListHandler,
+	whiteListerVerifiedTxs process.WhiteListHandler,
+	epochNotifier process.EpochNotifier,
+) (dataRetriever.TxPoolPersister, error) {
+	persistenceConfig := generalConfig.TxPoolPersistence
+	if !persistenceConfig.Enabled {
+		return txpool.NewDisabledTxPoolPersister(), nil
+	}
+
+	txPool, ok := dataComponents.Datapool.Transactions().(txpool.PersistableTxPool)
+	if !ok {
+		return nil, fmt.Errorf("%w for the transactions pool persistence", process.ErrWrongTypeAssertion)
+	}
+
+	argsTxDataFactory := &interceptorFactory.ArgInterceptedDataFactory{
+		ProtoMarshalizer:          coreComponents.InternalMarshalizer,
+		TxSignMarshalizer:         coreComponents.TxSignMarshalizer,
+		Hasher:                    coreComponents.Hasher,
+		ShardCoordinator:          shardCoordinator,
+		KeyGen:                    cryptoComponents.TxSignKeyGen,
+		Signer:                    cryptoComponents.TxSingleSigner,
+		AddressPubkeyConv:         stateComponents.AddressPubkeyConverter,
+		FeeHandler:                economicsData,
+		WhiteListerVerifiedTxs:    whiteListerVerifiedTxs,
+		EpochStartTrigger:         processComponents.EpochStartTrigger,
+		ArgsParser:                smartContract.NewArgumentParser(),
+		ChainID:                   coreComponents.ChainID,
+		MinTransactionVersion:     coreComponents.MinTransactionVersion,
+		EnableSignTxWithHashEpoch: generalConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		TxSignHasher:              coreComponents.TxSignHasher,
+		EpochNotifier:             epochNotifier,
+	}
+	txDataFactory, err := interceptorFactory.NewInterceptedTxDataFactory(argsTxDataFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		stateComponents.AccountsAdapter,
+		shardCoordinator,
+		whiteListRequest,
+		stateComponents.AddressPubkeyConverter,
+		core.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPath := pathManager.PathForStatic(shardIdString, persistenceConfig.DB.FilePath)
+	persister, err := storageFactory.NewPersisterFactory(persistenceConfig.DB).Create(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	argsTxPoolPersister := txpool.ArgsTxPoolPersister{
+		TxPool:               txPool,
+		Persister:            persister,
+		Marshalizer:          coreComponents.InternalMarshalizer,
+		TxDataFactory:        txDataFactory,
+		TxValidator:          txValidator,
+		SelfShardID:          shardCoordinator.SelfId(),
+		IncludeCrossShardTxs: persistenceConfig.IncludeCrossShardTxs,
+		SaveInterval:         time.Duration(persistenceConfig.SaveIntervalInSeconds) * time.Second,
+	}
+
+	return txpool.NewTxPoolPersister(argsTxPoolPersister)
+}
+
 func createApiResolver(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
@@ -2540,6 +3078,8 @@ func createApiResolver(
 		marshalizer,
 		accnts,
 		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -2697,6 +3237,8 @@ func createScQueryElement(
 		marshalizer,
 		accnts,
 		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -2782,6 +3324,8 @@ func createBuiltinFuncs(
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
+	epochNotifier process.EpochNotifier,
+	esdtNFTRoyaltiesPaymentEnableEpoch uint32,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
 		GasSchedule:      gasScheduleNotifier,
@@ -2789,6 +3333,9 @@ func createBuiltinFuncs(
 		Marshalizer:      marshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    epochNotifier,
+
+		ESDTNFTRoyaltiesPaymentEnableEpoch: esdtNFTRoyaltiesPaymentEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
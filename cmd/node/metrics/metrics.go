@@ -143,6 +143,7 @@ func StartStatusPolling(
 	networkComponents *mainFactory.NetworkComponents,
 	processComponents *factory.Process,
 	shardCoordinator sharding.Coordinator,
+	selfPubKey string,
 ) error {
 	if ash == nil {
 		return errors.New("nil AppStatusHandler")
@@ -177,6 +178,11 @@ func StartStatusPolling(
 		return err
 	}
 
+	err = registerPollRating(appStatusPollingHandler, processComponents, selfPubKey)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll()
 
 	return nil
@@ -221,6 +227,32 @@ func registerShardsInformation(
 	return nil
 }
 
+func registerPollRating(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	processComponents *factory.Process,
+	selfPubKey string,
+) error {
+	if check.IfNil(processComponents.ValidatorsProvider) {
+		return errors.New("nil validators provider")
+	}
+
+	computeRating := func(appStatusHandler core.AppStatusHandler) {
+		validatorInfo, ok := processComponents.ValidatorsProvider.GetLatestValidators()[selfPubKey]
+		if !ok {
+			return
+		}
+
+		appStatusHandler.SetUInt64Value(core.MetricRating, uint64(validatorInfo.TempRating))
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(computeRating)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for rating", err)
+	}
+
+	return nil
+}
+
 func computeNumConnectedPeers(
 	appStatusHandler core.AppStatusHandler,
 	networkComponents *mainFactory.NetworkComponents,
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// StartTrieNodesCachePolling will start reporting the hits, misses and size of the trie nodes cache
+func StartTrieNodesCachePolling(ash core.AppStatusHandler, cache data.TrieNodesCacher, pollingInterval time.Duration) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if check.IfNil(cache) {
+		return errors.New("nil trie nodes cache")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		computeTrieNodesCacheMetrics(appStatusHandler, cache)
+	})
+	if err != nil {
+		return errors.New("cannot register handler func for trie nodes cache")
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func computeTrieNodesCacheMetrics(appStatusHandler core.AppStatusHandler, cache data.TrieNodesCacher) {
+	numHits := cache.NumHits()
+	numMisses := cache.NumMisses()
+	hitRatePercent := uint64(0)
+	if numHits+numMisses > 0 {
+		hitRatePercent = numHits * 100 / (numHits + numMisses)
+	}
+
+	appStatusHandler.SetUInt64Value(core.MetricTrieNodesCacheHits, numHits)
+	appStatusHandler.SetUInt64Value(core.MetricTrieNodesCacheMisses, numMisses)
+	appStatusHandler.SetUInt64Value(core.MetricTrieNodesCacheHitRatePercent, hitRatePercent)
+	appStatusHandler.SetUInt64Value(core.MetricTrieNodesCacheSizeInBytes, cache.SizeInBytesContained())
+}
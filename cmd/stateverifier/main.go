@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/latestData"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	dbPath         string
+	configFilePath string
+	repairSources  cli.StringSlice
+	logLevel       string
+}
+
+// lastCommittedHeader holds the information about the last committed header the state is checked against
+type lastCommittedHeader struct {
+	ShardID                uint32 `json:"shardId"`
+	Epoch                  uint32 `json:"epoch"`
+	Nonce                  uint64 `json:"nonce"`
+	Hash                   []byte `json:"hash"`
+	RootHash               []byte `json:"rootHash"`
+	ValidatorStatsRootHash []byte `json:"validatorStatsRootHash,omitempty"`
+}
+
+type verificationReport struct {
+	Header           *lastCommittedHeader       `json:"header"`
+	AccountsTrie     *inspector.IntegrityReport `json:"accountsTrie"`
+	PeerAccountsTrie *inspector.IntegrityReport `json:"peerAccountsTrie,omitempty"`
+}
+
+var (
+	stateVerifierHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	argsConfig = &cfg{}
+
+	// dbPath defines a flag for the node database directory holding the databases of a chain
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "This string flag specifies the path for the database directory, the chain ID directory. Example: db/1",
+		Destination: &argsConfig.dbPath,
+	}
+	// configFilePath defines a flag which holds the node's configuration file path
+	configFilePath = cli.StringFlag{
+		Name:        "config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFilePath,
+	}
+	// repairFrom defines a flag for the level DB directories the missing or corrupted nodes are repaired from
+	repairFrom = cli.StringSliceFlag{
+		Name: "repair-from",
+		Usage: "The level DB directory, as a trie snapshot or the trie storage of another node, from which the " +
+			"missing or corrupted nodes are repaired. Can be provided many times. The node must be stopped",
+		Value: &argsConfig.repairSources,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	errUnhealthyState = errors.New("the state is not healthy, see the report")
+
+	log = logger.GetOrCreate("stateverifier")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = stateVerifierHelpTemplate
+	app.Name = "State verification Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary verifies, against the last committed header, that the tries stored in a node database " +
+		"are complete and uncorrupted, optionally repairing them from a trie snapshot"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		configFilePath,
+		repairFrom,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error verifying the state", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.dbPath) == 0 {
+		return fmt.Errorf("the db path should be provided")
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, argsConfig.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	header, err := loadLastCommittedHeader(generalConfig, marshalizer, hasher)
+	if err != nil {
+		return err
+	}
+	log.Info("verifying the state of the last committed header",
+		"shard", header.ShardID,
+		"epoch", header.Epoch,
+		"nonce", header.Nonce,
+		"hash", header.Hash,
+	)
+
+	repairSources, err := openRepairSources(generalConfig.AccountsTrieStorage.DB)
+	if err != nil {
+		return err
+	}
+	defer closePersisters(repairSources)
+
+	report := &verificationReport{
+		Header: header,
+	}
+	report.AccountsTrie, err = verifyTrie(generalConfig.AccountsTrieStorage.DB, header, header.RootHash, true, marshalizer, hasher, repairSources)
+	if err != nil {
+		return err
+	}
+	if len(header.ValidatorStatsRootHash) > 0 {
+		report.PeerAccountsTrie, err = verifyTrie(generalConfig.PeerAccountsTrieStorage.DB, header, header.ValidatorStatsRootHash, false, marshalizer, hasher, repairSources)
+		if err != nil {
+			return err
+		}
+	}
+
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(buff))
+
+	isHealthy := report.AccountsTrie.IsHealthy()
+	if report.PeerAccountsTrie != nil {
+		isHealthy = isHealthy && report.PeerAccountsTrie.IsHealthy()
+	}
+	if !isHealthy {
+		return errUnhealthyState
+	}
+
+	log.Info("the state is healthy")
+
+	return nil
+}
+
+// loadLastCommittedHeader reads the last committed header from the bootstrap storer of the most recent shard
+// directory, the same one the node would restart from
+func loadLastCommittedHeader(generalConfig *config.Config, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*lastCommittedHeader, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	latestDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         *generalConfig,
+		Marshalizer:           marshalizer,
+		Hasher:                hasher,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       storageFactory.NewDirectoryReader(),
+		WorkingDir:            argsConfig.dbPath,
+		DefaultEpochString:    factory.DefaultEpochString,
+		DefaultShardString:    factory.DefaultShardString,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := latestDataProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	_, lastEpoch, err := latestDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapPath := pathForEpoch(lastEpoch, latest.ShardID, generalConfig.BootstrapStorage.DB.FilePath)
+	persisterFactory := storageFactory.NewPersisterFactory(generalConfig.BootstrapStorage.DB)
+	bootstrapData, bootstrapStorer, err := bootstrapDataProvider.LoadForPath(persisterFactory, bootstrapPath)
+	if err != nil {
+		return nil, err
+	}
+	_ = bootstrapStorer.Close()
+
+	return loadHeader(generalConfig, marshalizer, bootstrapData.LastHeader, lastEpoch)
+}
+
+func loadHeader(
+	generalConfig *config.Config,
+	marshalizer marshal.Marshalizer,
+	headerInfo bootstrapStorage.BootstrapHeaderInfo,
+	lastEpoch uint32,
+) (*lastCommittedHeader, error) {
+	headersConfig := generalConfig.BlockHeaderStorage.DB
+	var header data.HeaderHandler = &block.Header{}
+	if headerInfo.ShardId == core.MetachainShardId {
+		headersConfig = generalConfig.MetaBlockStorage.DB
+		header = &block.MetaBlock{}
+	}
+
+	buff, err := getFromEpochs(headersConfig, headerInfo, lastEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lastCommittedHeader{
+		ShardID:                headerInfo.ShardId,
+		Epoch:                  header.GetEpoch(),
+		Nonce:                  header.GetNonce(),
+		Hash:                   headerInfo.Hash,
+		RootHash:               header.GetRootHash(),
+		ValidatorStatsRootHash: header.GetValidatorStatsRootHash(),
+	}, nil
+}
+
+// getFromEpochs searches the header in the epochs directories starting with the last one, as the pruning storer
+// writes in the directory of the epoch active at commit time
+func getFromEpochs(dbConfig config.DBConfig, headerInfo bootstrapStorage.BootstrapHeaderInfo, lastEpoch uint32) ([]byte, error) {
+	persisterFactory := storageFactory.NewPersisterFactory(dbConfig)
+	for epoch := int64(lastEpoch); epoch >= 0 && epoch >= int64(headerInfo.Epoch)-1; epoch-- {
+		path := pathForEpoch(uint32(epoch), headerInfo.ShardId, dbConfig.FilePath)
+		if !core.DoesFileExist(path) {
+			continue
+		}
+
+		persister, err := persisterFactory.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		buff, err := persister.Get(headerInfo.Hash)
+		_ = persister.Close()
+		if err == nil {
+			return buff, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for the last committed header %x", storage.ErrKeyNotFound, headerInfo.Hash)
+}
+
+func verifyTrie(
+	dbConfig config.DBConfig,
+	header *lastCommittedHeader,
+	rootHash []byte,
+	hasDataTries bool,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	repairSources []storage.Persister,
+) (*inspector.IntegrityReport, error) {
+	path := pathForStatic(header.ShardID, dbConfig.FilePath)
+	if !core.DoesFileExist(path) {
+		return nil, fmt.Errorf("no trie storage found at %s", path)
+	}
+
+	db, err := storageFactory.NewPersisterFactory(dbConfig).Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	sources := make([]data.DBWriteCacher, 0, len(repairSources))
+	for _, source := range repairSources {
+		sources = append(sources, source)
+	}
+
+	sic, err := inspector.NewStateIntegrityChecker(inspector.ArgsStateIntegrityChecker{
+		DB:            db,
+		Marshalizer:   marshalizer,
+		Hasher:        hasher,
+		RepairSources: sources,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("verifying trie", "path", path, "root hash", rootHash)
+	if hasDataTries {
+		return sic.CheckAccountsTrie(rootHash)
+	}
+
+	return sic.CheckTrie(rootHash)
+}
+
+func openRepairSources(dbConfig config.DBConfig) ([]storage.Persister, error) {
+	persisterFactory := storageFactory.NewPersisterFactory(dbConfig)
+	sources := make([]storage.Persister, 0, len(argsConfig.repairSources))
+	for _, path := range argsConfig.repairSources {
+		if !core.DoesFileExist(path) {
+			closePersisters(sources)
+			return nil, fmt.Errorf("no repair source found at %s", path)
+		}
+
+		source, err := persisterFactory.Create(path)
+		if err != nil {
+			closePersisters(sources)
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+func closePersisters(persisters []storage.Persister) {
+	for _, persister := range persisters {
+		_ = persister.Close()
+	}
+}
+
+func pathForEpoch(epoch uint32, shardID uint32, identifier string) string {
+	return filepath.Join(
+		argsConfig.dbPath,
+		fmt.Sprintf("%s_%d", factory.DefaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", factory.DefaultShardString, core.GetShardIDString(shardID)),
+		identifier,
+	)
+}
+
+func pathForStatic(shardID uint32, identifier string) string {
+	return filepath.Join(
+		argsConfig.dbPath,
+		factory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", factory.DefaultShardString, core.GetShardIDString(shardID)),
+		identifier,
+	)
+}
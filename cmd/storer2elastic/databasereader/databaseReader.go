@@ -42,6 +42,7 @@ type databaseReader struct {
 	marshalizer       marshal.Marshalizer
 	persisterFactory  storage.PersisterFactory
 	dbPathWithChainID string
+	compressibleUnits map[string]struct{}
 }
 
 // New will return a new instance of databaseReader
@@ -65,9 +66,23 @@ func New(args Args) (*databaseReader, error) {
 		marshalizer:       args.Marshalizer,
 		persisterFactory:  args.PersisterFactory,
 		dbPathWithChainID: args.DbPathWithChainID,
+		compressibleUnits: createCompressibleUnits(args.GeneralConfig),
 	}, nil
 }
 
+// createCompressibleUnits returns the units the node can store compressed, which are the ones holding marshalled
+// structures. The other units, such as the nonce to hash ones, hold raw values which must not be decoded
+func createCompressibleUnits(generalConfig config.Config) map[string]struct{} {
+	return map[string]struct{}{
+		generalConfig.MiniBlocksStorage.DB.FilePath:          {},
+		generalConfig.BlockHeaderStorage.DB.FilePath:         {},
+		generalConfig.MetaBlockStorage.DB.FilePath:           {},
+		generalConfig.TxStorage.DB.FilePath:                  {},
+		generalConfig.UnsignedTransactionStorage.DB.FilePath: {},
+		generalConfig.RewardTxStorage.DB.FilePath:            {},
+	}
+}
+
 // GetDatabaseInfo returns all the databases' data found in the path specified on the constructor
 func (dr *databaseReader) GetDatabaseInfo() ([]*DatabaseInfo, error) {
 	dbs := make([]*DatabaseInfo, 0)
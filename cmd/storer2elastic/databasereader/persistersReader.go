@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 )
 
 // GetHeaders returns all the headers found in meta block or shard header units
@@ -26,7 +27,7 @@ func (dr *databaseReader) GetHeaders(dbInfo *DatabaseInfo) ([]data.HeaderHandler
 		hdrStorer,
 	)
 
-	hdrPersister, err := dr.persisterFactory.Create(persisterPath)
+	hdrPersister, err := dr.createPersister(persisterPath, hdrStorer)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +95,7 @@ func (dr *databaseReader) LoadPersister(dbInfo *DatabaseInfo, unit string) (stor
 	}
 
 	persisterPath := filepath.Join(dr.dbPathWithChainID, fmt.Sprintf("Epoch_%d", dbInfo.Epoch), fmt.Sprintf("Shard_%s", shardIDStr), unit)
-	return dr.persisterFactory.Create(persisterPath)
+	return dr.createPersister(persisterPath, unit)
 }
 
 // LoadStaticPersister will load the static persister based on the database information and the unit
@@ -105,5 +106,27 @@ func (dr *databaseReader) LoadStaticPersister(dbInfo *DatabaseInfo, unit string)
 	}
 
 	persisterPath := filepath.Join(dr.dbPathWithChainID, factory.DefaultStaticDbString, fmt.Sprintf("Shard_%s", shardIDStr), unit)
-	return dr.persisterFactory.Create(persisterPath)
+	return dr.createPersister(persisterPath, unit)
+}
+
+// createPersister creates the persister of the unit. The values of the units holding marshalled structures are
+// decompressed if the node stored them compressed, while the values of the other units are read as they are
+func (dr *databaseReader) createPersister(persisterPath string, unit string) (storage.Persister, error) {
+	persister, err := dr.persisterFactory.Create(persisterPath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, isCompressible := dr.compressibleUnits[unit]
+	if !isCompressible {
+		return persister, nil
+	}
+
+	wrappedPersister, err := compression.WrapPersister(persister, compression.NoCompression)
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return wrappedPersister, nil
 }
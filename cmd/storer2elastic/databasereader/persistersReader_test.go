@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/mock"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,3 +89,55 @@ func TestDatabaseReader_LoadStaticPersister(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, persister, pers)
 }
+
+func TestDatabaseReader_LoadPersisterShouldDecompressTheMarshalledStructuresUnits(t *testing.T) {
+	t.Parallel()
+
+	txBytes := []byte(strings.Repeat("marshalled transaction ", 10))
+	persister := mock.NewPersisterMock()
+	compressedPersister, _ := compression.NewCompressedPersister(persister, compression.SnappyCompression)
+	_ = compressedPersister.Put([]byte("tx hash"), txBytes)
+
+	args := getDatabaseReaderArgs()
+	args.GeneralConfig.TxStorage.DB.FilePath = "Transactions"
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return persister, nil
+		},
+	}
+	dr, _ := databasereader.New(args)
+
+	pers, err := dr.LoadPersister(&databasereader.DatabaseInfo{Epoch: 5, Shard: 5}, "Transactions")
+	require.NoError(t, err)
+
+	value, err := pers.Get([]byte("tx hash"))
+	require.NoError(t, err)
+	require.Equal(t, txBytes, value)
+}
+
+func TestDatabaseReader_LoadStaticPersisterShouldNotDecodeTheNonceToHashUnits(t *testing.T) {
+	t.Parallel()
+
+	// a hash can start with the byte marking the compressed values
+	hash := append([]byte{0, 1}, []byte(strings.Repeat("h", 30))...)
+	persister := mock.NewPersisterMock()
+	_ = persister.Put([]byte("nonce"), hash)
+
+	args := getDatabaseReaderArgs()
+	args.GeneralConfig.MetaBlockStorage.DB.FilePath = "MetaBlock"
+	args.GeneralConfig.MetaHdrNonceHashStorage.DB.FilePath = "MetaHdrHashNonce"
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return persister, nil
+		},
+	}
+	dr, _ := databasereader.New(args)
+
+	pers, err := dr.LoadStaticPersister(&databasereader.DatabaseInfo{Epoch: 5, Shard: 5}, "MetaHdrHashNonce")
+	require.NoError(t, err)
+	require.Equal(t, persister, pers)
+
+	value, err := pers.Get([]byte("nonce"))
+	require.NoError(t, err)
+	require.Equal(t, hash, value)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -144,6 +145,8 @@ func (rp *ratingsProcessor) createPeerAdapter() error {
 		Hasher:                   rp.hasher,
 		PathManager:              pathManager,
 		TrieStorageManagerConfig: rp.generalConfig.TrieStorageManagerConfig,
+		TrieNodesCache:           trie.NewDisabledTrieNodesCache(),
+		StateRetentionCfg:        rp.generalConfig.StateTriesConfig.StateRetention,
 	}
 	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
 	if err != nil {
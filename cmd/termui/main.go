@@ -5,12 +5,14 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/termui/provider"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/presenter"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view/termuic"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view/termuic/termuiRenders"
 	"github.com/urfave/cli"
 )
 
@@ -21,6 +23,8 @@ type config struct {
 	interval           int
 	address            string
 	logLevel           string
+	maxNonceLag        uint64
+	minPeers           uint64
 }
 
 var (
@@ -41,11 +45,27 @@ VERSION:
 `
 	// address defines a flag for setting the address and port on which the node will listen for connections
 	address = cli.StringFlag{
-		Name:        "address",
-		Usage:       "Address and port number on which the application will try to connect to the elrond-go node",
+		Name: "address",
+		Usage: "Address and port number on which the application will try to connect to the elrond-go node. " +
+			"Multiple comma-separated addresses can be provided in order to watch several nodes, for example " +
+			"127.0.0.1:8080,127.0.0.1:8081",
 		Value:       "127.0.0.1:8080",
 		Destination: &argsConfig.address,
 	}
+	// maxNonceLag defines the number of blocks a node can be behind before being highlighted as lagging
+	maxNonceLag = cli.Uint64Flag{
+		Name:        "max-nonce-lag",
+		Usage:       "The number of blocks a node can be behind the network or the other watched nodes of its shard before being highlighted as lagging",
+		Value:       termuiRenders.DefaultThresholds.MaxNonceLag,
+		Destination: &argsConfig.maxNonceLag,
+	}
+	// minPeers defines the number of connected peers under which a node is highlighted
+	minPeers = cli.Uint64Flag{
+		Name:        "min-peers",
+		Usage:       "The number of connected peers under which a node is highlighted",
+		Value:       termuiRenders.DefaultThresholds.MinConnectedPeers,
+		Destination: &argsConfig.minPeers,
+	}
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -104,22 +124,43 @@ func main() {
 }
 
 func startTermuiViewer(ctx *cli.Context) error {
-	nodeAddress := argsConfig.address
+	nodeAddresses := parseAddresses(argsConfig.address)
+	if len(nodeAddresses) == 0 {
+		return provider.ErrInvalidAddressLength
+	}
 	fetchIntervalFlagValue := argsConfig.interval
 
-	presenterStatusHandler := presenter.NewPresenterStatusHandler()
-	statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchIntervalFlagValue)
+	nodes := make([]termuiRenders.NodePresenter, 0, len(nodeAddresses))
+	presenters := make([]*presenter.PresenterStatusHandler, 0, len(nodeAddresses))
+	statusMetricsProviders := make([]*provider.StatusMetricsProvider, 0, len(nodeAddresses))
+	for _, nodeAddress := range nodeAddresses {
+		presenterStatusHandler := presenter.NewPresenterStatusHandler()
+		statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchIntervalFlagValue)
+		if err != nil {
+			return fmt.Errorf("%w for address %s", err, nodeAddress)
+		}
+
+		nodes = append(nodes, termuiRenders.NodePresenter{
+			Name:      nodeAddress,
+			Presenter: presenterStatusHandler,
+		})
+		presenters = append(presenters, presenterStatusHandler)
+		statusMetricsProviders = append(statusMetricsProviders, statusMetricsProvider)
+	}
+
+	thresholds := termuiRenders.Thresholds{
+		MaxNonceLag:       argsConfig.maxNonceLag,
+		MinConnectedPeers: argsConfig.minPeers,
+	}
+	termuiConsole, err := termuic.NewMultiNodeTermuiConsole(nodes, thresholds, fetchIntervalFlagValue)
 	if err != nil {
 		return err
 	}
 
-	termuiConsole, err := termuic.NewTermuiConsole(presenterStatusHandler, fetchIntervalFlagValue)
-	if err != nil {
-		return err
+	for _, statusMetricsProvider := range statusMetricsProviders {
+		statusMetricsProvider.StartUpdatingData()
 	}
 
-	statusMetricsProvider.StartUpdatingData()
-
 	loggerProfile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
@@ -130,9 +171,11 @@ func startTermuiViewer(ctx *cli.Context) error {
 		log.LogIfError(err)
 	}
 
-	err = provider.InitLogHandler(presenterStatusHandler, nodeAddress, loggerProfile, argsConfig.useWss, customLogProfile)
-	if err != nil {
-		return err
+	for i, nodeAddress := range nodeAddresses {
+		err = provider.InitLogHandler(presenters[i], nodeAddress, loggerProfile, argsConfig.useWss, customLogProfile)
+		if err != nil {
+			return err
+		}
 	}
 
 	chanStartTermUI := make(chan struct{})
@@ -147,6 +190,18 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func parseAddresses(addresses string) []string {
+	nodeAddresses := make([]string, 0)
+	for _, nodeAddress := range strings.Split(addresses, ",") {
+		nodeAddress = strings.TrimSpace(nodeAddress)
+		if len(nodeAddress) > 0 {
+			nodeAddresses = append(nodeAddresses, nodeAddress)
+		}
+	}
+
+	return nodeAddresses
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
@@ -160,6 +215,8 @@ func initCliFlags() {
 		logWithLoggerName,
 		fetchIntervalInMilliseconds,
 		useWss,
+		maxNonceLag,
+		minPeers,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -14,7 +15,8 @@ import (
 )
 
 var formatter = logger.PlainFormatter{}
-var webSocket *websocket.Conn
+var mutWebSockets sync.Mutex
+var webSockets = make(map[string]*websocket.Conn)
 var retryDuration = time.Second * 10
 
 const (
@@ -24,19 +26,19 @@ const (
 
 // InitLogHandler will open the websocket and set the log level
 func InitLogHandler(presenter PresenterHandler, nodeURL string, profile *logger.Profile, useWss bool, customLogProfile bool) error {
-	var err error
 	scheme := ws
 	if useWss {
 		scheme = wss
 	}
 	go func() {
 		for {
-			webSocket, err = openWebSocket(scheme, nodeURL)
+			webSocket, err := openWebSocket(scheme, nodeURL)
 			if err != nil {
 				_, _ = presenter.Write([]byte(fmt.Sprintf("termui websocket error, retrying in %v...", retryDuration)))
 				time.Sleep(retryDuration)
 				continue
 			}
+			setWebSocket(nodeURL, webSocket)
 
 			if customLogProfile {
 				err = sendProfile(webSocket, profile)
@@ -45,7 +47,7 @@ func InitLogHandler(presenter PresenterHandler, nodeURL string, profile *logger.
 			}
 			log.LogIfError(err)
 
-			startListeningOnWebSocket(presenter)
+			startListeningOnWebSocket(webSocket, presenter)
 			time.Sleep(retryDuration)
 		}
 	}()
@@ -53,6 +55,12 @@ func InitLogHandler(presenter PresenterHandler, nodeURL string, profile *logger.
 	return nil
 }
 
+func setWebSocket(nodeURL string, webSocket *websocket.Conn) {
+	mutWebSockets.Lock()
+	webSockets[nodeURL] = webSocket
+	mutWebSockets.Unlock()
+}
+
 func openWebSocket(scheme string, address string) (*websocket.Conn, error) {
 	u := url.URL{
 		Scheme: scheme,
@@ -81,7 +89,7 @@ func sendDefaultProfileIdentifier(conn *websocket.Conn) error {
 }
 
 // startListeningOnWebSocket will listen if a new log message is received and will display it
-func startListeningOnWebSocket(presenter PresenterHandler) {
+func startListeningOnWebSocket(webSocket *websocket.Conn, presenter PresenterHandler) {
 	for {
 		msgType, message, err := webSocket.ReadMessage()
 		if msgType == websocket.CloseMessage {
@@ -124,11 +132,16 @@ func formatMessage(message []byte) []byte {
 	return formatter.Output(logLine)
 }
 
-// StopWebSocket will send notify the node that the app is closed
+// StopWebSocket will send notify the nodes that the app is closed
 func StopWebSocket() {
-	if webSocket != nil {
+	mutWebSockets.Lock()
+	defer mutWebSockets.Unlock()
+
+	for _, webSocket := range webSockets {
 		err := webSocket.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
 		log.LogIfError(err)
+	}
+	if len(webSockets) > 0 {
 		time.Sleep(time.Second)
 	}
 }
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrieType = "accounts"
+	peerTrieType     = "peer"
+
+	batchDelaySeconds = 2
+	maxBatchSize      = 100
+	maxOpenFiles      = 10
+)
+
+type cfg struct {
+	dbPath          string
+	rootHashes      cli.StringSlice
+	trieType        string
+	numTopDataTries int
+	checkOrphans    bool
+	logLevel        string
+}
+
+type inspectorHandler interface {
+	InspectTrie(rootHash []byte) (*inspector.TrieReport, error)
+	InspectAccountsTrie(rootHash []byte) (*inspector.TrieReport, error)
+}
+
+type inspectionReport struct {
+	Tries       []*inspector.TrieReport      `json:"tries"`
+	OrphanNodes *inspector.OrphanNodesReport `json:"orphanNodes,omitempty"`
+}
+
+var (
+	trieInspectHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	argsConfig = &cfg{}
+
+	// dbPath defines a flag for the level DB directory holding the trie nodes
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The level DB directory holding the trie nodes. Example: db/1/Epoch_5/Shard_0/AccountsTrie/MainDB",
+		Destination: &argsConfig.dbPath,
+	}
+	// rootHash defines a flag for the hex encoded root hashes to be inspected
+	rootHash = cli.StringSliceFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded root hash of a trie to be inspected. Can be provided many times",
+		Value: &argsConfig.rootHashes,
+	}
+	// trieType defines a flag for the type of the inspected tries
+	trieType = cli.StringFlag{
+		Name: "trie-type",
+		Usage: fmt.Sprintf("The type of the inspected tries. Available options: %s (with data tries), %s",
+			accountsTrieType,
+			peerTrieType,
+		),
+		Value:       accountsTrieType,
+		Destination: &argsConfig.trieType,
+	}
+	// numTopDataTries defines a flag for the number of the largest data tries to be reported
+	numTopDataTries = cli.IntFlag{
+		Name:        "top",
+		Usage:       "The number of the largest data tries to be reported for each accounts trie",
+		Value:       20,
+		Destination: &argsConfig.numTopDataTries,
+	}
+	// checkOrphans is the flag that, if active, will report the nodes from storage not reachable from the provided roots
+	checkOrphans = cli.BoolFlag{
+		Name: "check-orphans",
+		Usage: "Boolean option that will report the keys from storage not reachable from the provided root hashes. " +
+			"All the root hashes still kept in storage should be provided",
+		Destination: &argsConfig.checkOrphans,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	log = logger.GetOrCreate("trieinspect")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = trieInspectHelpTemplate
+	app.Name = "Trie inspection Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary computes the statistics of the tries stored in a node database and prints them as JSON"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		rootHash,
+		trieType,
+		numTopDataTries,
+		checkOrphans,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting the tries", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.dbPath) == 0 {
+		return fmt.Errorf("the db path should be provided")
+	}
+	if len(argsConfig.rootHashes) == 0 {
+		return fmt.Errorf("at least one root hash should be provided")
+	}
+	if argsConfig.trieType != accountsTrieType && argsConfig.trieType != peerTrieType {
+		return fmt.Errorf("unknown trie type %s", argsConfig.trieType)
+	}
+
+	rootHashes := make([][]byte, 0, len(argsConfig.rootHashes))
+	for _, hexRootHash := range argsConfig.rootHashes {
+		decodedRootHash, errDecode := hex.DecodeString(hexRootHash)
+		if errDecode != nil {
+			return fmt.Errorf("%w for root hash %s", errDecode, hexRootHash)
+		}
+		rootHashes = append(rootHashes, decodedRootHash)
+	}
+
+	db, err := leveldb.NewDB(argsConfig.dbPath, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ti, err := inspector.NewTrieInspector(inspector.ArgsTrieInspector{
+		DB:                  db,
+		Marshalizer:         &marshal.GogoProtoMarshalizer{},
+		Hasher:              &blake2b.Blake2b{},
+		NumTopDataTries:     argsConfig.numTopDataTries,
+		TrackReachableNodes: argsConfig.checkOrphans,
+	})
+	if err != nil {
+		return err
+	}
+
+	report := &inspectionReport{
+		Tries: make([]*inspector.TrieReport, 0, len(rootHashes)),
+	}
+	for _, rh := range rootHashes {
+		log.Info("inspecting trie", "root hash", rh)
+
+		trieReport, errInspect := inspectTrie(ti, rh)
+		if errInspect != nil {
+			return errInspect
+		}
+		report.Tries = append(report.Tries, trieReport)
+	}
+
+	if argsConfig.checkOrphans {
+		log.Info("searching the orphan nodes")
+
+		report.OrphanNodes, err = ti.FindOrphanNodes(db)
+		if err != nil {
+			return err
+		}
+	}
+
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
+
+func inspectTrie(ti inspectorHandler, rootHash []byte) (*inspector.TrieReport, error) {
+	if argsConfig.trieType == accountsTrieType {
+		return ti.InspectAccountsTrie(rootHash)
+	}
+
+	return ti.InspectTrie(rootHash)
+}
@@ -24,6 +24,15 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       string
+}
+
+// TxPoolPersistenceConfig will hold the configuration of the transactions pool persistence across node restarts
+type TxPoolPersistenceConfig struct {
+	Enabled               bool
+	SaveIntervalInSeconds uint32
+	IncludeCrossShardTxs  bool
+	DB                    DBConfig
 }
 
 // BloomFilterConfig will map the bloom filter configuration
@@ -124,6 +133,7 @@ type Config struct {
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolPersistence           TxPoolPersistenceConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesDataPool           CacheConfig
@@ -163,6 +173,7 @@ type Config struct {
 	Hardfork HardforkConfig
 	Debug    DebugConfig
 	Health   HealthServiceConfig
+	Alerting AlertingConfig
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
@@ -170,6 +181,34 @@ type Config struct {
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
+	TrieNodesCache        TrieNodesCacheConfig
+	Tracing               TracingConfig
+	Profiling             ProfilingConfig
+}
+
+// TracingConfig will hold the settings of the optional tracing of block processing and REST API requests
+type TracingConfig struct {
+	Enabled               bool
+	ServiceName           string
+	Exporter              string
+	OTLPEndpoint          string
+	FilePath              string
+	FlushIntervalInMillis int
+	MaxBatchSize          int
+}
+
+// ProfilingConfig will hold the settings of the profiles captured on slow block processing, goroutine spikes or
+// on demand
+type ProfilingConfig struct {
+	Enabled                              bool
+	FolderName                           string
+	NumSnapshotsToKeep                   int
+	CPUProfileDurationInSeconds          int
+	MinIntervalBetweenCapturesInSeconds  int
+	SlowBlockProcessingThresholdInMillis int
+	IntervalCheckGoroutinesInSeconds     int
+	NumGoroutinesToCaptureProfiles       int
+	MutexProfileFraction                 int
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -238,6 +277,7 @@ type GeneralSettingsConfig struct {
 	ReturnDataToLastTransferEnableEpoch    uint32
 	ArwenESDTFunctionsEnableEpoch          uint32
 	SenderInOutTransferEnableEpoch         uint32
+	ESDTNFTRoyaltiesPaymentEnableEpoch     uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -253,6 +293,13 @@ type StateTriesConfig struct {
 	PeerStatePruningEnabled     bool
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
+	StateRetention              StateRetentionConfig
+}
+
+// StateRetentionConfig will hold the policy deciding which past states of the tries are kept queryable
+type StateRetentionConfig struct {
+	Policy          string
+	NumEpochsToKeep uint32
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
@@ -407,6 +454,36 @@ type HealthServiceConfig struct {
 	MemoryUsageToCreateProfiles               int
 	NumMemoryUsageRecordsToKeep               int
 	FolderPath                                string
+	MaxNonceLagForReadiness                   uint64
+	MinConnectedPeersForReadiness             int
+}
+
+// AlertingConfig will hold the configuration of the alerting rules evaluated over the status metrics
+type AlertingConfig struct {
+	Enabled                     bool
+	EvaluationIntervalInSeconds uint32
+	Rules                       []AlertRuleConfig
+	Sinks                       []AlertSinkConfig
+}
+
+// AlertRuleConfig will hold the configuration of one alerting rule
+type AlertRuleConfig struct {
+	Name              string
+	Metric            string
+	Function          string
+	WindowInSeconds   uint32
+	Operator          string
+	Threshold         float64
+	DurationInSeconds uint32
+	Severity          string
+}
+
+// AlertSinkConfig will hold the configuration of one destination of the alert notifications
+type AlertSinkConfig struct {
+	Type             string
+	URL              string
+	ScriptPath       string
+	TimeoutInSeconds uint32
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -429,18 +506,43 @@ type AntifloodDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
+	TLS         APITLSConfig
+	Auth        APIAuthConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// APITLSConfig holds the configuration for serving the Rest API over TLS
+type APITLSConfig struct {
+	Enabled         bool
+	CertificateFile string
+	KeyFile         string
+}
+
+// APIAuthConfig holds the API keys allowed to access the Rest API route groups that require roles
+type APIAuthConfig struct {
+	Keys []APIKeyConfig
+}
+
+// APIKeyConfig holds the configuration of a single API key
+type APIKeyConfig struct {
+	Name           string
+	KeyHash        string
+	Roles          []string
+	MaxNumRequests uint32
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
+	Roles  []string
 	Routes []RouteConfig
 }
 
-// RouteConfig holds the configuration for a single route
+// RouteConfig holds the configuration for a single route. Roles restrict the route to the API keys holding one of
+// them, on top of the roles of its package
 type RouteConfig struct {
-	Name string
-	Open bool
+	Name  string
+	Open  bool
+	Roles []string
 }
 
 // VersionByEpochs represents a version entry that will be applied between the provided epochs
@@ -467,6 +569,13 @@ type GasScheduleConfig struct {
 	GasScheduleByEpochs []GasScheduleByEpochs
 }
 
+// TrieNodesCacheConfig will hold the configuration of the cache holding the trie nodes read from storage, shared
+// between the accounts, peer accounts and data tries
+type TrieNodesCacheConfig struct {
+	Enabled bool
+	Cache   CacheConfig
+}
+
 // TrieSyncConfig represents the trie synchronization configuration area
 type TrieSyncConfig struct {
 	NumConcurrentTrieSyncers  int
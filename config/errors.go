@@ -0,0 +1,6 @@
+package config
+
+import "errors"
+
+// ErrInvalidConfig signals that at least one configuration value failed the validation
+var ErrInvalidConfig = errors.New("invalid configuration")
@@ -7,6 +7,7 @@ type SystemSmartContractsConfig struct {
 	StakingSystemSCConfig           StakingSystemSCConfig
 	DelegationManagerSystemSCConfig DelegationManagerSystemSCConfig
 	DelegationSystemSCConfig        DelegationSystemSCConfig
+	ScheduledTxSystemSCConfig       ScheduledTxSystemSCConfig
 }
 
 // StakingSystemSCConfig will hold the staking system smart contract settings
@@ -32,27 +33,34 @@ type StakingSystemSCConfig struct {
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
 type ESDTSystemSCConfig struct {
-	BaseIssuingCost string
-	OwnerAddress    string
-	EnabledEpoch    uint32
+	BaseIssuingCost          string
+	OwnerAddress             string
+	EnabledEpoch             uint32
+	TokenMetadataEnableEpoch uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
 type GovernanceSystemSCConfig struct {
-	ProposalCost     string
-	NumNodes         int64
-	MinQuorum        int32
-	MinPassThreshold int32
-	MinVetoThreshold int32
-	EnabledEpoch     uint32
+	ProposalCost                   string
+	NumNodes                       int64
+	MinQuorum                      int32
+	MinPassThreshold               int32
+	MinVetoThreshold               int32
+	EnabledEpoch                   uint32
+	MinQuorumStake                 string
+	MinPassThresholdStake          string
+	MinVetoThresholdStake          string
+	StakeWeightedVotingEnableEpoch uint32
 }
 
 // DelegationManagerSystemSCConfig defines a set of constants to initialize the delegation manager system smart contract
 type DelegationManagerSystemSCConfig struct {
-	MinCreationDeposit  string
-	EnabledEpoch        uint32
-	MinStakeAmount      string
-	ConfigChangeAddress string
+	MinCreationDeposit             string
+	EnabledEpoch                   uint32
+	MinStakeAmount                 string
+	ConfigChangeAddress            string
+	MoveDelegationEnableEpoch      uint32
+	MoveDelegationCooldownInEpochs uint32
 }
 
 // DelegationSystemSCConfig defines a set of constants to initialize the delegation system smart contract
@@ -61,3 +69,13 @@ type DelegationSystemSCConfig struct {
 	MinServiceFee uint64
 	MaxServiceFee uint64
 }
+
+// ScheduledTxSystemSCConfig defines a set of constants to initialize the scheduled transactions system smart contract
+type ScheduledTxSystemSCConfig struct {
+	EnabledEpoch           uint32
+	MaxPendingTxs          uint32
+	MaxPendingTxsPerSender uint32
+	MaxRoundsInFuture      uint64
+	MaxExecutionsPerCall   uint32
+	DepositPerTx           string
+}
@@ -0,0 +1,758 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultGeneralFileName   = "config.toml"
+	defaultApiRoutesFileName = "api.toml"
+	defaultEconomicsFileName = "economics.toml"
+	defaultSystemSCFileName  = "systemSmartContractsConfig.toml"
+	defaultRatingsFileName   = "ratings.toml"
+	defaultP2PFileName       = "p2p.toml"
+
+	// the values below mirror the ones enforced by the components created from the configuration, which can not be
+	// imported here
+	lruCacheType                   = "LRU"
+	sizeLRUCacheType               = "SizeLRU"
+	minSizeInBytesForSizeLRUCache  = 1024
+	listsSharderType               = "ListsSharder"
+	oneListSharderType             = "OneListSharder"
+	nilListSharderType             = "NilListSharder"
+	minPeersForListsSharder        = 5
+	minPeersForOneListSharder      = 3
+	minRangePortValue              = 1025
+	apiKeyHashLength               = 32
+	minGasPriceModifier            = 0.00000001
+	minConsecutiveMissedBlocksStep = 1
+	maxDecreaseFactor              = -1
+)
+
+var knownDBTypes = []string{"LvlDB", "LvlDBSerial", "MemoryDB"}
+
+var knownDBCompressionTypes = []string{"None", "Snappy"}
+
+var knownAPIPackages = []string{"node", "address", "network", "transaction", "vm-values", "validator", "hardfork", "block", "log"}
+
+var cacheConfigType = reflect.TypeOf(CacheConfig{})
+
+var dbConfigType = reflect.TypeOf(DBConfig{})
+
+// ValidationError describes a configuration value which failed the validation, along with its file and key
+type ValidationError struct {
+	File    string
+	Key     string
+	Message string
+}
+
+// Error returns the error as file: key: message
+func (ve ValidationError) Error() string {
+	if len(ve.Key) == 0 {
+		return fmt.Sprintf("%s: %s", ve.File, ve.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", ve.File, ve.Key, ve.Message)
+}
+
+// ValidationErrors holds all the errors found while validating the configuration files
+type ValidationErrors []ValidationError
+
+// Error returns all the validation errors, one per line
+func (ve ValidationErrors) Error() string {
+	lines := make([]string, 0, len(ve)+1)
+	lines = append(lines, fmt.Sprintf("%s, %d error(s) found:", ErrInvalidConfig.Error(), len(ve)))
+	for _, err := range ve {
+		lines = append(lines, "  "+err.Error())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap makes the validation errors match ErrInvalidConfig
+func (ve ValidationErrors) Unwrap() error {
+	return ErrInvalidConfig
+}
+
+// NodeConfigs holds the node configurations to be validated. The nil configurations are skipped, along with the
+// checks spanning them and other files
+type NodeConfigs struct {
+	General   *Config
+	ApiRoutes *ApiRoutesConfig
+	Economics *EconomicsConfig
+	SystemSC  *SystemSmartContractsConfig
+	Ratings   *RatingsConfig
+	P2P       *P2PConfig
+	FileNames ConfigFileNames
+}
+
+// ConfigFileNames holds the paths of the validated files, used to locate the errors. The empty ones default to the
+// names of the files from the node's config folder
+type ConfigFileNames struct {
+	General   string
+	ApiRoutes string
+	Economics string
+	SystemSC  string
+	Ratings   string
+	P2P       string
+}
+
+func (cfn ConfigFileNames) withDefaults() ConfigFileNames {
+	return ConfigFileNames{
+		General:   valueOrDefault(cfn.General, defaultGeneralFileName),
+		ApiRoutes: valueOrDefault(cfn.ApiRoutes, defaultApiRoutesFileName),
+		Economics: valueOrDefault(cfn.Economics, defaultEconomicsFileName),
+		SystemSC:  valueOrDefault(cfn.SystemSC, defaultSystemSCFileName),
+		Ratings:   valueOrDefault(cfn.Ratings, defaultRatingsFileName),
+		P2P:       valueOrDefault(cfn.P2P, defaultP2PFileName),
+	}
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if len(value) == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// Validate checks the node configurations, including the values spanning several files, and returns all the
+// errors found as ValidationErrors, or nil if the configurations are valid
+func Validate(configs NodeConfigs) error {
+	cv := &configValidator{
+		fileNames: configs.FileNames.withDefaults(),
+	}
+
+	if configs.General != nil {
+		cv.validateGeneral(configs.General)
+	}
+	if configs.ApiRoutes != nil {
+		cv.validateApiRoutes(configs.ApiRoutes)
+	}
+	if configs.Economics != nil {
+		cv.validateEconomics(configs.Economics)
+	}
+	if configs.SystemSC != nil {
+		cv.validateSystemSC(configs.SystemSC)
+	}
+	if configs.Ratings != nil {
+		cv.validateRatings(configs.Ratings)
+	}
+	if configs.P2P != nil {
+		cv.validateP2P(configs.P2P)
+	}
+	if configs.General != nil && configs.SystemSC != nil {
+		cv.validateESDTEnableEpochs(configs.General, configs.SystemSC)
+	}
+
+	if len(cv.errors) == 0 {
+		return nil
+	}
+
+	return cv.errors
+}
+
+type configValidator struct {
+	fileNames ConfigFileNames
+	errors    ValidationErrors
+}
+
+func (cv *configValidator) addError(file string, key string, format string, args ...interface{}) {
+	cv.errors = append(cv.errors, ValidationError{
+		File:    file,
+		Key:     key,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (cv *configValidator) validateGeneral(cfg *Config) {
+	file := cv.fileNames.General
+
+	cv.validateCachesAndDBs(file, "", reflect.ValueOf(*cfg))
+	cv.validateMaxNodesChange(file, cfg.GeneralSettings.MaxNodesChangeEnableEpoch)
+	cv.validateVersions(file, cfg.Versions.VersionsByEpochs)
+	cv.validateGasSchedule(file, cfg.GasSchedule.GasScheduleByEpochs)
+
+	epochStart := cfg.EpochStartConfig
+	if epochStart.RoundsPerEpoch <= 0 {
+		cv.addError(file, "EpochStartConfig.RoundsPerEpoch", "must be greater than 0")
+	}
+	if epochStart.MinRoundsBetweenEpochs > epochStart.RoundsPerEpoch {
+		cv.addError(file, "EpochStartConfig.MinRoundsBetweenEpochs", "%d exceeds RoundsPerEpoch %d",
+			epochStart.MinRoundsBetweenEpochs, epochStart.RoundsPerEpoch)
+	}
+	cv.checkPercentage(file, "EpochStartConfig.MinShuffledOutRestartThreshold", epochStart.MinShuffledOutRestartThreshold)
+	cv.checkPercentage(file, "EpochStartConfig.MaxShuffledOutRestartThreshold", epochStart.MaxShuffledOutRestartThreshold)
+	if epochStart.MinShuffledOutRestartThreshold > epochStart.MaxShuffledOutRestartThreshold {
+		cv.addError(file, "EpochStartConfig.MinShuffledOutRestartThreshold", "%v exceeds MaxShuffledOutRestartThreshold %v",
+			epochStart.MinShuffledOutRestartThreshold, epochStart.MaxShuffledOutRestartThreshold)
+	}
+
+	pruning := cfg.StoragePruning
+	if pruning.NumActivePersisters < 1 {
+		cv.addError(file, "StoragePruning.NumActivePersisters", "must be at least 1")
+	}
+	if pruning.NumEpochsToKeep < pruning.NumActivePersisters {
+		cv.addError(file, "StoragePruning.NumEpochsToKeep", "%d is lower than NumActivePersisters %d",
+			pruning.NumEpochsToKeep, pruning.NumActivePersisters)
+	}
+
+	throttle := cfg.BlockSizeThrottleConfig
+	if throttle.MinSizeInBytes > throttle.MaxSizeInBytes {
+		cv.addError(file, "BlockSizeThrottleConfig.MinSizeInBytes", "%d exceeds MaxSizeInBytes %d",
+			throttle.MinSizeInBytes, throttle.MaxSizeInBytes)
+	}
+
+	txPoolPersistence := cfg.TxPoolPersistence
+	if txPoolPersistence.Enabled && txPoolPersistence.SaveIntervalInSeconds == 0 {
+		cv.addError(file, "TxPoolPersistence.SaveIntervalInSeconds", "must be greater than 0 when the persistence is enabled")
+	}
+
+	heartbeat := cfg.Heartbeat
+	if heartbeat.MinTimeToWaitBetweenBroadcastsInSec > heartbeat.MaxTimeToWaitBetweenBroadcastsInSec {
+		cv.addError(file, "Heartbeat.MinTimeToWaitBetweenBroadcastsInSec", "%d exceeds MaxTimeToWaitBetweenBroadcastsInSec %d",
+			heartbeat.MinTimeToWaitBetweenBroadcastsInSec, heartbeat.MaxTimeToWaitBetweenBroadcastsInSec)
+	}
+}
+
+// validateCachesAndDBs walks the configuration structure and validates every cache and database configuration found
+func (cv *configValidator) validateCachesAndDBs(file string, prefix string, value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		key := joinKey(prefix, field.Name)
+		if field.Anonymous {
+			key = prefix
+		}
+
+		switch {
+		case field.Type == cacheConfigType:
+			cv.validateCache(file, key, value.Field(i).Interface().(CacheConfig))
+		case field.Type == dbConfigType:
+			cv.validateDB(file, key, value.Field(i).Interface().(DBConfig))
+		case field.Type.Kind() == reflect.Struct:
+			cv.validateCachesAndDBs(file, key, value.Field(i))
+		}
+	}
+}
+
+func (cv *configValidator) validateCache(file string, key string, cfg CacheConfig) {
+	if cfg.Capacity == 0 {
+		cv.addError(file, joinKey(key, "Capacity"), "must be greater than 0")
+	}
+
+	switch cfg.Type {
+	case lruCacheType:
+		if cfg.SizeInBytes != 0 {
+			cv.addError(file, joinKey(key, "SizeInBytes"), "must not be set for %s caches, use the %s type instead",
+				lruCacheType, sizeLRUCacheType)
+		}
+	case sizeLRUCacheType:
+		if cfg.SizeInBytes < minSizeInBytesForSizeLRUCache {
+			cv.addError(file, joinKey(key, "SizeInBytes"), "%d is lower than the minimum %d for %s caches",
+				cfg.SizeInBytes, minSizeInBytesForSizeLRUCache, sizeLRUCacheType)
+		}
+	}
+
+	if cfg.SizePerSender > cfg.Capacity {
+		cv.addError(file, joinKey(key, "SizePerSender"), "%d exceeds Capacity %d", cfg.SizePerSender, cfg.Capacity)
+	}
+	if cfg.SizeInBytes > 0 && uint64(cfg.SizeInBytesPerSender) > cfg.SizeInBytes {
+		cv.addError(file, joinKey(key, "SizeInBytesPerSender"), "%d exceeds SizeInBytes %d",
+			cfg.SizeInBytesPerSender, cfg.SizeInBytes)
+	}
+}
+
+func (cv *configValidator) validateDB(file string, key string, cfg DBConfig) {
+	if len(cfg.FilePath) == 0 {
+		cv.addError(file, joinKey(key, "FilePath"), "must not be empty")
+	}
+	if !contains(knownDBTypes, cfg.Type) {
+		cv.addError(file, joinKey(key, "Type"), "unknown type %q, expected one of %s",
+			cfg.Type, strings.Join(knownDBTypes, ", "))
+	}
+	if len(cfg.Compression) > 0 && !contains(knownDBCompressionTypes, cfg.Compression) {
+		cv.addError(file, joinKey(key, "Compression"), "unknown compression %q, expected one of %s or empty",
+			cfg.Compression, strings.Join(knownDBCompressionTypes, ", "))
+	}
+}
+
+func (cv *configValidator) validateMaxNodesChange(file string, configs []MaxNodesChangeConfig) {
+	key := "GeneralSettings.MaxNodesChangeEnableEpoch"
+	if len(configs) == 0 {
+		cv.addError(file, key, "must hold at least one entry")
+		return
+	}
+
+	for i, cfg := range configs {
+		entryKey := fmt.Sprintf("%s[%d]", key, i)
+		if cfg.MaxNumNodes == 0 {
+			cv.addError(file, joinKey(entryKey, "MaxNumNodes"), "must be greater than 0")
+		}
+		if i > 0 && cfg.EpochEnable <= configs[i-1].EpochEnable {
+			cv.addError(file, joinKey(entryKey, "EpochEnable"), "%d must be greater than the previous entry's %d",
+				cfg.EpochEnable, configs[i-1].EpochEnable)
+		}
+	}
+}
+
+func (cv *configValidator) validateVersions(file string, versions []VersionByEpochs) {
+	key := "Versions.VersionsByEpochs"
+	if len(versions) == 0 {
+		cv.addError(file, key, "must hold at least one entry")
+		return
+	}
+
+	for i, version := range versions {
+		entryKey := fmt.Sprintf("%s[%d]", key, i)
+		if i == 0 && version.StartEpoch != 0 {
+			cv.addError(file, joinKey(entryKey, "StartEpoch"), "the first version must start on epoch 0")
+		}
+		if i > 0 && version.StartEpoch <= versions[i-1].StartEpoch {
+			cv.addError(file, joinKey(entryKey, "StartEpoch"), "%d must be greater than the previous entry's %d",
+				version.StartEpoch, versions[i-1].StartEpoch)
+		}
+		if len(version.Version) == 0 {
+			cv.addError(file, joinKey(entryKey, "Version"), "must not be empty")
+		}
+	}
+}
+
+func (cv *configValidator) validateGasSchedule(file string, gasSchedules []GasScheduleByEpochs) {
+	key := "GasSchedule.GasScheduleByEpochs"
+	if len(gasSchedules) == 0 {
+		cv.addError(file, key, "must hold at least one entry")
+		return
+	}
+
+	for i, gasSchedule := range gasSchedules {
+		entryKey := fmt.Sprintf("%s[%d]", key, i)
+		if i > 0 && gasSchedule.StartEpoch <= gasSchedules[i-1].StartEpoch {
+			cv.addError(file, joinKey(entryKey, "StartEpoch"), "%d must be greater than the previous entry's %d",
+				gasSchedule.StartEpoch, gasSchedules[i-1].StartEpoch)
+		}
+		if len(gasSchedule.FileName) == 0 {
+			cv.addError(file, joinKey(entryKey, "FileName"), "must not be empty")
+		}
+	}
+}
+
+func (cv *configValidator) validateApiRoutes(cfg *ApiRoutesConfig) {
+	file := cv.fileNames.ApiRoutes
+
+	if cfg.TLS.Enabled {
+		if len(cfg.TLS.CertificateFile) == 0 {
+			cv.addError(file, "TLS.CertificateFile", "must be set when TLS is enabled")
+		}
+		if len(cfg.TLS.KeyFile) == 0 {
+			cv.addError(file, "TLS.KeyFile", "must be set when TLS is enabled")
+		}
+	}
+
+	keyNames := make(map[string]struct{})
+	for i, key := range cfg.Auth.Keys {
+		entryKey := fmt.Sprintf("Auth.Keys[%d]", i)
+		if len(key.Name) == 0 {
+			cv.addError(file, joinKey(entryKey, "Name"), "must not be empty")
+		}
+		_, exists := keyNames[key.Name]
+		if exists {
+			cv.addError(file, joinKey(entryKey, "Name"), "duplicated key name %q", key.Name)
+		}
+		keyNames[key.Name] = struct{}{}
+
+		keyHash, err := hex.DecodeString(key.KeyHash)
+		if err != nil || len(keyHash) != apiKeyHashLength {
+			cv.addError(file, joinKey(entryKey, "KeyHash"), "must be a hex encoded sha256 hash")
+		}
+	}
+
+	packageNames := make([]string, 0, len(cfg.APIPackages))
+	for packageName := range cfg.APIPackages {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	for _, packageName := range packageNames {
+		packageConfig := cfg.APIPackages[packageName]
+		packageKey := joinKey("APIPackages", packageName)
+		if !contains(knownAPIPackages, packageName) {
+			cv.addError(file, packageKey, "unknown package, expected one of %s", strings.Join(knownAPIPackages, ", "))
+		}
+
+		routeNames := make(map[string]struct{})
+		for i, route := range packageConfig.Routes {
+			routeKey := fmt.Sprintf("%s.Routes[%d].Name", packageKey, i)
+			if !strings.HasPrefix(route.Name, "/") {
+				cv.addError(file, routeKey, "route %q must start with /", route.Name)
+			}
+			_, exists := routeNames[route.Name]
+			if exists {
+				cv.addError(file, routeKey, "duplicated route %q", route.Name)
+			}
+			routeNames[route.Name] = struct{}{}
+		}
+	}
+}
+
+func (cv *configValidator) validateEconomics(cfg *EconomicsConfig) {
+	file := cv.fileNames.Economics
+
+	cv.parseBigInt(file, "GlobalSettings.GenesisTotalSupply", cfg.GlobalSettings.GenesisTotalSupply, false)
+	cv.parseBigInt(file, "RewardsSettings.TopUpGradientPoint", cfg.RewardsSettings.TopUpGradientPoint, false)
+	cv.parseUint(file, "FeeSettings.MinGasPrice", cfg.FeeSettings.MinGasPrice)
+	cv.parseUint(file, "FeeSettings.GasPerDataByte", cfg.FeeSettings.GasPerDataByte)
+	minGasLimit, isMinGasLimitValid := cv.parseUint(file, "FeeSettings.MinGasLimit", cfg.FeeSettings.MinGasLimit)
+	maxGasLimits := []struct {
+		key   string
+		value string
+	}{
+		{key: "FeeSettings.MaxGasLimitPerBlock", value: cfg.FeeSettings.MaxGasLimitPerBlock},
+		{key: "FeeSettings.MaxGasLimitPerMetaBlock", value: cfg.FeeSettings.MaxGasLimitPerMetaBlock},
+	}
+	for _, maxGasLimit := range maxGasLimits {
+		value, isValid := cv.parseUint(file, maxGasLimit.key, maxGasLimit.value)
+		if isValid && isMinGasLimitValid && value < minGasLimit {
+			cv.addError(file, maxGasLimit.key, "%d is lower than MinGasLimit %d", value, minGasLimit)
+		}
+	}
+	if cfg.FeeSettings.GasPriceModifier < minGasPriceModifier || cfg.FeeSettings.GasPriceModifier > 1 {
+		cv.addError(file, "FeeSettings.GasPriceModifier", "%v is not in the (0, 1] interval", cfg.FeeSettings.GasPriceModifier)
+	}
+
+	rewards := cfg.RewardsSettings
+	cv.checkPercentage(file, "RewardsSettings.LeaderPercentage", rewards.LeaderPercentage)
+	cv.checkPercentage(file, "RewardsSettings.DeveloperPercentage", rewards.DeveloperPercentage)
+	cv.checkPercentage(file, "RewardsSettings.ProtocolSustainabilityPercentage", rewards.ProtocolSustainabilityPercentage)
+	cv.checkPercentage(file, "RewardsSettings.TopUpFactor", rewards.TopUpFactor)
+	sumPercentages := rewards.LeaderPercentage + rewards.DeveloperPercentage + rewards.ProtocolSustainabilityPercentage
+	if sumPercentages > 1 {
+		cv.addError(file, "RewardsSettings", "the leader, developer and protocol sustainability percentages sum up to %v, above 1",
+			sumPercentages)
+	}
+	if len(rewards.ProtocolSustainabilityAddress) == 0 {
+		cv.addError(file, "RewardsSettings.ProtocolSustainabilityAddress", "must not be empty")
+	}
+
+	global := cfg.GlobalSettings
+	cv.checkPercentage(file, "GlobalSettings.MinimumInflation", global.MinimumInflation)
+	for i, yearSetting := range global.YearSettings {
+		entryKey := fmt.Sprintf("GlobalSettings.YearSettings[%d]", i)
+		if yearSetting == nil {
+			cv.addError(file, entryKey, "must not be empty")
+			continue
+		}
+
+		cv.checkPercentage(file, joinKey(entryKey, "MaximumInflation"), yearSetting.MaximumInflation)
+		if yearSetting.MaximumInflation < global.MinimumInflation {
+			cv.addError(file, joinKey(entryKey, "MaximumInflation"), "%v is lower than MinimumInflation %v",
+				yearSetting.MaximumInflation, global.MinimumInflation)
+		}
+		if i > 0 && global.YearSettings[i-1] != nil && yearSetting.Year <= global.YearSettings[i-1].Year {
+			cv.addError(file, joinKey(entryKey, "Year"), "%d must be greater than the previous entry's %d",
+				yearSetting.Year, global.YearSettings[i-1].Year)
+		}
+	}
+}
+
+func (cv *configValidator) validateSystemSC(cfg *SystemSmartContractsConfig) {
+	file := cv.fileNames.SystemSC
+
+	staking := cfg.StakingSystemSCConfig
+	cv.parseBigInt(file, "StakingSystemSCConfig.GenesisNodePrice", staking.GenesisNodePrice, false)
+	cv.parseBigInt(file, "StakingSystemSCConfig.MinStakeValue", staking.MinStakeValue, true)
+	cv.parseBigInt(file, "StakingSystemSCConfig.MinUnstakeTokensValue", staking.MinUnstakeTokensValue, false)
+	cv.parseBigInt(file, "StakingSystemSCConfig.UnJailValue", staking.UnJailValue, false)
+	cv.parseBigInt(file, "StakingSystemSCConfig.MinStepValue", staking.MinStepValue, false)
+	cv.checkPercentage(file, "StakingSystemSCConfig.MaximumPercentageToBleed", staking.MaximumPercentageToBleed)
+	cv.checkPercentage(file, "StakingSystemSCConfig.BleedPercentagePerRound", staking.BleedPercentagePerRound)
+
+	esdt := cfg.ESDTSystemSCConfig
+	cv.parseBigInt(file, "ESDTSystemSCConfig.BaseIssuingCost", esdt.BaseIssuingCost, false)
+	if esdt.TokenMetadataEnableEpoch < esdt.EnabledEpoch {
+		cv.addError(file, "ESDTSystemSCConfig.TokenMetadataEnableEpoch", "%d is lower than EnabledEpoch %d",
+			esdt.TokenMetadataEnableEpoch, esdt.EnabledEpoch)
+	}
+
+	governance := cfg.GovernanceSystemSCConfig
+	cv.parseBigInt(file, "GovernanceSystemSCConfig.ProposalCost", governance.ProposalCost, false)
+	cv.parseBigInt(file, "GovernanceSystemSCConfig.MinQuorumStake", governance.MinQuorumStake, false)
+	cv.parseBigInt(file, "GovernanceSystemSCConfig.MinPassThresholdStake", governance.MinPassThresholdStake, false)
+	cv.parseBigInt(file, "GovernanceSystemSCConfig.MinVetoThresholdStake", governance.MinVetoThresholdStake, false)
+	thresholds := []struct {
+		key   string
+		value int32
+	}{
+		{key: "GovernanceSystemSCConfig.MinQuorum", value: governance.MinQuorum},
+		{key: "GovernanceSystemSCConfig.MinPassThreshold", value: governance.MinPassThreshold},
+		{key: "GovernanceSystemSCConfig.MinVetoThreshold", value: governance.MinVetoThreshold},
+	}
+	for _, threshold := range thresholds {
+		if threshold.value < 0 || int64(threshold.value) > governance.NumNodes {
+			cv.addError(file, threshold.key, "%d is not between 0 and NumNodes %d", threshold.value, governance.NumNodes)
+		}
+	}
+	if governance.StakeWeightedVotingEnableEpoch < governance.EnabledEpoch {
+		cv.addError(file, "GovernanceSystemSCConfig.StakeWeightedVotingEnableEpoch", "%d is lower than EnabledEpoch %d",
+			governance.StakeWeightedVotingEnableEpoch, governance.EnabledEpoch)
+	}
+
+	delegationManager := cfg.DelegationManagerSystemSCConfig
+	cv.parseBigInt(file, "DelegationManagerSystemSCConfig.MinCreationDeposit", delegationManager.MinCreationDeposit, false)
+	cv.parseBigInt(file, "DelegationManagerSystemSCConfig.MinStakeAmount", delegationManager.MinStakeAmount, true)
+	if delegationManager.EnabledEpoch == 0 {
+		cv.addError(file, "DelegationManagerSystemSCConfig.EnabledEpoch", "must not be 0")
+	}
+	if len(delegationManager.ConfigChangeAddress) == 0 {
+		cv.addError(file, "DelegationManagerSystemSCConfig.ConfigChangeAddress", "must not be empty")
+	}
+	if delegationManager.MoveDelegationEnableEpoch < delegationManager.EnabledEpoch {
+		cv.addError(file, "DelegationManagerSystemSCConfig.MoveDelegationEnableEpoch", "%d is lower than EnabledEpoch %d",
+			delegationManager.MoveDelegationEnableEpoch, delegationManager.EnabledEpoch)
+	}
+
+	delegation := cfg.DelegationSystemSCConfig
+	if delegation.EnabledEpoch == 0 {
+		cv.addError(file, "DelegationSystemSCConfig.EnabledEpoch", "must not be 0")
+	}
+	if delegation.MaxServiceFee < 1 {
+		cv.addError(file, "DelegationSystemSCConfig.MaxServiceFee", "must be greater than 0")
+	}
+	if delegation.MinServiceFee > delegation.MaxServiceFee {
+		cv.addError(file, "DelegationSystemSCConfig.MinServiceFee", "%d exceeds MaxServiceFee %d",
+			delegation.MinServiceFee, delegation.MaxServiceFee)
+	}
+}
+
+// validateESDTEnableEpochs checks that the ESDT related features are not enabled before the ESDT system smart contract
+func (cv *configValidator) validateESDTEnableEpochs(general *Config, systemSC *SystemSmartContractsConfig) {
+	esdtEnabledEpoch := systemSC.ESDTSystemSCConfig.EnabledEpoch
+	epochs := []struct {
+		key   string
+		value uint32
+	}{
+		{key: "GeneralSettings.ArwenESDTFunctionsEnableEpoch", value: general.GeneralSettings.ArwenESDTFunctionsEnableEpoch},
+		{key: "GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch", value: general.GeneralSettings.ESDTNFTRoyaltiesPaymentEnableEpoch},
+	}
+	for _, epoch := range epochs {
+		if epoch.value < esdtEnabledEpoch {
+			cv.addError(cv.fileNames.General, epoch.key, "%d is lower than ESDTSystemSCConfig.EnabledEpoch %d from %s",
+				epoch.value, esdtEnabledEpoch, cv.fileNames.SystemSC)
+		}
+	}
+}
+
+func (cv *configValidator) validateRatings(cfg *RatingsConfig) {
+	file := cv.fileNames.Ratings
+
+	general := cfg.General
+	if general.MinRating < 1 {
+		cv.addError(file, "General.MinRating", "must be at least 1")
+	}
+	if general.StartRating < general.MinRating || general.StartRating > general.MaxRating {
+		cv.addError(file, "General.StartRating", "%d is not between MinRating %d and MaxRating %d",
+			general.StartRating, general.MinRating, general.MaxRating)
+	}
+	if general.SignedBlocksThreshold < 0 || general.SignedBlocksThreshold > 1 {
+		cv.addError(file, "General.SignedBlocksThreshold", "%v is not in the [0, 1] interval", general.SignedBlocksThreshold)
+	}
+
+	if len(general.SelectionChances) == 0 {
+		cv.addError(file, "General.SelectionChances", "must hold at least one entry")
+	}
+	for i, chance := range general.SelectionChances {
+		entryKey := fmt.Sprintf("General.SelectionChances[%d]", i)
+		if chance == nil {
+			cv.addError(file, entryKey, "must not be empty")
+			continue
+		}
+		if i > 0 && general.SelectionChances[i-1] != nil && chance.MaxThreshold <= general.SelectionChances[i-1].MaxThreshold {
+			cv.addError(file, joinKey(entryKey, "MaxThreshold"), "%d must be greater than the previous entry's %d",
+				chance.MaxThreshold, general.SelectionChances[i-1].MaxThreshold)
+		}
+		isLast := i == len(general.SelectionChances)-1
+		if isLast && chance.MaxThreshold < general.MaxRating {
+			cv.addError(file, joinKey(entryKey, "MaxThreshold"), "%d is lower than MaxRating %d, the last entry must cover it",
+				chance.MaxThreshold, general.MaxRating)
+		}
+	}
+
+	cv.validateRatingSteps(file, "ShardChain.RatingSteps", cfg.ShardChain.RatingSteps)
+	cv.validateRatingSteps(file, "MetaChain.RatingSteps", cfg.MetaChain.RatingSteps)
+
+	honesty := cfg.PeerHonesty
+	if honesty.MinScore >= honesty.MaxScore {
+		cv.addError(file, "PeerHonesty.MinScore", "%v must be lower than MaxScore %v", honesty.MinScore, honesty.MaxScore)
+	}
+	if honesty.BadPeerThreshold < honesty.MinScore || honesty.BadPeerThreshold > honesty.MaxScore {
+		cv.addError(file, "PeerHonesty.BadPeerThreshold", "%v is not between MinScore %v and MaxScore %v",
+			honesty.BadPeerThreshold, honesty.MinScore, honesty.MaxScore)
+	}
+}
+
+func (cv *configValidator) validateRatingSteps(file string, key string, steps RatingSteps) {
+	if steps.HoursToMaxRatingFromStartRating == 0 {
+		cv.addError(file, joinKey(key, "HoursToMaxRatingFromStartRating"), "must be greater than 0")
+	}
+	if steps.ConsecutiveMissedBlocksPenalty < minConsecutiveMissedBlocksStep {
+		cv.addError(file, joinKey(key, "ConsecutiveMissedBlocksPenalty"), "%v is lower than %d",
+			steps.ConsecutiveMissedBlocksPenalty, minConsecutiveMissedBlocksStep)
+	}
+	if steps.ProposerDecreaseFactor > maxDecreaseFactor {
+		cv.addError(file, joinKey(key, "ProposerDecreaseFactor"), "%v is greater than %d",
+			steps.ProposerDecreaseFactor, maxDecreaseFactor)
+	}
+	if steps.ValidatorDecreaseFactor > maxDecreaseFactor {
+		cv.addError(file, joinKey(key, "ValidatorDecreaseFactor"), "%v is greater than %d",
+			steps.ValidatorDecreaseFactor, maxDecreaseFactor)
+	}
+}
+
+func (cv *configValidator) validateP2P(cfg *P2PConfig) {
+	file := cv.fileNames.P2P
+
+	cv.validatePort(file, "Node.Port", cfg.Node.Port)
+
+	kadDht := cfg.KadDhtPeerDiscovery
+	if kadDht.Enabled {
+		if kadDht.RefreshIntervalInSec == 0 {
+			cv.addError(file, "KadDhtPeerDiscovery.RefreshIntervalInSec", "must be greater than 0")
+		}
+		if kadDht.RoutingTableRefreshIntervalInSec == 0 {
+			cv.addError(file, "KadDhtPeerDiscovery.RoutingTableRefreshIntervalInSec", "must be greater than 0")
+		}
+		if kadDht.BucketSize == 0 {
+			cv.addError(file, "KadDhtPeerDiscovery.BucketSize", "must be greater than 0")
+		}
+		if len(kadDht.ProtocolID) == 0 {
+			cv.addError(file, "KadDhtPeerDiscovery.ProtocolID", "must not be empty")
+		}
+	}
+
+	sharding := cfg.Sharding
+	switch sharding.Type {
+	case listsSharderType:
+		if sharding.TargetPeerCount < minPeersForListsSharder {
+			cv.addError(file, "Sharding.TargetPeerCount", "%d is lower than the minimum %d for the %s",
+				sharding.TargetPeerCount, minPeersForListsSharder, listsSharderType)
+		}
+		maxPeers := []struct {
+			key   string
+			value uint32
+		}{
+			{key: "Sharding.MaxIntraShardValidators", value: sharding.MaxIntraShardValidators},
+			{key: "Sharding.MaxCrossShardValidators", value: sharding.MaxCrossShardValidators},
+			{key: "Sharding.MaxIntraShardObservers", value: sharding.MaxIntraShardObservers},
+			{key: "Sharding.MaxCrossShardObservers", value: sharding.MaxCrossShardObservers},
+		}
+		sumMaxPeers := 0
+		for _, maxPeer := range maxPeers {
+			if maxPeer.value == 0 {
+				cv.addError(file, maxPeer.key, "must be greater than 0")
+			}
+			sumMaxPeers += int(maxPeer.value)
+		}
+		// the lists sharder keeps a slot for at least one peer of unknown shard
+		if sumMaxPeers+1 > sharding.TargetPeerCount {
+			cv.addError(file, "Sharding.TargetPeerCount", "%d must exceed the sum %d of the validators and observers maximums",
+				sharding.TargetPeerCount, sumMaxPeers)
+		}
+	case oneListSharderType:
+		if sharding.TargetPeerCount < minPeersForOneListSharder {
+			cv.addError(file, "Sharding.TargetPeerCount", "%d is lower than the minimum %d for the %s",
+				sharding.TargetPeerCount, minPeersForOneListSharder, oneListSharderType)
+		}
+	case nilListSharderType:
+	default:
+		cv.addError(file, "Sharding.Type", "unknown type %q, expected one of %s, %s, %s",
+			sharding.Type, listsSharderType, oneListSharderType, nilListSharderType)
+	}
+}
+
+// validatePort accepts a port number, 0 meaning a random port, or a start-end range
+func (cv *configValidator) validatePort(file string, key string, port string) {
+	value, err := strconv.Atoi(port)
+	if err == nil {
+		if value < 0 {
+			cv.addError(file, key, "%d must not be negative", value)
+		}
+		return
+	}
+
+	ports := strings.Split(port, "-")
+	if len(ports) != 2 {
+		cv.addError(file, key, "%q is neither a port nor a start-end range", port)
+		return
+	}
+
+	startPort, errStart := strconv.Atoi(ports[0])
+	endPort, errEnd := strconv.Atoi(ports[1])
+	if errStart != nil || errEnd != nil {
+		cv.addError(file, key, "%q is neither a port nor a start-end range", port)
+		return
+	}
+	if startPort < minRangePortValue {
+		cv.addError(file, key, "the range must start from at least %d", minRangePortValue)
+	}
+	if endPort < startPort {
+		cv.addError(file, key, "the range end %d is lower than its start %d", endPort, startPort)
+	}
+}
+
+func (cv *configValidator) parseUint(file string, key string, value string) (uint64, bool) {
+	result, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		cv.addError(file, key, "%q is not an unsigned integer", value)
+		return 0, false
+	}
+
+	return result, true
+}
+
+func (cv *configValidator) parseBigInt(file string, key string, value string, mustBePositive bool) {
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		cv.addError(file, key, "%q is not an integer", value)
+		return
+	}
+
+	if result.Sign() < 0 {
+		cv.addError(file, key, "%s must not be negative", value)
+	}
+	if mustBePositive && result.Sign() == 0 {
+		cv.addError(file, key, "must be greater than 0")
+	}
+}
+
+func (cv *configValidator) checkPercentage(file string, key string, value float64) {
+	if value < 0 || value > 1 {
+		cv.addError(file, key, "%v is not in the [0, 1] interval", value)
+	}
+}
+
+func joinKey(prefix string, name string) string {
+	if len(prefix) == 0 {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+func contains(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+
+	return false
+}
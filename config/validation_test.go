@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodeConfigFolder = "../cmd/node/config"
+
+func loadNodeConfigFile(t *testing.T, fileName string, cfg interface{}) {
+	buff, err := ioutil.ReadFile(filepath.Join(nodeConfigFolder, fileName))
+	require.Nil(t, err)
+
+	err = toml.Unmarshal(buff, cfg)
+	require.Nil(t, err)
+}
+
+func loadNodeConfigs(t *testing.T) NodeConfigs {
+	configs := NodeConfigs{
+		General:   &Config{},
+		ApiRoutes: &ApiRoutesConfig{},
+		Economics: &EconomicsConfig{},
+		SystemSC:  &SystemSmartContractsConfig{},
+		Ratings:   &RatingsConfig{},
+		P2P:       &P2PConfig{},
+	}
+	loadNodeConfigFile(t, defaultGeneralFileName, configs.General)
+	loadNodeConfigFile(t, defaultApiRoutesFileName, configs.ApiRoutes)
+	loadNodeConfigFile(t, defaultEconomicsFileName, configs.Economics)
+	loadNodeConfigFile(t, defaultSystemSCFileName, configs.SystemSC)
+	loadNodeConfigFile(t, defaultRatingsFileName, configs.Ratings)
+	loadNodeConfigFile(t, defaultP2PFileName, configs.P2P)
+
+	return configs
+}
+
+func requireValidationErrors(t *testing.T, err error) ValidationErrors {
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidConfig))
+
+	validationErrors, ok := err.(ValidationErrors)
+	require.True(t, ok)
+
+	return validationErrors
+}
+
+func findValidationError(validationErrors ValidationErrors, file string, key string) (ValidationError, bool) {
+	for _, validationError := range validationErrors {
+		if validationError.File == file && validationError.Key == key {
+			return validationError, true
+		}
+	}
+
+	return ValidationError{}, false
+}
+
+func TestValidate_NodeConfigFilesShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	err := Validate(loadNodeConfigs(t))
+	assert.Nil(t, err)
+}
+
+func TestValidate_NilConfigsShouldBeSkipped(t *testing.T) {
+	t.Parallel()
+
+	err := Validate(NodeConfigs{})
+	assert.Nil(t, err)
+}
+
+func TestValidate_ShouldReportAllErrorsWithTheirLocation(t *testing.T) {
+	t.Parallel()
+
+	configs := loadNodeConfigs(t)
+	configs.FileNames.General = "custom/config.toml"
+	configs.General.TxDataPool.SizePerSender = configs.General.TxDataPool.Capacity + 1
+	configs.General.MiniBlocksStorage.Cache.SizeInBytes = 10
+	configs.General.AccountsTrieStorage.DB.Type = "RocksDB"
+	configs.General.GeneralSettings.MaxNodesChangeEnableEpoch = []MaxNodesChangeConfig{
+		{EpochEnable: 2, MaxNumNodes: 10},
+		{EpochEnable: 1, MaxNumNodes: 20},
+	}
+	configs.Economics.FeeSettings.MinGasLimit = "not a number"
+	configs.Ratings.General.StartRating = configs.Ratings.General.MaxRating + 1
+	configs.P2P.Node.Port = "38383-37373"
+	configs.ApiRoutes.APIPackages["node"] = APIPackageConfig{
+		Routes: []RouteConfig{{Name: "/status"}, {Name: "/status"}},
+	}
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	expectedKeys := []struct {
+		file string
+		key  string
+	}{
+		{file: "custom/config.toml", key: "TxDataPool.SizePerSender"},
+		{file: "custom/config.toml", key: "MiniBlocksStorage.Cache.SizeInBytes"},
+		{file: "custom/config.toml", key: "AccountsTrieStorage.DB.Type"},
+		{file: "custom/config.toml", key: "GeneralSettings.MaxNodesChangeEnableEpoch[1].EpochEnable"},
+		{file: defaultEconomicsFileName, key: "FeeSettings.MinGasLimit"},
+		{file: defaultRatingsFileName, key: "General.StartRating"},
+		{file: defaultP2PFileName, key: "Node.Port"},
+		{file: defaultApiRoutesFileName, key: "APIPackages.node.Routes[1].Name"},
+	}
+	assert.Equal(t, len(expectedKeys), len(validationErrors), validationErrors.Error())
+	for _, expected := range expectedKeys {
+		_, found := findValidationError(validationErrors, expected.file, expected.key)
+		assert.True(t, found, "missing error for %s: %s", expected.file, expected.key)
+	}
+
+	lines := strings.Split(validationErrors.Error(), "\n")
+	assert.Equal(t, len(expectedKeys)+1, len(lines))
+	assert.True(t, strings.Contains(lines[0], "8 error(s) found"))
+}
+
+func TestValidate_LRUCacheWithSizeInBytesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{General: loadNodeConfigs(t).General}
+	configs.General.Versions.Cache.SizeInBytes = 1024
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 1, len(validationErrors))
+	assert.Equal(t, "Versions.Cache.SizeInBytes", validationErrors[0].Key)
+}
+
+func TestValidate_UnknownDBCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{General: loadNodeConfigs(t).General}
+	configs.General.TxStorage.DB.Compression = "Snappy"
+	configs.General.MiniBlocksStorage.DB.Compression = "Zstd"
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 1, len(validationErrors))
+	assert.Equal(t, "MiniBlocksStorage.DB.Compression", validationErrors[0].Key)
+}
+
+func TestValidate_TxPoolPersistenceWithoutSaveIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{General: loadNodeConfigs(t).General}
+	configs.General.TxPoolPersistence.SaveIntervalInSeconds = 0
+	assert.Nil(t, Validate(configs))
+
+	configs.General.TxPoolPersistence.Enabled = true
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 1, len(validationErrors))
+	assert.Equal(t, "TxPoolPersistence.SaveIntervalInSeconds", validationErrors[0].Key)
+}
+
+func TestValidate_EnableEpochsShouldBeConsistent(t *testing.T) {
+	t.Parallel()
+
+	configs := loadNodeConfigs(t)
+	configs.SystemSC.ESDTSystemSCConfig.EnabledEpoch = 3
+	configs.SystemSC.ESDTSystemSCConfig.TokenMetadataEnableEpoch = 2
+	configs.SystemSC.DelegationManagerSystemSCConfig.EnabledEpoch = 0
+	configs.General.GeneralSettings.ArwenESDTFunctionsEnableEpoch = 2
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	assert.Equal(t, 3, len(validationErrors), validationErrors.Error())
+
+	_, found := findValidationError(validationErrors, defaultSystemSCFileName, "ESDTSystemSCConfig.TokenMetadataEnableEpoch")
+	assert.True(t, found)
+	_, found = findValidationError(validationErrors, defaultSystemSCFileName, "DelegationManagerSystemSCConfig.EnabledEpoch")
+	assert.True(t, found)
+	validationError, found := findValidationError(validationErrors, defaultGeneralFileName, "GeneralSettings.ArwenESDTFunctionsEnableEpoch")
+	assert.True(t, found)
+	assert.True(t, strings.Contains(validationError.Message, defaultSystemSCFileName))
+}
+
+func TestValidate_VersionsShouldStartOnEpochZeroAndBeOrdered(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{General: loadNodeConfigs(t).General}
+	configs.General.Versions.VersionsByEpochs = []VersionByEpochs{
+		{StartEpoch: 1, Version: "v1"},
+		{StartEpoch: 1, Version: "v2"},
+	}
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 2, len(validationErrors), validationErrors.Error())
+	assert.Equal(t, "Versions.VersionsByEpochs[0].StartEpoch", validationErrors[0].Key)
+	assert.Equal(t, "Versions.VersionsByEpochs[1].StartEpoch", validationErrors[1].Key)
+}
+
+func TestValidate_ListsSharderShouldHaveRoomForAllPeers(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{P2P: loadNodeConfigs(t).P2P}
+	configs.P2P.Sharding.TargetPeerCount = int(configs.P2P.Sharding.MaxIntraShardValidators +
+		configs.P2P.Sharding.MaxCrossShardValidators +
+		configs.P2P.Sharding.MaxIntraShardObservers +
+		configs.P2P.Sharding.MaxCrossShardObservers)
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 1, len(validationErrors))
+	assert.Equal(t, "Sharding.TargetPeerCount", validationErrors[0].Key)
+}
+
+func TestValidate_EconomicsPercentagesShouldNotExceedOne(t *testing.T) {
+	t.Parallel()
+
+	configs := NodeConfigs{Economics: loadNodeConfigs(t).Economics}
+	configs.Economics.RewardsSettings.DeveloperPercentage = 0.9
+
+	validationErrors := requireValidationErrors(t, Validate(configs))
+	require.Equal(t, 1, len(validationErrors))
+	assert.Equal(t, "RewardsSettings", validationErrors[0].Key)
+}
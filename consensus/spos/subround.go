@@ -6,11 +6,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
 var _ consensus.SubroundHandler = (*Subround)(nil)
 
+var subroundDuration = prometheus.DefaultRegistry().NewHistogramVec(
+	"elrond_consensus_subround_duration_seconds",
+	"Duration of the consensus subrounds until they were done or timed out.",
+	prometheus.DefaultDurationBuckets,
+	"subround", "result",
+)
+
 // Subround struct contains the needed data for one Subround and the Subround properties. It defines a Subround
 // with it's properties (it's ID, next Subround ID, it's duration, it's name) and also it has some handler functions
 // which should be set. Job function will be the main function of this Subround, Extend function will handle the overtime
@@ -125,9 +133,11 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 
 	startTime := rounder.TimeStamp()
 	maxTime := rounder.TimeDuration() * MaxThresholdPercent / 100
+	workStartTime := time.Now()
 
 	sr.Job()
 	if sr.Check() {
+		sr.observeDuration(workStartTime, "done")
 		return true
 	}
 
@@ -135,6 +145,7 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
+				sr.observeDuration(workStartTime, "done")
 				return true
 			}
 		case <-time.After(rounder.RemainingTime(startTime, maxTime)):
@@ -143,11 +154,16 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 				sr.Extend(sr.current)
 			}
 
+			sr.observeDuration(workStartTime, "timeout")
 			return false
 		}
 	}
 }
 
+func (sr *Subround) observeDuration(start time.Time, result string) {
+	subroundDuration.WithLabelValues(sr.name, result).ObserveSince(start)
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
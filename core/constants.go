@@ -156,6 +156,18 @@ const MetricMemHeapInUse = "erd_mem_heap_inuse"
 // MetricMemStackInUse is a metric for monitoring the memory ("stack in use")
 const MetricMemStackInUse = "erd_mem_stack_inuse"
 
+// MetricTrieNodesCacheHits is the metric for monitoring the number of trie nodes found in the trie nodes cache
+const MetricTrieNodesCacheHits = "erd_trie_nodes_cache_hits"
+
+// MetricTrieNodesCacheMisses is the metric for monitoring the number of trie nodes not found in the trie nodes cache
+const MetricTrieNodesCacheMisses = "erd_trie_nodes_cache_misses"
+
+// MetricTrieNodesCacheHitRatePercent is the metric for monitoring the trie nodes cache hit rate [%]
+const MetricTrieNodesCacheHitRatePercent = "erd_trie_nodes_cache_hit_rate_percent"
+
+// MetricTrieNodesCacheSizeInBytes is the metric for monitoring the size of the trie nodes held in the trie nodes cache
+const MetricTrieNodesCacheSizeInBytes = "erd_trie_nodes_cache_size_in_bytes"
+
 // MetricNetworkRecvPercent is the metric for monitoring network receive load [%]
 const MetricNetworkRecvPercent = "erd_network_recv_percent"
 
@@ -407,6 +419,8 @@ const (
 	IndexerOrder
 	// NetStatisticsOrder defines the order in which netStatistic component is notified of a start of epoch event
 	NetStatisticsOrder
+	// StateTriesOrder defines the order in which the state tries storage managers are notified of a start of epoch event
+	StateTriesOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -442,6 +456,12 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricRating is the metric for monitoring the temporary rating of the node
+const MetricRating = "erd_rating"
+
+// MetricActiveAlerts is the metric that outputs the names of the alerts which are currently firing
+const MetricActiveAlerts = "erd_active_alerts"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -514,6 +534,9 @@ const BuiltInFunctionESDTNFTCreateRoleTransfer = "ESDTNFTCreateRoleTransfer"
 // BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
 const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
 
+// BuiltInFunctionESDTNFTRoyaltiesPayment is the key for the elrond standard digital token NFT royalties payment built-in function
+const BuiltInFunctionESDTNFTRoyaltiesPayment = "ESDTNFTRoyaltiesPayment"
+
 // ESDTRoleLocalMint is the constant string for the local role of mint for ESDT tokens
 const ESDTRoleLocalMint = "ESDTRoleLocalMint"
 
@@ -199,3 +199,23 @@ func SaveSkToPemFile(file *os.File, identifier string, skBytes []byte) error {
 
 	return pem.Encode(file, &blk)
 }
+
+// GetDirectorySize returns the total size in bytes of the files found in the provided directory and its subdirectories
+func GetDirectorySize(dirPath string) (uint64, error) {
+	size := uint64(0)
+	err := filepath.Walk(dirPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
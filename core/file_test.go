@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -274,3 +275,30 @@ func TestCreateFile(t *testing.T) {
 		_ = os.Remove(arg.Directory)
 	}
 }
+
+func TestGetDirectorySize_NoExistingDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	size, err := core.GetDirectorySize("missing directory")
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(0), size)
+}
+
+func TestGetDirectorySize_ShouldSumTheFilesSizes(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "directorySize")
+	assert.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	subDir := filepath.Join(dir, "subdir")
+	_ = os.Mkdir(subDir, os.ModePerm)
+	_ = ioutil.WriteFile(filepath.Join(dir, "file1"), make([]byte, 10), os.ModePerm)
+	_ = ioutil.WriteFile(filepath.Join(subDir, "file2"), make([]byte, 15), os.ModePerm)
+
+	size, err := core.GetDirectorySize(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(25), size)
+}
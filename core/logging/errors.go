@@ -0,0 +1,12 @@
+package logging
+
+import "errors"
+
+// ErrEmptyLogLevelPatterns signals that an empty log level pattern has been provided
+var ErrEmptyLogLevelPatterns = errors.New("empty log level patterns")
+
+// ErrTTLTooLarge signals that the provided time to live exceeds the allowed maximum
+var ErrTTLTooLarge = errors.New("ttl too large")
+
+// ErrLogProfileManagerClosed signals that the log profile manager was closed
+var ErrLogProfileManagerClosed = errors.New("log profile manager closed")
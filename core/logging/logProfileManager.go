@@ -0,0 +1,191 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// MaxProfileTTL is the longest time a temporary log profile can be kept before being reverted
+const MaxProfileTTL = time.Hour * 24
+
+// ProfileData is the JSON friendly representation of a logger.Profile
+type ProfileData struct {
+	LogLevelPatterns string `json:"logLevelPatterns"`
+	WithCorrelation  bool   `json:"withCorrelation"`
+	WithLoggerName   bool   `json:"withLoggerName"`
+}
+
+// ProfileUpdate describes a change of the logger profile. The nil fields keep their current values and a zero TTL
+// makes the change permanent
+type ProfileUpdate struct {
+	LogLevelPatterns *string
+	WithCorrelation  *bool
+	WithLoggerName   *bool
+	TTL              time.Duration
+}
+
+// ProfileState holds the current logger profile and, while a temporary profile is applied, the profile which will
+// be restored and when
+type ProfileState struct {
+	Current  ProfileData  `json:"current"`
+	RevertTo *ProfileData `json:"revertTo,omitempty"`
+	RevertAt *time.Time   `json:"revertAt,omitempty"`
+}
+
+// logProfileManager changes the logger profile of the node independently of any log viewer connection. Temporary
+// changes are reverted when their TTL expires, the profile restored being the one in place before the first of
+// the consecutive temporary changes
+type logProfileManager struct {
+	mut             sync.Mutex
+	revertProfile   *logger.Profile
+	revertAt        time.Time
+	revertTimer     *time.Timer
+	revertRequestID uint64
+	isClosed        bool
+}
+
+// NewLogProfileManager creates a new log profile manager
+func NewLogProfileManager() *logProfileManager {
+	return &logProfileManager{}
+}
+
+// GetProfileState returns the current logger profile and the pending revert, if any
+func (lpm *logProfileManager) GetProfileState() ProfileState {
+	lpm.mut.Lock()
+	defer lpm.mut.Unlock()
+
+	return lpm.profileStateUnprotected()
+}
+
+// SetProfile applies the update on top of the current logger profile. An update having a TTL is reverted when
+// the TTL expires while one without cancels any pending revert
+func (lpm *logProfileManager) SetProfile(update ProfileUpdate) (ProfileState, error) {
+	if update.TTL > MaxProfileTTL {
+		return ProfileState{}, fmt.Errorf("%w, maximum is %v", ErrTTLTooLarge, MaxProfileTTL)
+	}
+	if update.LogLevelPatterns != nil && len(*update.LogLevelPatterns) == 0 {
+		return ProfileState{}, ErrEmptyLogLevelPatterns
+	}
+
+	lpm.mut.Lock()
+	defer lpm.mut.Unlock()
+
+	if lpm.isClosed {
+		return ProfileState{}, ErrLogProfileManagerClosed
+	}
+
+	currentProfile := logger.GetCurrentProfile()
+	newProfile := currentProfile
+	if update.LogLevelPatterns != nil {
+		newProfile.LogLevelPatterns = *update.LogLevelPatterns
+	}
+	if update.WithCorrelation != nil {
+		newProfile.WithCorrelation = *update.WithCorrelation
+	}
+	if update.WithLoggerName != nil {
+		newProfile.WithLoggerName = *update.WithLoggerName
+	}
+
+	err := newProfile.Apply()
+	if err != nil {
+		return ProfileState{}, err
+	}
+	logger.NotifyProfileChange()
+
+	lpm.stopRevertUnprotected()
+	if update.TTL > 0 {
+		if lpm.revertProfile == nil {
+			lpm.revertProfile = &currentProfile
+		}
+		lpm.scheduleRevertUnprotected(update.TTL)
+	} else {
+		lpm.revertProfile = nil
+	}
+
+	log.Info("log profile changed", "profile", newProfile.String(), "ttl", update.TTL)
+
+	return lpm.profileStateUnprotected(), nil
+}
+
+func (lpm *logProfileManager) scheduleRevertUnprotected(ttl time.Duration) {
+	lpm.revertRequestID++
+	requestID := lpm.revertRequestID
+	lpm.revertAt = time.Now().Add(ttl)
+	lpm.revertTimer = time.AfterFunc(ttl, func() {
+		lpm.revert(requestID)
+	})
+}
+
+// revert restores the saved profile unless the request was superseded while the timer was firing
+func (lpm *logProfileManager) revert(requestID uint64) {
+	lpm.mut.Lock()
+	defer lpm.mut.Unlock()
+
+	if lpm.isClosed || requestID != lpm.revertRequestID || lpm.revertProfile == nil {
+		return
+	}
+
+	profile := lpm.revertProfile
+	lpm.revertProfile = nil
+	lpm.revertTimer = nil
+	lpm.revertAt = time.Time{}
+
+	err := profile.Apply()
+	if err != nil {
+		log.Error("can not revert log profile", "profile", profile.String(), "error", err.Error())
+		return
+	}
+	logger.NotifyProfileChange()
+
+	log.Info("reverted log profile", "profile", profile.String())
+}
+
+func (lpm *logProfileManager) stopRevertUnprotected() {
+	lpm.revertRequestID++
+	if lpm.revertTimer != nil {
+		lpm.revertTimer.Stop()
+		lpm.revertTimer = nil
+	}
+	lpm.revertAt = time.Time{}
+}
+
+func (lpm *logProfileManager) profileStateUnprotected() ProfileState {
+	state := ProfileState{
+		Current: newProfileData(logger.GetCurrentProfile()),
+	}
+	if lpm.revertProfile != nil {
+		revertTo := newProfileData(*lpm.revertProfile)
+		revertAt := lpm.revertAt
+		state.RevertTo = &revertTo
+		state.RevertAt = &revertAt
+	}
+
+	return state
+}
+
+func newProfileData(profile logger.Profile) ProfileData {
+	return ProfileData{
+		LogLevelPatterns: profile.LogLevelPatterns,
+		WithCorrelation:  profile.WithCorrelation,
+		WithLoggerName:   profile.WithLoggerName,
+	}
+}
+
+// Close cancels the pending revert, if any, leaving the current profile in place
+func (lpm *logProfileManager) Close() error {
+	lpm.mut.Lock()
+	defer lpm.mut.Unlock()
+
+	lpm.stopRevertUnprotected()
+	lpm.isClosed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lpm *logProfileManager) IsInterfaceNil() bool {
+	return lpm == nil
+}
@@ -0,0 +1,152 @@
+package logging
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the tests below change the global logger profile so they can not run in parallel
+func restoreProfile(t *testing.T) func() {
+	profile := logger.GetCurrentProfile()
+
+	return func() {
+		err := profile.Apply()
+		require.Nil(t, err)
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func TestNewLogProfileManager(t *testing.T) {
+	lpm := NewLogProfileManager()
+
+	assert.False(t, check.IfNil(lpm))
+}
+
+func TestLogProfileManager_SetProfileInvalidUpdateShouldErr(t *testing.T) {
+	defer restoreProfile(t)()
+
+	lpm := NewLogProfileManager()
+	initialProfile := logger.GetCurrentProfile()
+
+	_, err := lpm.SetProfile(ProfileUpdate{TTL: MaxProfileTTL + time.Second})
+	assert.True(t, errors.Is(err, ErrTTLTooLarge))
+
+	_, err = lpm.SetProfile(ProfileUpdate{LogLevelPatterns: stringPtr("")})
+	assert.Equal(t, ErrEmptyLogLevelPatterns, err)
+
+	_, err = lpm.SetProfile(ProfileUpdate{LogLevelPatterns: stringPtr("*:NOT_A_LEVEL")})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, initialProfile, logger.GetCurrentProfile())
+}
+
+func TestLogProfileManager_SetProfileShouldKeepTheNotProvidedFields(t *testing.T) {
+	defer restoreProfile(t)()
+
+	lpm := NewLogProfileManager()
+	_, err := lpm.SetProfile(ProfileUpdate{
+		LogLevelPatterns: stringPtr("*:INFO"),
+		WithCorrelation:  boolPtr(true),
+		WithLoggerName:   boolPtr(false),
+	})
+	require.Nil(t, err)
+
+	state, err := lpm.SetProfile(ProfileUpdate{WithLoggerName: boolPtr(true)})
+	require.Nil(t, err)
+
+	expectedProfile := ProfileData{
+		LogLevelPatterns: "*:INFO",
+		WithCorrelation:  true,
+		WithLoggerName:   true,
+	}
+	assert.Equal(t, expectedProfile, state.Current)
+	assert.Nil(t, state.RevertTo)
+	assert.Nil(t, state.RevertAt)
+	assert.Equal(t, state, lpm.GetProfileState())
+}
+
+func TestLogProfileManager_SetProfileWithTTLShouldRevert(t *testing.T) {
+	defer restoreProfile(t)()
+
+	lpm := NewLogProfileManager()
+	_, err := lpm.SetProfile(ProfileUpdate{LogLevelPatterns: stringPtr("*:INFO")})
+	require.Nil(t, err)
+
+	state, err := lpm.SetProfile(ProfileUpdate{
+		LogLevelPatterns: stringPtr("*:INFO,process/sync:DEBUG"),
+		TTL:              time.Millisecond * 100,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "*:INFO,process/sync:DEBUG", state.Current.LogLevelPatterns)
+	require.NotNil(t, state.RevertTo)
+	assert.Equal(t, "*:INFO", state.RevertTo.LogLevelPatterns)
+	assert.NotNil(t, state.RevertAt)
+
+	// a second temporary change should keep the profile to be reverted to
+	state, err = lpm.SetProfile(ProfileUpdate{
+		LogLevelPatterns: stringPtr("*:INFO,process/sync:TRACE"),
+		TTL:              time.Millisecond * 200,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "*:INFO", state.RevertTo.LogLevelPatterns)
+
+	time.Sleep(time.Millisecond * 150)
+	assert.Equal(t, "*:INFO,process/sync:TRACE", logger.GetLogLevelPattern())
+
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, "*:INFO", logger.GetLogLevelPattern())
+	state = lpm.GetProfileState()
+	assert.Nil(t, state.RevertTo)
+	assert.Nil(t, state.RevertAt)
+}
+
+func TestLogProfileManager_PermanentChangeShouldCancelTheRevert(t *testing.T) {
+	defer restoreProfile(t)()
+
+	lpm := NewLogProfileManager()
+	_, err := lpm.SetProfile(ProfileUpdate{
+		LogLevelPatterns: stringPtr("*:DEBUG"),
+		TTL:              time.Millisecond * 50,
+	})
+	require.Nil(t, err)
+
+	state, err := lpm.SetProfile(ProfileUpdate{LogLevelPatterns: stringPtr("*:WARN")})
+	require.Nil(t, err)
+	assert.Nil(t, state.RevertTo)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, "*:WARN", logger.GetLogLevelPattern())
+}
+
+func TestLogProfileManager_CloseShouldCancelTheRevert(t *testing.T) {
+	defer restoreProfile(t)()
+
+	lpm := NewLogProfileManager()
+	_, err := lpm.SetProfile(ProfileUpdate{
+		LogLevelPatterns: stringPtr("*:DEBUG"),
+		TTL:              time.Millisecond * 50,
+	})
+	require.Nil(t, err)
+
+	err = lpm.Close()
+	assert.Nil(t, err)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, "*:DEBUG", logger.GetLogLevelPattern())
+
+	_, err = lpm.SetProfile(ProfileUpdate{LogLevelPatterns: stringPtr("*:INFO")})
+	assert.Equal(t, ErrLogProfileManagerClosed, err)
+}
@@ -0,0 +1,18 @@
+package profiling
+
+import "errors"
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrCaptureInProgress signals that a capture was requested while another one is in progress
+var ErrCaptureInProgress = errors.New("profiles capture already in progress")
+
+// ErrCaptureTooSoon signals that an automatic capture was requested too soon after the previous one
+var ErrCaptureTooSoon = errors.New("profiles capture too soon after the previous one")
+
+// ErrSnapshotNotFound signals that the requested snapshot or snapshot file does not exist
+var ErrSnapshotNotFound = errors.New("profiles snapshot not found")
+
+// ErrProfilerClosed signals that the profiler was closed
+var ErrProfilerClosed = errors.New("profiler closed")
@@ -0,0 +1,312 @@
+package profiling
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+var log = logger.GetOrCreate("core/profiling")
+
+const (
+	// TriggerOnDemand marks the captures requested through the REST API
+	TriggerOnDemand = "on-demand"
+	// TriggerSlowBlock marks the captures done because a block took too long to be processed or committed
+	TriggerSlowBlock = "slow-block"
+	// TriggerGoroutines marks the captures done because of a goroutines spike
+	TriggerGoroutines = "goroutines"
+
+	cpuProfileName = "cpu"
+)
+
+// lookupProfiles are the runtime profiles written at the beginning of each capture, before the CPU profile which
+// takes a while
+var lookupProfiles = []string{"goroutine", "heap", "mutex"}
+
+var mutGlobalProfiler sync.RWMutex
+var globalProfiler *profiler
+
+// ArgsProfiler is the DTO used to create a profiler
+type ArgsProfiler struct {
+	Config config.ProfilingConfig
+	Folder string
+}
+
+// profiler captures sets of runtime profiles on demand or when the node misbehaves, storing them in the snapshots
+// folder. A single capture runs at a time and the automatic captures are rate limited
+type profiler struct {
+	store                      *snapshotsStore
+	cpuProfileDuration         time.Duration
+	minIntervalBetweenCaptures time.Duration
+	slowBlockThreshold         time.Duration
+	intervalCheckGoroutines    time.Duration
+	numGoroutinesToCapture     int
+	mutexProfileFraction       int
+	numGoroutines              func() int
+
+	mutCapture           sync.Mutex
+	isCapturing          bool
+	isClosed             bool
+	lastAutomaticCapture time.Time
+	wgCapture            sync.WaitGroup
+	ctx                  context.Context
+	cancelFunc           func()
+}
+
+// NewProfiler creates a new profiler
+func NewProfiler(args ArgsProfiler) (*profiler, error) {
+	cfg := args.Config
+	if len(args.Folder) == 0 {
+		return nil, fmt.Errorf("%w for the profiles folder", ErrInvalidValue)
+	}
+	if cfg.NumSnapshotsToKeep <= 0 {
+		return nil, fmt.Errorf("%w for NumSnapshotsToKeep", ErrInvalidValue)
+	}
+	if cfg.CPUProfileDurationInSeconds < 0 {
+		return nil, fmt.Errorf("%w for CPUProfileDurationInSeconds", ErrInvalidValue)
+	}
+	if cfg.MinIntervalBetweenCapturesInSeconds < 0 {
+		return nil, fmt.Errorf("%w for MinIntervalBetweenCapturesInSeconds", ErrInvalidValue)
+	}
+	if cfg.NumGoroutinesToCaptureProfiles > 0 && cfg.IntervalCheckGoroutinesInSeconds <= 0 {
+		return nil, fmt.Errorf("%w for IntervalCheckGoroutinesInSeconds", ErrInvalidValue)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &profiler{
+		store:                      newSnapshotsStore(args.Folder, cfg.NumSnapshotsToKeep),
+		cpuProfileDuration:         time.Duration(cfg.CPUProfileDurationInSeconds) * time.Second,
+		minIntervalBetweenCaptures: time.Duration(cfg.MinIntervalBetweenCapturesInSeconds) * time.Second,
+		slowBlockThreshold:         time.Duration(cfg.SlowBlockProcessingThresholdInMillis) * time.Millisecond,
+		intervalCheckGoroutines:    time.Duration(cfg.IntervalCheckGoroutinesInSeconds) * time.Second,
+		numGoroutinesToCapture:     cfg.NumGoroutinesToCaptureProfiles,
+		mutexProfileFraction:       cfg.MutexProfileFraction,
+		numGoroutines:              runtime.NumGoroutine,
+		ctx:                        ctx,
+		cancelFunc:                 cancelFunc,
+	}, nil
+}
+
+// Start enables the automatic captures: the profiler receives the block processing durations and, if configured,
+// watches the number of goroutines
+func (p *profiler) Start() {
+	if p.mutexProfileFraction > 0 {
+		runtime.SetMutexProfileFraction(p.mutexProfileFraction)
+	}
+
+	mutGlobalProfiler.Lock()
+	globalProfiler = p
+	mutGlobalProfiler.Unlock()
+
+	if p.numGoroutinesToCapture > 0 {
+		go p.monitorGoroutines()
+	}
+
+	log.Info("profiler started", "folder", p.store.folder)
+}
+
+// NotifyBlockProcessingDuration informs the started profiler, if any, about the duration of a block operation,
+// a capture being triggered if it exceeds the configured threshold
+func NotifyBlockProcessingDuration(operation string, duration time.Duration) {
+	mutGlobalProfiler.RLock()
+	p := globalProfiler
+	mutGlobalProfiler.RUnlock()
+
+	if p == nil || p.slowBlockThreshold <= 0 || duration <= p.slowBlockThreshold {
+		return
+	}
+
+	p.triggerAutomaticCapture(TriggerSlowBlock, "operation", operation, "duration", duration)
+}
+
+func (p *profiler) monitorGoroutines() {
+	for {
+		select {
+		case <-time.After(p.intervalCheckGoroutines):
+		case <-p.ctx.Done():
+			return
+		}
+
+		numGoroutines := p.numGoroutines()
+		if numGoroutines >= p.numGoroutinesToCapture {
+			p.triggerAutomaticCapture(TriggerGoroutines, "num goroutines", numGoroutines)
+		}
+	}
+}
+
+// TriggerCapture starts capturing the profiles in background and returns the name of the snapshot which will hold
+// them. It fails if another capture is in progress
+func (p *profiler) TriggerCapture() (string, error) {
+	p.mutCapture.Lock()
+	defer p.mutCapture.Unlock()
+
+	name, err := p.startCaptureUnprotected(TriggerOnDemand)
+	if err != nil {
+		return "", err
+	}
+
+	log.Info("capturing profiles", "trigger", TriggerOnDemand, "snapshot", name)
+
+	return name, nil
+}
+
+func (p *profiler) triggerAutomaticCapture(trigger string, reason ...interface{}) {
+	p.mutCapture.Lock()
+	defer p.mutCapture.Unlock()
+
+	isTooSoon := !p.lastAutomaticCapture.IsZero() && time.Since(p.lastAutomaticCapture) < p.minIntervalBetweenCaptures
+	if isTooSoon {
+		log.Trace("profiles capture skipped", "trigger", trigger, "error", ErrCaptureTooSoon.Error())
+		return
+	}
+
+	name, err := p.startCaptureUnprotected(trigger)
+	if err != nil {
+		log.Debug("profiles capture skipped", "trigger", trigger, "error", err.Error())
+		return
+	}
+	p.lastAutomaticCapture = time.Now()
+
+	args := append([]interface{}{"trigger", trigger, "snapshot", name}, reason...)
+	log.Info("capturing profiles", args...)
+}
+
+func (p *profiler) startCaptureUnprotected(trigger string) (string, error) {
+	if p.isClosed {
+		return "", ErrProfilerClosed
+	}
+	if p.isCapturing {
+		return "", ErrCaptureInProgress
+	}
+
+	name := createSnapshotName(p.store.timeProvider(), trigger)
+	p.isCapturing = true
+	p.wgCapture.Add(1)
+	go p.capture(name)
+
+	return name, nil
+}
+
+func (p *profiler) capture(name string) {
+	defer func() {
+		p.mutCapture.Lock()
+		p.isCapturing = false
+		p.mutCapture.Unlock()
+		p.wgCapture.Done()
+	}()
+
+	folder, err := p.store.createInProgress(name)
+	if err != nil {
+		log.Error("can not create the profiles snapshot folder", "snapshot", name, "error", err.Error())
+		return
+	}
+
+	for _, profileName := range lookupProfiles {
+		err = writeLookupProfile(folder, profileName)
+		if err != nil {
+			log.Warn("can not write profile", "snapshot", name, "profile", profileName, "error", err.Error())
+		}
+	}
+
+	if p.cpuProfileDuration > 0 {
+		err = p.writeCPUProfile(folder)
+		if err != nil {
+			log.Warn("can not write profile", "snapshot", name, "profile", cpuProfileName, "error", err.Error())
+		}
+	}
+
+	err = p.store.complete(name)
+	if err != nil {
+		log.Error("can not store the profiles snapshot", "snapshot", name, "error", err.Error())
+		return
+	}
+
+	log.Info("profiles captured", "snapshot", name)
+}
+
+func writeLookupProfile(folder string, profileName string) error {
+	profile := pprof.Lookup(profileName)
+	if profile == nil {
+		return fmt.Errorf("%w, unknown profile", ErrInvalidValue)
+	}
+
+	file, err := os.Create(filepath.Join(folder, profileName+profileFileExtension))
+	if err != nil {
+		return err
+	}
+
+	err = profile.WriteTo(file, 0)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// writeCPUProfile samples the CPU for the configured duration, or less if the profiler is closed meanwhile. It fails
+// if the CPU profiling was already started, e.g. through the pprof routes
+func (p *profiler) writeCPUProfile(folder string) error {
+	path := filepath.Join(folder, cpuProfileName+profileFileExtension)
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = pprof.StartCPUProfile(file)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return err
+	}
+
+	select {
+	case <-time.After(p.cpuProfileDuration):
+	case <-p.ctx.Done():
+	}
+	pprof.StopCPUProfile()
+
+	return file.Close()
+}
+
+// ListSnapshots returns the stored snapshots, newest first
+func (p *profiler) ListSnapshots() ([]SnapshotInfo, error) {
+	return p.store.list()
+}
+
+// GetSnapshotFilePath returns the path of a file of a stored snapshot
+func (p *profiler) GetSnapshotFilePath(name string, file string) (string, error) {
+	return p.store.filePath(name, file)
+}
+
+// Close stops the automatic captures and waits for the capture in progress, if any, to end
+func (p *profiler) Close() error {
+	p.mutCapture.Lock()
+	p.isClosed = true
+	p.mutCapture.Unlock()
+
+	p.cancelFunc()
+	p.wgCapture.Wait()
+
+	mutGlobalProfiler.Lock()
+	if globalProfiler == p {
+		globalProfiler = nil
+	}
+	mutGlobalProfiler.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *profiler) IsInterfaceNil() bool {
+	return p == nil
+}
@@ -0,0 +1,231 @@
+package profiling
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempFolder(t *testing.T) (string, func()) {
+	folder, err := ioutil.TempDir("", "profiles")
+	require.Nil(t, err)
+
+	return folder, func() {
+		_ = os.RemoveAll(folder)
+	}
+}
+
+func createTestArgs(folder string) ArgsProfiler {
+	return ArgsProfiler{
+		Config: config.ProfilingConfig{
+			Enabled:                              true,
+			NumSnapshotsToKeep:                   2,
+			CPUProfileDurationInSeconds:          0,
+			MinIntervalBetweenCapturesInSeconds:  600,
+			SlowBlockProcessingThresholdInMillis: 1000,
+			IntervalCheckGoroutinesInSeconds:     1,
+			NumGoroutinesToCaptureProfiles:       0,
+		},
+		Folder: folder,
+	}
+}
+
+// setIncreasingTime makes the consecutive snapshots of a test have distinct names
+func setIncreasingTime(p *profiler) {
+	current := time.Date(2020, 12, 31, 23, 59, 0, 0, time.Local)
+	p.store.timeProvider = func() time.Time {
+		current = current.Add(time.Second)
+		return current
+	}
+}
+
+func TestNewProfiler_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createTestArgs("")
+	p, err := NewProfiler(args)
+	assert.True(t, check.IfNil(p))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createTestArgs("profiles")
+	args.Config.NumSnapshotsToKeep = 0
+	_, err = NewProfiler(args)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createTestArgs("profiles")
+	args.Config.CPUProfileDurationInSeconds = -1
+	_, err = NewProfiler(args)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createTestArgs("profiles")
+	args.Config.NumGoroutinesToCaptureProfiles = 100
+	args.Config.IntervalCheckGoroutinesInSeconds = 0
+	_, err = NewProfiler(args)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestProfiler_TriggerCaptureShouldStoreTheProfiles(t *testing.T) {
+	t.Parallel()
+
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	p, err := NewProfiler(createTestArgs(folder))
+	require.Nil(t, err)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	name, err := p.TriggerCapture()
+	require.Nil(t, err)
+	p.wgCapture.Wait()
+
+	snapshots, err := p.ListSnapshots()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(snapshots))
+	assert.Equal(t, name, snapshots[0].Name)
+	assert.Equal(t, TriggerOnDemand, snapshots[0].Trigger)
+	assert.Equal(t, []string{"goroutine.pprof", "heap.pprof", "mutex.pprof"}, snapshots[0].Files)
+	assert.True(t, snapshots[0].SizeInBytes > 0)
+
+	path, err := p.GetSnapshotFilePath(name, "heap.pprof")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(p.store.folder, name, "heap.pprof"), path)
+}
+
+func TestProfiler_TriggerCaptureWhileCapturingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	args := createTestArgs(folder)
+	args.Config.CPUProfileDurationInSeconds = 1
+	p, _ := NewProfiler(args)
+
+	_, err := p.TriggerCapture()
+	require.Nil(t, err)
+
+	_, err = p.TriggerCapture()
+	assert.Equal(t, ErrCaptureInProgress, err)
+
+	err = p.Close()
+	assert.Nil(t, err)
+
+	_, err = p.TriggerCapture()
+	assert.Equal(t, ErrProfilerClosed, err)
+}
+
+func TestProfiler_ShouldKeepOnlyTheNewestSnapshots(t *testing.T) {
+	t.Parallel()
+
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	p, _ := NewProfiler(createTestArgs(folder))
+	setIncreasingTime(p)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	names := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		name, err := p.TriggerCapture()
+		require.Nil(t, err)
+		p.wgCapture.Wait()
+		names = append(names, name)
+	}
+
+	snapshots, err := p.ListSnapshots()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(snapshots))
+	assert.Equal(t, names[2], snapshots[0].Name)
+	assert.Equal(t, names[1], snapshots[1].Name)
+
+	_, err = p.GetSnapshotFilePath(names[0], "heap.pprof")
+	assert.True(t, errors.Is(err, ErrSnapshotNotFound))
+}
+
+func TestProfiler_GetSnapshotFilePathShouldRejectOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	p, _ := NewProfiler(createTestArgs(folder))
+	defer func() {
+		_ = p.Close()
+	}()
+
+	name, _ := p.TriggerCapture()
+	p.wgCapture.Wait()
+
+	_, err := p.GetSnapshotFilePath(name, "../../etc/passwd")
+	assert.True(t, errors.Is(err, ErrSnapshotNotFound))
+	_, err = p.GetSnapshotFilePath("..", name)
+	assert.True(t, errors.Is(err, ErrSnapshotNotFound))
+	_, err = p.GetSnapshotFilePath(name, "cpu.pprof")
+	assert.True(t, errors.Is(err, ErrSnapshotNotFound))
+}
+
+// the test changes the global profiler so it can not run in parallel with other tests using it
+func TestNotifyBlockProcessingDuration_ShouldCaptureOnSlowBlocksOnce(t *testing.T) {
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	p, _ := NewProfiler(createTestArgs(folder))
+	defer func() {
+		_ = p.Close()
+	}()
+
+	NotifyBlockProcessingDuration("process", time.Second*5)
+	assert.False(t, p.isCapturing)
+
+	p.Start()
+	NotifyBlockProcessingDuration("process", time.Millisecond*500)
+	p.wgCapture.Wait()
+	snapshots, _ := p.ListSnapshots()
+	assert.Equal(t, 0, len(snapshots))
+
+	NotifyBlockProcessingDuration("process", time.Second*5)
+	p.wgCapture.Wait()
+	NotifyBlockProcessingDuration("commit", time.Second*5)
+	p.wgCapture.Wait()
+
+	snapshots, _ = p.ListSnapshots()
+	require.Equal(t, 1, len(snapshots))
+	assert.Equal(t, TriggerSlowBlock, snapshots[0].Trigger)
+}
+
+func TestProfiler_GoroutinesSpikeShouldCapture(t *testing.T) {
+	t.Parallel()
+
+	folder, removeFolder := createTempFolder(t)
+	defer removeFolder()
+
+	args := createTestArgs(folder)
+	args.Config.NumGoroutinesToCaptureProfiles = 1000
+	p, _ := NewProfiler(args)
+	p.intervalCheckGoroutines = time.Millisecond * 10
+	p.numGoroutines = func() int {
+		return 1001
+	}
+	defer func() {
+		_ = p.Close()
+	}()
+
+	go p.monitorGoroutines()
+
+	assert.Eventually(t, func() bool {
+		snapshots, _ := p.ListSnapshots()
+		return len(snapshots) == 1 && snapshots[0].Trigger == TriggerGoroutines
+	}, time.Second*2, time.Millisecond*10)
+}
@@ -0,0 +1,198 @@
+package profiling
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	snapshotNameSeparator   = "__"
+	snapshotTimestampLayout = "20060102_150405"
+	inProgressSuffix        = ".tmp"
+	profileFileExtension    = ".pprof"
+)
+
+// SnapshotInfo describes a stored capture of the profiles
+type SnapshotInfo struct {
+	Name        string   `json:"name"`
+	Trigger     string   `json:"trigger"`
+	Timestamp   int64    `json:"timestamp"`
+	Files       []string `json:"files"`
+	SizeInBytes int64    `json:"sizeInBytes"`
+}
+
+// snapshotsStore keeps each capture in its own folder, named after the capture time and trigger. The folders are
+// renamed from their in progress name only when complete, so the listed snapshots are always complete
+type snapshotsStore struct {
+	folder       string
+	numToKeep    int
+	timeProvider func() time.Time
+}
+
+func newSnapshotsStore(folder string, numToKeep int) *snapshotsStore {
+	return &snapshotsStore{
+		folder:       folder,
+		numToKeep:    numToKeep,
+		timeProvider: time.Now,
+	}
+}
+
+// createSnapshotName builds names such as 20201231_235959_123__slow-block, sorting in the order of the captures
+func createSnapshotName(timestamp time.Time, trigger string) string {
+	millis := timestamp.Nanosecond() / int(time.Millisecond)
+
+	return fmt.Sprintf("%s_%03d%s%s", timestamp.Format(snapshotTimestampLayout), millis, snapshotNameSeparator, trigger)
+}
+
+// createInProgress creates the folder in which the profiles of a new snapshot are written
+func (ss *snapshotsStore) createInProgress(name string) (string, error) {
+	inProgressFolder := filepath.Join(ss.folder, name+inProgressSuffix)
+	err := os.MkdirAll(inProgressFolder, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	return inProgressFolder, nil
+}
+
+// complete makes the snapshot visible and deletes the oldest snapshots above the number to keep
+func (ss *snapshotsStore) complete(name string) error {
+	err := os.Rename(filepath.Join(ss.folder, name+inProgressSuffix), filepath.Join(ss.folder, name))
+	if err != nil {
+		return err
+	}
+
+	return ss.rotate()
+}
+
+func (ss *snapshotsStore) rotate() error {
+	names, err := ss.listNames()
+	if err != nil {
+		return err
+	}
+
+	for len(names) > ss.numToKeep {
+		err = os.RemoveAll(filepath.Join(ss.folder, names[0]))
+		if err != nil {
+			return err
+		}
+
+		log.Debug("removed profiles snapshot", "name", names[0])
+		names = names[1:]
+	}
+
+	return nil
+}
+
+// listNames returns the names of the complete snapshots, oldest first
+func (ss *snapshotsStore) listNames() ([]string, error) {
+	entries, err := ioutil.ReadDir(ss.folder)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make([]string, 0), nil
+		}
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasSuffix(entry.Name(), inProgressSuffix) {
+			continue
+		}
+		if !strings.Contains(entry.Name(), snapshotNameSeparator) {
+			continue
+		}
+
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// list returns the complete snapshots, newest first
+func (ss *snapshotsStore) list() ([]SnapshotInfo, error) {
+	names, err := ss.listNames()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]SnapshotInfo, 0, len(names))
+	for i := len(names) - 1; i >= 0; i-- {
+		info, errInfo := ss.snapshotInfo(names[i])
+		if errInfo != nil {
+			log.Debug("can not read profiles snapshot", "name", names[i], "error", errInfo.Error())
+			continue
+		}
+
+		snapshots = append(snapshots, info)
+	}
+
+	return snapshots, nil
+}
+
+func (ss *snapshotsStore) snapshotInfo(name string) (SnapshotInfo, error) {
+	parts := strings.SplitN(name, snapshotNameSeparator, 2)
+	if len(parts[0]) < len(snapshotTimestampLayout) {
+		return SnapshotInfo{}, fmt.Errorf("%w for snapshot name %s", ErrInvalidValue, name)
+	}
+	timestamp, err := time.ParseInLocation(snapshotTimestampLayout, parts[0][:len(snapshotTimestampLayout)], time.Local)
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	entries, err := ioutil.ReadDir(filepath.Join(ss.folder, name))
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	info := SnapshotInfo{
+		Name:      name,
+		Trigger:   parts[1],
+		Timestamp: timestamp.Unix(),
+		Files:     make([]string, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info.Files = append(info.Files, entry.Name())
+		info.SizeInBytes += entry.Size()
+	}
+
+	return info, nil
+}
+
+// filePath returns the path of a file of a complete snapshot. Only the names found in the snapshots folder are
+// accepted, so the provided names can not be used to reach other files
+func (ss *snapshotsStore) filePath(name string, file string) (string, error) {
+	names, err := ss.listNames()
+	if err != nil {
+		return "", err
+	}
+
+	for _, existingName := range names {
+		if existingName != name {
+			continue
+		}
+
+		info, errInfo := ss.snapshotInfo(name)
+		if errInfo != nil {
+			return "", errInfo
+		}
+
+		for _, existingFile := range info.Files {
+			if existingFile == file {
+				return filepath.Join(ss.folder, name, file), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s/%s", ErrSnapshotNotFound, name, file)
+}
@@ -0,0 +1,210 @@
+package prometheus
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicksPerSecond is the USER_HZ value used by the linux kernel when reporting the CPU times in /proc
+const clockTicksPerSecond = 100
+
+// goCollector writes the metrics of the Go runtime
+type goCollector struct {
+}
+
+func (gc *goCollector) write(builder *strings.Builder) {
+	writeHeader(builder, "go_goroutines", "Number of goroutines that currently exist.", typeGauge)
+	writeSample(builder, "go_goroutines", nil, nil, float64(runtime.NumGoroutine()))
+
+	numThreads, _ := runtime.ThreadCreateProfile(nil)
+	writeHeader(builder, "go_threads", "Number of OS threads created.", typeGauge)
+	writeSample(builder, "go_threads", nil, nil, float64(numThreads))
+
+	writeHeader(builder, "go_info", "Information about the Go environment.", typeGauge)
+	writeSample(builder, "go_info", []string{"version"}, []string{runtime.Version()}, 1)
+
+	gc.writeGCStats(builder)
+	gc.writeMemStats(builder)
+}
+
+func (gc *goCollector) writeGCStats(builder *strings.Builder) {
+	stats := &debug.GCStats{PauseQuantiles: make([]time.Duration, 5)}
+	debug.ReadGCStats(stats)
+
+	name := "go_gc_duration_seconds"
+	writeHeader(builder, name, "A summary of the pause duration of garbage collection cycles.", typeSummary)
+	quantiles := []string{"0", "0.25", "0.5", "0.75", "1"}
+	for i, quantile := range quantiles {
+		writeSample(builder, name, []string{"quantile"}, []string{quantile}, stats.PauseQuantiles[i].Seconds())
+	}
+	writeSample(builder, name+"_sum", nil, nil, stats.PauseTotal.Seconds())
+	writeSample(builder, name+"_count", nil, nil, float64(stats.NumGC))
+}
+
+func (gc *goCollector) writeMemStats(builder *strings.Builder) {
+	memStats := &runtime.MemStats{}
+	runtime.ReadMemStats(memStats)
+
+	memMetrics := []struct {
+		name       string
+		help       string
+		metricType string
+		value      uint64
+	}{
+		{"go_memstats_alloc_bytes", "Number of bytes allocated and still in use.", typeGauge, memStats.Alloc},
+		{"go_memstats_alloc_bytes_total", "Total number of bytes allocated, even if freed.", typeCounter, memStats.TotalAlloc},
+		{"go_memstats_sys_bytes", "Number of bytes obtained from system.", typeGauge, memStats.Sys},
+		{"go_memstats_mallocs_total", "Total number of mallocs.", typeCounter, memStats.Mallocs},
+		{"go_memstats_frees_total", "Total number of frees.", typeCounter, memStats.Frees},
+		{"go_memstats_heap_alloc_bytes", "Number of heap bytes allocated and still in use.", typeGauge, memStats.HeapAlloc},
+		{"go_memstats_heap_sys_bytes", "Number of heap bytes obtained from system.", typeGauge, memStats.HeapSys},
+		{"go_memstats_heap_idle_bytes", "Number of heap bytes waiting to be used.", typeGauge, memStats.HeapIdle},
+		{"go_memstats_heap_inuse_bytes", "Number of heap bytes that are in use.", typeGauge, memStats.HeapInuse},
+		{"go_memstats_heap_released_bytes", "Number of heap bytes released to OS.", typeGauge, memStats.HeapReleased},
+		{"go_memstats_heap_objects", "Number of allocated objects.", typeGauge, memStats.HeapObjects},
+		{"go_memstats_stack_inuse_bytes", "Number of bytes in use by the stack allocator.", typeGauge, memStats.StackInuse},
+		{"go_memstats_next_gc_bytes", "Number of heap bytes when next garbage collection will take place.", typeGauge, memStats.NextGC},
+	}
+	for _, metric := range memMetrics {
+		writeHeader(builder, metric.name, metric.help, metric.metricType)
+		writeSample(builder, metric.name, nil, nil, float64(metric.value))
+	}
+
+	lastGC := float64(memStats.LastGC) / float64(time.Second)
+	writeHeader(builder, "go_memstats_last_gc_time_seconds", "Number of seconds since 1970 of last garbage collection.", typeGauge)
+	writeSample(builder, "go_memstats_last_gc_time_seconds", nil, nil, lastGC)
+}
+
+// processCollector writes the metrics of the node process. The values are read from /proc so the metrics are
+// only available on linux, the collector writing nothing on other systems
+type processCollector struct {
+}
+
+func (pc *processCollector) write(builder *strings.Builder) {
+	statFields, err := readProcSelfStat()
+	if err == nil {
+		pc.writeStat(builder, statFields)
+	}
+
+	numFds, err := countOpenFds()
+	if err == nil {
+		writeHeader(builder, "process_open_fds", "Number of open file descriptors.", typeGauge)
+		writeSample(builder, "process_open_fds", nil, nil, float64(numFds))
+	}
+
+	maxFds, err := readMaxFds()
+	if err == nil {
+		writeHeader(builder, "process_max_fds", "Maximum number of open file descriptors.", typeGauge)
+		writeSample(builder, "process_max_fds", nil, nil, maxFds)
+	}
+}
+
+// writeStat uses the fields of /proc/self/stat which follow the process name, the first one being the state
+func (pc *processCollector) writeStat(builder *strings.Builder, fields []string) {
+	const utimeIndex = 11
+	const stimeIndex = 12
+	const startTimeIndex = 19
+	const vsizeIndex = 20
+	const rssIndex = 21
+	if len(fields) <= rssIndex {
+		return
+	}
+
+	utime, _ := strconv.ParseFloat(fields[utimeIndex], 64)
+	stime, _ := strconv.ParseFloat(fields[stimeIndex], 64)
+	writeHeader(builder, "process_cpu_seconds_total", "Total user and system CPU time spent in seconds.", typeCounter)
+	writeSample(builder, "process_cpu_seconds_total", nil, nil, (utime+stime)/clockTicksPerSecond)
+
+	vsize, _ := strconv.ParseFloat(fields[vsizeIndex], 64)
+	writeHeader(builder, "process_virtual_memory_bytes", "Virtual memory size in bytes.", typeGauge)
+	writeSample(builder, "process_virtual_memory_bytes", nil, nil, vsize)
+
+	rss, _ := strconv.ParseFloat(fields[rssIndex], 64)
+	writeHeader(builder, "process_resident_memory_bytes", "Resident memory size in bytes.", typeGauge)
+	writeSample(builder, "process_resident_memory_bytes", nil, nil, rss*float64(os.Getpagesize()))
+
+	bootTime, err := readBootTime()
+	if err != nil {
+		return
+	}
+	startTicks, _ := strconv.ParseFloat(fields[startTimeIndex], 64)
+	writeHeader(builder, "process_start_time_seconds", "Start time of the process since unix epoch in seconds.", typeGauge)
+	writeSample(builder, "process_start_time_seconds", nil, nil, bootTime+startTicks/clockTicksPerSecond)
+}
+
+func readProcSelfStat() ([]string, error) {
+	content, err := ioutil.ReadFile("/proc/self/stat")
+	if err != nil {
+		return nil, err
+	}
+
+	// the process name is between parentheses and can contain spaces
+	stat := string(content)
+	nameEnd := strings.LastIndex(stat, ")")
+	if nameEnd < 0 {
+		return nil, os.ErrInvalid
+	}
+
+	return strings.Fields(stat[nameEnd+1:]), nil
+}
+
+func readBootTime() (float64, error) {
+	content, err := ioutil.ReadFile("/proc/stat")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "btime" {
+			return strconv.ParseFloat(fields[1], 64)
+		}
+	}
+
+	return 0, os.ErrNotExist
+}
+
+func countOpenFds() (int, error) {
+	dir, err := os.Open("/proc/self/fd")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = dir.Close()
+	}()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return 0, err
+	}
+
+	// the opened directory itself is also listed
+	return len(names) - 1, nil
+}
+
+func readMaxFds() (float64, error) {
+	content, err := ioutil.ReadFile("/proc/self/limits")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) == 0 {
+			break
+		}
+
+		return strconv.ParseFloat(fields[0], 64)
+	}
+
+	return 0, os.ErrNotExist
+}
@@ -0,0 +1,39 @@
+package prometheus
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRegistry_ShouldWriteGoRuntimeMetrics(t *testing.T) {
+	t.Parallel()
+
+	output := DefaultRegistry().String()
+
+	assert.True(t, strings.Contains(output, "# TYPE go_goroutines gauge\n"))
+	assert.True(t, strings.Contains(output, "go_info{version=\""+runtime.Version()+"\"} 1\n"))
+	assert.True(t, strings.Contains(output, "# TYPE go_gc_duration_seconds summary\n"))
+	assert.True(t, strings.Contains(output, "go_gc_duration_seconds{quantile=\"0.5\"}"))
+	assert.True(t, strings.Contains(output, "# TYPE go_memstats_alloc_bytes_total counter\n"))
+}
+
+func TestProcessCollector_ShouldWriteProcessMetricsOnLinux(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS != "linux" {
+		t.Skip("process metrics are read from /proc")
+	}
+
+	builder := &strings.Builder{}
+	pc := &processCollector{}
+	pc.write(builder)
+	output := builder.String()
+
+	assert.True(t, strings.Contains(output, "# TYPE process_cpu_seconds_total counter\n"))
+	assert.True(t, strings.Contains(output, "process_resident_memory_bytes "))
+	assert.True(t, strings.Contains(output, "process_start_time_seconds "))
+	assert.True(t, strings.Contains(output, "process_open_fds "))
+}
@@ -0,0 +1,320 @@
+package prometheus
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const labelValuesSeparator = "\xff"
+
+// DefaultDurationBuckets are the histogram buckets, in seconds, used for the processing durations
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8}
+
+type labeledValue struct {
+	labelValues []string
+	mut         sync.Mutex
+	value       float64
+}
+
+func (lv *labeledValue) add(delta float64) {
+	lv.mut.Lock()
+	lv.value += delta
+	lv.mut.Unlock()
+}
+
+func (lv *labeledValue) set(value float64) {
+	lv.mut.Lock()
+	lv.value = value
+	lv.mut.Unlock()
+}
+
+func (lv *labeledValue) get() float64 {
+	lv.mut.Lock()
+	defer lv.mut.Unlock()
+
+	return lv.value
+}
+
+type metricVec struct {
+	name       string
+	help       string
+	metricType string
+	labelNames []string
+
+	mut    sync.RWMutex
+	values map[string]*labeledValue
+}
+
+func newMetricVec(name string, help string, metricType string, labelNames []string) *metricVec {
+	return &metricVec{
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		labelNames: labelNames,
+		values:     make(map[string]*labeledValue),
+	}
+}
+
+func (mv *metricVec) getOrCreate(labelValues []string) *labeledValue {
+	labelValues = normalizeLabelValues(labelValues, len(mv.labelNames))
+	key := strings.Join(labelValues, labelValuesSeparator)
+
+	mv.mut.RLock()
+	value, ok := mv.values[key]
+	mv.mut.RUnlock()
+	if ok {
+		return value
+	}
+
+	mv.mut.Lock()
+	defer mv.mut.Unlock()
+
+	value, ok = mv.values[key]
+	if !ok {
+		value = &labeledValue{labelValues: labelValues}
+		mv.values[key] = value
+	}
+
+	return value
+}
+
+func (mv *metricVec) sortedValues() []*labeledValue {
+	mv.mut.RLock()
+	keys := make([]string, 0, len(mv.values))
+	for key := range mv.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([]*labeledValue, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, mv.values[key])
+	}
+	mv.mut.RUnlock()
+
+	return values
+}
+
+func (mv *metricVec) write(builder *strings.Builder) {
+	values := mv.sortedValues()
+	if len(values) == 0 {
+		return
+	}
+
+	writeHeader(builder, mv.name, mv.help, mv.metricType)
+	for _, value := range values {
+		writeSample(builder, mv.name, mv.labelNames, value.labelValues, value.get())
+	}
+}
+
+// normalizeLabelValues makes the number of label values match the number of label names so that a wrong call
+// does not break the exposition
+func normalizeLabelValues(labelValues []string, numLabels int) []string {
+	normalized := make([]string, numLabels)
+	copy(normalized, labelValues)
+
+	return normalized
+}
+
+// CounterVec is a set of counters partitioned by label values
+type CounterVec struct {
+	*metricVec
+}
+
+// Counter is a monotonically increasing value
+type Counter struct {
+	value *labeledValue
+}
+
+// WithLabelValues returns the counter for the provided label values, given in the order of the label names
+func (cv *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return &Counter{value: cv.getOrCreate(labelValues)}
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc() {
+	c.value.add(1)
+}
+
+// Add increments the counter by the provided value. Negative values are ignored
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+
+	c.value.add(delta)
+}
+
+// GaugeVec is a set of gauges partitioned by label values
+type GaugeVec struct {
+	*metricVec
+}
+
+// Gauge is a value which can go up and down
+type Gauge struct {
+	value *labeledValue
+}
+
+// WithLabelValues returns the gauge for the provided label values, given in the order of the label names
+func (gv *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return &Gauge{value: gv.getOrCreate(labelValues)}
+}
+
+// Set sets the gauge value
+func (g *Gauge) Set(value float64) {
+	g.value.set(value)
+}
+
+// Add adds the provided value, which can be negative, to the gauge
+func (g *Gauge) Add(delta float64) {
+	g.value.add(delta)
+}
+
+type gaugeVecFunc struct {
+	name       string
+	help       string
+	labelName  string
+	valuesFunc func() map[string]float64
+}
+
+func (gvf *gaugeVecFunc) write(builder *strings.Builder) {
+	values := gvf.valuesFunc()
+	if len(values) == 0 {
+		return
+	}
+
+	labelValues := make([]string, 0, len(values))
+	for labelValue := range values {
+		labelValues = append(labelValues, labelValue)
+	}
+	sort.Strings(labelValues)
+
+	writeHeader(builder, gvf.name, gvf.help, typeGauge)
+	labelNames := []string{gvf.labelName}
+	for _, labelValue := range labelValues {
+		writeSample(builder, gvf.name, labelNames, []string{labelValue}, values[labelValue])
+	}
+}
+
+// HistogramVec is a set of histograms partitioned by label values
+type HistogramVec struct {
+	name       string
+	help       string
+	buckets    []float64
+	labelNames []string
+
+	mut        sync.RWMutex
+	histograms map[string]*Histogram
+}
+
+// Histogram counts the observed values in configurable buckets
+type Histogram struct {
+	labelValues  []string
+	buckets      []float64
+	mut          sync.Mutex
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+func newHistogramVec(name string, help string, buckets []float64, labelNames []string) *HistogramVec {
+	sortedBuckets := make([]float64, 0, len(buckets))
+	for _, bucket := range buckets {
+		if !math.IsInf(bucket, 1) {
+			sortedBuckets = append(sortedBuckets, bucket)
+		}
+	}
+	sort.Float64s(sortedBuckets)
+
+	return &HistogramVec{
+		name:       name,
+		help:       help,
+		buckets:    sortedBuckets,
+		labelNames: labelNames,
+		histograms: make(map[string]*Histogram),
+	}
+}
+
+// WithLabelValues returns the histogram for the provided label values, given in the order of the label names
+func (hv *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	labelValues = normalizeLabelValues(labelValues, len(hv.labelNames))
+	key := strings.Join(labelValues, labelValuesSeparator)
+
+	hv.mut.RLock()
+	histogram, ok := hv.histograms[key]
+	hv.mut.RUnlock()
+	if ok {
+		return histogram
+	}
+
+	hv.mut.Lock()
+	defer hv.mut.Unlock()
+
+	histogram, ok = hv.histograms[key]
+	if !ok {
+		histogram = &Histogram{
+			labelValues:  labelValues,
+			buckets:      hv.buckets,
+			bucketCounts: make([]uint64, len(hv.buckets)),
+		}
+		hv.histograms[key] = histogram
+	}
+
+	return histogram
+}
+
+// Observe adds a value to the histogram
+func (h *Histogram) Observe(value float64) {
+	index := sort.SearchFloat64s(h.buckets, value)
+
+	h.mut.Lock()
+	if index < len(h.bucketCounts) {
+		h.bucketCounts[index]++
+	}
+	h.count++
+	h.sum += value
+	h.mut.Unlock()
+}
+
+// ObserveSince adds to the histogram the seconds elapsed since the provided time
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+func (hv *HistogramVec) write(builder *strings.Builder) {
+	hv.mut.RLock()
+	keys := make([]string, 0, len(hv.histograms))
+	for key := range hv.histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	histograms := make([]*Histogram, 0, len(keys))
+	for _, key := range keys {
+		histograms = append(histograms, hv.histograms[key])
+	}
+	hv.mut.RUnlock()
+
+	if len(histograms) == 0 {
+		return
+	}
+
+	writeHeader(builder, hv.name, hv.help, typeHistogram)
+	bucketLabelNames := append(append(make([]string, 0, len(hv.labelNames)+1), hv.labelNames...), "le")
+	for _, histogram := range histograms {
+		histogram.mut.Lock()
+		cumulativeCount := uint64(0)
+		bucketLabelValues := append(append(make([]string, 0, len(bucketLabelNames)), histogram.labelValues...), "")
+		for i, upperBound := range histogram.buckets {
+			cumulativeCount += histogram.bucketCounts[i]
+			bucketLabelValues[len(bucketLabelValues)-1] = formatFloat(upperBound)
+			writeSample(builder, hv.name+"_bucket", bucketLabelNames, bucketLabelValues, float64(cumulativeCount))
+		}
+		bucketLabelValues[len(bucketLabelValues)-1] = "+Inf"
+		writeSample(builder, hv.name+"_bucket", bucketLabelNames, bucketLabelValues, float64(histogram.count))
+		writeSample(builder, hv.name+"_sum", hv.labelNames, histogram.labelValues, histogram.sum)
+		writeSample(builder, hv.name+"_count", hv.labelNames, histogram.labelValues, float64(histogram.count))
+		histogram.mut.Unlock()
+	}
+}
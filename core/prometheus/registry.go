@@ -0,0 +1,202 @@
+package prometheus
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("core/prometheus")
+
+// ContentType is the content type of the Prometheus text exposition format written by the registry
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+	typeSummary   = "summary"
+)
+
+var defaultRegistry = newDefaultRegistry()
+
+// collector writes one or more metric families in the Prometheus text exposition format
+type collector interface {
+	write(builder *strings.Builder)
+}
+
+// Registry holds the collectors of the metrics exposed in the Prometheus text exposition format
+type Registry struct {
+	mut        sync.RWMutex
+	collectors map[string]collector
+	metricType map[string]string
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		collectors: make(map[string]collector),
+		metricType: make(map[string]string),
+	}
+}
+
+func newDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	registry.register("go", "", &goCollector{})
+	registry.register("process", "", &processCollector{})
+
+	return registry
+}
+
+// DefaultRegistry returns the registry exposed by the node, which already holds the Go runtime and process collectors
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewCounterVec returns the counter registered under the provided name, creating it if it does not exist
+func (r *Registry) NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	existing := r.getOrRegister(name, typeCounter, func() collector {
+		return &CounterVec{metricVec: newMetricVec(name, help, typeCounter, labelNames)}
+	})
+
+	return existing.(*CounterVec)
+}
+
+// NewGaugeVec returns the gauge registered under the provided name, creating it if it does not exist
+func (r *Registry) NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	existing := r.getOrRegister(name, typeGauge, func() collector {
+		return &GaugeVec{metricVec: newMetricVec(name, help, typeGauge, labelNames)}
+	})
+
+	return existing.(*GaugeVec)
+}
+
+// NewHistogramVec returns the histogram registered under the provided name, creating it if it does not exist.
+// The buckets are the upper bounds of the histogram buckets, the +Inf bucket being implicit
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	existing := r.getOrRegister(name, typeHistogram, func() collector {
+		return newHistogramVec(name, help, buckets, labelNames)
+	})
+
+	return existing.(*HistogramVec)
+}
+
+// SetGaugeVecFunc registers a gauge whose values are computed by the provided function at each scrape, one value
+// for each value of the provided label. A previously registered function for the same name is replaced
+func (r *Registry) SetGaugeVecFunc(name string, help string, labelName string, valuesFunc func() map[string]float64) {
+	r.register(name, typeGauge, &gaugeVecFunc{
+		name:       name,
+		help:       help,
+		labelName:  labelName,
+		valuesFunc: valuesFunc,
+	})
+}
+
+func (r *Registry) getOrRegister(name string, metricType string, create func() collector) collector {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	existing, ok := r.collectors[name]
+	if ok {
+		if r.metricType[name] == metricType {
+			return existing
+		}
+
+		log.Error("metric not registered as the name is already used",
+			"name", name, "type", metricType, "existing type", r.metricType[name])
+		return create()
+	}
+
+	c := create()
+	r.collectors[name] = c
+	r.metricType[name] = metricType
+
+	return c
+}
+
+func (r *Registry) register(name string, metricType string, c collector) {
+	r.mut.Lock()
+	r.collectors[name] = c
+	r.metricType[name] = metricType
+	r.mut.Unlock()
+}
+
+// String returns all the metrics in the Prometheus text exposition format
+func (r *Registry) String() string {
+	r.mut.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	collectors := make([]collector, 0, len(names))
+	sort.Strings(names)
+	for _, name := range names {
+		collectors = append(collectors, r.collectors[name])
+	}
+	r.mut.RUnlock()
+
+	builder := &strings.Builder{}
+	for _, c := range collectors {
+		c.write(builder)
+	}
+
+	return builder.String()
+}
+
+// WriteTo writes all the metrics in the Prometheus text exposition format
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, r.String())
+
+	return int64(n), err
+}
+
+func writeHeader(builder *strings.Builder, name string, help string, metricType string) {
+	if len(help) > 0 {
+		builder.WriteString("# HELP " + name + " " + escapeHelp(help) + "\n")
+	}
+	builder.WriteString("# TYPE " + name + " " + metricType + "\n")
+}
+
+func writeSample(builder *strings.Builder, name string, labelNames []string, labelValues []string, value float64) {
+	builder.WriteString(name)
+	if len(labelNames) > 0 {
+		builder.WriteString("{")
+		for i, labelName := range labelNames {
+			if i > 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(labelName + "=\"" + escapeLabelValue(labelValues[i]) + "\"")
+		}
+		builder.WriteString("}")
+	}
+	builder.WriteString(" " + formatFloat(value) + "\n")
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
@@ -0,0 +1,135 @@
+package prometheus
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_CounterVecShouldWriteLabelledSamples(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	counter := registry.NewCounterVec("messages_total", "Number of messages.", "topic", "result")
+	counter.WithLabelValues("transactions", "accepted").Inc()
+	counter.WithLabelValues("transactions", "accepted").Add(2)
+	counter.WithLabelValues("headers", "rejected").Inc()
+	counter.WithLabelValues("headers", "rejected").Add(-5)
+
+	expected := "# HELP messages_total Number of messages.\n" +
+		"# TYPE messages_total counter\n" +
+		"messages_total{topic=\"headers\",result=\"rejected\"} 1\n" +
+		"messages_total{topic=\"transactions\",result=\"accepted\"} 3\n"
+	assert.Equal(t, expected, registry.String())
+}
+
+func TestRegistry_NewCounterVecSameNameShouldReturnSameCounter(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	registry.NewCounterVec("counter", "", "label").WithLabelValues("a").Inc()
+	registry.NewCounterVec("counter", "", "label").WithLabelValues("a").Inc()
+
+	assert.True(t, strings.Contains(registry.String(), "counter{label=\"a\"} 2\n"))
+}
+
+func TestRegistry_SameNameDifferentTypeShouldNotRegister(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	registry.NewCounterVec("metric", "").WithLabelValues().Inc()
+	gauge := registry.NewGaugeVec("metric", "")
+	require.NotNil(t, gauge)
+	gauge.WithLabelValues().Set(10)
+
+	assert.Equal(t, "# TYPE metric counter\nmetric 1\n", registry.String())
+}
+
+func TestRegistry_GaugeVecShouldSetAndAdd(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	gauge := registry.NewGaugeVec("peers", "", "type")
+	gauge.WithLabelValues("validator").Set(10)
+	gauge.WithLabelValues("validator").Add(-2.5)
+
+	assert.Equal(t, "# TYPE peers gauge\npeers{type=\"validator\"} 7.5\n", registry.String())
+}
+
+func TestRegistry_HistogramVecShouldWriteCumulativeBuckets(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	histogram := registry.NewHistogramVec("duration_seconds", "Duration.", []float64{1, 0.1}, "operation")
+	histogram.WithLabelValues("process").Observe(0.05)
+	histogram.WithLabelValues("process").Observe(0.1)
+	histogram.WithLabelValues("process").Observe(0.5)
+	histogram.WithLabelValues("process").Observe(3)
+
+	expected := "# HELP duration_seconds Duration.\n" +
+		"# TYPE duration_seconds histogram\n" +
+		"duration_seconds_bucket{operation=\"process\",le=\"0.1\"} 2\n" +
+		"duration_seconds_bucket{operation=\"process\",le=\"1\"} 3\n" +
+		"duration_seconds_bucket{operation=\"process\",le=\"+Inf\"} 4\n" +
+		"duration_seconds_sum{operation=\"process\"} 3.65\n" +
+		"duration_seconds_count{operation=\"process\"} 4\n"
+	assert.Equal(t, expected, registry.String())
+}
+
+func TestRegistry_SetGaugeVecFuncShouldComputeValuesOnWrite(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	size := 0.0
+	registry.SetGaugeVecFunc("pool_size", "", "cache", func() map[string]float64 {
+		size++
+		return map[string]float64{"txs": size, "rewards": 0}
+	})
+
+	buff := &bytes.Buffer{}
+	_, err := registry.WriteTo(buff)
+	require.Nil(t, err)
+	assert.Equal(t, "# TYPE pool_size gauge\npool_size{cache=\"rewards\"} 0\npool_size{cache=\"txs\"} 1\n", buff.String())
+	assert.True(t, strings.Contains(registry.String(), "pool_size{cache=\"txs\"} 2\n"))
+}
+
+func TestRegistry_ShouldEscapeAndNormalizeLabelValues(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	counter := registry.NewCounterVec("counter", "line1\nline2", "a", "b")
+	counter.WithLabelValues("quoted \"value\"\\").Inc()
+	counter.WithLabelValues("x", "y", "ignored").Inc()
+
+	expected := "# HELP counter line1\\nline2\n" +
+		"# TYPE counter counter\n" +
+		"counter{a=\"quoted \\\"value\\\"\\\\\",b=\"\"} 1\n" +
+		"counter{a=\"x\",b=\"y\"} 1\n"
+	assert.Equal(t, expected, registry.String())
+}
+
+func TestRegistry_ConcurrentUpdatesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			registry.NewCounterVec("counter", "", "label").WithLabelValues("value").Inc()
+			registry.NewHistogramVec("histogram", "", DefaultDurationBuckets).WithLabelValues().Observe(float64(idx))
+			_ = registry.String()
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	output := registry.String()
+	assert.True(t, strings.Contains(output, "counter{label=\"value\"} 100\n"))
+	assert.True(t, strings.Contains(output, "histogram_count 100\n"))
+}
@@ -0,0 +1,15 @@
+package tracing
+
+import "errors"
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrUnknownExporter signals that an unknown spans exporter has been configured
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+// ErrEmptyEndpoint signals that an empty OTLP endpoint has been provided
+var ErrEmptyEndpoint = errors.New("empty OTLP endpoint")
+
+// ErrExportFailed signals that the collector did not accept the exported spans
+var ErrExportFailed = errors.New("spans export failed")
@@ -0,0 +1,234 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const otlpRequestTimeout = 10 * time.Second
+const otlpScopeName = "github.com/ElrondNetwork/elrond-go"
+const otlpStatusCodeError = 2
+
+type serviceSpanRecord struct {
+	Service string `json:"service"`
+	*SpanRecord
+}
+
+// writerExporter writes each span as a JSON line
+type writerExporter struct {
+	writer      io.Writer
+	closer      io.Closer
+	serviceName string
+}
+
+func newWriterExporter(writer io.Writer, serviceName string) *writerExporter {
+	return &writerExporter{
+		writer:      writer,
+		serviceName: serviceName,
+	}
+}
+
+func newFileExporter(filePath string, serviceName string) (*writerExporter, error) {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &writerExporter{
+		writer:      file,
+		closer:      file,
+		serviceName: serviceName,
+	}, nil
+}
+
+// Export writes the provided spans
+func (we *writerExporter) Export(spans []*SpanRecord) error {
+	buff := bytes.Buffer{}
+	encoder := json.NewEncoder(&buff)
+	for _, span := range spans {
+		err := encoder.Encode(serviceSpanRecord{Service: we.serviceName, SpanRecord: span})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := we.writer.Write(buff.Bytes())
+
+	return err
+}
+
+// Close closes the underlying file, if any
+func (we *writerExporter) Close() error {
+	if we.closer == nil {
+		return nil
+	}
+
+	return we.closer.Close()
+}
+
+// otlpExporter sends the spans to an OpenTelemetry collector using the OTLP/HTTP protocol with JSON encoding
+type otlpExporter struct {
+	endpoint    string
+	serviceName string
+	httpClient  *http.Client
+}
+
+func newOTLPExporter(endpoint string, serviceName string) (*otlpExporter, error) {
+	if len(endpoint) == 0 {
+		return nil, ErrEmptyEndpoint
+	}
+
+	return &otlpExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		httpClient:  &http.Client{Timeout: otlpRequestTimeout},
+	}, nil
+}
+
+// Export sends the provided spans to the collector
+func (oe *otlpExporter) Export(spans []*SpanRecord) error {
+	body, err := json.Marshal(oe.createRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	resp, err := oe.httpClient.Post(oe.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w, status code %d", ErrExportFailed, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close does nothing as the exporter does not hold resources
+func (oe *otlpExporter) Close() error {
+	return nil
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+func (oe *otlpExporter) createRequest(spans []*SpanRecord) *otlpExportRequest {
+	convertedSpans := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		converted := otlpSpan{
+			TraceID:           span.TraceID,
+			SpanID:            span.SpanID,
+			ParentSpanID:      span.ParentSpanID,
+			Name:              span.Name,
+			Kind:              span.Kind,
+			StartTimeUnixNano: strconv.FormatUint(span.StartTimeUnixNano, 10),
+			EndTimeUnixNano:   strconv.FormatUint(span.EndTimeUnixNano, 10),
+			Attributes:        convertAttributes(span.Attributes),
+		}
+		if len(span.Error) > 0 {
+			converted.Status = &otlpStatus{Code: otlpStatusCodeError, Message: span.Error}
+		}
+
+		convertedSpans = append(convertedSpans, converted)
+	}
+
+	return &otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{{Key: "service.name", Value: otlpAnyValue{StringValue: oe.serviceName}}},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: otlpScopeName},
+						Spans: convertedSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertAttributes(attributes map[string]string) []otlpKeyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	converted := make([]otlpKeyValue, 0, len(keys))
+	for _, key := range keys {
+		converted = append(converted, otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: attributes[key]}})
+	}
+
+	return converted
+}
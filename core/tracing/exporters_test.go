@@ -0,0 +1,112 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSpanRecord() *SpanRecord {
+	return &SpanRecord{
+		TraceID:           "0af7651916cd43dd8448eb211c80319c",
+		SpanID:            "b7ad6b7169203331",
+		ParentSpanID:      "00f067aa0ba902b7",
+		Name:              "shardProcessor.ProcessBlock",
+		Kind:              SpanKindInternal,
+		StartTimeUnixNano: 1000,
+		EndTimeUnixNano:   4000000000,
+		Attributes:        map[string]string{"round": "12", "nonce": "10"},
+		Error:             "block hash does not match",
+	}
+}
+
+func TestWriterExporter_ExportShouldWriteJSONLines(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	exporter := newWriterExporter(buff, "node")
+
+	err := exporter.Export([]*SpanRecord{createTestSpanRecord(), createTestSpanRecord()})
+	require.Nil(t, err)
+	assert.Nil(t, exporter.Close())
+
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	decoded := make(map[string]interface{})
+	err = json.Unmarshal([]byte(lines[0]), &decoded)
+	require.Nil(t, err)
+	assert.Equal(t, "node", decoded["service"])
+	assert.Equal(t, "shardProcessor.ProcessBlock", decoded["name"])
+	assert.Equal(t, "00f067aa0ba902b7", decoded["parentSpanId"])
+}
+
+func TestNewOTLPExporter_EmptyEndpointShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exporter, err := newOTLPExporter("", "node")
+	assert.Nil(t, exporter)
+	assert.Equal(t, ErrEmptyEndpoint, err)
+}
+
+func TestOTLPExporter_ExportShouldSendOTLPJSON(t *testing.T) {
+	t.Parallel()
+
+	var receivedBody []byte
+	var receivedContentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedContentType = r.Header.Get("Content-Type")
+		receivedBody, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	exporter, err := newOTLPExporter(server.URL+"/v1/traces", "node")
+	require.Nil(t, err)
+
+	err = exporter.Export([]*SpanRecord{createTestSpanRecord()})
+	require.Nil(t, err)
+	assert.Equal(t, "application/json", receivedContentType)
+
+	request := &otlpExportRequest{}
+	err = json.Unmarshal(receivedBody, request)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(request.ResourceSpans))
+	resourceSpans := request.ResourceSpans[0]
+	assert.Equal(t, "service.name", resourceSpans.Resource.Attributes[0].Key)
+	assert.Equal(t, "node", resourceSpans.Resource.Attributes[0].Value.StringValue)
+	require.Equal(t, 1, len(resourceSpans.ScopeSpans[0].Spans))
+
+	span := resourceSpans.ScopeSpans[0].Spans[0]
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", span.TraceID)
+	assert.Equal(t, "4000000000", span.EndTimeUnixNano)
+	assert.Equal(t, []otlpKeyValue{
+		{Key: "nonce", Value: otlpAnyValue{StringValue: "10"}},
+		{Key: "round", Value: otlpAnyValue{StringValue: "12"}},
+	}, span.Attributes)
+	require.NotNil(t, span.Status)
+	assert.Equal(t, otlpStatusCodeError, span.Status.Code)
+	assert.Equal(t, "block hash does not match", span.Status.Message)
+}
+
+func TestOTLPExporter_ExportRejectedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	exporter, _ := newOTLPExporter(server.URL, "node")
+
+	err := exporter.Export([]*SpanRecord{createTestSpanRecord()})
+	assert.True(t, errors.Is(err, ErrExportFailed))
+}
@@ -0,0 +1,6 @@
+package tracing
+
+type spanExporter interface {
+	Export(spans []*SpanRecord) error
+	Close() error
+}
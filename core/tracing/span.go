@@ -0,0 +1,179 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SpanKind defines the role of a span in a trace, following the OpenTelemetry span kinds
+type SpanKind int
+
+const (
+	// SpanKindInternal is the kind of the spans recording internal operations
+	SpanKindInternal SpanKind = 1
+	// SpanKindServer is the kind of the spans recording the handling of a remote request
+	SpanKindServer SpanKind = 2
+)
+
+type spanContextKey struct{}
+
+type spanContext struct {
+	traceID [16]byte
+	spanID  [8]byte
+}
+
+// Span is a timed operation which is part of a trace. All methods are safe to be called on a nil span,
+// which is what the start functions return while tracing is disabled
+type Span struct {
+	tracer   *tracer
+	context  spanContext
+	parentID [8]byte
+	name     string
+	kind     SpanKind
+	start    time.Time
+
+	mut        sync.Mutex
+	attributes map[string]string
+	errMessage string
+	ended      bool
+}
+
+// SpanRecord holds the data of an ended span, as handed to the exporters
+type SpanRecord struct {
+	TraceID           string            `json:"traceId"`
+	SpanID            string            `json:"spanId"`
+	ParentSpanID      string            `json:"parentSpanId,omitempty"`
+	Name              string            `json:"name"`
+	Kind              SpanKind          `json:"kind"`
+	StartTimeUnixNano uint64            `json:"startTimeUnixNano"`
+	EndTimeUnixNano   uint64            `json:"endTimeUnixNano"`
+	Attributes        map[string]string `json:"attributes,omitempty"`
+	Error             string            `json:"error,omitempty"`
+}
+
+// StartSpan starts a span as a child of the span held by the provided context, if any, returning the context
+// holding the new span
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	t := getTracer()
+	if t == nil {
+		return ctx, nil
+	}
+
+	parent, _ := ctx.Value(spanContextKey{}).(spanContext)
+	span := t.newSpan(name, parent)
+
+	return context.WithValue(ctx, spanContextKey{}, span.context), span
+}
+
+// ContextWithRemoteParent returns a context holding the parent described by a W3C traceparent header value.
+// The provided context is returned unchanged if the value can not be parsed
+func ContextWithRemoteParent(ctx context.Context, traceParent string) context.Context {
+	parts := strings.Split(traceParent, "-")
+	if len(parts) != 4 {
+		return ctx
+	}
+
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != 16 {
+		return ctx
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != 8 {
+		return ctx
+	}
+
+	parent := spanContext{}
+	copy(parent.traceID[:], traceID)
+	copy(parent.spanID[:], spanID)
+
+	return context.WithValue(ctx, spanContextKey{}, parent)
+}
+
+// SetKind sets the kind of the span
+func (s *Span) SetKind(kind SpanKind) {
+	if s == nil {
+		return
+	}
+
+	s.mut.Lock()
+	s.kind = kind
+	s.mut.Unlock()
+}
+
+// SetAttribute records an attribute on the span, the value being converted to its string representation
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+
+	s.mut.Lock()
+	s.attributes[key] = fmt.Sprintf("%v", value)
+	s.mut.Unlock()
+}
+
+// SetError marks the span as failed if the provided error is not nil
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.mut.Lock()
+	s.errMessage = err.Error()
+	s.mut.Unlock()
+}
+
+// TraceID returns the hex encoded identifier of the trace the span belongs to
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+
+	return hex.EncodeToString(s.context.traceID[:])
+}
+
+// End ends the span and hands it to the exporter. Calling End more than once has no effect
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	end := time.Now()
+
+	s.mut.Lock()
+	if s.ended {
+		s.mut.Unlock()
+		return
+	}
+	s.ended = true
+	record := s.createRecord(end)
+	s.mut.Unlock()
+
+	s.tracer.endSpan(record)
+}
+
+func (s *Span) createRecord(end time.Time) *SpanRecord {
+	record := &SpanRecord{
+		TraceID:           hex.EncodeToString(s.context.traceID[:]),
+		SpanID:            hex.EncodeToString(s.context.spanID[:]),
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: uint64(s.start.UnixNano()),
+		EndTimeUnixNano:   uint64(end.UnixNano()),
+		Error:             s.errMessage,
+	}
+	if s.parentID != [8]byte{} {
+		record.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if len(s.attributes) > 0 {
+		record.Attributes = make(map[string]string, len(s.attributes))
+		for key, value := range s.attributes {
+			record.Attributes[key] = value
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,245 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+var log = logger.GetOrCreate("core/tracing")
+
+const (
+	// ExporterOTLP sends the spans as OTLP/HTTP JSON requests to a collector
+	ExporterOTLP = "otlp"
+	// ExporterFile appends the spans as JSON lines to a file
+	ExporterFile = "file"
+	// ExporterStdout writes the spans as JSON lines to the standard output
+	ExporterStdout = "stdout"
+)
+
+const maxPendingBatches = 10
+
+var mutGlobalTracer sync.RWMutex
+var globalTracer *tracer
+
+type tracer struct {
+	exporter      spanExporter
+	maxBatchSize  int
+	flushInterval time.Duration
+	cancelFunc    func()
+	chanFlush     chan struct{}
+	chanDone      chan struct{}
+	closeOnce     sync.Once
+	closeErr      error
+
+	mutPending  sync.Mutex
+	pending     []*SpanRecord
+	numDropped  uint64
+	mutExporter sync.Mutex
+}
+
+// Start enables the tracing using the provided configuration. The returned closer flushes the pending spans
+// and disables the tracing. Nothing is started if tracing is disabled in the configuration
+func Start(cfg config.TracingConfig, workingDir string) (io.Closer, error) {
+	if !cfg.Enabled {
+		return &disabledCloser{}, nil
+	}
+	if cfg.FlushIntervalInMillis <= 0 {
+		return nil, fmt.Errorf("%w for FlushIntervalInMillis", ErrInvalidValue)
+	}
+	if cfg.MaxBatchSize <= 0 {
+		return nil, fmt.Errorf("%w for MaxBatchSize", ErrInvalidValue)
+	}
+
+	exporter, err := createExporter(cfg, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	t := newTracer(exporter, cfg.MaxBatchSize, time.Duration(cfg.FlushIntervalInMillis)*time.Millisecond)
+
+	mutGlobalTracer.Lock()
+	previous := globalTracer
+	globalTracer = t
+	mutGlobalTracer.Unlock()
+
+	if previous != nil {
+		log.LogIfError(previous.Close())
+	}
+
+	log.Info("tracing started", "exporter", cfg.Exporter, "service", cfg.ServiceName)
+
+	return &tracerCloser{tracer: t}, nil
+}
+
+// IsEnabled returns true if the tracing is started
+func IsEnabled() bool {
+	return getTracer() != nil
+}
+
+func getTracer() *tracer {
+	mutGlobalTracer.RLock()
+	defer mutGlobalTracer.RUnlock()
+
+	return globalTracer
+}
+
+func createExporter(cfg config.TracingConfig, workingDir string) (spanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		return newOTLPExporter(cfg.OTLPEndpoint, cfg.ServiceName)
+	case ExporterFile:
+		filePath := cfg.FilePath
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(workingDir, filePath)
+		}
+		return newFileExporter(filePath, cfg.ServiceName)
+	case ExporterStdout:
+		return newWriterExporter(os.Stdout, cfg.ServiceName), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, cfg.Exporter)
+}
+
+func newTracer(exporter spanExporter, maxBatchSize int, flushInterval time.Duration) *tracer {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t := &tracer{
+		exporter:      exporter,
+		maxBatchSize:  maxBatchSize,
+		flushInterval: flushInterval,
+		cancelFunc:    cancelFunc,
+		chanFlush:     make(chan struct{}, 1),
+		chanDone:      make(chan struct{}),
+		pending:       make([]*SpanRecord, 0, maxBatchSize),
+	}
+
+	go t.exportLoop(ctx)
+
+	return t
+}
+
+func (t *tracer) newSpan(name string, parent spanContext) *Span {
+	span := &Span{
+		tracer:     t,
+		name:       name,
+		kind:       SpanKindInternal,
+		start:      time.Now(),
+		attributes: make(map[string]string),
+	}
+
+	if parent.traceID == [16]byte{} {
+		_, _ = rand.Read(span.context.traceID[:])
+	} else {
+		span.context.traceID = parent.traceID
+		span.parentID = parent.spanID
+	}
+	_, _ = rand.Read(span.context.spanID[:])
+
+	return span
+}
+
+func (t *tracer) endSpan(record *SpanRecord) {
+	t.mutPending.Lock()
+	if len(t.pending) >= maxPendingBatches*t.maxBatchSize {
+		t.numDropped++
+		t.mutPending.Unlock()
+		return
+	}
+	t.pending = append(t.pending, record)
+	shouldFlush := len(t.pending) >= t.maxBatchSize
+	t.mutPending.Unlock()
+
+	if shouldFlush {
+		select {
+		case t.chanFlush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *tracer) exportLoop(ctx context.Context) {
+	defer close(t.chanDone)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.flush()
+			return
+		case <-t.chanFlush:
+		case <-time.After(t.flushInterval):
+		}
+
+		t.flush()
+	}
+}
+
+func (t *tracer) flush() {
+	t.mutPending.Lock()
+	pending := t.pending
+	numDropped := t.numDropped
+	t.pending = make([]*SpanRecord, 0, t.maxBatchSize)
+	t.numDropped = 0
+	t.mutPending.Unlock()
+
+	if numDropped > 0 {
+		log.Debug("tracing spans dropped as the exporter could not keep up", "num", numDropped)
+	}
+
+	t.mutExporter.Lock()
+	defer t.mutExporter.Unlock()
+
+	for len(pending) > 0 {
+		batchSize := t.maxBatchSize
+		if batchSize > len(pending) {
+			batchSize = len(pending)
+		}
+
+		err := t.exporter.Export(pending[:batchSize])
+		if err != nil {
+			log.Debug("tracing export", "error", err.Error(), "num spans", batchSize)
+		}
+		pending = pending[batchSize:]
+	}
+}
+
+// Close stops the export loop after flushing the pending spans and closes the exporter
+func (t *tracer) Close() error {
+	t.closeOnce.Do(func() {
+		t.cancelFunc()
+		<-t.chanDone
+		t.closeErr = t.exporter.Close()
+	})
+
+	return t.closeErr
+}
+
+type tracerCloser struct {
+	tracer *tracer
+}
+
+// Close disables the tracing, flushing the spans not yet exported
+func (tc *tracerCloser) Close() error {
+	mutGlobalTracer.Lock()
+	if globalTracer == tc.tracer {
+		globalTracer = nil
+	}
+	mutGlobalTracer.Unlock()
+
+	return tc.tracer.Close()
+}
+
+type disabledCloser struct {
+}
+
+// Close does nothing
+func (dc *disabledCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,201 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the tests in this file change the global tracer so they are not run in parallel
+
+type exporterStub struct {
+	mut   sync.Mutex
+	spans []*SpanRecord
+}
+
+func (es *exporterStub) Export(spans []*SpanRecord) error {
+	es.mut.Lock()
+	es.spans = append(es.spans, spans...)
+	es.mut.Unlock()
+
+	return nil
+}
+
+func (es *exporterStub) Close() error {
+	return nil
+}
+
+func (es *exporterStub) getSpans() map[string]*SpanRecord {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+
+	spans := make(map[string]*SpanRecord)
+	for _, span := range es.spans {
+		spans[span.Name] = span
+	}
+
+	return spans
+}
+
+func startTracerWithExporter(exporter spanExporter) *tracerCloser {
+	t := newTracer(exporter, 10, time.Hour)
+
+	mutGlobalTracer.Lock()
+	globalTracer = t
+	mutGlobalTracer.Unlock()
+
+	return &tracerCloser{tracer: t}
+}
+
+func TestStart_DisabledShouldNotStartTracing(t *testing.T) {
+	closer, err := Start(config.TracingConfig{Enabled: false}, "")
+	require.Nil(t, err)
+	assert.False(t, IsEnabled())
+	assert.Nil(t, closer.Close())
+
+	_, span := StartSpan(context.Background(), "span")
+	assert.Nil(t, span)
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("error"))
+	span.End()
+}
+
+func TestStart_InvalidConfigShouldErr(t *testing.T) {
+	cfg := config.TracingConfig{
+		Enabled:               true,
+		Exporter:              ExporterStdout,
+		FlushIntervalInMillis: 0,
+		MaxBatchSize:          10,
+	}
+	_, err := Start(cfg, "")
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	cfg.FlushIntervalInMillis = 10
+	cfg.MaxBatchSize = 0
+	_, err = Start(cfg, "")
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	cfg.MaxBatchSize = 10
+	cfg.Exporter = "unknown"
+	_, err = Start(cfg, "")
+	assert.True(t, errors.Is(err, ErrUnknownExporter))
+
+	cfg.Exporter = ExporterOTLP
+	_, err = Start(cfg, "")
+	assert.Equal(t, ErrEmptyEndpoint, err)
+	assert.False(t, IsEnabled())
+}
+
+func TestStart_FileExporterShouldWriteSpansOnClose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tracing")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	cfg := config.TracingConfig{
+		Enabled:               true,
+		ServiceName:           "test-node",
+		Exporter:              ExporterFile,
+		FilePath:              "traces/spans.jsonl",
+		FlushIntervalInMillis: 60000,
+		MaxBatchSize:          100,
+	}
+	closer, err := Start(cfg, dir)
+	require.Nil(t, err)
+	assert.True(t, IsEnabled())
+
+	_, span := StartSpan(context.Background(), "ProcessBlock")
+	span.SetAttribute("nonce", 7)
+	span.End()
+
+	err = closer.Close()
+	require.Nil(t, err)
+	assert.False(t, IsEnabled())
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "traces", "spans.jsonl"))
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Equal(t, 1, len(lines))
+	assert.True(t, strings.Contains(lines[0], `"service":"test-node"`))
+	assert.True(t, strings.Contains(lines[0], `"name":"ProcessBlock"`))
+	assert.True(t, strings.Contains(lines[0], `"nonce":"7"`))
+}
+
+func TestStartSpan_InterleavedPipelinesShouldNotNestIntoEachOther(t *testing.T) {
+	exporter := &exporterStub{}
+	closer := startTracerWithExporter(exporter)
+
+	blockCtx, blockSpan := StartSpan(context.Background(), "ProcessBlock")
+	otherBlockCtx, otherBlockSpan := StartSpan(context.Background(), "ProcessBlock other")
+	preProcCtx, preProcSpan := StartSpan(blockCtx, "ProcessBlockTransactions")
+	_, otherCommitSpan := StartSpan(otherBlockCtx, "Commit other")
+	_, scSpan := StartSpan(preProcCtx, "ExecuteSmartContractTransaction")
+	scSpan.SetError(errors.New("out of gas"))
+	blockSpan.End()
+	scSpan.End()
+	preProcSpan.End()
+	otherCommitSpan.End()
+	otherBlockSpan.End()
+
+	require.Nil(t, closer.Close())
+
+	spans := exporter.getSpans()
+	require.Equal(t, 5, len(spans))
+	block := spans["ProcessBlock"]
+	assert.Equal(t, "", block.ParentSpanID)
+	assert.Equal(t, block.SpanID, spans["ProcessBlockTransactions"].ParentSpanID)
+	assert.Equal(t, spans["ProcessBlockTransactions"].SpanID, spans["ExecuteSmartContractTransaction"].ParentSpanID)
+	assert.Equal(t, "out of gas", spans["ExecuteSmartContractTransaction"].Error)
+	for _, name := range []string{"ProcessBlockTransactions", "ExecuteSmartContractTransaction"} {
+		assert.Equal(t, block.TraceID, spans[name].TraceID)
+	}
+
+	otherBlock := spans["ProcessBlock other"]
+	assert.NotEqual(t, block.TraceID, otherBlock.TraceID)
+	assert.Equal(t, "", otherBlock.ParentSpanID)
+	assert.Equal(t, otherBlock.SpanID, spans["Commit other"].ParentSpanID)
+	assert.Equal(t, otherBlock.TraceID, spans["Commit other"].TraceID)
+}
+
+func TestStartSpan_ShouldUseContextParent(t *testing.T) {
+	exporter := &exporterStub{}
+	closer := startTracerWithExporter(exporter)
+
+	remoteParent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	ctx := ContextWithRemoteParent(context.Background(), remoteParent)
+	ctx, requestSpan := StartSpan(ctx, "GET /node/status")
+	requestSpan.SetKind(SpanKindServer)
+	_, childSpan := StartSpan(ctx, "child")
+	childSpan.End()
+	requestSpan.End()
+	requestSpan.End()
+
+	require.Nil(t, closer.Close())
+
+	spans := exporter.getSpans()
+	require.Equal(t, 2, len(exporter.spans))
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spans["GET /node/status"].TraceID)
+	assert.Equal(t, "b7ad6b7169203331", spans["GET /node/status"].ParentSpanID)
+	assert.Equal(t, SpanKindServer, spans["GET /node/status"].Kind)
+	assert.Equal(t, spans["GET /node/status"].SpanID, spans["child"].ParentSpanID)
+	assert.Equal(t, SpanKindInternal, spans["child"].Kind)
+}
+
+func TestContextWithRemoteParent_InvalidValueShouldReturnSameContext(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, ctx, ContextWithRemoteParent(ctx, ""))
+	assert.Equal(t, ctx, ContextWithRemoteParent(ctx, "00-zz-b7ad6b7169203331-01"))
+	assert.Equal(t, ctx, ContextWithRemoteParent(ctx, "00-0af7651916cd43dd8448eb211c80319c-b7ad-01"))
+}
@@ -22,6 +22,16 @@ func (dw *DisabledWatchdog) Stop(_ string) {
 func (dw *DisabledWatchdog) Reset(_ string) {
 }
 
+// HealthCheckName returns the name used when reporting the liveness of the node
+func (dw *DisabledWatchdog) HealthCheckName() string {
+	return "watchdog"
+}
+
+// CheckLiveness returns nil as no alarm can expire
+func (dw *DisabledWatchdog) CheckLiveness() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dw *DisabledWatchdog) IsInterfaceNil() bool {
 	return dw == nil
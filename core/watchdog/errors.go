@@ -7,3 +7,6 @@ var ErrNilAlarmScheduler = errors.New("nil alarm scheduler")
 
 // ErrNilEndProcessChan is raised when a valid end process chan is expected but nil is used
 var ErrNilEndProcessChan = errors.New("nil end process chan")
+
+// ErrAlarmExpired signals that a default alarm has expired, meaning that the watched component is stuck
+var ErrAlarmExpired = errors.New("watchdog alarm has expired")
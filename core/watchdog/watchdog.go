@@ -2,7 +2,9 @@ package watchdog
 
 import (
 	"bytes"
+	"fmt"
 	"runtime/pprof"
+	"sync"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -16,6 +18,8 @@ var log = logger.GetOrCreate("watchdog")
 type watchdog struct {
 	alarmScheduler      core.TimersScheduler
 	chanStopNodeProcess chan endProcess.ArgEndProcess
+	mutExpiredAlarm     sync.RWMutex
+	expiredAlarmID      string
 }
 
 // NewWatchdog creates a new instance of WatchdogTimer
@@ -55,6 +59,9 @@ func (w *watchdog) defaultWatchdogExpiry(watchdogID string) {
 	}
 
 	log.Error("watchdog alarm has expired", "alarm", watchdogID)
+	w.mutExpiredAlarm.Lock()
+	w.expiredAlarmID = watchdogID
+	w.mutExpiredAlarm.Unlock()
 	log.Warn(buffer.String())
 
 	arg := endProcess.ArgEndProcess{
@@ -74,6 +81,23 @@ func (w *watchdog) Reset(alarmID string) {
 	w.alarmScheduler.Reset(alarmID)
 }
 
+// HealthCheckName returns the name used when reporting the liveness of the node
+func (w *watchdog) HealthCheckName() string {
+	return "watchdog"
+}
+
+// CheckLiveness returns an error if a default alarm has expired, as the node is closing in this case
+func (w *watchdog) CheckLiveness() error {
+	w.mutExpiredAlarm.RLock()
+	defer w.mutExpiredAlarm.RUnlock()
+
+	if len(w.expiredAlarmID) > 0 {
+		return fmt.Errorf("%w: %s", ErrAlarmExpired, w.expiredAlarmID)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (w *watchdog) IsInterfaceNil() bool {
 	return w == nil
@@ -1,6 +1,8 @@
 package watchdog_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,6 +70,30 @@ func TestWatchdog_SetDefault(t *testing.T) {
 	assert.True(t, addCalled)
 }
 
+func TestWatchdog_CheckLiveness(t *testing.T) {
+	t.Parallel()
+
+	alarm := "testComponent"
+	var expire func(alarmID string)
+	alarmScheduler := &mock.AlarmSchedulerStub{
+		AddCalled: func(f func(alarmID string), duration time.Duration, s string) {
+			expire = f
+		},
+	}
+	w, _ := watchdog.NewWatchdog(alarmScheduler, make(chan endProcess.ArgEndProcess, 1))
+	checker := w.(interface {
+		CheckLiveness() error
+	})
+
+	w.SetDefault(time.Second, alarm)
+	assert.Nil(t, checker.CheckLiveness())
+
+	expire(alarm)
+	err := checker.CheckLiveness()
+	assert.True(t, errors.Is(err, watchdog.ErrAlarmExpired))
+	assert.True(t, strings.Contains(err.Error(), alarm))
+}
+
 func TestWatchdog_Stop(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +56,23 @@ type DirectStakedValue struct {
 	Total      string `json:"total"`
 }
 
+// ESDTTokenProperties holds the properties and the optional metadata of an issued ESDT token
+type ESDTTokenProperties struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	TokenName       string   `json:"name"`
+	Ticker          string   `json:"ticker"`
+	TokenType       string   `json:"type"`
+	Owner           string   `json:"owner"`
+	NumDecimals     uint32   `json:"decimals"`
+	MintedValue     string   `json:"minted"`
+	BurntValue      string   `json:"burnt"`
+	IsPaused        bool     `json:"isPaused"`
+	Website         string   `json:"website,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	LogoHash        string   `json:"logoHash,omitempty"`
+	SocialLinks     []string `json:"socialLinks,omitempty"`
+}
+
 // DelegatedValue holds the value and the delegation system SC address
 type DelegatedValue struct {
 	DelegationScAddress string `json:"delegationScAddress"`
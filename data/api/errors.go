@@ -0,0 +1,6 @@
+package api
+
+import "errors"
+
+// ErrTokenNotFound signals that the requested token was not issued through the esdt system smart contract
+var ErrTokenNotFound = errors.New("token not found")
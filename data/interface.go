@@ -218,6 +218,26 @@ type StorageManager interface {
 	EnterPruningBufferingMode()
 	ExitPruningBufferingMode()
 	GetSnapshotDbBatchDelay() int
+	SetEpochForSnapshots(epoch uint32)
+	GetSnapshotsInfo() []SnapshotInfo
+	IsInterfaceNil() bool
+}
+
+// SnapshotInfo holds information about a trie snapshot database, as the epoch it was taken in and its disk usage
+type SnapshotInfo struct {
+	ID           int    `json:"id"`
+	Epoch        uint32 `json:"epoch"`
+	IsEpochKnown bool   `json:"isEpochKnown"`
+	SizeInBytes  uint64 `json:"sizeInBytes"`
+}
+
+// StateRetentionPolicyHandler decides which past states of the tries are kept on disk and remain queryable
+type StateRetentionPolicyHandler interface {
+	Policy() string
+	NumEpochsToKeep() uint32
+	IsPruningEnabled(pruningEnabled bool) bool
+	MaxSnapshots(maxSnapshots uint32) uint32
+	AreSnapshotsQueryable() bool
 	IsInterfaceNil() bool
 }
 
@@ -277,7 +297,31 @@ type SyncStatisticsHandler interface {
 	Reset()
 	AddNumReceived(value int)
 	SetNumMissing(rootHash []byte, value int)
+	AddNumTriesSynced(value int)
 	NumReceived() int
 	NumMissing() int
+	NumTriesSynced() int
+	NumTriesInProgress() int
+	IsInterfaceNil() bool
+}
+
+// TrieSyncCheckpointHandler defines the methods for a component able to record the progress of the tries syncing so an
+// interrupted sync can be resumed
+type TrieSyncCheckpointHandler interface {
+	IsTrieSynced(rootHash []byte) bool
+	MarkTrieSynced(rootHash []byte)
+	PendingHashes(rootHash []byte) [][]byte
+	SavePendingHashes(rootHash []byte, hashes [][]byte)
+	IsInterfaceNil() bool
+}
+
+// TrieNodesCacher defines the size bounded cache of the encoded trie nodes read from storage, keyed by the node hash
+type TrieNodesCacher interface {
+	Get(hash []byte) ([]byte, bool)
+	Put(hash []byte, encodedNode []byte)
+	Remove(hash []byte)
+	NumHits() uint64
+	NumMisses() uint64
+	SizeInBytesContained() uint64
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// SnapshotDbHandlerStub -
+type SnapshotDbHandlerStub struct {
+	data.DBWriteCacher
+	IsInUseCalled               func() bool
+	DecreaseNumReferencesCalled func()
+	IncreaseNumReferencesCalled func()
+	MarkForRemovalCalled        func()
+	SetPathCalled               func(string)
+}
+
+// IsInUse -
+func (sdhs *SnapshotDbHandlerStub) IsInUse() bool {
+	if sdhs.IsInUseCalled != nil {
+		return sdhs.IsInUseCalled()
+	}
+	return false
+}
+
+// DecreaseNumReferences -
+func (sdhs *SnapshotDbHandlerStub) DecreaseNumReferences() {
+	if sdhs.DecreaseNumReferencesCalled != nil {
+		sdhs.DecreaseNumReferencesCalled()
+	}
+}
+
+// IncreaseNumReferences -
+func (sdhs *SnapshotDbHandlerStub) IncreaseNumReferences() {
+	if sdhs.IncreaseNumReferencesCalled != nil {
+		sdhs.IncreaseNumReferencesCalled()
+	}
+}
+
+// MarkForRemoval -
+func (sdhs *SnapshotDbHandlerStub) MarkForRemoval() {
+	if sdhs.MarkForRemovalCalled != nil {
+		sdhs.MarkForRemovalCalled()
+	}
+}
+
+// SetPath -
+func (sdhs *SnapshotDbHandlerStub) SetPath(path string) {
+	if sdhs.SetPathCalled != nil {
+		sdhs.SetPathCalled(path)
+	}
+}
+
+// IsInterfaceNil -
+func (sdhs *SnapshotDbHandlerStub) IsInterfaceNil() bool {
+	return sdhs == nil
+}
@@ -17,6 +17,8 @@ type StorageManagerStub struct {
 	IsPruningEnabledCalled            func() bool
 	EnterPruningBufferingModeCalled   func()
 	ExitPruningBufferingModeCalled    func()
+	SetEpochForSnapshotsCalled        func(epoch uint32)
+	GetSnapshotsInfoCalled            func() []data.SnapshotInfo
 	IsInterfaceNilCalled              func() bool
 }
 
@@ -100,6 +102,21 @@ func (sms *StorageManagerStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
 
+// SetEpochForSnapshots -
+func (sms *StorageManagerStub) SetEpochForSnapshots(epoch uint32) {
+	if sms.SetEpochForSnapshotsCalled != nil {
+		sms.SetEpochForSnapshotsCalled(epoch)
+	}
+}
+
+// GetSnapshotsInfo -
+func (sms *StorageManagerStub) GetSnapshotsInfo() []data.SnapshotInfo {
+	if sms.GetSnapshotsInfoCalled != nil {
+		return sms.GetSnapshotsInfoCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil --
 func (sms *StorageManagerStub) IsInterfaceNil() bool {
 	return sms == nil
@@ -0,0 +1,46 @@
+package mock
+
+// TrieSyncCheckpointHandlerStub -
+type TrieSyncCheckpointHandlerStub struct {
+	IsTrieSyncedCalled      func(rootHash []byte) bool
+	MarkTrieSyncedCalled    func(rootHash []byte)
+	PendingHashesCalled     func(rootHash []byte) [][]byte
+	SavePendingHashesCalled func(rootHash []byte, hashes [][]byte)
+}
+
+// IsTrieSynced -
+func (stub *TrieSyncCheckpointHandlerStub) IsTrieSynced(rootHash []byte) bool {
+	if stub.IsTrieSyncedCalled != nil {
+		return stub.IsTrieSyncedCalled(rootHash)
+	}
+
+	return false
+}
+
+// MarkTrieSynced -
+func (stub *TrieSyncCheckpointHandlerStub) MarkTrieSynced(rootHash []byte) {
+	if stub.MarkTrieSyncedCalled != nil {
+		stub.MarkTrieSyncedCalled(rootHash)
+	}
+}
+
+// PendingHashes -
+func (stub *TrieSyncCheckpointHandlerStub) PendingHashes(rootHash []byte) [][]byte {
+	if stub.PendingHashesCalled != nil {
+		return stub.PendingHashesCalled(rootHash)
+	}
+
+	return nil
+}
+
+// SavePendingHashes -
+func (stub *TrieSyncCheckpointHandlerStub) SavePendingHashes(rootHash []byte, hashes [][]byte) {
+	if stub.SavePendingHashesCalled != nil {
+		stub.SavePendingHashesCalled(rootHash, hashes)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TrieSyncCheckpointHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
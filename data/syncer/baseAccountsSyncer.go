@@ -31,6 +31,7 @@ type baseAccountsSyncer struct {
 	name                      string
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	checkpointHandler         data.TrieSyncCheckpointHandler
 }
 
 const timeBetweenStatisticsPrints = time.Second * 2
@@ -46,6 +47,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	MaxTrieLevelInMemory      uint
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	CheckpointHandler         data.TrieSyncCheckpointHandler
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -67,6 +69,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if check.IfNil(args.CheckpointHandler) {
+		return trie.ErrNilTrieSyncCheckpointHandler
+	}
 
 	return trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
 }
@@ -96,6 +101,7 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		TrieSyncStatistics:             ssh,
 		TimeoutBetweenTrieNodesCommits: b.timeout,
 		MaxHardCapForMissingNodes:      b.maxHardCapForMissingNodes,
+		CheckpointHandler:              b.checkpointHandler,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
 	if err != nil {
@@ -114,13 +120,19 @@ func (b *baseAccountsSyncer) printStatistics(ssh data.SyncStatisticsHandler, ctx
 	for {
 		select {
 		case <-ctx.Done():
-			log.Info("finished trie sync", "name", b.name, "num received", ssh.NumReceived(), "num missing", ssh.NumMissing())
+			log.Info("finished trie sync",
+				"name", b.name,
+				"num received", ssh.NumReceived(),
+				"num missing", ssh.NumMissing(),
+				"num tries synced", ssh.NumTriesSynced())
 			return
 		case <-time.After(timeBetweenStatisticsPrints):
 			log.Info("trie sync in progress",
 				"name", b.name,
 				"num received", ssh.NumReceived(),
 				"num missing", ssh.NumMissing(),
+				"num tries synced", ssh.NumTriesSynced(),
+				"num tries in progress", ssh.NumTriesInProgress(),
 				"intercepted trie nodes cache", fmt.Sprintf("len: %d, size: %s", b.cacher.Len(), core.ConvertBytes(b.cacher.SizeInBytesContained())))
 		}
 	}
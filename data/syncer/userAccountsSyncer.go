@@ -61,6 +61,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		name:                      fmt.Sprintf("user accounts for shard %s", core.GetShardIDString(args.ShardId)),
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		checkpointHandler:         args.CheckpointHandler,
 	}
 
 	u := &userAccountsSyncer{
@@ -87,53 +88,71 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 		return err
 	}
 
-	log.Debug("main trie synced, starting to sync data tries", "num data tries", len(u.dataTries))
+	log.Debug("main trie synced, starting to sync data tries")
 
-	rootHashes, err := u.findAllAccountRootHashes(mainTrie, ctx)
+	err = u.syncAccountDataTries(mainTrie, tss, ctx)
 	if err != nil {
 		return err
 	}
 
-	err = u.syncAccountDataTries(rootHashes, tss, ctx)
+	return nil
+}
+
+// syncAccountDataTries starts syncing the data tries while the leaves of the main trie are being read, keeping at most
+// the throttler's number of data tries in progress. The first error stops all the data tries syncers
+func (u *userAccountsSyncer) syncAccountDataTries(mainTrie data.Trie, ssh data.SyncStatisticsHandler, ctx context.Context) error {
+	mainRootHash, err := mainTrie.RootHash()
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	ctxDataTries, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(mainRootHash, ctxDataTries)
+	if err != nil {
+		return err
+	}
 
-func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ssh data.SyncStatisticsHandler, ctx context.Context) error {
 	var errFound error
 	errMutex := sync.Mutex{}
+	setError := func(newErr error) {
+		errMutex.Lock()
+		if errFound == nil {
+			errFound = newErr
+		}
+		errMutex.Unlock()
 
-	wg := sync.WaitGroup{}
-	wg.Add(len(rootHashes))
+		cancel()
+	}
 
-	for _, rootHash := range rootHashes {
-		for {
-			if u.throttler.CanProcess() {
-				break
-			}
+	wg := sync.WaitGroup{}
+	for leaf := range leavesChannel {
+		rootHash := u.getDataTrieRootHash(leaf.Value())
+		if len(rootHash) == 0 {
+			continue
+		}
 
-			select {
-			case <-time.After(timeBetweenRetries):
-				continue
-			case <-ctx.Done():
-				return data.ErrTimeIsOut
-			}
+		err = u.waitForThrottler(ctxDataTries)
+		if err != nil {
+			setError(err)
+			break
 		}
 
+		wg.Add(1)
 		go func(trieRootHash []byte) {
-			newErr := u.syncDataTrie(trieRootHash, ssh, ctx)
+			defer wg.Done()
+
+			newErr := u.syncDataTrie(trieRootHash, ssh, ctxDataTries)
 			if newErr != nil {
-				errMutex.Lock()
-				errFound = newErr
-				errMutex.Unlock()
+				setError(newErr)
 			}
-			wg.Done()
 		}(rootHash)
 	}
 
+	for range leavesChannel {
+		// drain the channel so the leaves producer can finish
+	}
 	wg.Wait()
 
 	errMutex.Lock()
@@ -142,6 +161,32 @@ func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ssh data.
 	return errFound
 }
 
+func (u *userAccountsSyncer) getDataTrieRootHash(leafValue []byte) []byte {
+	account := state.NewEmptyUserAccount()
+	err := u.marshalizer.Unmarshal(account, leafValue)
+	if err != nil {
+		log.Trace("this must be a leaf with code", "err", err)
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func (u *userAccountsSyncer) waitForThrottler(ctx context.Context) error {
+	for {
+		if u.throttler.CanProcess() {
+			return nil
+		}
+
+		select {
+		case <-time.After(timeBetweenRetries):
+			continue
+		case <-ctx.Done():
+			return data.ErrTimeIsOut
+		}
+	}
+}
+
 func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisticsHandler, ctx context.Context) error {
 	u.throttler.StartProcessing()
 	defer u.throttler.EndProcessing()
@@ -167,8 +212,9 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisti
 		TrieSyncStatistics:             ssh,
 		TimeoutBetweenTrieNodesCommits: u.timeout,
 		MaxHardCapForMissingNodes:      u.maxHardCapForMissingNodes,
+		CheckpointHandler:              u.checkpointHandler,
 	}
-	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
+	trieSyncer, err := trie.CreateTrieSyncer(arg, trie.DataTrieSyncerVersion(u.trieSyncerVersion))
 	if err != nil {
 
 		return err
@@ -179,33 +225,7 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisti
 		return err
 	}
 
-	return nil
-}
-
-func (u *userAccountsSyncer) findAllAccountRootHashes(mainTrie data.Trie, ctx context.Context) ([][]byte, error) {
-	mainRootHash, err := mainTrie.RootHash()
-	if err != nil {
-		return nil, err
-	}
-
-	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(mainRootHash, ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	rootHashes := make([][]byte, 0)
-	for leaf := range leavesChannel {
-		account := state.NewEmptyUserAccount()
-		err = u.marshalizer.Unmarshal(account, leaf.Value())
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
-		}
-
-		if len(account.RootHash) > 0 {
-			rootHashes = append(rootHashes, account.RootHash)
-		}
-	}
+	ssh.AddNumTriesSynced(1)
 
-	return rootHashes, nil
+	return nil
 }
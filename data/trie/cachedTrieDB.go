@@ -0,0 +1,68 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ data.DBWriteCacher = (*cachedTrieDB)(nil)
+
+// cachedTrieDB reads the trie nodes through the trie nodes cache, adding in the cache the nodes read from the
+// database. The nodes written are not cached so a trie commit does not evict the nodes frequently read
+type cachedTrieDB struct {
+	db    data.DBWriteCacher
+	cache data.TrieNodesCacher
+}
+
+// NewCachedTrieDB creates a trie database reading the nodes through the provided trie nodes cache
+func NewCachedTrieDB(db data.DBWriteCacher, cache data.TrieNodesCacher) (*cachedTrieDB, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(cache) {
+		return nil, ErrNilTrieNodesCache
+	}
+
+	return &cachedTrieDB{
+		db:    db,
+		cache: cache,
+	}, nil
+}
+
+// Put writes the node in the database
+func (ctd *cachedTrieDB) Put(key, val []byte) error {
+	return ctd.db.Put(key, val)
+}
+
+// Get returns the node from the cache or reads it from the database
+func (ctd *cachedTrieDB) Get(key []byte) ([]byte, error) {
+	val, ok := ctd.cache.Get(key)
+	if ok {
+		return val, nil
+	}
+
+	val, err := ctd.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	ctd.cache.Put(key, val)
+
+	return val, nil
+}
+
+// Remove removes the node from the cache and from the database
+func (ctd *cachedTrieDB) Remove(key []byte) error {
+	ctd.cache.Remove(key)
+
+	return ctd.db.Remove(key)
+}
+
+// Close closes the underlying database
+func (ctd *cachedTrieDB) Close() error {
+	return ctd.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ctd *cachedTrieDB) IsInterfaceNil() bool {
+	return ctd == nil
+}
@@ -0,0 +1,108 @@
+package trie
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCachedTrieDB_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctd, err := NewCachedTrieDB(nil, NewDisabledTrieNodesCache())
+	assert.True(t, check.IfNil(ctd))
+	assert.Equal(t, ErrNilDatabase, err)
+
+	ctd, err = NewCachedTrieDB(mock.NewMemDbMock(), nil)
+	assert.True(t, check.IfNil(ctd))
+	assert.Equal(t, ErrNilTrieNodesCache, err)
+}
+
+func TestCachedTrieDB_GetShouldReadThroughTheCache(t *testing.T) {
+	t.Parallel()
+
+	db := mock.NewMemDbMock()
+	cache, _ := NewTrieNodesCache(10, 1000)
+	ctd, err := NewCachedTrieDB(db, cache)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(ctd))
+
+	_ = ctd.Put([]byte("hash"), []byte("node"))
+	_, ok := cache.Get([]byte("hash"))
+	assert.False(t, ok, "written nodes should not be cached")
+
+	val, err := ctd.Get([]byte("hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("node"), val)
+
+	_ = db.Remove([]byte("hash"))
+	val, err = ctd.Get([]byte("hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("node"), val)
+	assert.Equal(t, uint64(1), cache.NumHits())
+}
+
+func TestCachedTrieDB_RemoveShouldRemoveFromCacheAndDatabase(t *testing.T) {
+	t.Parallel()
+
+	db := mock.NewMemDbMock()
+	cache, _ := NewTrieNodesCache(10, 1000)
+	ctd, _ := NewCachedTrieDB(db, cache)
+
+	_ = ctd.Put([]byte("hash"), []byte("node"))
+	_, _ = ctd.Get([]byte("hash"))
+	err := ctd.Remove([]byte("hash"))
+	assert.Nil(t, err)
+
+	_, ok := cache.Get([]byte("hash"))
+	assert.False(t, ok)
+	_, err = ctd.Get([]byte("hash"))
+	assert.NotNil(t, err)
+}
+
+func TestCachedTrieDB_GetMissingNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	db := &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	cache, _ := NewTrieNodesCache(10, 1000)
+	ctd, _ := NewCachedTrieDB(db, cache)
+
+	val, err := ctd.Get([]byte("hash"))
+	assert.Nil(t, val)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, uint64(0), cache.SizeInBytesContained())
+}
+
+func TestCachedTrieDB_TriesShouldShareTheCache(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := NewTrieNodesCache(1000, 1000000)
+	ctd, _ := NewCachedTrieDB(mock.NewMemDbMock(), cache)
+	trieStorage, _ := NewTrieStorageManagerWithoutPruning(ctd)
+	tr, _ := NewTrie(trieStorage, marshalizer, hasher, 1)
+
+	addDataToTrie(100, tr)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	dataTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+	_, err = dataTrie.Get(hasher.Compute("1"))
+	require.Nil(t, err)
+	numMissesAfterFirstRead := cache.NumMisses()
+
+	otherTrie, _ := tr.Recreate(rootHash)
+	_, err = otherTrie.Get(hasher.Compute("1"))
+	require.Nil(t, err)
+	assert.Equal(t, numMissesAfterFirstRead, cache.NumMisses())
+	assert.True(t, cache.NumHits() > 0)
+}
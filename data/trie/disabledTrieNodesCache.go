@@ -0,0 +1,48 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ data.TrieNodesCacher = (*disabledTrieNodesCache)(nil)
+
+type disabledTrieNodesCache struct {
+}
+
+// NewDisabledTrieNodesCache returns a trie nodes cache that holds nothing
+func NewDisabledTrieNodesCache() *disabledTrieNodesCache {
+	return &disabledTrieNodesCache{}
+}
+
+// Get returns nil and false
+func (dtnc *disabledTrieNodesCache) Get(_ []byte) ([]byte, bool) {
+	return nil, false
+}
+
+// Put does nothing
+func (dtnc *disabledTrieNodesCache) Put(_ []byte, _ []byte) {
+}
+
+// Remove does nothing
+func (dtnc *disabledTrieNodesCache) Remove(_ []byte) {
+}
+
+// NumHits returns 0
+func (dtnc *disabledTrieNodesCache) NumHits() uint64 {
+	return 0
+}
+
+// NumMisses returns 0
+func (dtnc *disabledTrieNodesCache) NumMisses() uint64 {
+	return 0
+}
+
+// SizeInBytesContained returns 0
+func (dtnc *disabledTrieNodesCache) SizeInBytesContained() uint64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtnc *disabledTrieNodesCache) IsInterfaceNil() bool {
+	return dtnc == nil
+}
@@ -0,0 +1,38 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ data.TrieSyncCheckpointHandler = (*disabledTrieSyncCheckpoint)(nil)
+
+type disabledTrieSyncCheckpoint struct {
+}
+
+// NewDisabledTrieSyncCheckpoint returns a trie sync checkpoint handler that records nothing
+func NewDisabledTrieSyncCheckpoint() *disabledTrieSyncCheckpoint {
+	return &disabledTrieSyncCheckpoint{}
+}
+
+// IsTrieSynced returns false
+func (dtsc *disabledTrieSyncCheckpoint) IsTrieSynced(_ []byte) bool {
+	return false
+}
+
+// MarkTrieSynced does nothing
+func (dtsc *disabledTrieSyncCheckpoint) MarkTrieSynced(_ []byte) {
+}
+
+// PendingHashes returns nil
+func (dtsc *disabledTrieSyncCheckpoint) PendingHashes(_ []byte) [][]byte {
+	return nil
+}
+
+// SavePendingHashes does nothing
+func (dtsc *disabledTrieSyncCheckpoint) SavePendingHashes(_ []byte, _ [][]byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtsc *disabledTrieSyncCheckpoint) IsInterfaceNil() bool {
+	return dtsc == nil
+}
@@ -34,8 +34,13 @@ type doubleListTrieSyncer struct {
 	maxHardCapForMissingNodes int
 	existingNodes             map[string]node
 	missingHashes             map[string]struct{}
+	checkpointHandler         data.TrieSyncCheckpointHandler
+	timeBetweenCheckpoints    time.Duration
+	lastCheckpoint            time.Time
 }
 
+const timeBetweenCheckpoints = 10 * time.Second
+
 // NewDoubleListTrieSyncer creates a new instance of trieSyncer that uses 2 list for keeping the "margin" nodes.
 // One is used for keeping track of the loaded nodes (their children will need to be checked) and the other one that holds
 // missing nodes
@@ -58,6 +63,8 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 		trieSyncStatistics:        arg.TrieSyncStatistics,
 		timeoutBetweenCommits:     arg.TimeoutBetweenTrieNodesCommits,
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
+		checkpointHandler:         arg.CheckpointHandler,
+		timeBetweenCheckpoints:    timeBetweenCheckpoints,
 	}
 
 	return d, nil
@@ -66,6 +73,8 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 // StartSyncing completes the trie, asking for missing trie nodes on the network. All concurrent calls will be serialized
 // so this function is treated as a large critical section. This was done so the inner processing can be done without using
 // other mutexes.
+// The tries already marked as synced in the checkpoint handler are skipped and the ones having pending hashes recorded
+// are resumed from those hashes.
 func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
 		return nil
@@ -73,6 +82,10 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	if ctx == nil {
 		return ErrNilContext
 	}
+	if d.checkpointHandler.IsTrieSynced(rootHash) {
+		log.Trace("doubleListTrieSyncer.StartSyncing: trie already synced", "root hash", rootHash)
+		return nil
+	}
 
 	d.mutOperation.Lock()
 	defer func() {
@@ -80,13 +93,22 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}()
 
 	d.lastSyncedTrieNode = time.Now()
+	d.lastCheckpoint = time.Now()
 	d.existingNodes = make(map[string]node)
 	d.missingHashes = make(map[string]struct{})
 
 	d.rootFound = false
 	d.rootHash = rootHash
 
-	d.missingHashes[string(rootHash)] = struct{}{}
+	pendingHashes := d.checkpointHandler.PendingHashes(rootHash)
+	if len(pendingHashes) == 0 {
+		pendingHashes = [][]byte{rootHash}
+	} else {
+		log.Debug("resuming trie sync", "root hash", rootHash, "num pending hashes", len(pendingHashes))
+	}
+	for _, hash := range pendingHashes {
+		d.missingHashes[string(hash)] = struct{}{}
+	}
 
 	for {
 		isSynced, err := d.checkIsSyncedWhileProcessingMissingAndExisting()
@@ -94,9 +116,13 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 			return err
 		}
 		if isSynced {
+			d.trieSyncStatistics.SetNumMissing(d.rootHash, 0)
+			d.checkpointHandler.MarkTrieSynced(d.rootHash)
 			return nil
 		}
 
+		d.saveCheckpointIfNeeded()
+
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
@@ -106,6 +132,25 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}
 }
 
+// saveCheckpointIfNeeded records the hashes still to be processed. All the nodes not found in the database below the
+// root are descendants of these hashes, as each committed node had its children added in one of the two lists
+func (d *doubleListTrieSyncer) saveCheckpointIfNeeded() {
+	if time.Since(d.lastCheckpoint) < d.timeBetweenCheckpoints {
+		return
+	}
+	d.lastCheckpoint = time.Now()
+
+	pendingHashes := make([][]byte, 0, len(d.missingHashes)+len(d.existingNodes))
+	for hash := range d.missingHashes {
+		pendingHashes = append(pendingHashes, []byte(hash))
+	}
+	for hash := range d.existingNodes {
+		pendingHashes = append(pendingHashes, []byte(hash))
+	}
+
+	d.checkpointHandler.SavePendingHashes(d.rootHash, pendingHashes)
+}
+
 func (d *doubleListTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	err := d.processMissingAndExisting()
 	if err != nil {
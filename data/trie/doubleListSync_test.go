@@ -263,3 +263,91 @@ func TestDoubleListTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testi
 		require.Equal(t, keyVal, val)
 	}
 }
+
+func TestDoubleListTrieSyncer_StartSyncingAlreadySyncedTrieShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Fail(t, "should have not requested trie nodes")
+		},
+	}
+	arg.CheckpointHandler = &mock.TrieSyncCheckpointHandlerStub{
+		IsTrieSyncedCalled: func(rootHash []byte) bool {
+			return true
+		},
+	}
+
+	d, _ := NewDoubleListTrieSyncer(arg)
+	err := d.StartSyncing([]byte("roothash"), context.Background())
+	assert.Nil(t, err)
+}
+
+func TestDoubleListTrieSyncer_StartSyncingShouldResumeFromPendingHashes(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, memUnitSource := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	roothash, _ := trSource.RootHash()
+
+	arg := createMockArgument()
+	rootBuff, _ := memUnitSource.Get(roothash)
+	_ = arg.DB.Put(roothash, rootBuff)
+	rootNode, _ := getNodeFromDBAndDecode(roothash, arg.DB, marshalizer, hasher)
+	childrenHashes, _, _ := rootNode.loadChildren(func(_ []byte) (node, error) {
+		return nil, ErrNodeNotFound
+	})
+	require.True(t, len(childrenHashes) > 0)
+
+	requester := createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.False(t, hashInList(roothash, hashes))
+			requester.RequestTrieNodes(destShardID, hashes, topic)
+		},
+	}
+	var syncedRootHash []byte
+	arg.CheckpointHandler = &mock.TrieSyncCheckpointHandlerStub{
+		PendingHashesCalled: func(rootHash []byte) [][]byte {
+			return childrenHashes
+		},
+		MarkTrieSyncedCalled: func(rootHash []byte) {
+			syncedRootHash = rootHash
+		},
+	}
+
+	d, _ := NewDoubleListTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := d.StartSyncing(roothash, ctx)
+	require.Nil(t, err)
+	assert.Equal(t, roothash, syncedRootHash)
+	checkSyncedTrie(t, arg.DB, roothash, numKeysValues)
+}
+
+func TestDoubleListTrieSyncer_StartSyncingShouldSavePendingHashes(t *testing.T) {
+	t.Parallel()
+
+	roothash := []byte("roothash")
+	arg := createMockArgument()
+	var savedHashes [][]byte
+	arg.CheckpointHandler = &mock.TrieSyncCheckpointHandlerStub{
+		SavePendingHashesCalled: func(rootHash []byte, hashes [][]byte) {
+			assert.Equal(t, roothash, rootHash)
+			savedHashes = hashes
+		},
+	}
+
+	d, _ := NewDoubleListTrieSyncer(arg)
+	d.timeBetweenCheckpoints = 0
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*300)
+	defer cancelFunc()
+
+	err := d.StartSyncing(roothash, ctx)
+	assert.Equal(t, ErrContextClosing, err)
+	assert.Equal(t, [][]byte{roothash}, savedHashes)
+}
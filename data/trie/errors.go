@@ -91,6 +91,12 @@ var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
 // ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler was provided
 var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics handler")
 
+// ErrNilTrieSyncCheckpointHandler signals that a nil trie sync checkpoint handler was provided
+var ErrNilTrieSyncCheckpointHandler = errors.New("nil trie sync checkpoint handler")
+
+// ErrNilTrieNodesCache signals that a nil trie nodes cache was provided
+var ErrNilTrieNodesCache = errors.New("nil trie nodes cache")
+
 // ErrContextClosing signals that the parent context requested the closing of its children
 var ErrContextClosing = errors.New("context closing")
 
@@ -100,5 +106,14 @@ var ErrInvalidTimeout = errors.New("invalid timeout value")
 // ErrInvalidMaxHardCapForMissingNodes signals that the maximum hardcap value for missing nodes is invalid
 var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for missing nodes")
 
+// ErrInvalidNumWorkers signals that an invalid number of workers was provided
+var ErrInvalidNumWorkers = errors.New("invalid number of workers")
+
 // ErrInvalidTrieSyncerVersion signals that an invalid trie syncer version was provided
 var ErrInvalidTrieSyncerVersion = errors.New("invalid trie syncer version")
+
+// ErrInvalidStateRetentionPolicy signals that an unknown state retention policy was configured
+var ErrInvalidStateRetentionPolicy = errors.New("invalid state retention policy")
+
+// ErrInvalidNumEpochsToKeep signals that an invalid number of epochs to keep was configured
+var ErrInvalidNumEpochsToKeep = errors.New("invalid number of epochs to keep")
@@ -24,6 +24,8 @@ type trieCreator struct {
 	hasher                   hashing.Hasher
 	pathManager              storage.PathManagerHandler
 	trieStorageManagerConfig config.TrieStorageManagerConfig
+	trieNodesCache           data.TrieNodesCacher
+	stateRetentionPolicy     data.StateRetentionPolicyHandler
 }
 
 var log = logger.GetOrCreate("trie")
@@ -41,6 +43,13 @@ func NewTrieFactory(
 	if check.IfNil(args.PathManager) {
 		return nil, trie.ErrNilPathManager
 	}
+	if check.IfNil(args.TrieNodesCache) {
+		return nil, trie.ErrNilTrieNodesCache
+	}
+	stateRetentionPolicy, err := trie.NewStateRetentionPolicy(args.StateRetentionCfg)
+	if err != nil {
+		return nil, err
+	}
 
 	return &trieCreator{
 		evictionWaitingListCfg:   args.EvictionWaitingListCfg,
@@ -49,10 +58,12 @@ func NewTrieFactory(
 		hasher:                   args.Hasher,
 		pathManager:              args.PathManager,
 		trieStorageManagerConfig: args.TrieStorageManagerConfig,
+		trieNodesCache:           args.TrieNodesCache,
+		stateRetentionPolicy:     stateRetentionPolicy,
 	}, nil
 }
 
-// Create creates a new trie
+// Create creates a new trie. The state retention policy decides if the pruning is done and how many snapshots are kept
 func (tc *trieCreator) Create(
 	trieStorageCfg config.StorageConfig,
 	shardID string,
@@ -72,9 +83,15 @@ func (tc *trieCreator) Create(
 		return nil, nil, err
 	}
 
-	log.Trace("trie pruning status", "enabled", pruningEnabled)
+	cachedTrieStorage, err := trie.NewCachedTrieDB(accountsTrieStorage, tc.trieNodesCache)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pruningEnabled = tc.stateRetentionPolicy.IsPruningEnabled(pruningEnabled)
+	log.Trace("trie pruning status", "enabled", pruningEnabled, "state retention", tc.stateRetentionPolicy.Policy())
 	if !pruningEnabled {
-		trieStorage, errNewTrie := trie.NewTrieStorageManagerWithoutPruning(accountsTrieStorage)
+		trieStorage, errNewTrie := trie.NewTrieStorageManagerWithoutPruning(cachedTrieStorage)
 		if errNewTrie != nil {
 			return nil, nil, errNewTrie
 		}
@@ -112,13 +129,16 @@ func (tc *trieCreator) Create(
 		MaxOpenFiles:      tc.snapshotDbCfg.MaxOpenFiles,
 	}
 
+	trieStorageManagerConfig := tc.trieStorageManagerConfig
+	trieStorageManagerConfig.MaxSnapshots = tc.stateRetentionPolicy.MaxSnapshots(trieStorageManagerConfig.MaxSnapshots)
+
 	trieStorage, err := trie.NewTrieStorageManager(
-		accountsTrieStorage,
+		cachedTrieStorage,
 		tc.marshalizer,
 		tc.hasher,
 		snapshotDbCfg,
 		ewl,
-		tc.trieStorageManagerConfig,
+		trieStorageManagerConfig,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -132,6 +152,15 @@ func (tc *trieCreator) Create(
 	return trieStorage, newTrie, nil
 }
 
+// CreateTrieNodesCache creates the trie nodes cache shared between the tries, or a disabled one if not enabled
+func CreateTrieNodesCache(cfg config.TrieNodesCacheConfig) (data.TrieNodesCacher, error) {
+	if !cfg.Enabled {
+		return trie.NewDisabledTrieNodesCache(), nil
+	}
+
+	return trie.NewTrieNodesCache(int(cfg.Cache.Capacity), int64(cfg.Cache.SizeInBytes))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tc *trieCreator) IsInterfaceNil() bool {
 	return tc == nil
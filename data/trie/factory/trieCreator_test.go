@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -16,9 +17,10 @@ import (
 
 func getArgs() TrieFactoryArgs {
 	return TrieFactoryArgs{
-		Marshalizer: &mock.MarshalizerMock{},
-		Hasher:      &mock.HasherMock{},
-		PathManager: &mock.PathManagerStub{},
+		Marshalizer:    &mock.MarshalizerMock{},
+		Hasher:         &mock.HasherMock{},
+		PathManager:    &mock.PathManagerStub{},
+		TrieNodesCache: trie.NewDisabledTrieNodesCache(),
 	}
 }
 
@@ -63,6 +65,28 @@ func TestNewTrieFactory_NilPathManagerShouldErr(t *testing.T) {
 	assert.Equal(t, trie.ErrNilPathManager, err)
 }
 
+func TestNewTrieFactory_NilTrieNodesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.TrieNodesCache = nil
+	tf, err := NewTrieFactory(args)
+
+	assert.Nil(t, tf)
+	assert.Equal(t, trie.ErrNilTrieNodesCache, err)
+}
+
+func TestNewTrieFactory_InvalidStateRetentionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.StateRetentionCfg = config.StateRetentionConfig{Policy: "unknown"}
+	tf, err := NewTrieFactory(args)
+
+	assert.Nil(t, tf)
+	assert.True(t, errors.Is(err, trie.ErrInvalidStateRetentionPolicy))
+}
+
 func TestNewTrieFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -144,3 +168,41 @@ func TestTrieFactory_CreateWithPRunningShouldWork(t *testing.T) {
 	require.NotNil(t, tr)
 	require.Nil(t, err)
 }
+
+func TestTrieFactory_CreateWithArchiveStateRetentionShouldNotPrune(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.StateRetentionCfg = config.StateRetentionConfig{Policy: trie.ArchiveStateRetention}
+	tf, _ := NewTrieFactory(args)
+	trieStorageCfg := createTrieStorageCfg()
+
+	maxTrieLevelInMemory := uint(5)
+	storageManager, tr, err := tf.Create(trieStorageCfg, "0", true, maxTrieLevelInMemory)
+	require.NotNil(t, tr)
+	require.Nil(t, err)
+	assert.False(t, storageManager.IsPruningEnabled())
+}
+
+func TestCreateTrieNodesCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := CreateTrieNodesCache(config.TrieNodesCacheConfig{})
+	require.Nil(t, err)
+	cache.Put([]byte("hash"), []byte("node"))
+	_, ok := cache.Get([]byte("hash"))
+	assert.False(t, ok)
+
+	cache, err = CreateTrieNodesCache(config.TrieNodesCacheConfig{
+		Enabled: true,
+		Cache:   config.CacheConfig{Capacity: 10, SizeInBytes: 1000},
+	})
+	require.Nil(t, err)
+	cache.Put([]byte("hash"), []byte("node"))
+	val, ok := cache.Get([]byte("hash"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("node"), val)
+
+	_, err = CreateTrieNodesCache(config.TrieNodesCacheConfig{Enabled: true})
+	assert.Equal(t, storage.ErrCacheSizeInvalid, err)
+}
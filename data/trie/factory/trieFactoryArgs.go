@@ -2,6 +2,7 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -21,4 +22,6 @@ type TrieFactoryArgs struct {
 	Hasher                   hashing.Hasher
 	PathManager              storage.PathManagerHandler
 	TrieStorageManagerConfig config.TrieStorageManagerConfig
+	TrieNodesCache           data.TrieNodesCacher
+	StateRetentionCfg        config.StateRetentionConfig
 }
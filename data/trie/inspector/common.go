@@ -0,0 +1,26 @@
+package inspector
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// unmarshalAccountsTrieLeaf unmarshals a leaf of the accounts trie, which holds either an account or, under the code
+// hash, the code entry of a smart contract. A nil account is returned for a code entry
+func unmarshalAccountsTrieLeaf(marshalizer marshal.Marshalizer, value []byte) (*state.UserAccountData, error) {
+	account := &state.UserAccountData{}
+	err := marshalizer.Unmarshal(account, value)
+	if err == nil && len(account.Address) > 0 {
+		return account, nil
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = marshalizer.Unmarshal(codeEntry, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w while unmarshalling an accounts trie leaf", err)
+	}
+
+	return nil, nil
+}
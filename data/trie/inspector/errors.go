@@ -0,0 +1,30 @@
+package inspector
+
+import "errors"
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager was provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter was provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrUnknownTrie signals that statistics were requested for an unknown trie
+var ErrUnknownTrie = errors.New("unknown trie")
+
+// ErrInspectionInProgress signals that another trie inspection is in progress
+var ErrInspectionInProgress = errors.New("another trie inspection is in progress")
+
+// ErrReachableNodesNotTracked signals that the orphan nodes were requested without tracking the reachable nodes
+var ErrReachableNodesNotTracked = errors.New("the reachable nodes are not tracked")
+
+// ErrNilKeysRanger signals that a nil keys ranger was provided
+var ErrNilKeysRanger = errors.New("nil keys ranger")
+
+// ErrNilStateRetentionPolicy signals that a nil state retention policy was provided
+var ErrNilStateRetentionPolicy = errors.New("nil state retention policy")
+
+// ErrStateNotRetained signals that the requested state is not retained by the state retention policy
+var ErrStateNotRetained = errors.New("state not retained")
+
+// ErrNilRepairSource signals that a nil repair source has been provided
+var ErrNilRepairSource = errors.New("nil repair source")
@@ -0,0 +1,7 @@
+package inspector
+
+// KeysRanger defines a storage able to iterate over all its keys, as the level DB persisters
+type KeysRanger interface {
+	RangeKeys(handler func(key []byte, val []byte) bool)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,176 @@
+package inspector
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const maxUnhealthyDataTriesInReport = 100
+
+// DataTrieIntegrity holds the result of checking the data trie of an account
+type DataTrieIntegrity struct {
+	Address           []byte `json:"address"`
+	RootHash          []byte `json:"rootHash"`
+	NumMissingNodes   uint64 `json:"numMissingNodes"`
+	NumCorruptedNodes uint64 `json:"numCorruptedNodes"`
+}
+
+// IntegrityReport holds the result of checking a trie and, for an accounts trie, the aggregated result of checking the
+// data tries together with the accounts having unhealthy data tries
+type IntegrityReport struct {
+	Trie               *trie.TrieIntegrityReport `json:"trie"`
+	NumAccounts        uint64                    `json:"numAccounts,omitempty"`
+	NumCodeEntries     uint64                    `json:"numCodeEntries,omitempty"`
+	NumDataTries       uint64                    `json:"numDataTries,omitempty"`
+	DataTries          *trie.TrieIntegrityReport `json:"dataTries,omitempty"`
+	UnhealthyDataTries []*DataTrieIntegrity      `json:"unhealthyDataTries,omitempty"`
+}
+
+// IsHealthy returns true if no missing or corrupted node was found
+func (ir *IntegrityReport) IsHealthy() bool {
+	if ir.DataTries != nil && !ir.DataTries.IsHealthy() {
+		return false
+	}
+
+	return ir.Trie.IsHealthy()
+}
+
+// ArgsStateIntegrityChecker holds the arguments needed to create a state integrity checker
+type ArgsStateIntegrityChecker struct {
+	DB          data.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	// RepairSources, if set, are searched for the missing or corrupted nodes, as the trie snapshots or the storage of
+	// another node
+	RepairSources []data.DBWriteCacher
+}
+
+type stateIntegrityChecker struct {
+	db            data.DBWriteCacher
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	repairSources []data.DBWriteCacher
+}
+
+// NewStateIntegrityChecker creates a component verifying that the tries stored in the provided database are complete
+// and uncorrupted, optionally repairing them from other sources
+func NewStateIntegrityChecker(args ArgsStateIntegrityChecker) (*stateIntegrityChecker, error) {
+	if check.IfNil(args.DB) {
+		return nil, trie.ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, trie.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, trie.ErrNilHasher
+	}
+	for _, source := range args.RepairSources {
+		if check.IfNil(source) {
+			return nil, ErrNilRepairSource
+		}
+	}
+
+	return &stateIntegrityChecker{
+		db:            args.DB,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+		repairSources: args.RepairSources,
+	}, nil
+}
+
+// CheckTrie checks the integrity of a trie without data tries, as the peer accounts trie
+func (sic *stateIntegrityChecker) CheckTrie(rootHash []byte) (*IntegrityReport, error) {
+	trieReport, err := trie.CheckTrieIntegrity(sic.createArgs(rootHash, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return &IntegrityReport{
+		Trie: trieReport,
+	}, nil
+}
+
+// CheckAccountsTrie checks the integrity of an accounts trie and of the data tries of its accounts
+func (sic *stateIntegrityChecker) CheckAccountsTrie(rootHash []byte) (*IntegrityReport, error) {
+	report := &IntegrityReport{
+		DataTries:          trie.NewTrieIntegrityReport(nil),
+		UnhealthyDataTries: make([]*DataTrieIntegrity, 0),
+	}
+
+	leafHandler := func(value []byte) error {
+		return sic.checkAccount(value, report)
+	}
+
+	trieReport, err := trie.CheckTrieIntegrity(sic.createArgs(rootHash, leafHandler))
+	if err != nil {
+		return nil, err
+	}
+	report.Trie = trieReport
+
+	log.Debug("checked accounts trie",
+		"root hash", rootHash,
+		"num accounts", report.NumAccounts,
+		"num missing nodes", trieReport.NumMissingNodes,
+		"num corrupted nodes", trieReport.NumCorruptedNodes,
+		"num data tries", report.NumDataTries,
+		"num data tries missing nodes", report.DataTries.NumMissingNodes,
+		"num data tries corrupted nodes", report.DataTries.NumCorruptedNodes,
+	)
+
+	return report, nil
+}
+
+func (sic *stateIntegrityChecker) checkAccount(value []byte, report *IntegrityReport) error {
+	account, err := unmarshalAccountsTrieLeaf(sic.marshalizer, value)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		report.NumCodeEntries++
+		return nil
+	}
+
+	report.NumAccounts++
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	dataTrieReport, err := trie.CheckTrieIntegrity(sic.createArgs(account.RootHash, nil))
+	if err != nil {
+		return err
+	}
+
+	report.NumDataTries++
+	report.DataTries.Add(dataTrieReport)
+	if dataTrieReport.IsHealthy() || len(report.UnhealthyDataTries) >= maxUnhealthyDataTriesInReport {
+		return nil
+	}
+
+	report.UnhealthyDataTries = append(report.UnhealthyDataTries, &DataTrieIntegrity{
+		Address:           account.Address,
+		RootHash:          account.RootHash,
+		NumMissingNodes:   dataTrieReport.NumMissingNodes,
+		NumCorruptedNodes: dataTrieReport.NumCorruptedNodes,
+	})
+
+	return nil
+}
+
+func (sic *stateIntegrityChecker) createArgs(rootHash []byte, leafHandler func(value []byte) error) trie.ArgsCheckTrieIntegrity {
+	return trie.ArgsCheckTrieIntegrity{
+		DB:            sic.db,
+		Marshalizer:   sic.marshalizer,
+		Hasher:        sic.hasher,
+		RootHash:      rootHash,
+		RepairSources: sic.repairSources,
+		LeafHandler:   leafHandler,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sic *stateIntegrityChecker) IsInterfaceNil() bool {
+	return sic == nil
+}
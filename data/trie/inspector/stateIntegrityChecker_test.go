@@ -0,0 +1,150 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStateIntegrityCheckerArgs(db *mock.MemDbMock) ArgsStateIntegrityChecker {
+	return ArgsStateIntegrityChecker{
+		DB:          db,
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+	}
+}
+
+func getDataTrieRootHash(t *testing.T, adb *state.AccountsDB, address []byte) []byte {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler).GetRootHash()
+}
+
+func TestNewStateIntegrityChecker_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createStateIntegrityCheckerArgs(mock.NewMemDbMock())
+	args.DB = nil
+	sic, err := NewStateIntegrityChecker(args)
+	assert.True(t, check.IfNil(sic))
+	assert.Equal(t, trie.ErrNilDatabase, err)
+
+	args = createStateIntegrityCheckerArgs(mock.NewMemDbMock())
+	args.Marshalizer = nil
+	_, err = NewStateIntegrityChecker(args)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	args = createStateIntegrityCheckerArgs(mock.NewMemDbMock())
+	args.Hasher = nil
+	_, err = NewStateIntegrityChecker(args)
+	assert.Equal(t, trie.ErrNilHasher, err)
+
+	args = createStateIntegrityCheckerArgs(mock.NewMemDbMock())
+	args.RepairSources = []data.DBWriteCacher{mock.NewMemDbMock(), nil}
+	_, err = NewStateIntegrityChecker(args)
+	assert.Equal(t, ErrNilRepairSource, err)
+}
+
+func TestStateIntegrityChecker_CheckAccountsTrieShouldBeHealthy(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address without data trie......."), 0, nil)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	addAccount(t, adb, []byte("smart contract address.........."), 5, []byte("code"))
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	sic, err := NewStateIntegrityChecker(createStateIntegrityCheckerArgs(db))
+	require.False(t, check.IfNil(sic))
+	require.Nil(t, err)
+
+	report, err := sic.CheckAccountsTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(3), report.NumAccounts)
+	assert.Equal(t, uint64(1), report.NumCodeEntries)
+	assert.Equal(t, uint64(2), report.NumDataTries)
+	assert.Equal(t, uint64(15), report.DataTries.NumLeaves)
+	assert.Equal(t, 0, len(report.UnhealthyDataTries))
+}
+
+func TestStateIntegrityChecker_CheckAccountsTrieShouldReportTheUnhealthyDataTries(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address with data trie..........")
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address without data trie......."), 0, nil)
+	addAccount(t, adb, address, 10, nil)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	dataTrieRootHash := getDataTrieRootHash(t, adb, address)
+	_ = db.Remove(dataTrieRootHash)
+
+	sic, _ := NewStateIntegrityChecker(createStateIntegrityCheckerArgs(db))
+	report, err := sic.CheckAccountsTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.False(t, report.IsHealthy())
+	assert.True(t, report.Trie.IsHealthy())
+	assert.Equal(t, uint64(1), report.DataTries.NumMissingNodes)
+	require.Equal(t, 1, len(report.UnhealthyDataTries))
+	assert.Equal(t, address, report.UnhealthyDataTries[0].Address)
+	assert.Equal(t, dataTrieRootHash, report.UnhealthyDataTries[0].RootHash)
+	assert.Equal(t, uint64(1), report.UnhealthyDataTries[0].NumMissingNodes)
+}
+
+func TestStateIntegrityChecker_CheckAccountsTrieShouldRepair(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address with data trie..........")
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, address, 10, nil)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	source := mock.NewMemDbMock()
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		_ = source.Put(key, val)
+		return true
+	})
+	dataTrieRootHash := getDataTrieRootHash(t, adb, address)
+	_ = db.Remove(dataTrieRootHash)
+	_ = db.Put(rootHash, []byte("corrupted node"))
+
+	args := createStateIntegrityCheckerArgs(db)
+	args.RepairSources = []data.DBWriteCacher{source}
+	sic, _ := NewStateIntegrityChecker(args)
+	report, err := sic.CheckAccountsTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(1), report.Trie.NumRepairedNodes)
+	assert.Equal(t, uint64(1), report.DataTries.NumRepairedNodes)
+	assert.Equal(t, uint64(1), report.NumDataTries)
+}
+
+func TestStateIntegrityChecker_CheckTrie(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	rootHash, _ := adb.Commit()
+
+	sic, _ := NewStateIntegrityChecker(createStateIntegrityCheckerArgs(db))
+	report, err := sic.CheckTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(1), report.Trie.NumLeaves)
+	assert.Nil(t, report.DataTries)
+}
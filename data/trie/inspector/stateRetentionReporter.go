@@ -0,0 +1,112 @@
+package inspector
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// RetainedTrie holds the storage components of a trie whose retained states are reported
+type RetainedTrie struct {
+	StorageManager data.StorageManager
+	// MainDbPath, if set, is the directory of the main database of the trie, its size being reported
+	MainDbPath string
+}
+
+// TrieRetentionReport holds the disk usage of a trie: the main database, holding the current state and, for the
+// archive policy, all the past states, and the snapshots, each holding the epoch start state of an epoch
+type TrieRetentionReport struct {
+	MainDbSizeInBytes    uint64              `json:"mainDbSizeInBytes"`
+	SnapshotsSizeInBytes uint64              `json:"snapshotsSizeInBytes"`
+	Snapshots            []data.SnapshotInfo `json:"snapshots"`
+}
+
+// StateRetentionReport holds the state retention policy and the disk usage of each trie per retained epoch
+type StateRetentionReport struct {
+	Policy                string                          `json:"policy"`
+	NumEpochsToKeep       uint32                          `json:"numEpochsToKeep"`
+	AreSnapshotsQueryable bool                            `json:"areSnapshotsQueryable"`
+	Tries                 map[string]*TrieRetentionReport `json:"tries"`
+}
+
+// ArgsStateRetentionReporter holds the arguments needed to create a state retention reporter
+type ArgsStateRetentionReporter struct {
+	Tries                map[string]RetainedTrie
+	StateRetentionPolicy data.StateRetentionPolicyHandler
+}
+
+type stateRetentionReporter struct {
+	tries           map[string]RetainedTrie
+	retentionPolicy data.StateRetentionPolicyHandler
+}
+
+// NewStateRetentionReporter creates a component reporting, on request, the disk usage of the states retained by the
+// node tries
+func NewStateRetentionReporter(args ArgsStateRetentionReporter) (*stateRetentionReporter, error) {
+	for trieID, retainedTrie := range args.Tries {
+		if check.IfNil(retainedTrie.StorageManager) {
+			return nil, fmt.Errorf("%w for trie %s", ErrNilTrieStorageManager, trieID)
+		}
+	}
+	if check.IfNil(args.StateRetentionPolicy) {
+		return nil, ErrNilStateRetentionPolicy
+	}
+
+	return &stateRetentionReporter{
+		tries:           args.Tries,
+		retentionPolicy: args.StateRetentionPolicy,
+	}, nil
+}
+
+// GetStateRetentionReport computes the disk usage of each trie, the snapshots being reported per epoch
+func (srr *stateRetentionReporter) GetStateRetentionReport() (*StateRetentionReport, error) {
+	report := &StateRetentionReport{
+		Policy:                srr.retentionPolicy.Policy(),
+		NumEpochsToKeep:       srr.retentionPolicy.NumEpochsToKeep(),
+		AreSnapshotsQueryable: srr.retentionPolicy.AreSnapshotsQueryable(),
+		Tries:                 make(map[string]*TrieRetentionReport, len(srr.tries)),
+	}
+
+	for trieID, retainedTrie := range srr.tries {
+		trieReport, err := getTrieRetentionReport(retainedTrie)
+		if err != nil {
+			return nil, fmt.Errorf("%w for trie %s", err, trieID)
+		}
+
+		report.Tries[trieID] = trieReport
+	}
+
+	return report, nil
+}
+
+func getTrieRetentionReport(retainedTrie RetainedTrie) (*TrieRetentionReport, error) {
+	trieReport := &TrieRetentionReport{
+		Snapshots: retainedTrie.StorageManager.GetSnapshotsInfo(),
+	}
+	if trieReport.Snapshots == nil {
+		trieReport.Snapshots = make([]data.SnapshotInfo, 0)
+	}
+
+	for _, snapshotInfo := range trieReport.Snapshots {
+		trieReport.SnapshotsSizeInBytes += snapshotInfo.SizeInBytes
+	}
+
+	if len(retainedTrie.MainDbPath) == 0 {
+		return trieReport, nil
+	}
+
+	var err error
+	trieReport.MainDbSizeInBytes, err = core.GetDirectorySize(retainedTrie.MainDbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return trieReport, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srr *stateRetentionReporter) IsInterfaceNil() bool {
+	return srr == nil
+}
@@ -0,0 +1,95 @@
+package inspector
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateRetentionReporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srr, err := NewStateRetentionReporter(ArgsStateRetentionReporter{
+		Tries:                map[string]RetainedTrie{"accounts": {}},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.MinimalStateRetention),
+	})
+	assert.True(t, check.IfNil(srr))
+	assert.True(t, errors.Is(err, ErrNilTrieStorageManager))
+
+	_, err = NewStateRetentionReporter(ArgsStateRetentionReporter{
+		Tries: map[string]RetainedTrie{"accounts": {StorageManager: &mock.StorageManagerStub{}}},
+	})
+	assert.Equal(t, ErrNilStateRetentionPolicy, err)
+}
+
+func TestStateRetentionReporter_GetStateRetentionReport(t *testing.T) {
+	t.Parallel()
+
+	mainDbPath, err := ioutil.TempDir("", "stateRetention")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(mainDbPath)
+	}()
+	_ = ioutil.WriteFile(filepath.Join(mainDbPath, "000001.ldb"), make([]byte, 5), os.ModePerm)
+
+	snapshots := []data.SnapshotInfo{
+		{ID: 3, Epoch: 7, IsEpochKnown: true, SizeInBytes: 10},
+		{ID: 4, Epoch: 8, IsEpochKnown: true, SizeInBytes: 20},
+	}
+	srr, err := NewStateRetentionReporter(ArgsStateRetentionReporter{
+		Tries: map[string]RetainedTrie{
+			"accounts": {
+				StorageManager: &mock.StorageManagerStub{
+					GetSnapshotsInfoCalled: func() []data.SnapshotInfo {
+						return snapshots
+					},
+				},
+				MainDbPath: mainDbPath,
+			},
+			"peer": {StorageManager: &mock.StorageManagerStub{}},
+		},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.LastEpochsStateRetention),
+	})
+	require.False(t, check.IfNil(srr))
+	require.Nil(t, err)
+
+	report, err := srr.GetStateRetentionReport()
+	require.Nil(t, err)
+
+	assert.Equal(t, trie.LastEpochsStateRetention, report.Policy)
+	assert.Equal(t, uint32(2), report.NumEpochsToKeep)
+	assert.True(t, report.AreSnapshotsQueryable)
+	require.Equal(t, 2, len(report.Tries))
+	assert.Equal(t, &TrieRetentionReport{
+		MainDbSizeInBytes:    5,
+		SnapshotsSizeInBytes: 30,
+		Snapshots:            snapshots,
+	}, report.Tries["accounts"])
+	assert.Equal(t, &TrieRetentionReport{
+		Snapshots: make([]data.SnapshotInfo, 0),
+	}, report.Tries["peer"])
+}
+
+func TestStateRetentionReporter_GetStateRetentionReportMissingMainDbShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srr, _ := NewStateRetentionReporter(ArgsStateRetentionReporter{
+		Tries: map[string]RetainedTrie{
+			"accounts": {StorageManager: &mock.StorageManagerStub{}, MainDbPath: "missing directory"},
+		},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.ArchiveStateRetention),
+	})
+
+	report, err := srr.GetStateRetentionReport()
+	assert.Nil(t, report)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,239 @@
+package inspector
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("trie/inspector")
+
+const maxOrphanHashesInReport = 100
+
+// DataTrieStatistics holds the statistics of the data trie of an account
+type DataTrieStatistics struct {
+	Address          []byte `json:"address"`
+	RootHash         []byte `json:"rootHash"`
+	NumLeaves        uint64 `json:"numLeaves"`
+	NumNodes         uint64 `json:"numNodes"`
+	TotalSizeInBytes uint64 `json:"totalSizeInBytes"`
+}
+
+// TrieReport holds the statistics of a trie and, for an accounts trie, the aggregated statistics of the data tries
+// and the largest data tries
+type TrieReport struct {
+	Trie           *trie.TrieStatistics  `json:"trie"`
+	NumAccounts    uint64                `json:"numAccounts,omitempty"`
+	NumCodeEntries uint64                `json:"numCodeEntries,omitempty"`
+	NumDataTries   uint64                `json:"numDataTries,omitempty"`
+	DataTries      *trie.TrieStatistics  `json:"dataTries,omitempty"`
+	TopDataTries   []*DataTrieStatistics `json:"topDataTries,omitempty"`
+}
+
+// OrphanNodesReport holds the keys found in storage that are not reachable from the inspected tries
+type OrphanNodesReport struct {
+	NumKeys            uint64   `json:"numKeys"`
+	NumOrphans         uint64   `json:"numOrphans"`
+	OrphansSizeInBytes uint64   `json:"orphansSizeInBytes"`
+	OrphanHashes       [][]byte `json:"orphanHashes"`
+}
+
+// ArgsTrieInspector holds the arguments needed to create a trie inspector
+type ArgsTrieInspector struct {
+	DB              data.DBWriteCacher
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	NumTopDataTries int
+	// TrackReachableNodes keeps the hashes of all the inspected nodes, needed to find the orphan nodes afterwards
+	TrackReachableNodes bool
+}
+
+type trieInspector struct {
+	db                  data.DBWriteCacher
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	numTopDataTries     int
+	trackReachableNodes bool
+	reachable           map[string]struct{}
+}
+
+// NewTrieInspector creates a component computing the statistics of the tries stored in the provided database
+func NewTrieInspector(args ArgsTrieInspector) (*trieInspector, error) {
+	if check.IfNil(args.DB) {
+		return nil, trie.ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, trie.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, trie.ErrNilHasher
+	}
+
+	return &trieInspector{
+		db:                  args.DB,
+		marshalizer:         args.Marshalizer,
+		hasher:              args.Hasher,
+		numTopDataTries:     args.NumTopDataTries,
+		trackReachableNodes: args.TrackReachableNodes,
+		reachable:           make(map[string]struct{}),
+	}, nil
+}
+
+// InspectTrie computes the statistics of a trie without data tries, as the peer accounts trie
+func (ti *trieInspector) InspectTrie(rootHash []byte) (*TrieReport, error) {
+	stats, err := trie.ComputeTrieStatistics(ti.createArgs(rootHash, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrieReport{
+		Trie: stats,
+	}, nil
+}
+
+// InspectAccountsTrie computes the statistics of an accounts trie and of the data tries of its accounts
+func (ti *trieInspector) InspectAccountsTrie(rootHash []byte) (*TrieReport, error) {
+	report := &TrieReport{
+		DataTries:    trie.NewTrieStatistics(nil),
+		TopDataTries: make([]*DataTrieStatistics, 0, ti.numTopDataTries),
+	}
+
+	leafHandler := func(value []byte) error {
+		return ti.inspectAccount(value, report)
+	}
+
+	stats, err := trie.ComputeTrieStatistics(ti.createArgs(rootHash, leafHandler))
+	if err != nil {
+		return nil, err
+	}
+	report.Trie = stats
+
+	log.Debug("inspected accounts trie",
+		"root hash", rootHash,
+		"num accounts", report.NumAccounts,
+		"num code entries", report.NumCodeEntries,
+		"num nodes", stats.NumNodes(),
+		"num data tries", report.NumDataTries,
+		"num data tries nodes", report.DataTries.NumNodes(),
+	)
+
+	return report, nil
+}
+
+func (ti *trieInspector) inspectAccount(value []byte, report *TrieReport) error {
+	account, err := unmarshalAccountsTrieLeaf(ti.marshalizer, value)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		report.NumCodeEntries++
+		return nil
+	}
+
+	report.NumAccounts++
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	stats, err := trie.ComputeTrieStatistics(ti.createArgs(account.RootHash, nil))
+	if err != nil {
+		return err
+	}
+
+	report.NumDataTries++
+	report.DataTries.Add(stats)
+	ti.addTopDataTrie(report, &DataTrieStatistics{
+		Address:          account.Address,
+		RootHash:         account.RootHash,
+		NumLeaves:        stats.NumLeaves,
+		NumNodes:         stats.NumNodes(),
+		TotalSizeInBytes: stats.TotalSizeInBytes,
+	})
+
+	return nil
+}
+
+// addTopDataTrie keeps the largest data tries sorted by size, descending
+func (ti *trieInspector) addTopDataTrie(report *TrieReport, dataTrie *DataTrieStatistics) {
+	if ti.numTopDataTries <= 0 {
+		return
+	}
+
+	topDataTries := report.TopDataTries
+	isFull := len(topDataTries) >= ti.numTopDataTries
+	if isFull && topDataTries[len(topDataTries)-1].TotalSizeInBytes >= dataTrie.TotalSizeInBytes {
+		return
+	}
+
+	pos := sort.Search(len(topDataTries), func(i int) bool {
+		return topDataTries[i].TotalSizeInBytes < dataTrie.TotalSizeInBytes
+	})
+	if !isFull {
+		topDataTries = append(topDataTries, nil)
+	}
+	copy(topDataTries[pos+1:], topDataTries[pos:])
+	topDataTries[pos] = dataTrie
+
+	report.TopDataTries = topDataTries
+}
+
+func (ti *trieInspector) createArgs(rootHash []byte, leafHandler func(value []byte) error) trie.ArgsComputeTrieStatistics {
+	args := trie.ArgsComputeTrieStatistics{
+		DB:          ti.db,
+		Marshalizer: ti.marshalizer,
+		Hasher:      ti.hasher,
+		RootHash:    rootHash,
+		LeafHandler: leafHandler,
+	}
+	if ti.trackReachableNodes {
+		args.NodeHandler = ti.markReachable
+	}
+
+	return args
+}
+
+func (ti *trieInspector) markReachable(hash []byte) {
+	ti.reachable[string(hash)] = struct{}{}
+}
+
+// FindOrphanNodes returns the keys of the provided storage not reachable from the tries inspected so far. All the
+// root hashes kept in storage, as the ones not pruned yet, should be inspected before
+func (ti *trieInspector) FindOrphanNodes(ranger KeysRanger) (*OrphanNodesReport, error) {
+	if check.IfNil(ranger) {
+		return nil, ErrNilKeysRanger
+	}
+	if !ti.trackReachableNodes {
+		return nil, ErrReachableNodesNotTracked
+	}
+
+	report := &OrphanNodesReport{
+		OrphanHashes: make([][]byte, 0),
+	}
+	ranger.RangeKeys(func(key []byte, val []byte) bool {
+		report.NumKeys++
+		_, found := ti.reachable[string(key)]
+		if found {
+			return true
+		}
+
+		report.NumOrphans++
+		report.OrphansSizeInBytes += uint64(len(key) + len(val))
+		if len(report.OrphanHashes) < maxOrphanHashesInReport {
+			report.OrphanHashes = append(report.OrphanHashes, append([]byte{}, key...))
+		}
+
+		return true
+	})
+
+	return report, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *trieInspector) IsInterfaceNil() bool {
+	return ti == nil
+}
@@ -0,0 +1,149 @@
+package inspector
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsOnMemDb(t *testing.T) (*state.AccountsDB, *mock.MemDbMock) {
+	db := mock.NewMemDbMock()
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, _ := trie.NewTrie(tsm, &mock.MarshalizerMock{}, &mock.HasherMock{}, 6)
+	adb, err := state.NewAccountsDB(tr, &mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	return adb, db
+}
+
+func addAccount(t *testing.T, adb *state.AccountsDB, address []byte, numDataTrieValues int, code []byte) {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	for i := 0; i < numDataTrieValues; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = userAccount.DataTrieTracker().SaveKeyValue(key, key)
+	}
+	if len(code) > 0 {
+		userAccount.SetCode(code)
+	}
+
+	err = adb.SaveAccount(userAccount)
+	require.Nil(t, err)
+}
+
+func createArgs(db *mock.MemDbMock) ArgsTrieInspector {
+	return ArgsTrieInspector{
+		DB:              db,
+		Marshalizer:     &mock.MarshalizerMock{},
+		Hasher:          &mock.HasherMock{},
+		NumTopDataTries: 2,
+	}
+}
+
+func TestNewTrieInspector_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(mock.NewMemDbMock())
+	args.DB = nil
+	ti, err := NewTrieInspector(args)
+	assert.True(t, check.IfNil(ti))
+	assert.Equal(t, trie.ErrNilDatabase, err)
+
+	args = createArgs(mock.NewMemDbMock())
+	args.Marshalizer = nil
+	_, err = NewTrieInspector(args)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	args = createArgs(mock.NewMemDbMock())
+	args.Hasher = nil
+	_, err = NewTrieInspector(args)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
+func TestTrieInspector_InspectAccountsTrie(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address without data trie......."), 0, nil)
+	addAccount(t, adb, []byte("address with small data trie...."), 2, nil)
+	addAccount(t, adb, []byte("address with large data trie...."), 100, nil)
+	addAccount(t, adb, []byte("address with medium data trie..."), 10, nil)
+	addAccount(t, adb, []byte("smart contract address.........."), 0, []byte("code"))
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	ti, err := NewTrieInspector(createArgs(db))
+	require.False(t, check.IfNil(ti))
+	require.Nil(t, err)
+
+	report, err := ti.InspectAccountsTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(5), report.NumAccounts)
+	assert.Equal(t, uint64(1), report.NumCodeEntries)
+	assert.Equal(t, uint64(6), report.Trie.NumLeaves)
+	assert.Equal(t, uint64(3), report.NumDataTries)
+	assert.Equal(t, uint64(112), report.DataTries.NumLeaves)
+	require.Equal(t, 2, len(report.TopDataTries))
+	assert.Equal(t, []byte("address with large data trie...."), report.TopDataTries[0].Address)
+	assert.Equal(t, uint64(100), report.TopDataTries[0].NumLeaves)
+	assert.Equal(t, []byte("address with medium data trie..."), report.TopDataTries[1].Address)
+	assert.True(t, report.TopDataTries[0].TotalSizeInBytes > report.TopDataTries[1].TotalSizeInBytes)
+
+	_, err = ti.FindOrphanNodes(db)
+	assert.Equal(t, ErrReachableNodesNotTracked, err)
+}
+
+func TestTrieInspector_InspectTrie(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	rootHash, _ := adb.Commit()
+
+	ti, _ := NewTrieInspector(createArgs(db))
+	report, err := ti.InspectTrie(rootHash)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(1), report.Trie.NumLeaves)
+	assert.Equal(t, uint64(0), report.NumDataTries)
+	assert.Nil(t, report.DataTries)
+}
+
+func TestTrieInspector_FindOrphanNodes(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	addAccount(t, adb, []byte("smart contract address.........."), 5, []byte("code"))
+	rootHash, _ := adb.Commit()
+	_ = db.Put([]byte("orphan node hash"), []byte("orphan node"))
+
+	args := createArgs(db)
+	args.TrackReachableNodes = true
+	ti, _ := NewTrieInspector(args)
+
+	_, err := ti.FindOrphanNodes(nil)
+	assert.Equal(t, ErrNilKeysRanger, err)
+
+	_, err = ti.InspectAccountsTrie(rootHash)
+	require.Nil(t, err)
+
+	report, err := ti.FindOrphanNodes(db)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), report.NumOrphans)
+	assert.Equal(t, uint64(len("orphan node hash")+len("orphan node")), report.OrphansSizeInBytes)
+	require.Equal(t, 1, len(report.OrphanHashes))
+	assert.True(t, bytes.Equal([]byte("orphan node hash"), report.OrphanHashes[0]))
+	assert.True(t, report.NumKeys > report.NumOrphans)
+}
@@ -0,0 +1,142 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// InspectedTrie holds the components of a trie that can be inspected while the node is running
+type InspectedTrie struct {
+	StorageManager data.StorageManager
+	Accounts       state.AccountsAdapter
+	HasDataTries   bool
+}
+
+// ArgsTrieStatisticsProvider holds the arguments needed to create a trie statistics provider
+type ArgsTrieStatisticsProvider struct {
+	Tries           map[string]InspectedTrie
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	NumTopDataTries int
+	// StateRetentionPolicy decides if the states held by the tries snapshots can be inspected
+	StateRetentionPolicy data.StateRetentionPolicyHandler
+}
+
+type trieStatisticsProvider struct {
+	tries           map[string]InspectedTrie
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	numTopDataTries int
+	retentionPolicy data.StateRetentionPolicyHandler
+	isInspecting    uint32
+}
+
+// NewTrieStatisticsProvider creates a component computing the statistics of the node tries on request. Only one
+// inspection runs at a time as it walks the whole trie
+func NewTrieStatisticsProvider(args ArgsTrieStatisticsProvider) (*trieStatisticsProvider, error) {
+	for trieID, inspectedTrie := range args.Tries {
+		if check.IfNil(inspectedTrie.StorageManager) {
+			return nil, fmt.Errorf("%w for trie %s", ErrNilTrieStorageManager, trieID)
+		}
+		if check.IfNil(inspectedTrie.Accounts) {
+			return nil, fmt.Errorf("%w for trie %s", ErrNilAccountsAdapter, trieID)
+		}
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, trie.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, trie.ErrNilHasher
+	}
+	if check.IfNil(args.StateRetentionPolicy) {
+		return nil, ErrNilStateRetentionPolicy
+	}
+
+	return &trieStatisticsProvider{
+		tries:           args.Tries,
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		numTopDataTries: args.NumTopDataTries,
+		retentionPolicy: args.StateRetentionPolicy,
+	}, nil
+}
+
+// GetTrieStatistics computes the statistics of the trie with the provided identifier for the provided root hash, or
+// for the current root hash if none is provided. A past root hash is searched in the main database, then, if the
+// state retention policy allows it, in the trie snapshots
+func (tsp *trieStatisticsProvider) GetTrieStatistics(trieID string, rootHash []byte) (*TrieReport, error) {
+	inspectedTrie, ok := tsp.tries[trieID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTrie, trieID)
+	}
+
+	if !atomic.CompareAndSwapUint32(&tsp.isInspecting, 0, 1) {
+		return nil, ErrInspectionInProgress
+	}
+	defer atomic.StoreUint32(&tsp.isInspecting, 0)
+
+	var err error
+	if len(rootHash) == 0 {
+		rootHash, err = inspectedTrie.Accounts.RootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db := inspectedTrie.StorageManager.Database()
+	_, err = db.Get(rootHash)
+	if err != nil && !bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		snapshot, errSnapshot := tsp.getRetainedSnapshot(inspectedTrie.StorageManager, rootHash)
+		if errSnapshot != nil {
+			return nil, errSnapshot
+		}
+		defer snapshot.DecreaseNumReferences()
+
+		db = snapshot
+	}
+
+	ti, err := NewTrieInspector(ArgsTrieInspector{
+		DB:              db,
+		Marshalizer:     tsp.marshalizer,
+		Hasher:          tsp.hasher,
+		NumTopDataTries: tsp.numTopDataTries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if inspectedTrie.HasDataTries {
+		return ti.InspectAccountsTrie(rootHash)
+	}
+
+	return ti.InspectTrie(rootHash)
+}
+
+func (tsp *trieStatisticsProvider) getRetainedSnapshot(storageManager data.StorageManager, rootHash []byte) (data.SnapshotDbHandler, error) {
+	if !tsp.retentionPolicy.AreSnapshotsQueryable() {
+		return nil, fmt.Errorf("%w by the %s state retention policy for root hash %s",
+			ErrStateNotRetained, tsp.retentionPolicy.Policy(), hex.EncodeToString(rootHash))
+	}
+
+	snapshot := storageManager.GetSnapshotThatContainsHash(rootHash)
+	if check.IfNil(snapshot) {
+		return nil, fmt.Errorf("%w by the %s state retention policy for root hash %s",
+			ErrStateNotRetained, tsp.retentionPolicy.Policy(), hex.EncodeToString(rootHash))
+	}
+
+	return snapshot, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsp *trieStatisticsProvider) IsInterfaceNil() bool {
+	return tsp == nil
+}
@@ -0,0 +1,182 @@
+package inspector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStateRetentionPolicy(policy string) data.StateRetentionPolicyHandler {
+	retentionPolicy, _ := trie.NewStateRetentionPolicy(config.StateRetentionConfig{
+		Policy:          policy,
+		NumEpochsToKeep: 2,
+	})
+
+	return retentionPolicy
+}
+
+func copyDb(db *mock.MemDbMock) *mock.MemDbMock {
+	dbCopy := mock.NewMemDbMock()
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		_ = dbCopy.Put(key, val)
+		return true
+	})
+
+	return dbCopy
+}
+
+func TestNewTrieStatisticsProvider_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+
+	tsp, err := NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+		Tries:                map[string]InspectedTrie{"accounts": {Accounts: adb}},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Hasher:               &mock.HasherMock{},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.MinimalStateRetention),
+	})
+	assert.True(t, check.IfNil(tsp))
+	assert.True(t, errors.Is(err, ErrNilTrieStorageManager))
+
+	_, err = NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+		Tries:                map[string]InspectedTrie{"accounts": {StorageManager: tsm}},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Hasher:               &mock.HasherMock{},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.MinimalStateRetention),
+	})
+	assert.True(t, errors.Is(err, ErrNilAccountsAdapter))
+
+	_, err = NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+		Tries:       map[string]InspectedTrie{"accounts": {StorageManager: tsm, Accounts: adb}},
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+	})
+	assert.Equal(t, ErrNilStateRetentionPolicy, err)
+}
+
+func TestTrieStatisticsProvider_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	rootHash, _ := adb.Commit()
+	addAccount(t, adb, []byte("another address................."), 0, nil)
+	currentRootHash, _ := adb.Commit()
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+
+	tsp, err := NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+		Tries: map[string]InspectedTrie{
+			"accounts": {StorageManager: tsm, Accounts: adb, HasDataTries: true},
+			"peer":     {StorageManager: tsm, Accounts: adb},
+		},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Hasher:               &mock.HasherMock{},
+		NumTopDataTries:      10,
+		StateRetentionPolicy: createStateRetentionPolicy(trie.MinimalStateRetention),
+	})
+	require.False(t, check.IfNil(tsp))
+	require.Nil(t, err)
+
+	_, err = tsp.GetTrieStatistics("unknown", nil)
+	assert.True(t, errors.Is(err, ErrUnknownTrie))
+
+	report, err := tsp.GetTrieStatistics("accounts", nil)
+	require.Nil(t, err)
+	assert.Equal(t, currentRootHash, report.Trie.RootHash)
+	assert.Equal(t, uint64(2), report.NumAccounts)
+	assert.Equal(t, uint64(1), report.NumDataTries)
+	assert.Equal(t, 1, len(report.TopDataTries))
+
+	report, err = tsp.GetTrieStatistics("accounts", rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), report.NumAccounts)
+
+	report, err = tsp.GetTrieStatistics("peer", nil)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), report.Trie.NumLeaves)
+	assert.Equal(t, uint64(0), report.NumDataTries)
+}
+
+func TestTrieStatisticsProvider_ConcurrentInspectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tsp, _ := NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+		Tries:                map[string]InspectedTrie{"accounts": {StorageManager: tsm, Accounts: adb}},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Hasher:               &mock.HasherMock{},
+		StateRetentionPolicy: createStateRetentionPolicy(trie.MinimalStateRetention),
+	})
+
+	tsp.isInspecting = 1
+	_, err := tsp.GetTrieStatistics("accounts", nil)
+	assert.Equal(t, ErrInspectionInProgress, err)
+}
+
+func TestTrieStatisticsProvider_GetTrieStatisticsOfPrunedStateShouldHonourTheRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	adb, db := createAccountsOnMemDb(t)
+	addAccount(t, adb, []byte("address with data trie.........."), 10, nil)
+	prunedRootHash, _ := adb.Commit()
+	snapshot := &mock.SnapshotDbHandlerStub{DBWriteCacher: copyDb(db)}
+	addAccount(t, adb, []byte("another address................."), 0, nil)
+	_, _ = adb.Commit()
+	_ = db.Remove(prunedRootHash)
+
+	isSnapshotFound := false
+	numSnapshotReferences := 0
+	snapshot.IncreaseNumReferencesCalled = func() {
+		numSnapshotReferences++
+	}
+	snapshot.DecreaseNumReferencesCalled = func() {
+		numSnapshotReferences--
+	}
+	tsm := &mock.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return db
+		},
+		GetSnapshotThatContainsHashCalled: func(rootHash []byte) data.SnapshotDbHandler {
+			if !isSnapshotFound {
+				return nil
+			}
+			snapshot.IncreaseNumReferences()
+			return snapshot
+		},
+	}
+	createProvider := func(policy string) *trieStatisticsProvider {
+		tsp, _ := NewTrieStatisticsProvider(ArgsTrieStatisticsProvider{
+			Tries:                map[string]InspectedTrie{"accounts": {StorageManager: tsm, Accounts: adb, HasDataTries: true}},
+			Marshalizer:          &mock.MarshalizerMock{},
+			Hasher:               &mock.HasherMock{},
+			StateRetentionPolicy: createStateRetentionPolicy(policy),
+		})
+		return tsp
+	}
+
+	isSnapshotFound = true
+	_, err := createProvider(trie.MinimalStateRetention).GetTrieStatistics("accounts", prunedRootHash)
+	assert.True(t, errors.Is(err, ErrStateNotRetained))
+
+	isSnapshotFound = false
+	tsp := createProvider(trie.LastEpochsStateRetention)
+	_, err = tsp.GetTrieStatistics("accounts", prunedRootHash)
+	assert.True(t, errors.Is(err, ErrStateNotRetained))
+
+	isSnapshotFound = true
+	report, err := tsp.GetTrieStatistics("accounts", prunedRootHash)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), report.NumAccounts)
+	assert.Equal(t, uint64(1), report.NumDataTries)
+	assert.Equal(t, 0, numSnapshotReferences)
+}
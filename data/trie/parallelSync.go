@@ -0,0 +1,251 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ data.TrieSyncer = (*parallelTrieSyncer)(nil)
+
+// maxSplitDepth limits the number of trie levels fetched before starting the sub-tries syncers. Each level of branch
+// nodes multiplies the number of sub-tries by up to 16
+const maxSplitDepth = 3
+
+type parallelTrieSyncer struct {
+	arg                   ArgTrieSyncer
+	numWorkers            int
+	rootHash              []byte
+	waitTimeBetweenChecks time.Duration
+	mutOperation          sync.Mutex
+}
+
+// NewParallelTrieSyncer creates a trie syncer that splits the trie by key prefix: it fetches the top levels of the
+// trie until it has enough sub-tries for the provided number of workers, then each worker syncs sub-tries with a
+// double list trie syncer
+func NewParallelTrieSyncer(arg ArgTrieSyncer, numWorkers int) (*parallelTrieSyncer, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if numWorkers < 1 {
+		return nil, fmt.Errorf("%w provided: %d", ErrInvalidNumWorkers, numWorkers)
+	}
+
+	return &parallelTrieSyncer{
+		arg:                   arg,
+		numWorkers:            numWorkers,
+		waitTimeBetweenChecks: time.Millisecond * 100,
+	}, nil
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network. All concurrent calls will be serialized.
+// The progress is recorded in the checkpoint handler for the whole trie and for each of the sub-tries
+func (p *parallelTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if p.arg.CheckpointHandler.IsTrieSynced(rootHash) {
+		log.Trace("parallelTrieSyncer.StartSyncing: trie already synced", "root hash", rootHash)
+		return nil
+	}
+
+	p.mutOperation.Lock()
+	defer p.mutOperation.Unlock()
+
+	p.rootHash = rootHash
+	subTriesHashes, err := p.splitTrie(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("parallel trie syncer: syncing sub-tries",
+		"root hash", rootHash,
+		"num sub-tries", len(subTriesHashes),
+		"num workers", p.numWorkers,
+	)
+
+	err = p.syncSubTries(subTriesHashes, ctx)
+	if err != nil {
+		return err
+	}
+
+	p.arg.CheckpointHandler.MarkTrieSynced(rootHash)
+
+	return nil
+}
+
+// splitTrie commits the top levels of the trie and returns the hashes of the sub-tries below them. The returned
+// sub-tries roots that were already fetched are committed so the sub-tries syncers find them in the database
+func (p *parallelTrieSyncer) splitTrie(ctx context.Context) ([][]byte, error) {
+	frontier := [][]byte{p.rootHash}
+	fetchedNodes := make(map[string]node)
+
+	for depth := 0; depth < maxSplitDepth && len(frontier) > 0 && len(frontier) < p.numWorkers; depth++ {
+		nodes, err := p.waitForNodes(frontier, fetchedNodes, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		nextFrontier := make([][]byte, 0)
+		for _, n := range nodes {
+			err = encodeNodeAndCommitToDB(n, p.arg.DB)
+			if err != nil {
+				return nil, err
+			}
+			p.arg.TrieSyncStatistics.AddNumReceived(1)
+
+			missingChildrenHashes, children, errLoad := n.loadChildren(p.getNode)
+			if errLoad != nil {
+				return nil, errLoad
+			}
+
+			for _, child := range children {
+				fetchedNodes[string(child.getHash())] = child
+				nextFrontier = append(nextFrontier, child.getHash())
+			}
+			nextFrontier = append(nextFrontier, missingChildrenHashes...)
+		}
+
+		frontier = nextFrontier
+	}
+
+	for _, hash := range frontier {
+		n, found := fetchedNodes[string(hash)]
+		if !found {
+			continue
+		}
+
+		err := encodeNodeAndCommitToDB(n, p.arg.DB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return frontier, nil
+}
+
+// waitForNodes returns the nodes with the provided hashes, requesting the missing ones until all are received
+func (p *parallelTrieSyncer) waitForNodes(hashes [][]byte, fetchedNodes map[string]node, ctx context.Context) ([]node, error) {
+	nodes := make([]node, 0, len(hashes))
+	missingHashes := make(map[string]struct{})
+	for _, hash := range hashes {
+		n, found := fetchedNodes[string(hash)]
+		if !found {
+			missingHashes[string(hash)] = struct{}{}
+			continue
+		}
+
+		delete(fetchedNodes, string(hash))
+		nodes = append(nodes, n)
+	}
+
+	for {
+		for hash := range missingHashes {
+			n, err := p.getNode([]byte(hash))
+			if err != nil {
+				continue
+			}
+
+			delete(missingHashes, hash)
+			nodes = append(nodes, n)
+		}
+
+		p.arg.TrieSyncStatistics.SetNumMissing(p.rootHash, len(missingHashes))
+		if len(missingHashes) == 0 {
+			return nodes, nil
+		}
+
+		hashesToRequest := make([][]byte, 0, len(missingHashes))
+		for hash := range missingHashes {
+			hashesToRequest = append(hashesToRequest, []byte(hash))
+		}
+		p.arg.RequestHandler.RequestTrieNodes(p.arg.ShardId, hashesToRequest, p.arg.Topic)
+
+		select {
+		case <-time.After(p.waitTimeBetweenChecks):
+			continue
+		case <-ctx.Done():
+			return nil, ErrContextClosing
+		}
+	}
+}
+
+// syncSubTries syncs the sub-tries on the workers, stopping all of them on the first error
+func (p *parallelTrieSyncer) syncSubTries(subTriesHashes [][]byte, ctx context.Context) error {
+	ctxSubTries, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	subTriesChan := make(chan []byte, len(subTriesHashes))
+	for _, hash := range subTriesHashes {
+		subTriesChan <- hash
+	}
+	close(subTriesChan)
+
+	numWorkers := p.numWorkers
+	if numWorkers > len(subTriesHashes) {
+		numWorkers = len(subTriesHashes)
+	}
+
+	errChan := make(chan error, numWorkers)
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for hash := range subTriesChan {
+				err := p.syncSubTrie(hash, ctxSubTries)
+				if err != nil {
+					errChan <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errChan)
+
+	return <-errChan
+}
+
+func (p *parallelTrieSyncer) syncSubTrie(hash []byte, ctx context.Context) error {
+	subTrieSyncer, err := NewDoubleListTrieSyncer(p.arg)
+	if err != nil {
+		return err
+	}
+
+	return subTrieSyncer.StartSyncing(hash, ctx)
+}
+
+func (p *parallelTrieSyncer) getNode(hash []byte) (node, error) {
+	n, ok := p.arg.InterceptedNodes.Get(hash)
+	if ok {
+		p.arg.InterceptedNodes.Remove(hash)
+		return trieNode(n)
+	}
+
+	existingNode, err := getNodeFromDBAndDecode(hash, p.arg.DB, p.arg.Marshalizer, p.arg.Hasher)
+	if err != nil {
+		return nil, ErrNodeNotFound
+	}
+	err = existingNode.setHash()
+	if err != nil {
+		return nil, ErrNodeNotFound
+	}
+
+	return existingNode, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *parallelTrieSyncer) IsInterfaceNil() bool {
+	return p == nil
+}
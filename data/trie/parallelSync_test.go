@@ -0,0 +1,182 @@
+package trie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkSyncedTrie(t *testing.T, db data.DBWriteCacher, rootHash []byte, numKeysValues int) {
+	tr, _ := createInMemoryTrieFromDB(db.(*mock.MemDbMock))
+	tr, _ = tr.Recreate(rootHash)
+	require.False(t, check.IfNil(tr))
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasher.Compute(fmt.Sprintf("%d", i))
+		val, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+}
+
+func TestNewParallelTrieSyncer_InvalidParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Topic = ""
+	p, err := NewParallelTrieSyncer(arg, 4)
+	assert.True(t, check.IfNil(p))
+	assert.Equal(t, ErrInvalidTrieTopic, err)
+
+	p, err = NewParallelTrieSyncer(createMockArgument(), 0)
+	assert.True(t, check.IfNil(p))
+	assert.True(t, errors.Is(err, ErrInvalidNumWorkers))
+}
+
+func TestNewParallelTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewParallelTrieSyncer(createMockArgument(), 4)
+	assert.False(t, check.IfNil(p))
+	assert.Nil(t, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingEmptyRootHashOrNilContext(t *testing.T) {
+	t.Parallel()
+
+	p, _ := NewParallelTrieSyncer(createMockArgument(), 4)
+
+	assert.Nil(t, p.StartSyncing(nil, context.Background()))
+	assert.Nil(t, p.StartSyncing(EmptyTrieHash, context.Background()))
+	assert.Equal(t, ErrNilContext, p.StartSyncing([]byte("roothash"), nil))
+}
+
+func TestParallelTrieSyncer_StartSyncingCanTimeout(t *testing.T) {
+	t.Parallel()
+
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(10, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	p, _ := NewParallelTrieSyncer(createMockArgument(), 4)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	assert.Equal(t, ErrContextClosing, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingNewTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, numWorkers := range []int{1, 4, numSubTriesWorkers, 100} {
+		numKeysValues := 1000
+		trSource, _ := createInMemoryTrie()
+		addDataToTrie(numKeysValues, trSource)
+		_ = trSource.Commit()
+		rootHash, _ := trSource.RootHash()
+
+		arg := createMockArgument()
+		arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+
+		p, _ := NewParallelTrieSyncer(arg, numWorkers)
+		ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+
+		err := p.StartSyncing(rootHash, ctx)
+		cancelFunc()
+		require.Nil(t, err, "num workers %d", numWorkers)
+
+		checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+		assert.Equal(t, 0, arg.TrieSyncStatistics.NumMissing())
+		assert.Equal(t, 0, arg.TrieSyncStatistics.NumTriesInProgress())
+		assert.True(t, arg.TrieSyncStatistics.NumReceived() > numKeysValues)
+	}
+}
+
+func TestParallelTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 1000
+	trSource, memUnitSource := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument()
+	exceptionHashes := make([][]byte, 0)
+	numKeysCopied := 0
+	memUnitSource.RangeKeys(func(key []byte, val []byte) bool {
+		if numKeysCopied >= numKeysValues/2 {
+			return false
+		}
+		_ = arg.DB.Put(key, val)
+		exceptionHashes = append(exceptionHashes, key)
+		numKeysCopied++
+		return true
+	})
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, exceptionHashes)
+
+	p, _ := NewParallelTrieSyncer(arg, numSubTriesWorkers)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+}
+
+func TestParallelTrieSyncer_StartSyncingShouldUseTheCheckpointHandler(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 1000
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	syncedTries := make(map[string]struct{})
+	mutSyncedTries := sync.Mutex{}
+	arg := createMockArgument()
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	arg.CheckpointHandler = &mock.TrieSyncCheckpointHandlerStub{
+		IsTrieSyncedCalled: func(rootHash []byte) bool {
+			mutSyncedTries.Lock()
+			defer mutSyncedTries.Unlock()
+
+			_, found := syncedTries[string(rootHash)]
+			return found
+		},
+		MarkTrieSyncedCalled: func(rootHash []byte) {
+			mutSyncedTries.Lock()
+			syncedTries[string(rootHash)] = struct{}{}
+			mutSyncedTries.Unlock()
+		},
+	}
+
+	p, _ := NewParallelTrieSyncer(arg, 4)
+	err := p.StartSyncing(rootHash, context.Background())
+	require.Nil(t, err)
+	_, found := syncedTries[string(rootHash)]
+	assert.True(t, found)
+	assert.True(t, len(syncedTries) > 1)
+
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Fail(t, "should have not requested trie nodes")
+		},
+	}
+	p, _ = NewParallelTrieSyncer(arg, 4)
+	err = p.StartSyncing(rootHash, context.Background())
+	assert.Nil(t, err)
+}
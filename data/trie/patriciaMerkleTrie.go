@@ -6,10 +6,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -20,6 +22,12 @@ var log = logger.GetOrCreate("trie")
 
 var _ dataRetriever.TrieDataGetter = (*patriciaMerkleTrie)(nil)
 
+var trieCommitDuration = prometheus.DefaultRegistry().NewHistogramVec(
+	"elrond_trie_commit_duration_seconds",
+	"Duration of committing the dirty trie nodes to the storage.",
+	prometheus.DefaultDurationBuckets,
+)
+
 const (
 	extension = iota
 	leaf
@@ -237,10 +245,12 @@ func (tr *patriciaMerkleTrie) Commit() error {
 		log.Trace("started committing trie", "trie", tr.root.getHash())
 	}
 
+	start := time.Now()
 	err = tr.root.commit(false, 0, tr.maxTrieLevelInMemory, tr.trieStorage.Database(), tr.trieStorage.Database())
 	if err != nil {
 		return err
 	}
+	trieCommitDuration.WithLabelValues().ObserveSince(start)
 
 	return nil
 }
@@ -12,6 +12,9 @@ type snapshotDb struct {
 	shouldBeRemoved bool
 	path            string
 	mutex           sync.RWMutex
+	id              int
+	epoch           uint32
+	isEpochKnown    bool
 }
 
 // DecreaseNumReferences decreases the num references counter
@@ -0,0 +1,87 @@
+package trie
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+const (
+	// MinimalStateRetention prunes the old states as soon as possible, only the current state being queryable
+	MinimalStateRetention = "minimal"
+	// LastEpochsStateRetention keeps the epoch start snapshots of the last configured number of epochs queryable
+	LastEpochsStateRetention = "last-epochs"
+	// ArchiveStateRetention disables the pruning, all the past states remaining queryable
+	ArchiveStateRetention = "archive"
+)
+
+var _ data.StateRetentionPolicyHandler = (*stateRetentionPolicy)(nil)
+
+type stateRetentionPolicy struct {
+	policy          string
+	numEpochsToKeep uint32
+}
+
+// NewStateRetentionPolicy creates the state retention policy described by the provided config. An empty policy
+// defaults to the minimal one
+func NewStateRetentionPolicy(cfg config.StateRetentionConfig) (*stateRetentionPolicy, error) {
+	srp := &stateRetentionPolicy{
+		policy: cfg.Policy,
+	}
+
+	switch cfg.Policy {
+	case "":
+		srp.policy = MinimalStateRetention
+	case MinimalStateRetention, ArchiveStateRetention:
+	case LastEpochsStateRetention:
+		if cfg.NumEpochsToKeep == 0 {
+			return nil, fmt.Errorf("%w for policy %s: %d", ErrInvalidNumEpochsToKeep, cfg.Policy, cfg.NumEpochsToKeep)
+		}
+		srp.numEpochsToKeep = cfg.NumEpochsToKeep
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStateRetentionPolicy, cfg.Policy)
+	}
+
+	return srp, nil
+}
+
+// Policy returns the name of the state retention policy
+func (srp *stateRetentionPolicy) Policy() string {
+	return srp.policy
+}
+
+// NumEpochsToKeep returns the number of epochs whose epoch start states are kept queryable, 0 if the policy is not
+// expressed in epochs
+func (srp *stateRetentionPolicy) NumEpochsToKeep() uint32 {
+	return srp.numEpochsToKeep
+}
+
+// IsPruningEnabled returns false for the archive policy, otherwise the configured pruning flag
+func (srp *stateRetentionPolicy) IsPruningEnabled(pruningEnabled bool) bool {
+	if srp.policy == ArchiveStateRetention {
+		return false
+	}
+
+	return pruningEnabled
+}
+
+// MaxSnapshots returns the number of snapshots to keep, one for each retained epoch for the last epochs policy,
+// otherwise the configured number
+func (srp *stateRetentionPolicy) MaxSnapshots(maxSnapshots uint32) uint32 {
+	if srp.policy == LastEpochsStateRetention {
+		return srp.numEpochsToKeep
+	}
+
+	return maxSnapshots
+}
+
+// AreSnapshotsQueryable returns true if the states held by the snapshots can be queried
+func (srp *stateRetentionPolicy) AreSnapshotsQueryable() bool {
+	return srp.policy == LastEpochsStateRetention
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srp *stateRetentionPolicy) IsInterfaceNil() bool {
+	return srp == nil
+}
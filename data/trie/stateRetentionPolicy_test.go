@@ -0,0 +1,70 @@
+package trie
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateRetentionPolicy_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srp, err := NewStateRetentionPolicy(config.StateRetentionConfig{Policy: "unknown"})
+	assert.True(t, check.IfNil(srp))
+	assert.True(t, errors.Is(err, ErrInvalidStateRetentionPolicy))
+
+	srp, err = NewStateRetentionPolicy(config.StateRetentionConfig{Policy: LastEpochsStateRetention})
+	assert.True(t, check.IfNil(srp))
+	assert.True(t, errors.Is(err, ErrInvalidNumEpochsToKeep))
+}
+
+func TestNewStateRetentionPolicy_EmptyPolicyShouldBeMinimal(t *testing.T) {
+	t.Parallel()
+
+	srp, err := NewStateRetentionPolicy(config.StateRetentionConfig{NumEpochsToKeep: 4})
+	require.Nil(t, err)
+	require.False(t, check.IfNil(srp))
+
+	assert.Equal(t, MinimalStateRetention, srp.Policy())
+	assert.Equal(t, uint32(0), srp.NumEpochsToKeep())
+}
+
+func TestStateRetentionPolicy_Minimal(t *testing.T) {
+	t.Parallel()
+
+	srp, _ := NewStateRetentionPolicy(config.StateRetentionConfig{Policy: MinimalStateRetention})
+
+	assert.True(t, srp.IsPruningEnabled(true))
+	assert.False(t, srp.IsPruningEnabled(false))
+	assert.Equal(t, uint32(3), srp.MaxSnapshots(3))
+	assert.False(t, srp.AreSnapshotsQueryable())
+}
+
+func TestStateRetentionPolicy_LastEpochs(t *testing.T) {
+	t.Parallel()
+
+	srp, _ := NewStateRetentionPolicy(config.StateRetentionConfig{
+		Policy:          LastEpochsStateRetention,
+		NumEpochsToKeep: 5,
+	})
+
+	assert.Equal(t, LastEpochsStateRetention, srp.Policy())
+	assert.Equal(t, uint32(5), srp.NumEpochsToKeep())
+	assert.True(t, srp.IsPruningEnabled(true))
+	assert.Equal(t, uint32(5), srp.MaxSnapshots(3))
+	assert.True(t, srp.AreSnapshotsQueryable())
+}
+
+func TestStateRetentionPolicy_Archive(t *testing.T) {
+	t.Parallel()
+
+	srp, _ := NewStateRetentionPolicy(config.StateRetentionConfig{Policy: ArchiveStateRetention})
+
+	assert.False(t, srp.IsPruningEnabled(true))
+	assert.Equal(t, uint32(3), srp.MaxSnapshots(3))
+	assert.False(t, srp.AreSnapshotsQueryable())
+}
@@ -4,9 +4,10 @@ import "sync"
 
 type trieSyncStatistics struct {
 	sync.RWMutex
-	numReceived int
-	numMissing  int
-	missingMap  map[string]int
+	numReceived    int
+	numMissing     int
+	numTriesSynced int
+	missingMap     map[string]int
 }
 
 // NewTrieSyncStatistics returns a structure able to collect sync statistics from a trie and store them
@@ -21,6 +22,8 @@ func (tss *trieSyncStatistics) Reset() {
 	tss.Lock()
 	tss.numReceived = 0
 	tss.numMissing = 0
+	tss.numTriesSynced = 0
+	tss.missingMap = make(map[string]int)
 	tss.Unlock()
 }
 
@@ -51,6 +54,13 @@ func (tss *trieSyncStatistics) SetNumMissing(rootHash []byte, value int) {
 	tss.missingMap[string(rootHash)] = value
 }
 
+// AddNumTriesSynced will add the provided value to the number of completely synced tries
+func (tss *trieSyncStatistics) AddNumTriesSynced(value int) {
+	tss.Lock()
+	tss.numTriesSynced += value
+	tss.Unlock()
+}
+
 // NumReceived returns the received nodes
 func (tss *trieSyncStatistics) NumReceived() int {
 	tss.RLock()
@@ -67,6 +77,22 @@ func (tss *trieSyncStatistics) NumMissing() int {
 	return tss.numMissing
 }
 
+// NumTriesSynced returns the number of completely synced tries
+func (tss *trieSyncStatistics) NumTriesSynced() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.numTriesSynced
+}
+
+// NumTriesInProgress returns the number of tries, or sub-tries, still having missing nodes
+func (tss *trieSyncStatistics) NumTriesInProgress() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return len(tss.missingMap)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tss *trieSyncStatistics) IsInterfaceNil() bool {
 	return tss == nil
@@ -62,4 +62,28 @@ func TestTrieSyncStatistics_Missing(t *testing.T) {
 
 	tss.Reset()
 	assert.Equal(t, 0, tss.NumMissing())
+
+	tss.SetNumMissing([]byte("rh1"), 0)
+	assert.Equal(t, 0, tss.NumMissing())
+}
+
+func TestTrieSyncStatistics_Tries(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+
+	tss.AddNumTriesSynced(2)
+	tss.AddNumTriesSynced(3)
+	assert.Equal(t, 5, tss.NumTriesSynced())
+
+	tss.SetNumMissing([]byte("rh1"), 2)
+	tss.SetNumMissing([]byte("rh2"), 6)
+	assert.Equal(t, 2, tss.NumTriesInProgress())
+
+	tss.SetNumMissing([]byte("rh1"), 0)
+	assert.Equal(t, 1, tss.NumTriesInProgress())
+
+	tss.Reset()
+	assert.Equal(t, 0, tss.NumTriesSynced())
+	assert.Equal(t, 0, tss.NumTriesInProgress())
 }
@@ -57,6 +57,9 @@ type ArgTrieSyncer struct {
 	TrieSyncStatistics             data.SyncStatisticsHandler
 	TimeoutBetweenTrieNodesCommits time.Duration
 	MaxHardCapForMissingNodes      int
+	// CheckpointHandler is used by the syncers starting with the second version to skip the already synced tries and
+	// to resume the interrupted ones
+	CheckpointHandler data.TrieSyncCheckpointHandler
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
@@ -114,6 +117,9 @@ func checkArguments(arg ArgTrieSyncer) error {
 	if arg.MaxHardCapForMissingNodes < 1 {
 		return fmt.Errorf("%w provided: %v", ErrInvalidMaxHardCapForMissingNodes, arg.MaxHardCapForMissingNodes)
 	}
+	if check.IfNil(arg.CheckpointHandler) {
+		return ErrNilTrieSyncCheckpointHandler
+	}
 
 	return nil
 }
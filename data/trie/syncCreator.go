@@ -8,6 +8,11 @@ import (
 
 const initialVersion = 1
 const secondVersion = 2
+const parallelVersion = 3
+
+// numSubTriesWorkers is the number of workers syncing the sub-tries in the parallel version, one for each
+// child of a branch node
+const numSubTriesWorkers = 16
 
 // CreateTrieSyncer is the method factory to create the correct trie syncer implementation
 // TODO try to split this package (syncers should go in sync package, this file in the factory package)
@@ -17,6 +22,8 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (data.TrieSyncer
 		return NewTrieSyncer(arg)
 	case secondVersion:
 		return NewDoubleListTrieSyncer(arg)
+	case parallelVersion:
+		return NewParallelTrieSyncer(arg, numSubTriesWorkers)
 	default:
 		return nil, fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 	}
@@ -24,10 +31,20 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (data.TrieSyncer
 
 // CheckTrieSyncerVersion can check if the syncer version has a correct value
 func CheckTrieSyncerVersion(trieSyncerVersion int) error {
-	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= secondVersion
+	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= parallelVersion
 	if isCorrectVersion {
 		return nil
 	}
 
 	return fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 }
+
+// DataTrieSyncerVersion returns the syncer version to be used for the data tries. These are synced concurrently
+// already, so splitting each of them on its own workers would only multiply the goroutines
+func DataTrieSyncerVersion(trieSyncerVersion int) int {
+	if trieSyncerVersion == parallelVersion {
+		return secondVersion
+	}
+
+	return trieSyncerVersion
+}
@@ -43,6 +43,18 @@ func TestNewTrieSync_SecondVariantImplementation(t *testing.T) {
 	assert.True(t, isInstanceOk)
 }
 
+func TestNewTrieSync_ParallelVariantImplementation(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	syncer, err := CreateTrieSyncer(arg, 3)
+
+	require.False(t, check.IfNil(syncer))
+	require.Nil(t, err)
+	_, isInstanceOk := syncer.(*parallelTrieSyncer)
+	assert.True(t, isInstanceOk)
+}
+
 func TestCheckTrieSyncerVersion(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +67,17 @@ func TestCheckTrieSyncerVersion(t *testing.T) {
 	err = CheckTrieSyncerVersion(secondVersion)
 	assert.Nil(t, err)
 
-	err = CheckTrieSyncerVersion(3)
+	err = CheckTrieSyncerVersion(parallelVersion)
+	assert.Nil(t, err)
+
+	err = CheckTrieSyncerVersion(4)
 	assert.True(t, errors.Is(err, ErrInvalidTrieSyncerVersion))
 }
+
+func TestDataTrieSyncerVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, initialVersion, DataTrieSyncerVersion(initialVersion))
+	assert.Equal(t, secondVersion, DataTrieSyncerVersion(secondVersion))
+	assert.Equal(t, secondVersion, DataTrieSyncerVersion(parallelVersion))
+}
@@ -27,6 +27,7 @@ func createMockArgument() ArgTrieSyncer {
 		TrieSyncStatistics:             statistics.NewTrieSyncStatistics(),
 		TimeoutBetweenTrieNodesCommits: minTimeoutBetweenNodesCommits,
 		MaxHardCapForMissingNodes:      500,
+		CheckpointHandler:              NewDisabledTrieSyncCheckpoint(),
 	}
 }
 
@@ -74,6 +75,17 @@ func TestNewTrieSyncer_NilTrieStatisticsShouldErr(t *testing.T) {
 	assert.Equal(t, err, ErrNilTrieSyncStatistics)
 }
 
+func TestNewTrieSyncer_NilCheckpointHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.CheckpointHandler = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, err, ErrNilTrieSyncCheckpointHandler)
+}
+
 func TestNewTrieSyncer_NilDatabaseShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,188 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const maxHashesInIntegrityReport = 1000
+
+// TrieIntegrityReport holds the result of checking the nodes of a trie found in storage. The hashes lists are capped
+// while the counters hold the full numbers
+type TrieIntegrityReport struct {
+	RootHash          []byte   `json:"rootHash"`
+	NumNodes          uint64   `json:"numNodes"`
+	NumLeaves         uint64   `json:"numLeaves"`
+	NumMissingNodes   uint64   `json:"numMissingNodes"`
+	NumCorruptedNodes uint64   `json:"numCorruptedNodes"`
+	NumRepairedNodes  uint64   `json:"numRepairedNodes"`
+	MissingNodes      [][]byte `json:"missingNodes"`
+	CorruptedNodes    [][]byte `json:"corruptedNodes"`
+}
+
+// NewTrieIntegrityReport creates an empty integrity report for the provided root hash
+func NewTrieIntegrityReport(rootHash []byte) *TrieIntegrityReport {
+	return &TrieIntegrityReport{
+		RootHash:       rootHash,
+		MissingNodes:   make([][]byte, 0),
+		CorruptedNodes: make([][]byte, 0),
+	}
+}
+
+// IsHealthy returns true if all the nodes were found in storage, or repaired, with the expected hashes
+func (tir *TrieIntegrityReport) IsHealthy() bool {
+	return tir.NumMissingNodes == 0 && tir.NumCorruptedNodes == 0
+}
+
+// Add adds the provided report to the current one, used when aggregating the reports of many tries
+func (tir *TrieIntegrityReport) Add(other *TrieIntegrityReport) {
+	tir.NumNodes += other.NumNodes
+	tir.NumLeaves += other.NumLeaves
+	tir.NumMissingNodes += other.NumMissingNodes
+	tir.NumCorruptedNodes += other.NumCorruptedNodes
+	tir.NumRepairedNodes += other.NumRepairedNodes
+	tir.MissingNodes = appendCappedHashes(tir.MissingNodes, other.MissingNodes...)
+	tir.CorruptedNodes = appendCappedHashes(tir.CorruptedNodes, other.CorruptedNodes...)
+}
+
+func appendCappedHashes(hashes [][]byte, newHashes ...[]byte) [][]byte {
+	for _, hash := range newHashes {
+		if len(hashes) >= maxHashesInIntegrityReport {
+			break
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+// ArgsCheckTrieIntegrity holds the arguments needed to check the integrity of a trie from storage
+type ArgsCheckTrieIntegrity struct {
+	DB          data.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	RootHash    []byte
+	// RepairSources, if set, are searched for the missing or corrupted nodes, the ones found with the expected hash
+	// being written in DB
+	RepairSources []data.DBWriteCacher
+	// LeafHandler, if set, is called with the value of each leaf. An error stops the check
+	LeafHandler func(value []byte) error
+}
+
+// CheckTrieIntegrity walks the trie with the provided root hash reading the nodes from storage and verifies that each
+// node is found under the hash of its encoding. Unlike the trie iterators, which stop at the first missing node, it
+// reports all the missing and corrupted nodes. The children of a missing or corrupted node can not be reached
+func CheckTrieIntegrity(args ArgsCheckTrieIntegrity) (*TrieIntegrityReport, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	report := NewTrieIntegrityReport(args.RootHash)
+	if len(args.RootHash) == 0 || bytes.Equal(args.RootHash, EmptyTrieHash) {
+		return report, nil
+	}
+
+	stack := [][]byte{args.RootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n, ok := getVerifiedNode(args, hash, report)
+		if !ok {
+			continue
+		}
+
+		report.NumNodes++
+		switch typedNode := n.(type) {
+		case *branchNode:
+			for _, childHash := range typedNode.EncodedChildren {
+				if len(childHash) == 0 {
+					continue
+				}
+				stack = append(stack, childHash)
+			}
+		case *extensionNode:
+			stack = append(stack, typedNode.EncodedChild)
+		case *leafNode:
+			report.NumLeaves++
+			if args.LeafHandler == nil {
+				continue
+			}
+			err := args.LeafHandler(typedNode.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func getVerifiedNode(args ArgsCheckTrieIntegrity, hash []byte, report *TrieIntegrityReport) (node, bool) {
+	encodedNode, err := args.DB.Get(hash)
+	isMissing := err != nil
+	isCorrupted := !isMissing && !isEncodedNodeValid(args.Hasher, hash, encodedNode)
+	if isMissing || isCorrupted {
+		var isRepaired bool
+		encodedNode, isRepaired = repairNode(args, hash)
+		if !isRepaired {
+			recordInvalidNode(report, hash, isMissing)
+			return nil, false
+		}
+
+		report.NumRepairedNodes++
+	}
+
+	n, err := decodeNode(encodedNode, args.Marshalizer, args.Hasher)
+	if err != nil {
+		log.Debug("CheckTrieIntegrity: can not decode node", "hash", hash, "error", err)
+		recordInvalidNode(report, hash, false)
+		return nil, false
+	}
+
+	return n, true
+}
+
+func repairNode(args ArgsCheckTrieIntegrity, hash []byte) ([]byte, bool) {
+	for _, source := range args.RepairSources {
+		encodedNode, err := source.Get(hash)
+		if err != nil || !isEncodedNodeValid(args.Hasher, hash, encodedNode) {
+			continue
+		}
+
+		err = args.DB.Put(hash, encodedNode)
+		if err != nil {
+			log.Warn("CheckTrieIntegrity: can not write the repaired node", "hash", hash, "error", err)
+			return nil, false
+		}
+
+		return encodedNode, true
+	}
+
+	return nil, false
+}
+
+func isEncodedNodeValid(hasher hashing.Hasher, hash []byte, encodedNode []byte) bool {
+	return bytes.Equal(hasher.Compute(string(encodedNode)), hash)
+}
+
+func recordInvalidNode(report *TrieIntegrityReport, hash []byte, isMissing bool) {
+	if isMissing {
+		report.NumMissingNodes++
+		report.MissingNodes = appendCappedHashes(report.MissingNodes, hash)
+		return
+	}
+
+	report.NumCorruptedNodes++
+	report.CorruptedNodes = appendCappedHashes(report.CorruptedNodes, hash)
+}
@@ -0,0 +1,191 @@
+package trie
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrieIntegrityArgs(db *mock.MemDbMock, rootHash []byte) ArgsCheckTrieIntegrity {
+	return ArgsCheckTrieIntegrity{
+		DB:          db,
+		Marshalizer: marshalizer,
+		Hasher:      hasher,
+		RootHash:    rootHash,
+	}
+}
+
+// getLeavesKeys returns the keys of some leaves, none of them hiding another one when missing
+func getLeavesKeys(db *mock.MemDbMock, numKeys int) [][]byte {
+	keys := make([][]byte, 0, numKeys)
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		if val[len(val)-1] == leaf {
+			keys = append(keys, key)
+		}
+
+		return len(keys) < numKeys
+	})
+
+	return keys
+}
+
+func countKeys(db *mock.MemDbMock) int {
+	numKeys := 0
+	db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys
+}
+
+func copyMemDb(db *mock.MemDbMock) *mock.MemDbMock {
+	dbCopy := mock.NewMemDbMock()
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		_ = dbCopy.Put(key, val)
+		return true
+	})
+
+	return dbCopy
+}
+
+func TestCheckTrieIntegrity_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createTrieIntegrityArgs(mock.NewMemDbMock(), nil)
+	args.DB = nil
+	report, err := CheckTrieIntegrity(args)
+	assert.Nil(t, report)
+	assert.Equal(t, ErrNilDatabase, err)
+
+	args = createTrieIntegrityArgs(mock.NewMemDbMock(), nil)
+	args.Marshalizer = nil
+	_, err = CheckTrieIntegrity(args)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createTrieIntegrityArgs(mock.NewMemDbMock(), nil)
+	args.Hasher = nil
+	_, err = CheckTrieIntegrity(args)
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestCheckTrieIntegrity_EmptyTrieShouldBeHealthy(t *testing.T) {
+	t.Parallel()
+
+	report, err := CheckTrieIntegrity(createTrieIntegrityArgs(mock.NewMemDbMock(), EmptyTrieHash))
+	assert.Nil(t, err)
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(0), report.NumNodes)
+}
+
+func TestCheckTrieIntegrity_CompleteTrieShouldBeHealthy(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	tr, db, rootHash := createCommittedTrieOnMemDb(numKeysValues)
+
+	numLeavesHandled := 0
+	args := createTrieIntegrityArgs(db, rootHash)
+	args.LeafHandler = func(value []byte) error {
+		numLeavesHandled++
+		return nil
+	}
+	report, err := CheckTrieIntegrity(args)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(tr.GetNumNodes().Leaves), report.NumLeaves)
+	assert.Equal(t, numKeysValues, numLeavesHandled)
+	assert.Equal(t, uint64(countKeys(db)), report.NumNodes)
+}
+
+func TestCheckTrieIntegrity_ShouldReportMissingAndCorruptedNodes(t *testing.T) {
+	t.Parallel()
+
+	_, db, rootHash := createCommittedTrieOnMemDb(100)
+	keys := getLeavesKeys(db, 2)
+	require.Equal(t, 2, len(keys))
+	_ = db.Remove(keys[0])
+	_ = db.Put(keys[1], []byte("corrupted node"))
+
+	report, err := CheckTrieIntegrity(createTrieIntegrityArgs(db, rootHash))
+	require.Nil(t, err)
+
+	assert.False(t, report.IsHealthy())
+	assert.Equal(t, uint64(1), report.NumMissingNodes)
+	assert.Equal(t, [][]byte{keys[0]}, report.MissingNodes)
+	assert.Equal(t, uint64(1), report.NumCorruptedNodes)
+	assert.Equal(t, [][]byte{keys[1]}, report.CorruptedNodes)
+	assert.Equal(t, uint64(0), report.NumRepairedNodes)
+}
+
+func TestCheckTrieIntegrity_ShouldRepairFromSources(t *testing.T) {
+	t.Parallel()
+
+	_, db, rootHash := createCommittedTrieOnMemDb(100)
+	numNodes := countKeys(db)
+	corruptedSource := copyMemDb(db)
+	validSource := copyMemDb(db)
+	keys := getLeavesKeys(db, 2)
+	_ = db.Remove(keys[0])
+	_ = db.Put(keys[1], []byte("corrupted node"))
+	_ = corruptedSource.Put(keys[0], []byte("corrupted node"))
+
+	args := createTrieIntegrityArgs(db, rootHash)
+	args.RepairSources = append(args.RepairSources, corruptedSource, validSource)
+	report, err := CheckTrieIntegrity(args)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(2), report.NumRepairedNodes)
+	assert.Equal(t, uint64(numNodes), report.NumNodes)
+
+	report, err = CheckTrieIntegrity(createTrieIntegrityArgs(db, rootHash))
+	require.Nil(t, err)
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(0), report.NumRepairedNodes)
+}
+
+func TestCheckTrieIntegrity_LeafHandlerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, db, rootHash := createCommittedTrieOnMemDb(10)
+	expectedErr := errors.New("expected error")
+	args := createTrieIntegrityArgs(db, rootHash)
+	args.LeafHandler = func(value []byte) error {
+		return expectedErr
+	}
+
+	report, err := CheckTrieIntegrity(args)
+	assert.Nil(t, report)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTrieIntegrityReport_Add(t *testing.T) {
+	t.Parallel()
+
+	report := NewTrieIntegrityReport(nil)
+	report.Add(&TrieIntegrityReport{
+		NumNodes:          5,
+		NumLeaves:         3,
+		NumMissingNodes:   1,
+		NumCorruptedNodes: 1,
+		NumRepairedNodes:  2,
+		MissingNodes:      [][]byte{[]byte("missing")},
+		CorruptedNodes:    [][]byte{[]byte("corrupted")},
+	})
+	report.Add(&TrieIntegrityReport{
+		NumNodes:  2,
+		NumLeaves: 1,
+	})
+
+	assert.Equal(t, uint64(7), report.NumNodes)
+	assert.Equal(t, uint64(4), report.NumLeaves)
+	assert.Equal(t, uint64(2), report.NumRepairedNodes)
+	assert.Equal(t, [][]byte{[]byte("missing")}, report.MissingNodes)
+	assert.Equal(t, [][]byte{[]byte("corrupted")}, report.CorruptedNodes)
+	assert.False(t, report.IsHealthy())
+}
@@ -0,0 +1,79 @@
+package trie
+
+import (
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache/capacity"
+)
+
+var _ data.TrieNodesCacher = (*trieNodesCache)(nil)
+
+// trieNodesCache holds the encoded trie nodes read from storage in a size bounded LRU cache. As the nodes are keyed by
+// their hash, the same cache can be shared between all the tries of a node
+type trieNodesCache struct {
+	cache     storage.SizedLRUCacheHandler
+	numHits   uint64
+	numMisses uint64
+}
+
+// NewTrieNodesCache creates a trie nodes cache holding at most maxNumNodes nodes and sizeInBytes bytes
+func NewTrieNodesCache(maxNumNodes int, sizeInBytes int64) (*trieNodesCache, error) {
+	cache, err := capacity.NewCapacityLRU(maxNumNodes, sizeInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trieNodesCache{
+		cache: cache,
+	}, nil
+}
+
+// Get returns the encoded node with the provided hash, if cached
+func (tnc *trieNodesCache) Get(hash []byte) ([]byte, bool) {
+	val, ok := tnc.cache.Get(string(hash))
+	if !ok {
+		atomic.AddUint64(&tnc.numMisses, 1)
+		return nil, false
+	}
+
+	encodedNode, ok := val.([]byte)
+	if !ok {
+		atomic.AddUint64(&tnc.numMisses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&tnc.numHits, 1)
+	return encodedNode, true
+}
+
+// Put adds the encoded node in the cache
+func (tnc *trieNodesCache) Put(hash []byte, encodedNode []byte) {
+	_, _ = tnc.cache.AddSizedIfMissing(string(hash), encodedNode, int64(len(hash)+len(encodedNode)))
+}
+
+// Remove removes the node with the provided hash from the cache
+func (tnc *trieNodesCache) Remove(hash []byte) {
+	_ = tnc.cache.Remove(string(hash))
+}
+
+// NumHits returns the number of lookups that found the node in the cache
+func (tnc *trieNodesCache) NumHits() uint64 {
+	return atomic.LoadUint64(&tnc.numHits)
+}
+
+// NumMisses returns the number of lookups that did not find the node in the cache
+func (tnc *trieNodesCache) NumMisses() uint64 {
+	return atomic.LoadUint64(&tnc.numMisses)
+}
+
+// SizeInBytesContained returns the size in bytes of the cached nodes
+func (tnc *trieNodesCache) SizeInBytesContained() uint64 {
+	return tnc.cache.SizeInBytesContained()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tnc *trieNodesCache) IsInterfaceNil() bool {
+	return tnc == nil
+}
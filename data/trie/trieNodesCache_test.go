@@ -0,0 +1,63 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrieNodesCache_InvalidSizesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tnc, err := NewTrieNodesCache(0, 1000)
+	assert.True(t, check.IfNil(tnc))
+	assert.Equal(t, storage.ErrCacheSizeInvalid, err)
+
+	tnc, err = NewTrieNodesCache(10, 0)
+	assert.True(t, check.IfNil(tnc))
+	assert.Equal(t, storage.ErrCacheCapacityInvalid, err)
+}
+
+func TestTrieNodesCache_ShouldCountHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	tnc, err := NewTrieNodesCache(10, 1000)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(tnc))
+
+	_, ok := tnc.Get([]byte("hash"))
+	assert.False(t, ok)
+
+	tnc.Put([]byte("hash"), []byte("node"))
+	val, ok := tnc.Get([]byte("hash"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte("node"), val)
+	assert.Equal(t, uint64(len("hash")+len("node")), tnc.SizeInBytesContained())
+
+	tnc.Remove([]byte("hash"))
+	_, ok = tnc.Get([]byte("hash"))
+	assert.False(t, ok)
+
+	assert.Equal(t, uint64(1), tnc.NumHits())
+	assert.Equal(t, uint64(2), tnc.NumMisses())
+	assert.Equal(t, uint64(0), tnc.SizeInBytesContained())
+}
+
+func TestTrieNodesCache_ShouldBeBoundedBySize(t *testing.T) {
+	t.Parallel()
+
+	tnc, _ := NewTrieNodesCache(100, 30)
+	tnc.Put([]byte("hash1"), []byte("node1"))
+	tnc.Put([]byte("hash2"), []byte("node2"))
+	tnc.Put([]byte("hash3"), []byte("node3"))
+	tnc.Put([]byte("hash4"), []byte("node4"))
+
+	assert.True(t, tnc.SizeInBytesContained() <= 30)
+	_, ok := tnc.Get([]byte("hash1"))
+	assert.False(t, ok)
+	_, ok = tnc.Get([]byte("hash4"))
+	assert.True(t, ok)
+}
@@ -0,0 +1,138 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// TrieStatistics holds the statistics of a trie computed by walking its nodes from storage
+type TrieStatistics struct {
+	RootHash         []byte            `json:"rootHash"`
+	NumBranches      uint64            `json:"numBranches"`
+	NumExtensions    uint64            `json:"numExtensions"`
+	NumLeaves        uint64            `json:"numLeaves"`
+	NumMissingNodes  uint64            `json:"numMissingNodes"`
+	TotalSizeInBytes uint64            `json:"totalSizeInBytes"`
+	MaxDepth         uint32            `json:"maxDepth"`
+	LeavesPerDepth   map[uint32]uint64 `json:"leavesPerDepth"`
+}
+
+// NewTrieStatistics creates an empty trie statistics for the provided root hash
+func NewTrieStatistics(rootHash []byte) *TrieStatistics {
+	return &TrieStatistics{
+		RootHash:       rootHash,
+		LeavesPerDepth: make(map[uint32]uint64),
+	}
+}
+
+// NumNodes returns the total number of nodes found in storage
+func (ts *TrieStatistics) NumNodes() uint64 {
+	return ts.NumBranches + ts.NumExtensions + ts.NumLeaves
+}
+
+// Add adds the provided statistics to the current ones, used when aggregating the statistics of many tries
+func (ts *TrieStatistics) Add(other *TrieStatistics) {
+	ts.NumBranches += other.NumBranches
+	ts.NumExtensions += other.NumExtensions
+	ts.NumLeaves += other.NumLeaves
+	ts.NumMissingNodes += other.NumMissingNodes
+	ts.TotalSizeInBytes += other.TotalSizeInBytes
+	if other.MaxDepth > ts.MaxDepth {
+		ts.MaxDepth = other.MaxDepth
+	}
+	for depth, numLeaves := range other.LeavesPerDepth {
+		ts.LeavesPerDepth[depth] += numLeaves
+	}
+}
+
+// ArgsComputeTrieStatistics holds the arguments needed to compute the statistics of a trie from storage
+type ArgsComputeTrieStatistics struct {
+	DB          data.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	RootHash    []byte
+	// NodeHandler, if set, is called with the hash of each node found in storage
+	NodeHandler func(hash []byte)
+	// LeafHandler, if set, is called with the value of each leaf. An error stops the walk
+	LeafHandler func(value []byte) error
+}
+
+type hashAtDepth struct {
+	hash  []byte
+	depth uint32
+}
+
+// ComputeTrieStatistics walks the trie with the provided root hash reading the nodes from storage, without loading
+// the whole trie in memory. The nodes missing from storage are counted and skipped
+func ComputeTrieStatistics(args ArgsComputeTrieStatistics) (*TrieStatistics, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	stats := NewTrieStatistics(args.RootHash)
+	if len(args.RootHash) == 0 || bytes.Equal(args.RootHash, EmptyTrieHash) {
+		return stats, nil
+	}
+
+	stack := []hashAtDepth{{hash: args.RootHash}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := args.DB.Get(current.hash)
+		if err != nil {
+			log.Trace("ComputeTrieStatistics: missing node", "hash", current.hash, "error", err)
+			stats.NumMissingNodes++
+			continue
+		}
+
+		n, err := decodeNode(encodedNode, args.Marshalizer, args.Hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		if args.NodeHandler != nil {
+			args.NodeHandler(current.hash)
+		}
+		stats.TotalSizeInBytes += uint64(len(current.hash) + len(encodedNode))
+		if current.depth > stats.MaxDepth {
+			stats.MaxDepth = current.depth
+		}
+
+		switch typedNode := n.(type) {
+		case *branchNode:
+			stats.NumBranches++
+			for _, childHash := range typedNode.EncodedChildren {
+				if len(childHash) == 0 {
+					continue
+				}
+				stack = append(stack, hashAtDepth{hash: childHash, depth: current.depth + 1})
+			}
+		case *extensionNode:
+			stats.NumExtensions++
+			stack = append(stack, hashAtDepth{hash: typedNode.EncodedChild, depth: current.depth + 1})
+		case *leafNode:
+			stats.NumLeaves++
+			stats.LeavesPerDepth[current.depth]++
+			if args.LeafHandler == nil {
+				continue
+			}
+			err = args.LeafHandler(typedNode.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,163 @@
+package trie
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrieStatisticsArgs(db *mock.MemDbMock, rootHash []byte) ArgsComputeTrieStatistics {
+	return ArgsComputeTrieStatistics{
+		DB:          db,
+		Marshalizer: marshalizer,
+		Hasher:      hasher,
+		RootHash:    rootHash,
+	}
+}
+
+func createCommittedTrieOnMemDb(numKeysValues int) (data.Trie, *mock.MemDbMock, []byte) {
+	db := mock.NewMemDbMock()
+	tsm, _ := NewTrieStorageManagerWithoutPruning(db)
+	tr, _ := NewTrie(tsm, marshalizer, hasher, 6)
+	addDataToTrie(numKeysValues, tr)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	return tr, db, rootHash
+}
+
+func TestComputeTrieStatistics_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createTrieStatisticsArgs(mock.NewMemDbMock(), nil)
+	args.DB = nil
+	stats, err := ComputeTrieStatistics(args)
+	assert.Nil(t, stats)
+	assert.Equal(t, ErrNilDatabase, err)
+
+	args = createTrieStatisticsArgs(mock.NewMemDbMock(), nil)
+	args.Marshalizer = nil
+	_, err = ComputeTrieStatistics(args)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createTrieStatisticsArgs(mock.NewMemDbMock(), nil)
+	args.Hasher = nil
+	_, err = ComputeTrieStatistics(args)
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestComputeTrieStatistics_EmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	stats, err := ComputeTrieStatistics(createTrieStatisticsArgs(mock.NewMemDbMock(), EmptyTrieHash))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), stats.NumNodes())
+}
+
+func TestComputeTrieStatistics_ShouldMatchTheTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 1000
+	tr, db, rootHash := createCommittedTrieOnMemDb(numKeysValues)
+	numNodes := tr.GetNumNodes()
+
+	numNodesHandled := 0
+	numLeavesHandled := 0
+	args := createTrieStatisticsArgs(db, rootHash)
+	args.NodeHandler = func(hash []byte) {
+		numNodesHandled++
+	}
+	args.LeafHandler = func(value []byte) error {
+		numLeavesHandled++
+		return nil
+	}
+	stats, err := ComputeTrieStatistics(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(numNodes.Branches), stats.NumBranches)
+	assert.Equal(t, uint64(numNodes.Extensions), stats.NumExtensions)
+	assert.Equal(t, uint64(numKeysValues), stats.NumLeaves)
+	assert.Equal(t, uint32(numNodes.MaxLevel-1), stats.MaxDepth)
+	assert.Equal(t, uint64(0), stats.NumMissingNodes)
+	assert.Equal(t, int(stats.NumNodes()), numNodesHandled)
+	assert.Equal(t, numKeysValues, numLeavesHandled)
+
+	numLeavesPerDepth := uint64(0)
+	for _, numLeaves := range stats.LeavesPerDepth {
+		numLeavesPerDepth += numLeaves
+	}
+	assert.Equal(t, uint64(numKeysValues), numLeavesPerDepth)
+
+	totalSize := uint64(0)
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		totalSize += uint64(len(key) + len(val))
+		return true
+	})
+	assert.Equal(t, totalSize, stats.TotalSizeInBytes)
+}
+
+func TestComputeTrieStatistics_ShouldCountMissingNodes(t *testing.T) {
+	t.Parallel()
+
+	_, db, rootHash := createCommittedTrieOnMemDb(100)
+
+	leafHash := []byte(nil)
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		if val[len(val)-1] == leaf {
+			leafHash = key
+			return false
+		}
+		return true
+	})
+	_ = db.Remove(leafHash)
+
+	stats, err := ComputeTrieStatistics(createTrieStatisticsArgs(db, rootHash))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), stats.NumMissingNodes)
+	assert.Equal(t, uint64(99), stats.NumLeaves)
+}
+
+func TestComputeTrieStatistics_LeafHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	_, db, rootHash := createCommittedTrieOnMemDb(100)
+
+	expectedErr := errors.New("expected error")
+	args := createTrieStatisticsArgs(db, rootHash)
+	args.LeafHandler = func(value []byte) error {
+		return expectedErr
+	}
+	stats, err := ComputeTrieStatistics(args)
+	assert.Nil(t, stats)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTrieStatistics_Add(t *testing.T) {
+	t.Parallel()
+
+	stats := NewTrieStatistics(nil)
+	stats.Add(&TrieStatistics{
+		NumBranches:      1,
+		NumExtensions:    2,
+		NumLeaves:        3,
+		NumMissingNodes:  4,
+		TotalSizeInBytes: 5,
+		MaxDepth:         6,
+		LeavesPerDepth:   map[uint32]uint64{1: 2, 2: 1},
+	})
+	stats.Add(&TrieStatistics{
+		NumLeaves:      1,
+		MaxDepth:       2,
+		LeavesPerDepth: map[uint32]uint64{1: 1},
+	})
+
+	assert.Equal(t, uint64(7), stats.NumNodes())
+	assert.Equal(t, uint64(4), stats.NumMissingNodes)
+	assert.Equal(t, uint64(5), stats.TotalSizeInBytes)
+	assert.Equal(t, uint32(6), stats.MaxDepth)
+	assert.Equal(t, map[uint32]uint64{1: 3, 2: 1}, stats.LeavesPerDepth)
+}
@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"io/ioutil"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -27,6 +29,10 @@ const (
 	prune       pruningOperation = 1
 )
 
+// snapshotEpochKey is the key under which a snapshot database holds the epoch it was taken in. It can not collide
+// with the trie nodes keys, which are hashes
+var snapshotEpochKey = []byte("snapshotEpoch")
+
 // trieStorageManager manages all the storage operations of the trie (commit, snapshot, checkpoint, pruning)
 type trieStorageManager struct {
 	db data.DBWriteCacher
@@ -38,6 +44,7 @@ type trieStorageManager struct {
 	pruningBuffer      atomicBuffer
 	pruningBlockingOps uint32
 	maxSnapshots       uint32
+	epochForSnapshots  uint32
 
 	dbEvictionWaitingList data.DBRemoveCacher
 	storageOperationMutex sync.RWMutex
@@ -46,6 +53,7 @@ type trieStorageManager struct {
 type snapshotsQueueEntry struct {
 	rootHash []byte
 	newDb    bool
+	epoch    uint32
 }
 
 // NewTrieStorageManager creates a new instance of trieStorageManager
@@ -161,9 +169,11 @@ func getSnapshotsAndSnapshotId(snapshotDbCfg config.DBConfig) ([]data.SnapshotDb
 
 		snapshot := &snapshotDb{
 			DBWriteCacher: db,
+			id:            snapshotName,
 		}
+		snapshot.epoch, snapshot.isEpochKnown = getSnapshotEpoch(db)
 
-		log.Debug("restored snapshot", "snapshot ID", snapshotName)
+		log.Debug("restored snapshot", "snapshot ID", snapshotName, "epoch", snapshot.epoch)
 		snapshotsMap[snapshotName] = snapshot
 	}
 
@@ -174,6 +184,15 @@ func getSnapshotsAndSnapshotId(snapshotDbCfg config.DBConfig) ([]data.SnapshotDb
 	return getOrderedSnapshots(snapshotsMap), snapshotId, nil
 }
 
+func getSnapshotEpoch(db storage.Persister) (uint32, bool) {
+	epochBytes, err := db.Get(snapshotEpochKey)
+	if err != nil || len(epochBytes) != 4 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(epochBytes), true
+}
+
 // Database returns the main database
 func (tsm *trieStorageManager) Database() data.DBWriteCacher {
 	return tsm.db
@@ -365,7 +384,11 @@ func (tsm *trieStorageManager) TakeSnapshot(rootHash []byte) {
 
 	tsm.EnterPruningBufferingMode()
 
-	snapshotEntry := &snapshotsQueueEntry{rootHash: rootHash, newDb: true}
+	snapshotEntry := &snapshotsQueueEntry{
+		rootHash: rootHash,
+		newDb:    true,
+		epoch:    atomic.LoadUint32(&tsm.epochForSnapshots),
+	}
 	tsm.writeOnChan(snapshotEntry)
 }
 
@@ -380,7 +403,11 @@ func (tsm *trieStorageManager) SetCheckpoint(rootHash []byte) {
 
 	tsm.EnterPruningBufferingMode()
 
-	checkpointEntry := &snapshotsQueueEntry{rootHash: rootHash, newDb: false}
+	checkpointEntry := &snapshotsQueueEntry{
+		rootHash: rootHash,
+		newDb:    false,
+		epoch:    atomic.LoadUint32(&tsm.epochForSnapshots),
+	}
 	tsm.writeOnChan(checkpointEntry)
 }
 
@@ -403,7 +430,7 @@ func (tsm *trieStorageManager) takeSnapshot(snapshot *snapshotsQueueEntry, msh m
 		log.Error("trie storage manager: newSnapshotTrie", "error", err.Error())
 		return
 	}
-	db := tsm.getSnapshotDb(snapshot.newDb)
+	db := tsm.getSnapshotDb(snapshot.newDb, snapshot.epoch)
 	if check.IfNil(db) {
 		return
 	}
@@ -435,7 +462,7 @@ func (tsm *trieStorageManager) isPresentInLastSnapshotDb(rootHash []byte) bool {
 	return true
 }
 
-func (tsm *trieStorageManager) getSnapshotDb(newDb bool) data.DBWriteCacher {
+func (tsm *trieStorageManager) getSnapshotDb(newDb bool, epoch uint32) data.DBWriteCacher {
 	tsm.storageOperationMutex.Lock()
 	defer tsm.storageOperationMutex.Unlock()
 
@@ -444,7 +471,7 @@ func (tsm *trieStorageManager) getSnapshotDb(newDb bool) data.DBWriteCacher {
 		return tsm.snapshots[len(tsm.snapshots)-1]
 	}
 
-	db, err := tsm.newSnapshotDb()
+	db, err := tsm.newSnapshotDb(epoch)
 	if err != nil {
 		log.Error("trie storage manager: getSnapshotDb", "error", err.Error())
 		return nil
@@ -511,7 +538,7 @@ func newSnapshotNode(
 	return newRoot, nil
 }
 
-func (tsm *trieStorageManager) newSnapshotDb() (storage.Persister, error) {
+func (tsm *trieStorageManager) newSnapshotDb(epoch uint32) (storage.Persister, error) {
 	snapshotPath := path.Join(tsm.snapshotDbCfg.FilePath, strconv.Itoa(tsm.snapshotId))
 	for directoryExists(snapshotPath) {
 		tsm.snapshotId++
@@ -531,12 +558,21 @@ func (tsm *trieStorageManager) newSnapshotDb() (storage.Persister, error) {
 		return nil, err
 	}
 
-	tsm.snapshotId++
+	epochBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(epochBytes, epoch)
+	err = db.Put(snapshotEpochKey, epochBytes)
+	if err != nil {
+		log.Warn("trie storage manager: can not save the snapshot epoch", "snapshot ID", tsm.snapshotId, "error", err)
+	}
 
 	snapshot := &snapshotDb{
 		DBWriteCacher: db,
+		id:            tsm.snapshotId,
+		epoch:         epoch,
+		isEpochKnown:  err == nil,
 	}
 	tsm.snapshots = append(tsm.snapshots, snapshot)
+	tsm.snapshotId++
 
 	return db, nil
 }
@@ -546,6 +582,42 @@ func directoryExists(path string) bool {
 	return !os.IsNotExist(err)
 }
 
+// SetEpochForSnapshots sets the epoch the following snapshots are tagged with
+func (tsm *trieStorageManager) SetEpochForSnapshots(epoch uint32) {
+	atomic.StoreUint32(&tsm.epochForSnapshots, epoch)
+}
+
+// GetSnapshotsInfo returns the epoch and the disk usage of each snapshot database, from the oldest to the newest one
+func (tsm *trieStorageManager) GetSnapshotsInfo() []data.SnapshotInfo {
+	tsm.storageOperationMutex.RLock()
+	snapshotsInfo := make([]data.SnapshotInfo, 0, len(tsm.snapshots))
+	for _, snapshot := range tsm.snapshots {
+		sDb, ok := snapshot.(*snapshotDb)
+		if !ok {
+			continue
+		}
+
+		snapshotsInfo = append(snapshotsInfo, data.SnapshotInfo{
+			ID:           sDb.id,
+			Epoch:        sDb.epoch,
+			IsEpochKnown: sDb.isEpochKnown,
+		})
+	}
+	tsm.storageOperationMutex.RUnlock()
+
+	for i := range snapshotsInfo {
+		snapshotPath := path.Join(tsm.snapshotDbCfg.FilePath, strconv.Itoa(snapshotsInfo[i].ID))
+		size, err := core.GetDirectorySize(snapshotPath)
+		if err != nil {
+			log.Debug("trie storage manager: can not compute the snapshot size", "path", snapshotPath, "error", err)
+			continue
+		}
+		snapshotsInfo[i].SizeInBytes = size
+	}
+
+	return snapshotsInfo
+}
+
 // IsPruningEnabled returns true if the trie pruning is enabled
 func (tsm *trieStorageManager) IsPruningEnabled() bool {
 	return true
@@ -65,3 +65,12 @@ func TestTrieStorageManagerWithoutPruning_IsPruningEnabled(t *testing.T) {
 	ts, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
 	assert.False(t, ts.IsPruningEnabled())
 }
+
+func TestTrieStorageManagerWithoutPruning_GetSnapshotsInfoShouldBeEmpty(t *testing.T) {
+	t.Parallel()
+
+	tsm, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tsm.SetEpochForSnapshots(3)
+
+	assert.Equal(t, 0, len(tsm.GetSnapshotsInfo()))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const pruningDelay = time.Second / 2
@@ -694,3 +695,61 @@ func TestShouldNotRemoveSnapshotDbIfItIsStillInUse(t *testing.T) {
 	assert.Nil(t, val)
 	assert.NotNil(t, err)
 }
+
+func TestTrieStorageManager_GetSnapshotsInfoShouldReturnTheSnapshotsEpochs(t *testing.T) {
+	t.Parallel()
+
+	tempDir, _ := ioutil.TempDir("", "leveldb_temp")
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+	cfg := config.DBConfig{
+		FilePath:          tempDir,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+
+	msh, hsh := getTestMarshalizerAndHasher()
+	evictionWaitList, _ := mock.NewEvictionWaitingList(100, mock.NewMemDbMock(), msh)
+	trieStorage, _ := NewTrieStorageManager(mock.NewMemDbMock(), msh, hsh, cfg, evictionWaitList, generalCfg)
+	tr, _ := NewTrie(trieStorage, msh, hsh, 5)
+
+	for epoch := uint32(6); epoch <= 8; epoch++ {
+		_ = tr.Update([]byte(strconv.Itoa(int(epoch))), []byte("value"))
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		trieStorage.SetEpochForSnapshots(epoch)
+		trieStorage.TakeSnapshot(rootHash)
+		time.Sleep(snapshotDelay)
+	}
+
+	snapshotsInfo := trieStorage.GetSnapshotsInfo()
+	require.Equal(t, 2, len(snapshotsInfo))
+	for i, expectedEpoch := range []uint32{7, 8} {
+		assert.Equal(t, i+1, snapshotsInfo[i].ID)
+		assert.Equal(t, expectedEpoch, snapshotsInfo[i].Epoch)
+		assert.True(t, snapshotsInfo[i].IsEpochKnown)
+		assert.True(t, snapshotsInfo[i].SizeInBytes > 0)
+	}
+
+	trieStorage.storageOperationMutex.Lock()
+	for _, snapshot := range trieStorage.snapshots {
+		_ = snapshot.Close()
+	}
+	trieStorage.storageOperationMutex.Unlock()
+
+	newTrieStorage, _ := NewTrieStorageManager(memorydb.New(), msh, hsh, cfg, evictionWaitList, generalCfg)
+	newSnapshotsInfo := newTrieStorage.GetSnapshotsInfo()
+	require.Equal(t, 2, len(newSnapshotsInfo))
+	assert.Equal(t, uint32(7), newSnapshotsInfo[0].Epoch)
+	assert.Equal(t, uint32(8), newSnapshotsInfo[1].Epoch)
+	assert.True(t, newSnapshotsInfo[1].IsEpochKnown)
+}
@@ -196,3 +196,18 @@ var ErrNilGracefullyCloseChannel = errors.New("nil gracefully close channel")
 
 // ErrNilSmartContractsPool signals that a nil smart contracts pool has been provided
 var ErrNilSmartContractsPool = errors.New("nil smart contracts pool")
+
+// ErrNilPeersLatencyHandler signals that a nil peers latency handler has been provided
+var ErrNilPeersLatencyHandler = errors.New("nil peers latency handler")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilInterceptedDataFactory signals that a nil intercepted data factory has been provided
+var ErrNilInterceptedDataFactory = errors.New("nil intercepted data factory")
+
+// ErrNilTxValidator signals that a nil tx validator has been provided
+var ErrNilTxValidator = errors.New("nil tx validator")
+
+// ErrInvalidSaveInterval signals that an invalid save interval has been provided
+var ErrInvalidSaveInterval = errors.New("invalid save interval")
@@ -141,6 +141,7 @@ type TopicResolverSender interface {
 	SetNumPeersToQuery(intra int, cross int)
 	SetResolverDebugHandler(handler ResolverDebugHandler) error
 	ResolverDebugHandler() ResolverDebugHandler
+	SetPeersLatencyHandler(handler PeersLatencyHandler) error
 	NumPeersToQuery() (int, int)
 	IsInterfaceNil() bool
 }
@@ -363,3 +364,21 @@ type ResolverDebugHandler interface {
 	LogSucceededToResolveData(topic string, hash []byte)
 	IsInterfaceNil() bool
 }
+
+// PeersLatencyHandler measures how fast the peers answer the requests and orders the peers to be requested, the
+// faster ones having a higher chance to come first
+type PeersLatencyHandler interface {
+	RequestSent(peer core.PeerID, hashes [][]byte)
+	ResponseReceived(peer core.PeerID, hash []byte)
+	OrderPeers(peers []core.PeerID, randomizer IntRandomizer) []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// TxPoolPersister persists the transactions pool so that the pending transactions survive the node restarts
+type TxPoolPersister interface {
+	LoadTransactions()
+	StartPeriodicSave()
+	SaveTransactions() error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// InterceptedTxStub -
+type InterceptedTxStub struct {
+	Tx                  data.TransactionHandler
+	TxHash              []byte
+	SenderShard         uint32
+	ReceiverShard       uint32
+	CheckValidityCalled func() error
+}
+
+// CheckValidity -
+func (its *InterceptedTxStub) CheckValidity() error {
+	if its.CheckValidityCalled != nil {
+		return its.CheckValidityCalled()
+	}
+
+	return nil
+}
+
+// IsForCurrentShard -
+func (its *InterceptedTxStub) IsForCurrentShard() bool {
+	return true
+}
+
+// Hash -
+func (its *InterceptedTxStub) Hash() []byte {
+	return its.TxHash
+}
+
+// Type -
+func (its *InterceptedTxStub) Type() string {
+	return "intercepted tx"
+}
+
+// Identifiers -
+func (its *InterceptedTxStub) Identifiers() [][]byte {
+	return [][]byte{its.TxHash}
+}
+
+// String -
+func (its *InterceptedTxStub) String() string {
+	return "intercepted tx stub"
+}
+
+// SenderShardId -
+func (its *InterceptedTxStub) SenderShardId() uint32 {
+	return its.SenderShard
+}
+
+// ReceiverShardId -
+func (its *InterceptedTxStub) ReceiverShardId() uint32 {
+	return its.ReceiverShard
+}
+
+// Nonce -
+func (its *InterceptedTxStub) Nonce() uint64 {
+	return its.Tx.GetNonce()
+}
+
+// SenderAddress -
+func (its *InterceptedTxStub) SenderAddress() []byte {
+	return its.Tx.GetSndAddr()
+}
+
+// Fee -
+func (its *InterceptedTxStub) Fee() *big.Int {
+	return big.NewInt(0)
+}
+
+// Transaction -
+func (its *InterceptedTxStub) Transaction() data.TransactionHandler {
+	return its.Tx
+}
+
+// IsInterfaceNil -
+func (its *InterceptedTxStub) IsInterfaceNil() bool {
+	return its == nil
+}
@@ -8,12 +8,13 @@ import (
 
 // TopicResolverSenderStub -
 type TopicResolverSenderStub struct {
-	SendOnRequestTopicCalled func(rd *dataRetriever.RequestData, originalHashes [][]byte) error
-	SendCalled               func(buff []byte, peer core.PeerID) error
-	TargetShardIDCalled      func() uint32
-	SetNumPeersToQueryCalled func(intra int, cross int)
-	GetNumPeersToQueryCalled func() (int, int)
-	debugHandler             dataRetriever.ResolverDebugHandler
+	SendOnRequestTopicCalled     func(rd *dataRetriever.RequestData, originalHashes [][]byte) error
+	SendCalled                   func(buff []byte, peer core.PeerID) error
+	TargetShardIDCalled          func() uint32
+	SetNumPeersToQueryCalled     func(intra int, cross int)
+	GetNumPeersToQueryCalled     func() (int, int)
+	SetPeersLatencyHandlerCalled func(handler dataRetriever.PeersLatencyHandler) error
+	debugHandler                 dataRetriever.ResolverDebugHandler
 }
 
 // SetNumPeersToQuery -
@@ -80,6 +81,15 @@ func (trss *TopicResolverSenderStub) SetResolverDebugHandler(handler dataRetriev
 	return nil
 }
 
+// SetPeersLatencyHandler -
+func (trss *TopicResolverSenderStub) SetPeersLatencyHandler(handler dataRetriever.PeersLatencyHandler) error {
+	if trss.SetPeersLatencyHandlerCalled != nil {
+		return trss.SetPeersLatencyHandlerCalled(handler)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (trss *TopicResolverSenderStub) IsInterfaceNil() bool {
 	return trss == nil
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// TxValidatorStub -
+type TxValidatorStub struct {
+	CheckTxValidityCalled  func(txValidatorHandler process.TxValidatorHandler) error
+	CheckTxWhiteListCalled func(data process.InterceptedData) error
+}
+
+// CheckTxValidity -
+func (tvs *TxValidatorStub) CheckTxValidity(txValidatorHandler process.TxValidatorHandler) error {
+	if tvs.CheckTxValidityCalled != nil {
+		return tvs.CheckTxValidityCalled(txValidatorHandler)
+	}
+
+	return nil
+}
+
+// CheckTxWhiteList -
+func (tvs *TxValidatorStub) CheckTxWhiteList(data process.InterceptedData) error {
+	if tvs.CheckTxWhiteListCalled != nil {
+		return tvs.CheckTxWhiteListCalled(data)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tvs *TxValidatorStub) IsInterfaceNil() bool {
+	return tvs == nil
+}
@@ -0,0 +1,45 @@
+package peersLatency
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/random"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.PeersLatencyHandler = (*disabledPeersLatencyTracker)(nil)
+
+type disabledPeersLatencyTracker struct {
+}
+
+// NewDisabledPeersLatencyTracker returns a peers latency handler that measures nothing and shuffles the peers
+func NewDisabledPeersLatencyTracker() *disabledPeersLatencyTracker {
+	return &disabledPeersLatencyTracker{}
+}
+
+// RequestSent does nothing
+func (dplt *disabledPeersLatencyTracker) RequestSent(_ core.PeerID, _ [][]byte) {
+}
+
+// ResponseReceived does nothing
+func (dplt *disabledPeersLatencyTracker) ResponseReceived(_ core.PeerID, _ []byte) {
+}
+
+// OrderPeers returns the peers shuffled
+func (dplt *disabledPeersLatencyTracker) OrderPeers(peers []core.PeerID, randomizer dataRetriever.IntRandomizer) []core.PeerID {
+	indexes := make([]int, len(peers))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	shuffledPeers := make([]core.PeerID, 0, len(peers))
+	for _, index := range random.FisherYatesShuffle(indexes, randomizer) {
+		shuffledPeers = append(shuffledPeers, peers[index])
+	}
+
+	return shuffledPeers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dplt *disabledPeersLatencyTracker) IsInterfaceNil() bool {
+	return dplt == nil
+}
@@ -0,0 +1,252 @@
+package peersLatency
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.PeersLatencyHandler = (*peersLatencyTracker)(nil)
+
+var log = logger.GetOrCreate("dataretriever/peerslatency")
+
+const (
+	minResponseTimeout = time.Millisecond
+	// latencySmoothingFactor is the weight of a new sample in the average latency of a peer
+	latencySmoothingFactor = 0.2
+	// weightScale is divided by the average latency of a peer to get its weight when ordering the peers
+	weightScale = float64(time.Minute)
+)
+
+// ArgPeersLatencyTracker is the argument structure used to create a new peers latency tracker
+type ArgPeersLatencyTracker struct {
+	ResponseTimeout    time.Duration
+	MaxPendingRequests int
+}
+
+type pendingRequest struct {
+	sentTime time.Time
+	peers    map[core.PeerID]struct{}
+}
+
+type peersLatencyTracker struct {
+	mut                sync.Mutex
+	pendingRequests    map[string]*pendingRequest
+	averageLatencies   map[core.PeerID]time.Duration
+	responseTimeout    time.Duration
+	maxPendingRequests int
+	lastSweep          time.Time
+	timeProvider       func() time.Time
+}
+
+// NewPeersLatencyTracker creates a tracker of the time the peers take to answer the requests. The requests not
+// answered within the response timeout count as answered in the response timeout
+func NewPeersLatencyTracker(arg ArgPeersLatencyTracker) (*peersLatencyTracker, error) {
+	if arg.ResponseTimeout < minResponseTimeout {
+		return nil, fmt.Errorf("%w for ResponseTimeout, minimum %v, provided %v",
+			dataRetriever.ErrInvalidValue, minResponseTimeout, arg.ResponseTimeout)
+	}
+	if arg.MaxPendingRequests < 1 {
+		return nil, fmt.Errorf("%w for MaxPendingRequests, provided %d", dataRetriever.ErrInvalidValue, arg.MaxPendingRequests)
+	}
+
+	return &peersLatencyTracker{
+		pendingRequests:    make(map[string]*pendingRequest),
+		averageLatencies:   make(map[core.PeerID]time.Duration),
+		responseTimeout:    arg.ResponseTimeout,
+		maxPendingRequests: arg.MaxPendingRequests,
+		timeProvider:       time.Now,
+	}, nil
+}
+
+// RequestSent records the time the hashes were requested from the peer
+func (plt *peersLatencyTracker) RequestSent(peer core.PeerID, hashes [][]byte) {
+	plt.mut.Lock()
+	defer plt.mut.Unlock()
+
+	now := plt.timeProvider()
+	if now.Sub(plt.lastSweep) >= plt.responseTimeout {
+		plt.sweepExpiredRequests(now)
+	}
+
+	for _, hash := range hashes {
+		request, found := plt.pendingRequests[string(hash)]
+		if !found {
+			if len(plt.pendingRequests) >= plt.maxPendingRequests {
+				log.Trace("peersLatencyTracker.RequestSent: too many pending requests", "num", len(plt.pendingRequests))
+				return
+			}
+
+			request = &pendingRequest{
+				sentTime: now,
+				peers:    make(map[core.PeerID]struct{}),
+			}
+			plt.pendingRequests[string(hash)] = request
+		}
+
+		request.peers[peer] = struct{}{}
+	}
+}
+
+// sweepExpiredRequests penalizes the peers that did not answer within the response timeout
+func (plt *peersLatencyTracker) sweepExpiredRequests(now time.Time) {
+	plt.lastSweep = now
+	for hash, request := range plt.pendingRequests {
+		if now.Sub(request.sentTime) < plt.responseTimeout {
+			continue
+		}
+
+		for peer := range request.peers {
+			plt.addLatencySample(peer, plt.responseTimeout)
+		}
+		delete(plt.pendingRequests, hash)
+	}
+}
+
+// ResponseReceived records the latency of the peer if the hash was requested from it
+func (plt *peersLatencyTracker) ResponseReceived(peer core.PeerID, hash []byte) {
+	plt.mut.Lock()
+	defer plt.mut.Unlock()
+
+	request, found := plt.pendingRequests[string(hash)]
+	if !found {
+		return
+	}
+	_, wasRequested := request.peers[peer]
+	if !wasRequested {
+		return
+	}
+
+	latency := plt.timeProvider().Sub(request.sentTime)
+	if latency > plt.responseTimeout {
+		latency = plt.responseTimeout
+	}
+	plt.addLatencySample(peer, latency)
+
+	delete(request.peers, peer)
+	if len(request.peers) == 0 {
+		delete(plt.pendingRequests, string(hash))
+	}
+}
+
+func (plt *peersLatencyTracker) addLatencySample(peer core.PeerID, latency time.Duration) {
+	average, found := plt.averageLatencies[peer]
+	if !found {
+		plt.averageLatencies[peer] = latency
+		return
+	}
+
+	plt.averageLatencies[peer] = average + time.Duration(latencySmoothingFactor*float64(latency-average))
+}
+
+// OrderPeers returns the peers in a random order in which each peer has a chance to come before the remaining ones
+// proportional to the inverse of its average latency. The peers with no measured latency get the average of the
+// measured ones, so they still get requests
+func (plt *peersLatencyTracker) OrderPeers(peers []core.PeerID, randomizer dataRetriever.IntRandomizer) []core.PeerID {
+	weights := plt.computeWeights(peers)
+
+	remainingPeers := make([]core.PeerID, len(peers))
+	copy(remainingPeers, peers)
+	totalWeight := 0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	orderedPeers := make([]core.PeerID, 0, len(peers))
+	for len(remainingPeers) > 0 {
+		index := pickWeightedIndex(weights, randomizer.Intn(totalWeight))
+		orderedPeers = append(orderedPeers, remainingPeers[index])
+
+		totalWeight -= weights[index]
+		lastIndex := len(remainingPeers) - 1
+		remainingPeers[index] = remainingPeers[lastIndex]
+		remainingPeers = remainingPeers[:lastIndex]
+		weights[index] = weights[lastIndex]
+		weights = weights[:lastIndex]
+	}
+
+	return orderedPeers
+}
+
+func (plt *peersLatencyTracker) computeWeights(peers []core.PeerID) []int {
+	plt.mut.Lock()
+	defer plt.mut.Unlock()
+
+	weights := make([]int, len(peers))
+	sumMeasured := time.Duration(0)
+	numMeasured := 0
+	for i, peer := range peers {
+		latency, found := plt.averageLatencies[peer]
+		if !found {
+			continue
+		}
+
+		weights[i] = computeWeight(latency)
+		sumMeasured += latency
+		numMeasured++
+	}
+
+	defaultLatency := plt.responseTimeout
+	if numMeasured > 0 {
+		defaultLatency = sumMeasured / time.Duration(numMeasured)
+	}
+
+	for i, peer := range peers {
+		_, found := plt.averageLatencies[peer]
+		if !found {
+			weights[i] = computeWeight(defaultLatency)
+		}
+	}
+
+	return weights
+}
+
+func computeWeight(latency time.Duration) int {
+	if latency < time.Microsecond {
+		latency = time.Microsecond
+	}
+
+	weight := int(weightScale / float64(latency))
+	if weight < 1 {
+		return 1
+	}
+
+	return weight
+}
+
+func pickWeightedIndex(weights []int, value int) int {
+	for i, weight := range weights {
+		if value < weight {
+			return i
+		}
+		value -= weight
+	}
+
+	return len(weights) - 1
+}
+
+// AverageLatency returns the average latency of a peer and false if no latency was measured for it
+func (plt *peersLatencyTracker) AverageLatency(peer core.PeerID) (time.Duration, bool) {
+	plt.mut.Lock()
+	defer plt.mut.Unlock()
+
+	latency, found := plt.averageLatencies[peer]
+	return latency, found
+}
+
+// NumPendingRequests returns the number of requested hashes waiting for an answer
+func (plt *peersLatencyTracker) NumPendingRequests() int {
+	plt.mut.Lock()
+	defer plt.mut.Unlock()
+
+	return len(plt.pendingRequests)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (plt *peersLatencyTracker) IsInterfaceNil() bool {
+	return plt == nil
+}
@@ -0,0 +1,159 @@
+package peersLatency
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPeersLatencyTracker() ArgPeersLatencyTracker {
+	return ArgPeersLatencyTracker{
+		ResponseTimeout:    time.Second,
+		MaxPendingRequests: 100,
+	}
+}
+
+type manualClock struct {
+	now time.Time
+}
+
+func (mc *manualClock) advance(duration time.Duration) {
+	mc.now = mc.now.Add(duration)
+}
+
+func createTrackerWithClock(t *testing.T, arg ArgPeersLatencyTracker) (*peersLatencyTracker, *manualClock) {
+	plt, err := NewPeersLatencyTracker(arg)
+	require.Nil(t, err)
+
+	clock := &manualClock{now: time.Unix(1000, 0)}
+	plt.timeProvider = func() time.Time {
+		return clock.now
+	}
+
+	return plt, clock
+}
+
+func TestNewPeersLatencyTracker_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeersLatencyTracker()
+	arg.ResponseTimeout = 0
+	plt, err := NewPeersLatencyTracker(arg)
+	assert.True(t, check.IfNil(plt))
+	assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+
+	arg = createMockArgPeersLatencyTracker()
+	arg.MaxPendingRequests = 0
+	plt, err = NewPeersLatencyTracker(arg)
+	assert.True(t, check.IfNil(plt))
+	assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+}
+
+func TestNewPeersLatencyTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	plt, err := NewPeersLatencyTracker(createMockArgPeersLatencyTracker())
+	assert.False(t, check.IfNil(plt))
+	assert.Nil(t, err)
+}
+
+func TestPeersLatencyTracker_ResponseReceivedShouldRecordTheLatency(t *testing.T) {
+	t.Parallel()
+
+	plt, clock := createTrackerWithClock(t, createMockArgPeersLatencyTracker())
+	plt.RequestSent("pid1", [][]byte{[]byte("hash1"), []byte("hash2")})
+	plt.RequestSent("pid2", [][]byte{[]byte("hash1")})
+
+	clock.advance(100 * time.Millisecond)
+	plt.ResponseReceived("pid1", []byte("hash1"))
+	plt.ResponseReceived("pid3", []byte("hash1"))
+	plt.ResponseReceived("pid1", []byte("not requested"))
+
+	latency, found := plt.AverageLatency("pid1")
+	assert.True(t, found)
+	assert.Equal(t, 100*time.Millisecond, latency)
+	_, found = plt.AverageLatency("pid3")
+	assert.False(t, found)
+	assert.Equal(t, 2, plt.NumPendingRequests())
+
+	clock.advance(100 * time.Millisecond)
+	plt.ResponseReceived("pid1", []byte("hash2"))
+
+	latency, _ = plt.AverageLatency("pid1")
+	assert.Equal(t, 120*time.Millisecond, latency)
+	assert.Equal(t, 1, plt.NumPendingRequests())
+}
+
+func TestPeersLatencyTracker_UnansweredRequestsShouldCountAsTimeouts(t *testing.T) {
+	t.Parallel()
+
+	plt, clock := createTrackerWithClock(t, createMockArgPeersLatencyTracker())
+	plt.RequestSent("pid1", [][]byte{[]byte("hash1")})
+
+	clock.advance(2 * time.Second)
+	plt.RequestSent("pid2", [][]byte{[]byte("hash2")})
+
+	latency, found := plt.AverageLatency("pid1")
+	assert.True(t, found)
+	assert.Equal(t, time.Second, latency)
+	assert.Equal(t, 1, plt.NumPendingRequests())
+}
+
+func TestPeersLatencyTracker_RequestSentShouldNotExceedMaxPendingRequests(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeersLatencyTracker()
+	arg.MaxPendingRequests = 2
+	plt, _ := createTrackerWithClock(t, arg)
+
+	plt.RequestSent("pid1", [][]byte{[]byte("hash1"), []byte("hash2"), []byte("hash3")})
+	plt.RequestSent("pid2", [][]byte{[]byte("hash1")})
+
+	assert.Equal(t, 2, plt.NumPendingRequests())
+}
+
+func TestPeersLatencyTracker_OrderPeersShouldFavorTheFasterPeers(t *testing.T) {
+	t.Parallel()
+
+	plt, clock := createTrackerWithClock(t, createMockArgPeersLatencyTracker())
+	plt.RequestSent("fast", [][]byte{[]byte("hash1")})
+	plt.RequestSent("slow", [][]byte{[]byte("hash2")})
+	clock.advance(10 * time.Millisecond)
+	plt.ResponseReceived("fast", []byte("hash1"))
+	clock.advance(990 * time.Millisecond)
+	plt.ResponseReceived("slow", []byte("hash2"))
+
+	peers := []core.PeerID{"slow", "unknown", "fast"}
+	numFirst := make(map[core.PeerID]int)
+	randomizer := &mock.IntRandomizerStub{}
+	counter := 0
+	randomizer.IntnCalled = func(n int) int {
+		counter = (counter*7919 + 104729) % 1000003
+		return counter % n
+	}
+	for i := 0; i < 1000; i++ {
+		orderedPeers := plt.OrderPeers(peers, randomizer)
+		require.Equal(t, len(peers), len(orderedPeers))
+		assert.ElementsMatch(t, peers, orderedPeers)
+		numFirst[orderedPeers[0]]++
+	}
+
+	assert.True(t, numFirst["fast"] > numFirst["unknown"])
+	assert.True(t, numFirst["unknown"] > numFirst["slow"])
+	assert.True(t, numFirst["slow"] > 0)
+}
+
+func TestPeersLatencyTracker_OrderPeersEmptyListShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	plt, _ := createTrackerWithClock(t, createMockArgPeersLatencyTracker())
+
+	assert.Equal(t, 0, len(plt.OrderPeers(make([]core.PeerID, 0), &mock.IntRandomizerStub{})))
+}
@@ -7,8 +7,8 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
-	"github.com/ElrondNetwork/elrond-go/core/random"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersLatency"
 	resolverDebug "github.com/ElrondNetwork/elrond-go/debug/resolver"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -49,6 +49,8 @@ type topicResolverSender struct {
 	numCrossShardPeers      int
 	mutResolverDebugHandler sync.RWMutex
 	resolverDebugHandler    dataRetriever.ResolverDebugHandler
+	mutPeersLatencyHandler  sync.RWMutex
+	peersLatencyHandler     dataRetriever.PeersLatencyHandler
 }
 
 // NewTopicResolverSender returns a new topic resolver instance
@@ -93,6 +95,7 @@ func NewTopicResolverSender(arg ArgTopicResolverSender) (*topicResolverSender, e
 		numCrossShardPeers: arg.NumCrossShardPeers,
 	}
 	resolver.resolverDebugHandler = resolverDebug.NewDisabledInterceptorResolver()
+	resolver.peersLatencyHandler = peersLatency.NewDisabledPeersLatencyTracker()
 
 	return resolver, nil
 }
@@ -108,10 +111,10 @@ func (trs *topicResolverSender) SendOnRequestTopic(rd *dataRetriever.RequestData
 	topicToSendRequest := trs.topicName + topicRequestSuffix
 
 	crossPeers := trs.peerListCreator.PeerList()
-	numSentCross := trs.sendOnTopic(crossPeers, topicToSendRequest, buff, trs.numCrossShardPeers, "cross peer")
+	numSentCross := trs.sendOnTopic(crossPeers, topicToSendRequest, buff, originalHashes, trs.numCrossShardPeers, "cross peer")
 
 	intraPeers := trs.peerListCreator.IntraShardPeerList()
-	numSentIntra := trs.sendOnTopic(intraPeers, topicToSendRequest, buff, trs.numIntraShardPeers, "intra peer")
+	numSentIntra := trs.sendOnTopic(intraPeers, topicToSendRequest, buff, originalHashes, trs.numIntraShardPeers, "intra peer")
 
 	trs.callDebugHandler(originalHashes, numSentIntra, numSentCross)
 
@@ -133,33 +136,31 @@ func (trs *topicResolverSender) callDebugHandler(originalHashes [][]byte, numSen
 	trs.resolverDebugHandler.LogRequestedData(trs.topicName, originalHashes, numSentIntra, numSentCross)
 }
 
-func createIndexList(listLength int) []int {
-	indexes := make([]int, listLength)
-	for i := 0; i < listLength; i++ {
-		indexes[i] = i
-	}
-
-	return indexes
-}
-
-func (trs *topicResolverSender) sendOnTopic(peerList []core.PeerID, topicToSendRequest string, buff []byte, maxToSend int, peerType string) int {
+func (trs *topicResolverSender) sendOnTopic(
+	peerList []core.PeerID,
+	topicToSendRequest string,
+	buff []byte,
+	originalHashes [][]byte,
+	maxToSend int,
+	peerType string,
+) int {
 	if len(peerList) == 0 || maxToSend == 0 {
 		return 0
 	}
 
-	indexes := createIndexList(len(peerList))
-	shuffledIndexes := random.FisherYatesShuffle(indexes, trs.randomizer)
+	latencyHandler := trs.getPeersLatencyHandler()
+	orderedPeers := latencyHandler.OrderPeers(peerList, trs.randomizer)
 
 	logData := make([]interface{}, 0)
 	msgSentCounter := 0
-	for _, shuffledIndex := range shuffledIndexes {
-		peer := peerList[shuffledIndex]
-
+	for _, peer := range orderedPeers {
 		err := trs.sendToConnectedPeer(topicToSendRequest, buff, peer)
 		if err != nil {
 			continue
 		}
 
+		latencyHandler.RequestSent(peer, originalHashes)
+
 		logData = append(logData, peerType)
 		logData = append(logData, peer.Pretty())
 		msgSentCounter++
@@ -218,6 +219,26 @@ func (trs *topicResolverSender) SetResolverDebugHandler(handler dataRetriever.Re
 	return nil
 }
 
+func (trs *topicResolverSender) getPeersLatencyHandler() dataRetriever.PeersLatencyHandler {
+	trs.mutPeersLatencyHandler.RLock()
+	defer trs.mutPeersLatencyHandler.RUnlock()
+
+	return trs.peersLatencyHandler
+}
+
+// SetPeersLatencyHandler sets the handler used to pick the peers the requests are sent to
+func (trs *topicResolverSender) SetPeersLatencyHandler(handler dataRetriever.PeersLatencyHandler) error {
+	if check.IfNil(handler) {
+		return dataRetriever.ErrNilPeersLatencyHandler
+	}
+
+	trs.mutPeersLatencyHandler.Lock()
+	trs.peersLatencyHandler = handler
+	trs.mutPeersLatencyHandler.Unlock()
+
+	return nil
+}
+
 // RequestTopic returns the topic with the request suffix used for sending requests
 func (trs *topicResolverSender) RequestTopic() string {
 	return trs.topicName + topicRequestSuffix
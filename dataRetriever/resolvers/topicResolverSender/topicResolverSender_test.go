@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -450,6 +451,66 @@ func TestTopicResolverSender_SetResolverDebugHandlerNilShouldErr(t *testing.T) {
 	assert.Equal(t, dataRetriever.ErrNilResolverDebugHandler, err)
 }
 
+func TestTopicResolverSender_SetPeersLatencyHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTopicResolverSender()
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SetPeersLatencyHandler(nil)
+	assert.Equal(t, dataRetriever.ErrNilPeersLatencyHandler, err)
+}
+
+func TestTopicResolverSender_SendOnRequestTopicShouldUsePeersLatencyHandler(t *testing.T) {
+	t.Parallel()
+
+	crossPeers := []core.PeerID{"cross1", "cross2", "cross3"}
+	intraPeers := []core.PeerID{"intra1", "intra2", "intra3"}
+	sentToPeers := make([]core.PeerID, 0)
+	arg := createMockArgTopicResolverSender()
+	arg.NumCrossShardPeers = 1
+	arg.NumIntraShardPeers = 1
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentToPeers = append(sentToPeers, peerID)
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		PeerListCalled: func() []core.PeerID {
+			return crossPeers
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return intraPeers
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	requestedPeers := make([]core.PeerID, 0)
+	handler := &testscommon.PeersLatencyHandlerStub{
+		OrderPeersCalled: func(peers []core.PeerID, randomizer dataRetriever.IntRandomizer) []core.PeerID {
+			reversed := make([]core.PeerID, 0, len(peers))
+			for i := len(peers) - 1; i >= 0; i-- {
+				reversed = append(reversed, peers[i])
+			}
+			return reversed
+		},
+		RequestSentCalled: func(peer core.PeerID, hashes [][]byte) {
+			requestedPeers = append(requestedPeers, peer)
+			assert.Equal(t, defaultHashes, hashes)
+		},
+	}
+	err := trs.SetPeersLatencyHandler(handler)
+	assert.Nil(t, err)
+
+	err = trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+	assert.Nil(t, err)
+
+	expectedPeers := []core.PeerID{"cross3", "intra3"}
+	assert.Equal(t, expectedPeers, sentToPeers)
+	assert.Equal(t, expectedPeers, requestedPeers)
+}
+
 func TestTopicResolverSender_NumPeersToQueryr(t *testing.T) {
 	t.Parallel()
 
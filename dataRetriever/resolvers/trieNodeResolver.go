@@ -253,6 +253,11 @@ func (tnRes *TrieNodeResolver) SetResolverDebugHandler(handler dataRetriever.Res
 	return tnRes.TopicResolverSender.SetResolverDebugHandler(handler)
 }
 
+// SetPeersLatencyHandler sets the handler used to pick the peers the trie nodes are requested from
+func (tnRes *TrieNodeResolver) SetPeersLatencyHandler(handler dataRetriever.PeersLatencyHandler) error {
+	return tnRes.TopicResolverSender.SetPeersLatencyHandler(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tnRes *TrieNodeResolver) IsInterfaceNil() bool {
 	return tnRes == nil
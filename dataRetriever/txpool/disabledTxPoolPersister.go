@@ -0,0 +1,39 @@
+package txpool
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.TxPoolPersister = (*disabledTxPoolPersister)(nil)
+
+type disabledTxPoolPersister struct {
+}
+
+// NewDisabledTxPoolPersister creates a transactions pool persister which does nothing, used when the persistence of
+// the transactions pool is disabled
+func NewDisabledTxPoolPersister() *disabledTxPoolPersister {
+	return &disabledTxPoolPersister{}
+}
+
+// LoadTransactions does nothing
+func (dtpp *disabledTxPoolPersister) LoadTransactions() {
+}
+
+// StartPeriodicSave does nothing
+func (dtpp *disabledTxPoolPersister) StartPeriodicSave() {
+}
+
+// SaveTransactions does nothing and returns nil
+func (dtpp *disabledTxPoolPersister) SaveTransactions() error {
+	return nil
+}
+
+// Close does nothing and returns nil
+func (dtpp *disabledTxPoolPersister) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtpp *disabledTxPoolPersister) IsInterfaceNil() bool {
+	return dtpp == nil
+}
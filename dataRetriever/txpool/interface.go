@@ -1,6 +1,8 @@
 package txpool
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
@@ -16,3 +18,16 @@ type txCache interface {
 	NumBytes() int
 	Diagnose(deep bool)
 }
+
+// PersistableTxPool defines the transactions pool operations needed to persist and reload its transactions
+type PersistableTxPool interface {
+	ForEachTransaction(handler func(tx *txcache.WrappedTransaction))
+	AddData(key []byte, data interface{}, sizeInBytes int, cacheID string)
+	IsInterfaceNil() bool
+}
+
+type interceptedTransaction interface {
+	process.InterceptedData
+	process.TxValidatorHandler
+	Transaction() data.TransactionHandler
+}
@@ -15,6 +15,7 @@ import (
 )
 
 var _ dataRetriever.ShardedDataCacherNotifier = (*shardedTxPool)(nil)
+var _ PersistableTxPool = (*shardedTxPool)(nil)
 
 var log = logger.GetOrCreate("txpool")
 
@@ -287,6 +288,18 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 	txPool.mutexBackingMap.Unlock()
 }
 
+// ForEachTransaction iterates over the transactions of all the caches. The handler must not call back into the pool
+func (txPool *shardedTxPool) ForEachTransaction(handler func(tx *txcache.WrappedTransaction)) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		shard.Cache.ForEachTransaction(func(_ []byte, tx *txcache.WrappedTransaction) {
+			handler(tx)
+		})
+	}
+}
+
 // Clear clears everything in the pool
 func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
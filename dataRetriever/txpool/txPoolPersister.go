@@ -0,0 +1,267 @@
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ dataRetriever.TxPoolPersister = (*txPoolPersister)(nil)
+
+// ArgsTxPoolPersister holds the arguments needed to create a transactions pool persister
+type ArgsTxPoolPersister struct {
+	TxPool      PersistableTxPool
+	Persister   storage.Persister
+	Marshalizer marshal.Marshalizer
+	// TxDataFactory creates the intercepted transactions used to check again the reloaded transactions
+	TxDataFactory        process.InterceptedDataFactory
+	TxValidator          process.TxValidator
+	SelfShardID          uint32
+	IncludeCrossShardTxs bool
+	SaveInterval         time.Duration
+}
+
+// txPoolPersister keeps in the persister, under their hashes, the transactions of the pool sent from the self shard
+// and, optionally, the ones sent from other shards to the self shard
+type txPoolPersister struct {
+	txPool               PersistableTxPool
+	persister            storage.Persister
+	marshalizer          marshal.Marshalizer
+	txDataFactory        process.InterceptedDataFactory
+	txValidator          process.TxValidator
+	selfShardID          uint32
+	includeCrossShardTxs bool
+	saveInterval         time.Duration
+
+	mutPersisted    sync.Mutex
+	persistedHashes map[string]struct{}
+	closed          bool
+	cancelFunc      func()
+}
+
+// NewTxPoolPersister creates a component able to persist the transactions pool and to reload it on startup
+func NewTxPoolPersister(args ArgsTxPoolPersister) (*txPoolPersister, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, dataRetriever.ErrNilTxDataPool
+	}
+	if check.IfNil(args.Persister) {
+		return nil, dataRetriever.ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxDataFactory) {
+		return nil, dataRetriever.ErrNilInterceptedDataFactory
+	}
+	if check.IfNil(args.TxValidator) {
+		return nil, dataRetriever.ErrNilTxValidator
+	}
+	if args.SaveInterval <= 0 {
+		return nil, fmt.Errorf("%w: %v", dataRetriever.ErrInvalidSaveInterval, args.SaveInterval)
+	}
+
+	return &txPoolPersister{
+		txPool:               args.TxPool,
+		persister:            args.Persister,
+		marshalizer:          args.Marshalizer,
+		txDataFactory:        args.TxDataFactory,
+		txValidator:          args.TxValidator,
+		selfShardID:          args.SelfShardID,
+		includeCrossShardTxs: args.IncludeCrossShardTxs,
+		saveInterval:         args.SaveInterval,
+		persistedHashes:      make(map[string]struct{}),
+	}, nil
+}
+
+// LoadTransactions adds the persisted transactions back to the pool. Each transaction is checked again as if it was
+// received from the network, the sender nonce and balance being compared against the current state, so it should be
+// called after the node loaded its last committed block
+func (tpp *txPoolPersister) LoadTransactions() {
+	tpp.mutPersisted.Lock()
+	defer tpp.mutPersisted.Unlock()
+
+	numLoaded, numRejected := 0, 0
+	tpp.persister.RangeKeys(func(key []byte, val []byte) bool {
+		tpp.persistedHashes[string(key)] = struct{}{}
+
+		err := tpp.loadTransaction(key, val)
+		if err != nil {
+			log.Trace("txPoolPersister.LoadTransactions: rejected transaction", "hash", key, "error", err)
+			numRejected++
+			return true
+		}
+
+		numLoaded++
+		return true
+	})
+
+	log.Info("loaded the persisted transactions pool", "num loaded", numLoaded, "num rejected", numRejected)
+}
+
+func (tpp *txPoolPersister) loadTransaction(txHash []byte, buff []byte) error {
+	interceptedData, err := tpp.txDataFactory.Create(buff)
+	if err != nil {
+		return err
+	}
+
+	interceptedTx, ok := interceptedData.(interceptedTransaction)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	if !bytes.Equal(interceptedTx.Hash(), txHash) {
+		return fmt.Errorf("%w: the persisted transaction hash does not match its content", dataRetriever.ErrInvalidValue)
+	}
+	if !tpp.shouldPersist(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId()) {
+		return fmt.Errorf("%w: the transaction is not persisted for its shards", dataRetriever.ErrInvalidValue)
+	}
+
+	err = interceptedTx.CheckValidity()
+	if err != nil {
+		return err
+	}
+	err = tpp.txValidator.CheckTxValidity(interceptedTx)
+	if err != nil {
+		return err
+	}
+
+	cacheID := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	tpp.txPool.AddData(txHash, interceptedTx.Transaction(), interceptedTx.Transaction().Size(), cacheID)
+
+	return nil
+}
+
+// StartPeriodicSave starts saving the transactions pool every save interval, until the persister is closed
+func (tpp *txPoolPersister) StartPeriodicSave() {
+	var ctx context.Context
+	ctx, tpp.cancelFunc = context.WithCancel(context.Background())
+
+	go tpp.saveContinuously(ctx)
+}
+
+func (tpp *txPoolPersister) saveContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolPersister's go routine is stopping...")
+			return
+		case <-time.After(tpp.saveInterval):
+		}
+
+		err := tpp.SaveTransactions()
+		if err != nil {
+			log.Warn("txPoolPersister.SaveTransactions", "error", err)
+		}
+	}
+}
+
+// SaveTransactions brings the persister in sync with the transactions pool: the new transactions are added and the
+// ones no longer in the pool are removed. It does nothing after the persister was closed
+func (tpp *txPoolPersister) SaveTransactions() error {
+	tpp.mutPersisted.Lock()
+	defer tpp.mutPersisted.Unlock()
+
+	if tpp.closed {
+		return nil
+	}
+
+	return tpp.saveTransactions()
+}
+
+func (tpp *txPoolPersister) saveTransactions() error {
+	txs := make(map[string]*txcache.WrappedTransaction)
+	tpp.txPool.ForEachTransaction(func(tx *txcache.WrappedTransaction) {
+		if tpp.shouldPersist(tx.SenderShardID, tx.ReceiverShardID) {
+			txs[string(tx.TxHash)] = tx
+		}
+	})
+
+	numRemoved := 0
+	for txHash := range tpp.persistedHashes {
+		_, isInPool := txs[txHash]
+		if isInPool {
+			continue
+		}
+
+		err := tpp.persister.Remove([]byte(txHash))
+		if err != nil {
+			return err
+		}
+
+		delete(tpp.persistedHashes, txHash)
+		numRemoved++
+	}
+
+	numAdded := 0
+	for txHash, tx := range txs {
+		_, isPersisted := tpp.persistedHashes[txHash]
+		if isPersisted {
+			continue
+		}
+
+		buff, err := tpp.marshalizer.Marshal(tx.Tx)
+		if err != nil {
+			return err
+		}
+
+		err = tpp.persister.Put(tx.TxHash, buff)
+		if err != nil {
+			return err
+		}
+
+		tpp.persistedHashes[txHash] = struct{}{}
+		numAdded++
+	}
+
+	log.Debug("saved the transactions pool",
+		"num persisted", len(tpp.persistedHashes),
+		"num added", numAdded,
+		"num removed", numRemoved,
+	)
+
+	return nil
+}
+
+func (tpp *txPoolPersister) shouldPersist(senderShardID uint32, receiverShardID uint32) bool {
+	if senderShardID == tpp.selfShardID {
+		return true
+	}
+
+	return tpp.includeCrossShardTxs && receiverShardID == tpp.selfShardID
+}
+
+// Close stops the periodic save, saves the transactions pool one last time and closes the persister. A periodic save
+// still running will find the persister closed and return early
+func (tpp *txPoolPersister) Close() error {
+	if tpp.cancelFunc != nil {
+		tpp.cancelFunc()
+	}
+
+	tpp.mutPersisted.Lock()
+	defer tpp.mutPersisted.Unlock()
+
+	if tpp.closed {
+		return nil
+	}
+
+	err := tpp.saveTransactions()
+	if err != nil {
+		log.Warn("txPoolPersister.Close: could not save the transactions pool", "error", err)
+	}
+
+	tpp.closed = true
+	return tpp.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *txPoolPersister) IsInterfaceNil() bool {
+	return tpp == nil
+}
@@ -0,0 +1,272 @@
+package txpool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func createTxForShards(txHash string, nonce uint64, senderShardID uint32, receiverShardID uint32) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:   nonce,
+		SndAddr: []byte{byte(senderShardID), 's', 'n', 'd'},
+		RcvAddr: []byte{byte(receiverShardID), 'r', 'c', 'v'},
+		Data:    []byte(txHash),
+	}
+}
+
+func addTxToPool(pool dataRetriever.ShardedDataCacherNotifier, tx *transaction.Transaction) {
+	cacheID := process.ShardCacherIdentifier(uint32(tx.SndAddr[0]), uint32(tx.RcvAddr[0]))
+	pool.AddData(tx.Data, tx, tx.Size(), cacheID)
+}
+
+func createArgsTxPoolPersister(pool dataRetriever.ShardedDataCacherNotifier, persister storage.Persister) ArgsTxPoolPersister {
+	marshalizer := &mock.MarshalizerMock{}
+
+	return ArgsTxPoolPersister{
+		TxPool:      pool.(*shardedTxPool),
+		Persister:   persister,
+		Marshalizer: marshalizer,
+		TxDataFactory: &mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+				tx := &transaction.Transaction{}
+				err := marshalizer.Unmarshal(tx, buff)
+				if err != nil {
+					return nil, err
+				}
+
+				return &mock.InterceptedTxStub{
+					Tx:            tx,
+					TxHash:        tx.Data,
+					SenderShard:   uint32(tx.SndAddr[0]),
+					ReceiverShard: uint32(tx.RcvAddr[0]),
+				}, nil
+			},
+		},
+		TxValidator:  &mock.TxValidatorStub{},
+		SelfShardID:  0,
+		SaveInterval: time.Second,
+	}
+}
+
+func persistedHashes(persister storage.Persister) map[string]struct{} {
+	hashes := make(map[string]struct{})
+	persister.RangeKeys(func(key []byte, _ []byte) bool {
+		hashes[string(key)] = struct{}{}
+		return true
+	})
+
+	return hashes
+}
+
+func Test_NewTxPoolPersister(t *testing.T) {
+	pool, _ := newTxPoolToTest()
+
+	args := createArgsTxPoolPersister(pool, memorydb.New())
+	args.TxPool = nil
+	tpp, err := NewTxPoolPersister(args)
+	require.True(t, check.IfNil(tpp))
+	require.Equal(t, dataRetriever.ErrNilTxDataPool, err)
+
+	args = createArgsTxPoolPersister(pool, nil)
+	_, err = NewTxPoolPersister(args)
+	require.Equal(t, dataRetriever.ErrNilPersister, err)
+
+	args = createArgsTxPoolPersister(pool, memorydb.New())
+	args.Marshalizer = nil
+	_, err = NewTxPoolPersister(args)
+	require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+
+	args = createArgsTxPoolPersister(pool, memorydb.New())
+	args.TxDataFactory = nil
+	_, err = NewTxPoolPersister(args)
+	require.Equal(t, dataRetriever.ErrNilInterceptedDataFactory, err)
+
+	args = createArgsTxPoolPersister(pool, memorydb.New())
+	args.TxValidator = nil
+	_, err = NewTxPoolPersister(args)
+	require.Equal(t, dataRetriever.ErrNilTxValidator, err)
+
+	args = createArgsTxPoolPersister(pool, memorydb.New())
+	args.SaveInterval = 0
+	_, err = NewTxPoolPersister(args)
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidSaveInterval))
+
+	args = createArgsTxPoolPersister(pool, memorydb.New())
+	tpp, err = NewTxPoolPersister(args)
+	require.False(t, check.IfNil(tpp))
+	require.Nil(t, err)
+}
+
+func Test_TxPoolPersister_SaveAndLoadShouldRestoreTheSelfShardTxs(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+	addTxToPool(pool, createTxForShards("intra", 1, 0, 0))
+	addTxToPool(pool, createTxForShards("cross-from-me", 2, 0, 1))
+	addTxToPool(pool, createTxForShards("cross-to-me", 3, 1, 0))
+
+	tpp, _ := NewTxPoolPersister(createArgsTxPoolPersister(pool, db))
+	tpp.LoadTransactions()
+	err := tpp.SaveTransactions()
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"intra": {}, "cross-from-me": {}}, persistedHashes(db))
+
+	restartedPool, _ := newTxPoolToTest()
+	tpp, _ = NewTxPoolPersister(createArgsTxPoolPersister(restartedPool, db))
+	tpp.LoadTransactions()
+
+	tx, ok := restartedPool.SearchFirstData([]byte("intra"))
+	require.True(t, ok)
+	require.Equal(t, uint64(1), tx.(*transaction.Transaction).Nonce)
+	_, ok = restartedPool.SearchFirstData([]byte("cross-from-me"))
+	require.True(t, ok)
+	_, ok = restartedPool.SearchFirstData([]byte("cross-to-me"))
+	require.False(t, ok)
+	require.Equal(t, int64(2), restartedPool.GetCounts().GetTotal())
+}
+
+func Test_TxPoolPersister_IncludeCrossShardTxsShouldRestoreTheTxsSentToMe(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+	addTxToPool(pool, createTxForShards("cross-to-me", 3, 1, 0))
+	addTxToPool(pool, createTxForShards("cross-between-others", 4, 1, 2))
+
+	args := createArgsTxPoolPersister(pool, db)
+	args.IncludeCrossShardTxs = true
+	tpp, _ := NewTxPoolPersister(args)
+	_ = tpp.SaveTransactions()
+	require.Equal(t, map[string]struct{}{"cross-to-me": {}}, persistedHashes(db))
+
+	restartedPool, _ := newTxPoolToTest()
+	args = createArgsTxPoolPersister(restartedPool, db)
+	args.IncludeCrossShardTxs = true
+	tpp, _ = NewTxPoolPersister(args)
+	tpp.LoadTransactions()
+
+	_, ok := restartedPool.ShardDataStore("1_0").Get([]byte("cross-to-me"))
+	require.True(t, ok)
+}
+
+func Test_TxPoolPersister_SaveShouldRemoveTheTxsNoLongerInPool(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+	addTxToPool(pool, createTxForShards("processed", 1, 0, 0))
+	addTxToPool(pool, createTxForShards("pending", 2, 0, 0))
+
+	tpp, _ := NewTxPoolPersister(createArgsTxPoolPersister(pool, db))
+	_ = tpp.SaveTransactions()
+	require.Equal(t, 2, len(persistedHashes(db)))
+
+	pool.RemoveDataFromAllShards([]byte("processed"))
+	addTxToPool(pool, createTxForShards("new", 3, 0, 0))
+	_ = tpp.SaveTransactions()
+	require.Equal(t, map[string]struct{}{"pending": {}, "new": {}}, persistedHashes(db))
+}
+
+func Test_TxPoolPersister_LoadShouldRejectTheTxsNoLongerValid(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+	addTxToPool(pool, createTxForShards("nonce too low", 1, 0, 0))
+	addTxToPool(pool, createTxForShards("valid", 2, 0, 0))
+	addTxToPool(pool, createTxForShards("nil signature", 3, 0, 0))
+	tpp, _ := NewTxPoolPersister(createArgsTxPoolPersister(pool, db))
+	_ = tpp.SaveTransactions()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tamperedTx, _ := marshalizer.Marshal(createTxForShards("other hash", 4, 0, 0))
+	_ = db.Put([]byte("tampered"), tamperedTx)
+
+	restartedPool, _ := newTxPoolToTest()
+	args := createArgsTxPoolPersister(restartedPool, db)
+	createIntercepted := args.TxDataFactory.(*mock.InterceptedDataFactoryStub).CreateCalled
+	args.TxDataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+			interceptedData, err := createIntercepted(buff)
+			if err != nil {
+				return nil, err
+			}
+
+			interceptedTx := interceptedData.(*mock.InterceptedTxStub)
+			if interceptedTx.Tx.GetNonce() == 3 {
+				interceptedTx.CheckValidityCalled = func() error {
+					return process.ErrNilSignature
+				}
+			}
+
+			return interceptedTx, nil
+		},
+	}
+	args.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+			if txValidatorHandler.Nonce() < 2 {
+				return process.ErrWrongTransaction
+			}
+
+			return nil
+		},
+	}
+	tpp, _ = NewTxPoolPersister(args)
+	tpp.LoadTransactions()
+
+	require.Equal(t, int64(1), restartedPool.GetCounts().GetTotal())
+	_, ok := restartedPool.SearchFirstData([]byte("valid"))
+	require.True(t, ok)
+
+	_ = tpp.SaveTransactions()
+	require.Equal(t, map[string]struct{}{"valid": {}}, persistedHashes(db))
+}
+
+func Test_TxPoolPersister_PeriodicSaveAndClose(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+
+	args := createArgsTxPoolPersister(pool, db)
+	args.SaveInterval = 10 * time.Millisecond
+	tpp, _ := NewTxPoolPersister(args)
+	tpp.StartPeriodicSave()
+
+	addTxToPool(pool, createTxForShards("periodic", 1, 0, 0))
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, map[string]struct{}{"periodic": {}}, persistedHashes(db))
+
+	addTxToPool(pool, createTxForShards("on close", 2, 0, 0))
+	err := tpp.Close()
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"periodic": {}, "on close": {}}, persistedHashes(db))
+}
+
+func Test_TxPoolPersister_SaveAfterCloseShouldNotTouchThePersister(t *testing.T) {
+	db := memorydb.New()
+	pool, _ := newTxPoolToTest()
+
+	tpp, _ := NewTxPoolPersister(createArgsTxPoolPersister(pool, db))
+	addTxToPool(pool, createTxForShards("on close", 1, 0, 0))
+	err := tpp.Close()
+	require.Nil(t, err)
+
+	pool.Clear()
+	addTxToPool(pool, createTxForShards("after close", 2, 0, 0))
+	err = tpp.SaveTransactions()
+	require.Nil(t, err)
+	require.Nil(t, tpp.Close())
+	require.Equal(t, map[string]struct{}{"on close": {}}, persistedHashes(db))
+}
+
+func Test_DisabledTxPoolPersister(t *testing.T) {
+	dtpp := NewDisabledTxPoolPersister()
+	require.False(t, check.IfNil(dtpp))
+
+	dtpp.LoadTransactions()
+	dtpp.StartPeriodicSave()
+	require.Nil(t, dtpp.SaveTransactions())
+	require.Nil(t, dtpp.Close())
+}
@@ -0,0 +1,306 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var _ data.TrieSyncCheckpointHandler = (*bootstrapCheckpoint)(nil)
+
+const bootstrapCheckpointFilename = "bootstrapcheckpoint.json"
+const timeBetweenCheckpointSaves = 10 * time.Second
+
+type checkpointHeader struct {
+	Hash    []byte
+	ShardID uint32
+	Header  []byte
+}
+
+type checkpointPendingHashes struct {
+	RootHash []byte
+	Hashes   [][]byte
+}
+
+type checkpointData struct {
+	Epoch              uint32
+	EpochStartMetaHash []byte
+	Headers            []checkpointHeader
+	SyncedTries        [][]byte
+	PendingHashes      []checkpointPendingHashes
+}
+
+// bootstrapCheckpoint records the progress of the start in epoch bootstrap in a file so a bootstrap interrupted while
+// syncing the tries can be resumed. The progress is kept only for the same epoch start meta block
+type bootstrapCheckpoint struct {
+	mut                sync.Mutex
+	filePath           string
+	marshalizer        marshal.Marshalizer
+	hasher             hashing.Hasher
+	epoch              uint32
+	epochStartMetaHash []byte
+	headers            map[string]checkpointHeader
+	syncedTries        map[string]struct{}
+	pendingHashes      map[string][][]byte
+	resumed            bool
+	lastSave           time.Time
+	timeBetweenSaves   time.Duration
+}
+
+func newBootstrapCheckpoint(directory string, marshalizer marshal.Marshalizer, hasher hashing.Hasher) *bootstrapCheckpoint {
+	bc := &bootstrapCheckpoint{
+		filePath:         filepath.Join(directory, bootstrapCheckpointFilename),
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		timeBetweenSaves: timeBetweenCheckpointSaves,
+	}
+	bc.reset()
+
+	return bc
+}
+
+func (bc *bootstrapCheckpoint) reset() {
+	bc.headers = make(map[string]checkpointHeader)
+	bc.syncedTries = make(map[string]struct{})
+	bc.pendingHashes = make(map[string][][]byte)
+	bc.resumed = false
+}
+
+// start loads the progress saved by a previous run for the provided epoch start meta block. The progress saved for
+// another epoch start meta block is discarded. Returns true if the bootstrap is resumed
+func (bc *bootstrapCheckpoint) start(epochStartMeta *block.MetaBlock) (bool, error) {
+	metaHash, err := core.CalculateHash(bc.marshalizer, bc.hasher, epochStartMeta)
+	if err != nil {
+		return false, err
+	}
+
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	bc.reset()
+	bc.epoch = epochStartMeta.Epoch
+	bc.epochStartMetaHash = metaHash
+
+	saved := &checkpointData{}
+	err = core.LoadJsonFile(saved, bc.filePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("bootstrap checkpoint can not be loaded, starting from scratch", "file", bc.filePath, "error", err)
+		}
+		return false, nil
+	}
+	if !bytes.Equal(saved.EpochStartMetaHash, metaHash) {
+		log.Debug("bootstrap checkpoint saved for another epoch start meta block, starting from scratch",
+			"saved epoch", saved.Epoch, "epoch", epochStartMeta.Epoch)
+		return false, nil
+	}
+
+	for _, hdr := range saved.Headers {
+		bc.headers[string(hdr.Hash)] = hdr
+	}
+	for _, rootHash := range saved.SyncedTries {
+		bc.syncedTries[string(rootHash)] = struct{}{}
+	}
+	for _, pending := range saved.PendingHashes {
+		bc.pendingHashes[string(pending.RootHash)] = pending.Hashes
+	}
+	bc.resumed = true
+
+	log.Info("resuming the bootstrap from checkpoint",
+		"epoch", bc.epoch,
+		"num headers", len(bc.headers),
+		"num synced tries", len(bc.syncedTries),
+		"num tries in progress", len(bc.pendingHashes),
+	)
+
+	return true, nil
+}
+
+// savedHeaders returns the headers recorded in the checkpoint, skipping the ones not matching their hash
+func (bc *bootstrapCheckpoint) savedHeaders() map[string]data.HeaderHandler {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	headers := make(map[string]data.HeaderHandler, len(bc.headers))
+	for hash, hdr := range bc.headers {
+		if !bytes.Equal(bc.hasher.Compute(string(hdr.Header)), hdr.Hash) {
+			log.Warn("bootstrap checkpoint: header does not match its hash", "hash", hdr.Hash)
+			continue
+		}
+
+		var header data.HeaderHandler = &block.Header{}
+		if hdr.ShardID == core.MetachainShardId {
+			header = &block.MetaBlock{}
+		}
+		err := bc.marshalizer.Unmarshal(header, hdr.Header)
+		if err != nil {
+			log.Warn("bootstrap checkpoint: header can not be decoded", "hash", hdr.Hash, "error", err)
+			continue
+		}
+
+		headers[hash] = header
+	}
+
+	return headers
+}
+
+// addHeaders records the provided headers and saves the checkpoint. The headers not matching their hash, as the
+// placeholder for the genesis meta block, are not recorded
+func (bc *bootstrapCheckpoint) addHeaders(headers map[string]data.HeaderHandler) {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	numAdded := 0
+	for hash, header := range headers {
+		buff, err := bc.marshalizer.Marshal(header)
+		if err != nil {
+			log.Warn("bootstrap checkpoint: header can not be encoded", "hash", []byte(hash), "error", err)
+			continue
+		}
+		if !bytes.Equal(bc.hasher.Compute(string(buff)), []byte(hash)) {
+			continue
+		}
+
+		bc.headers[hash] = checkpointHeader{
+			Hash:    []byte(hash),
+			ShardID: header.GetShardID(),
+			Header:  buff,
+		}
+		numAdded++
+	}
+
+	if numAdded > 0 {
+		bc.save()
+	}
+}
+
+// isResumed returns true if the progress of a previous run was loaded
+func (bc *bootstrapCheckpoint) isResumed() bool {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	return bc.resumed
+}
+
+// IsTrieSynced returns true if the trie with the provided root hash was completely synced
+func (bc *bootstrapCheckpoint) IsTrieSynced(rootHash []byte) bool {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	_, found := bc.syncedTries[string(rootHash)]
+	return found
+}
+
+// MarkTrieSynced records that the trie with the provided root hash was completely synced
+func (bc *bootstrapCheckpoint) MarkTrieSynced(rootHash []byte) {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	bc.syncedTries[string(rootHash)] = struct{}{}
+	delete(bc.pendingHashes, string(rootHash))
+
+	bc.saveIfNeeded()
+}
+
+// PendingHashes returns the hashes from which the sync of the trie with the provided root hash can be resumed
+func (bc *bootstrapCheckpoint) PendingHashes(rootHash []byte) [][]byte {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	return bc.pendingHashes[string(rootHash)]
+}
+
+// SavePendingHashes records the hashes from which the sync of the trie with the provided root hash can be resumed
+func (bc *bootstrapCheckpoint) SavePendingHashes(rootHash []byte, hashes [][]byte) {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	bc.pendingHashes[string(rootHash)] = hashes
+
+	bc.saveIfNeeded()
+}
+
+func (bc *bootstrapCheckpoint) saveIfNeeded() {
+	if time.Since(bc.lastSave) < bc.timeBetweenSaves {
+		return
+	}
+
+	bc.save()
+}
+
+// save writes the checkpoint in a temporary file renamed afterwards so an interruption while writing does not corrupt
+// the previous checkpoint. Errors are only logged as the checkpoint is not needed for the current run
+func (bc *bootstrapCheckpoint) save() {
+	bc.lastSave = time.Now()
+
+	saved := &checkpointData{
+		Epoch:              bc.epoch,
+		EpochStartMetaHash: bc.epochStartMetaHash,
+		Headers:            make([]checkpointHeader, 0, len(bc.headers)),
+		SyncedTries:        make([][]byte, 0, len(bc.syncedTries)),
+		PendingHashes:      make([]checkpointPendingHashes, 0, len(bc.pendingHashes)),
+	}
+	for _, hdr := range bc.headers {
+		saved.Headers = append(saved.Headers, hdr)
+	}
+	for rootHash := range bc.syncedTries {
+		saved.SyncedTries = append(saved.SyncedTries, []byte(rootHash))
+	}
+	for rootHash, hashes := range bc.pendingHashes {
+		saved.PendingHashes = append(saved.PendingHashes, checkpointPendingHashes{
+			RootHash: []byte(rootHash),
+			Hashes:   hashes,
+		})
+	}
+
+	buff, err := json.Marshal(saved)
+	if err != nil {
+		log.Warn("bootstrap checkpoint can not be encoded", "error", err)
+		return
+	}
+
+	err = os.MkdirAll(filepath.Dir(bc.filePath), os.ModePerm)
+	if err != nil {
+		log.Warn("bootstrap checkpoint directory can not be created", "file", bc.filePath, "error", err)
+		return
+	}
+
+	tempFilePath := bc.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, core.FileModeUserReadWrite)
+	if err != nil {
+		log.Warn("bootstrap checkpoint can not be written", "file", tempFilePath, "error", err)
+		return
+	}
+
+	err = os.Rename(tempFilePath, bc.filePath)
+	if err != nil {
+		log.Warn("bootstrap checkpoint can not be renamed", "file", bc.filePath, "error", err)
+	}
+}
+
+// remove deletes the checkpoint file, called after the bootstrap data was saved in storage
+func (bc *bootstrapCheckpoint) remove() {
+	bc.mut.Lock()
+	defer bc.mut.Unlock()
+
+	bc.reset()
+	err := os.Remove(bc.filePath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Warn("bootstrap checkpoint can not be removed", "file", bc.filePath, "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bc *bootstrapCheckpoint) IsInterfaceNil() bool {
+	return bc == nil
+}
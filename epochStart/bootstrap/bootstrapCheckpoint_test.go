@@ -0,0 +1,126 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestBootstrapCheckpoint(t *testing.T, directory string) *bootstrapCheckpoint {
+	bc := newBootstrapCheckpoint(directory, &mock.MarshalizerMock{}, &mock.HasherMock{})
+	require.False(t, bc.IsInterfaceNil())
+	bc.timeBetweenSaves = 0
+
+	return bc
+}
+
+func TestBootstrapCheckpoint_StartWithoutSavedCheckpointShouldNotResume(t *testing.T) {
+	t.Parallel()
+
+	directory, _ := ioutil.TempDir("", "bootstrapcheckpoint")
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	bc := createTestBootstrapCheckpoint(t, directory)
+	isResumed, err := bc.start(&block.MetaBlock{Epoch: 5})
+	assert.Nil(t, err)
+	assert.False(t, isResumed)
+	assert.False(t, bc.isResumed())
+	assert.Equal(t, 0, len(bc.savedHeaders()))
+}
+
+func TestBootstrapCheckpoint_ShouldResumeTheSavedProgress(t *testing.T) {
+	t.Parallel()
+
+	directory, _ := ioutil.TempDir("", "bootstrapcheckpoint")
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	epochStartMeta := &block.MetaBlock{Epoch: 5, Nonce: 100}
+	shardHeader := &block.Header{ShardID: 1, Nonce: 90}
+	metaHeader := &block.MetaBlock{Epoch: 4, Nonce: 50}
+	shardHeaderHash, _ := core.CalculateHash(marshalizer, hasher, shardHeader)
+	metaHeaderHash, _ := core.CalculateHash(marshalizer, hasher, metaHeader)
+
+	bc := createTestBootstrapCheckpoint(t, directory)
+	_, _ = bc.start(epochStartMeta)
+	bc.addHeaders(map[string]data.HeaderHandler{
+		string(shardHeaderHash):   shardHeader,
+		string(metaHeaderHash):    metaHeader,
+		"genesis meta block hash": &block.MetaBlock{},
+	})
+	bc.MarkTrieSynced([]byte("data trie root"))
+	bc.SavePendingHashes([]byte("main trie root"), [][]byte{[]byte("hash1"), []byte("hash2")})
+
+	resumedCheckpoint := createTestBootstrapCheckpoint(t, directory)
+	isResumed, err := resumedCheckpoint.start(epochStartMeta)
+	assert.Nil(t, err)
+	assert.True(t, isResumed)
+	assert.True(t, resumedCheckpoint.isResumed())
+
+	headers := resumedCheckpoint.savedHeaders()
+	assert.Equal(t, 2, len(headers))
+	assert.Equal(t, shardHeader, headers[string(shardHeaderHash)])
+	assert.Equal(t, metaHeader, headers[string(metaHeaderHash)])
+	assert.True(t, resumedCheckpoint.IsTrieSynced([]byte("data trie root")))
+	assert.False(t, resumedCheckpoint.IsTrieSynced([]byte("main trie root")))
+	assert.Equal(t, [][]byte{[]byte("hash1"), []byte("hash2")}, resumedCheckpoint.PendingHashes([]byte("main trie root")))
+
+	resumedCheckpoint.MarkTrieSynced([]byte("main trie root"))
+	assert.Nil(t, resumedCheckpoint.PendingHashes([]byte("main trie root")))
+}
+
+func TestBootstrapCheckpoint_StartForAnotherEpochStartMetaShouldDiscardTheProgress(t *testing.T) {
+	t.Parallel()
+
+	directory, _ := ioutil.TempDir("", "bootstrapcheckpoint")
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	bc := createTestBootstrapCheckpoint(t, directory)
+	_, _ = bc.start(&block.MetaBlock{Epoch: 5})
+	bc.MarkTrieSynced([]byte("data trie root"))
+
+	isResumed, err := bc.start(&block.MetaBlock{Epoch: 6})
+	assert.Nil(t, err)
+	assert.False(t, isResumed)
+	assert.False(t, bc.IsTrieSynced([]byte("data trie root")))
+}
+
+func TestBootstrapCheckpoint_RemoveShouldDeleteTheFile(t *testing.T) {
+	t.Parallel()
+
+	directory, _ := ioutil.TempDir("", "bootstrapcheckpoint")
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	epochStartMeta := &block.MetaBlock{Epoch: 5}
+	bc := createTestBootstrapCheckpoint(t, directory)
+	_, _ = bc.start(epochStartMeta)
+	bc.MarkTrieSynced([]byte("data trie root"))
+
+	_, err := os.Stat(filepath.Join(directory, bootstrapCheckpointFilename))
+	require.Nil(t, err)
+
+	bc.remove()
+	_, err = os.Stat(filepath.Join(directory, bootstrapCheckpointFilename))
+	assert.True(t, os.IsNotExist(err))
+	assert.False(t, bc.IsTrieSynced([]byte("data trie root")))
+
+	isResumed, _ := createTestBootstrapCheckpoint(t, directory).start(epochStartMeta)
+	assert.False(t, isResumed)
+}
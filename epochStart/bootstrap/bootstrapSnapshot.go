@@ -0,0 +1,179 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+)
+
+const (
+	snapshotDescriptorFilename  = "snapshot.json"
+	snapshotAccountsTrieDir     = "AccountsTrie"
+	snapshotPeerAccountsTrieDir = "PeerAccountsTrie"
+	snapshotTrieDBMaxOpenFiles  = 10
+	snapshotTrieDBMaxBatchSize  = 1
+	snapshotTrieDBBatchDelayInS = 1
+)
+
+// SnapshotHeader holds a marshalized header from a bootstrap snapshot
+type SnapshotHeader struct {
+	ShardID uint32
+	Header  []byte
+}
+
+// SnapshotDescriptor is the content of the snapshot.json file from a bootstrap snapshot directory. Besides this file,
+// the directory can hold the AccountsTrie and PeerAccountsTrie level DB databases with the trie nodes keyed by their
+// hashes, as the trie databases of a node are. The headers must contain the epoch start meta block and the headers
+// referenced by it, the mini blocks must contain the validator info and the pending mini blocks. Any data missing from
+// the snapshot is requested from the network
+type SnapshotDescriptor struct {
+	EpochStartMetaHash []byte
+	Headers            []SnapshotHeader
+	MiniBlocks         [][]byte
+}
+
+// bootstrapSnapshot holds the data of a bootstrap snapshot, keyed by the hashes computed when loading it, so any data
+// found by hash is valid
+type bootstrapSnapshot struct {
+	epochStartMetaHash []byte
+	headers            map[string]data.HeaderHandler
+	miniBlocks         map[string]*block.MiniBlock
+	trieDBs            []storage.Persister
+}
+
+func loadBootstrapSnapshot(directory string, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*bootstrapSnapshot, error) {
+	descriptor := &SnapshotDescriptor{}
+	err := core.LoadJsonFile(descriptor, filepath.Join(directory, snapshotDescriptorFilename))
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the bootstrap snapshot descriptor from %s", err, directory)
+	}
+
+	bs := &bootstrapSnapshot{
+		epochStartMetaHash: descriptor.EpochStartMetaHash,
+		headers:            make(map[string]data.HeaderHandler, len(descriptor.Headers)),
+		miniBlocks:         make(map[string]*block.MiniBlock, len(descriptor.MiniBlocks)),
+		trieDBs:            make([]storage.Persister, 0),
+	}
+
+	for _, hdr := range descriptor.Headers {
+		var header data.HeaderHandler = &block.Header{}
+		if hdr.ShardID == core.MetachainShardId {
+			header = &block.MetaBlock{}
+		}
+		err = marshalizer.Unmarshal(header, hdr.Header)
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding a header from the bootstrap snapshot", err)
+		}
+
+		bs.headers[string(hasher.Compute(string(hdr.Header)))] = header
+	}
+
+	for _, buff := range descriptor.MiniBlocks {
+		miniBlock := &block.MiniBlock{}
+		err = marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding a mini block from the bootstrap snapshot", err)
+		}
+
+		bs.miniBlocks[string(hasher.Compute(string(buff)))] = miniBlock
+	}
+
+	for _, trieDir := range []string{snapshotAccountsTrieDir, snapshotPeerAccountsTrieDir} {
+		err = bs.openTrieDB(filepath.Join(directory, trieDir))
+		if err != nil {
+			bs.close()
+			return nil, err
+		}
+	}
+
+	log.Info("loaded bootstrap snapshot",
+		"directory", directory,
+		"epoch start meta hash", bs.epochStartMetaHash,
+		"num headers", len(bs.headers),
+		"num mini blocks", len(bs.miniBlocks),
+		"num trie databases", len(bs.trieDBs),
+	)
+
+	return bs, nil
+}
+
+func (bs *bootstrapSnapshot) openTrieDB(path string) error {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		log.Debug("bootstrap snapshot without trie database", "path", path)
+		return nil
+	}
+
+	db, err := leveldb.NewDB(path, snapshotTrieDBBatchDelayInS, snapshotTrieDBMaxBatchSize, snapshotTrieDBMaxOpenFiles)
+	if err != nil {
+		return fmt.Errorf("%w while opening the bootstrap snapshot trie database", err)
+	}
+
+	bs.trieDBs = append(bs.trieDBs, db)
+
+	return nil
+}
+
+// epochStartMeta returns the epoch start meta block of the snapshot after checking it against the expected hash
+func (bs *bootstrapSnapshot) epochStartMeta(expectedHash []byte) (*block.MetaBlock, error) {
+	header, found := bs.headers[string(expectedHash)]
+	if !found {
+		return nil, fmt.Errorf("%w for hash %x", epochStart.ErrEpochStartMetaNotInSnapshot, expectedHash)
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok || !metaBlock.IsStartOfEpochBlock() {
+		return nil, fmt.Errorf("%w for hash %x", epochStart.ErrEpochStartMetaNotInSnapshot, expectedHash)
+	}
+
+	return metaBlock, nil
+}
+
+// checkEpochStartMeta returns an error if the snapshot was not created for the provided epoch start meta block hash
+func (bs *bootstrapSnapshot) checkEpochStartMeta(epochStartMetaHash []byte) error {
+	if !bytes.Equal(bs.epochStartMetaHash, epochStartMetaHash) {
+		return fmt.Errorf("%w: snapshot hash %x, expected hash %x",
+			epochStart.ErrSnapshotEpochStartMetaMismatch, bs.epochStartMetaHash, epochStartMetaHash)
+	}
+
+	return nil
+}
+
+func (bs *bootstrapSnapshot) header(hash []byte) (data.HeaderHandler, bool) {
+	header, found := bs.headers[string(hash)]
+	return header, found
+}
+
+func (bs *bootstrapSnapshot) miniBlock(hash []byte) (*block.MiniBlock, bool) {
+	miniBlock, found := bs.miniBlocks[string(hash)]
+	return miniBlock, found
+}
+
+func (bs *bootstrapSnapshot) trieNode(hash []byte) ([]byte, bool) {
+	for _, db := range bs.trieDBs {
+		buff, err := db.Get(hash)
+		if err == nil {
+			return buff, true
+		}
+	}
+
+	return nil, false
+}
+
+func (bs *bootstrapSnapshot) close() {
+	for _, db := range bs.trieDBs {
+		err := db.Close()
+		log.LogIfError(err)
+	}
+	bs.trieDBs = make([]storage.Persister, 0)
+}
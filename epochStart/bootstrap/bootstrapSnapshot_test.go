@@ -0,0 +1,149 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSnapshot struct {
+	directory          string
+	epochStartMeta     *block.MetaBlock
+	epochStartMetaHash []byte
+	shardHeader        *block.Header
+	shardHeaderHash    []byte
+	miniBlock          *block.MiniBlock
+	miniBlockHash      []byte
+	trieRootHash       []byte
+}
+
+func createTestSnapshot(t *testing.T) *testSnapshot {
+	directory, err := ioutil.TempDir("", "bootstrapsnapshot")
+	require.Nil(t, err)
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	ts := &testSnapshot{
+		directory:      directory,
+		epochStartMeta: &block.MetaBlock{Epoch: 5, Nonce: 100},
+		shardHeader:    &block.Header{ShardID: 1, Nonce: 90},
+		miniBlock:      &block.MiniBlock{SenderShardID: 1, ReceiverShardID: 0},
+	}
+	ts.epochStartMeta.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 1}}
+	metaBuff, _ := marshalizer.Marshal(ts.epochStartMeta)
+	shardHeaderBuff, _ := marshalizer.Marshal(ts.shardHeader)
+	miniBlockBuff, _ := marshalizer.Marshal(ts.miniBlock)
+	ts.epochStartMetaHash = hasher.Compute(string(metaBuff))
+	ts.shardHeaderHash = hasher.Compute(string(shardHeaderBuff))
+	ts.miniBlockHash = hasher.Compute(string(miniBlockBuff))
+
+	descriptor := &SnapshotDescriptor{
+		EpochStartMetaHash: ts.epochStartMetaHash,
+		Headers: []SnapshotHeader{
+			{ShardID: core.MetachainShardId, Header: metaBuff},
+			{ShardID: 1, Header: shardHeaderBuff},
+		},
+		MiniBlocks: [][]byte{miniBlockBuff},
+	}
+	descriptorBuff, _ := json.Marshal(descriptor)
+	err = ioutil.WriteFile(filepath.Join(directory, snapshotDescriptorFilename), descriptorBuff, core.FileModeUserReadWrite)
+	require.Nil(t, err)
+
+	db, err := leveldb.NewDB(filepath.Join(directory, snapshotAccountsTrieDir), 1, 1, 10)
+	require.Nil(t, err)
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, _ := trie.NewTrie(trieStorage, marshalizer, hasher, 5)
+	for i := 0; i < 10; i++ {
+		key := hasher.Compute(fmt.Sprintf("%d", i))
+		_ = tr.Update(key, key)
+	}
+	require.Nil(t, tr.Commit())
+	ts.trieRootHash, _ = tr.RootHash()
+	_ = db.Put([]byte("invalid node hash"), []byte("invalid node"))
+	require.Nil(t, db.Close())
+
+	return ts
+}
+
+func TestLoadBootstrapSnapshot_MissingDescriptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	directory, _ := ioutil.TempDir("", "bootstrapsnapshot")
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	bs, err := loadBootstrapSnapshot(directory, &mock.MarshalizerMock{}, &mock.HasherMock{})
+	assert.Nil(t, bs)
+	assert.True(t, os.IsNotExist(errors.Unwrap(err)))
+}
+
+func TestLoadBootstrapSnapshot_ShouldKeyTheDataByHash(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestSnapshot(t)
+	defer func() {
+		_ = os.RemoveAll(ts.directory)
+	}()
+
+	bs, err := loadBootstrapSnapshot(ts.directory, &mock.MarshalizerMock{}, &mock.HasherMock{})
+	require.Nil(t, err)
+	defer bs.close()
+
+	header, found := bs.header(ts.shardHeaderHash)
+	assert.True(t, found)
+	assert.Equal(t, ts.shardHeader, header)
+	header, found = bs.header(ts.epochStartMetaHash)
+	assert.True(t, found)
+	assert.Equal(t, ts.epochStartMeta, header)
+	_, found = bs.header([]byte("missing hash"))
+	assert.False(t, found)
+
+	miniBlock, found := bs.miniBlock(ts.miniBlockHash)
+	assert.True(t, found)
+	assert.Equal(t, ts.miniBlock, miniBlock)
+
+	_, found = bs.trieNode(ts.trieRootHash)
+	assert.True(t, found)
+	_, found = bs.trieNode([]byte("missing hash"))
+	assert.False(t, found)
+}
+
+func TestBootstrapSnapshot_EpochStartMeta(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestSnapshot(t)
+	defer func() {
+		_ = os.RemoveAll(ts.directory)
+	}()
+
+	bs, err := loadBootstrapSnapshot(ts.directory, &mock.MarshalizerMock{}, &mock.HasherMock{})
+	require.Nil(t, err)
+	defer bs.close()
+
+	assert.Nil(t, bs.checkEpochStartMeta(ts.epochStartMetaHash))
+	err = bs.checkEpochStartMeta([]byte("another hash"))
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotEpochStartMetaMismatch))
+
+	metaBlock, err := bs.epochStartMeta(ts.epochStartMetaHash)
+	assert.Nil(t, err)
+	assert.Equal(t, ts.epochStartMeta, metaBlock)
+
+	_, err = bs.epochStartMeta(ts.shardHeaderHash)
+	assert.True(t, errors.Is(err, epochStart.ErrEpochStartMetaNotInSnapshot))
+	_, err = bs.epochStartMeta([]byte("missing hash"))
+	assert.True(t, errors.Is(err, epochStart.ErrEpochStartMetaNotInSnapshot))
+}
@@ -103,12 +103,18 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 	if check.IfNil(args.EpochNotifier) {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilEpochNotifier)
 	}
+	if check.IfNil(args.TrieNodesCache) {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilTrieNodesCache)
+	}
 	if args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes < 1 {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrInvalidMaxHardCapForMissingNodes)
 	}
 	if args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers < 1 {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrInvalidNumConcurrentTrieSyncers)
 	}
+	if len(args.TrustedEpochStartMetaHash) > 0 && len(args.ImportSnapshotDirectory) == 0 {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrTrustedHashWithoutSnapshot)
+	}
 
 	return nil
 }
@@ -41,6 +41,10 @@ type Messenger interface {
 	ConnectedPeers() []core.PeerID
 }
 
+type peersLatencyHandlerSetter interface {
+	SetPeersLatencyHandler(handler dataRetriever.PeersLatencyHandler) error
+}
+
 // RequestHandler defines which methods a request handler should implement
 type RequestHandler interface {
 	RequestStartOfEpochMetaBlock(epoch uint32)
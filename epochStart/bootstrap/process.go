@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
@@ -23,6 +25,7 @@ import (
 	factoryDataPool "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersLatency"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
@@ -49,6 +52,8 @@ const timeBetweenRequests = 100 * time.Millisecond
 const maxToRequest = 100
 const gracePeriodInPercentage = float64(0.25)
 const roundGracePeriod = 25
+const trieNodesResponseTimeout = 5 * time.Second
+const maxPendingTrieNodesRequests = 50000
 
 // Parameters defines the DTO for the result produced by the bootstrap component
 type Parameters struct {
@@ -104,9 +109,12 @@ type epochStartBootstrap struct {
 	enableSignTxWithHashEpoch  uint32
 	txSignHasher               hashing.Hasher
 	epochNotifier              process.EpochNotifier
+	trieNodesCache             data.TrieNodesCacher
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
+	importSnapshotDirectory    string
+	trustedEpochStartMetaHash  []byte
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -121,6 +129,9 @@ type epochStartBootstrap struct {
 	storageOpenerHandler      storage.UnitOpenerHandler
 	latestStorageDataProvider storage.LatestStorageDataProviderHandler
 	argumentsParser           process.ArgumentsParser
+	peersLatencyHandler       dataRetriever.PeersLatencyHandler
+	checkpoint                *bootstrapCheckpoint
+	snapshot                  *bootstrapSnapshot
 
 	// gathered data
 	epochStartMeta     *block.MetaBlock
@@ -176,6 +187,9 @@ type ArgsEpochStartBootstrap struct {
 	HeaderIntegrityVerifier    process.HeaderIntegrityVerifier
 	TxSignHasher               hashing.Hasher
 	EpochNotifier              process.EpochNotifier
+	TrieNodesCache             data.TrieNodesCacher
+	ImportSnapshotDirectory    string
+	TrustedEpochStartMetaHash  []byte
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -220,9 +234,12 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		txSignHasher:               args.TxSignHasher,
 		enableSignTxWithHashEpoch:  args.GeneralConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
 		epochNotifier:              args.EpochNotifier,
+		trieNodesCache:             args.TrieNodesCache,
 		numConcurrentTrieSyncers:   args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes:  args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes,
 		trieSyncerVersion:          args.GeneralConfig.TrieSync.TrieSyncerVersion,
+		importSnapshotDirectory:    args.ImportSnapshotDirectory,
+		trustedEpochStartMetaHash:  args.TrustedEpochStartMetaHash,
 	}
 
 	whiteListCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(epochStartProvider.generalConfig.WhiteListPool))
@@ -240,6 +257,20 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		return nil, err
 	}
 
+	epochStartProvider.peersLatencyHandler, err = peersLatency.NewPeersLatencyTracker(peersLatency.ArgPeersLatencyTracker{
+		ResponseTimeout:    trieNodesResponseTimeout,
+		MaxPendingRequests: maxPendingTrieNodesRequests,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartProvider.checkpoint = newBootstrapCheckpoint(
+		filepath.Join(args.WorkingDir, args.DefaultDBPath),
+		args.Marshalizer,
+		args.Hasher,
+	)
+
 	epochStartProvider.trieContainer = state.NewDataTriesHolder()
 	epochStartProvider.trieStorageManagers = make(map[string]data.StorageManager)
 
@@ -411,19 +442,29 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 	if err != nil {
 		return Parameters{}, err
 	}
+	defer e.closeSnapshot()
 
-	e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	e.epochStartMeta, err = e.syncEpochStartMeta()
 	if err != nil {
 		return Parameters{}, err
 	}
 	log.Debug("start in epoch bootstrap: got epoch start meta header", "epoch", e.epochStartMeta.Epoch, "nonce", e.epochStartMeta.Nonce)
 	e.setEpochStartMetrics()
 
+	isResumed, err := e.checkpoint.start(e.epochStartMeta)
+	if err != nil {
+		return Parameters{}, err
+	}
+
 	err = e.createSyncers()
 	if err != nil {
 		return Parameters{}, err
 	}
 
+	if isResumed {
+		e.restoreCheckpointHeaders()
+	}
+
 	params, err := e.requestAndProcessing()
 	if err != nil {
 		return Parameters{}, err
@@ -488,6 +529,69 @@ func (e *epochStartBootstrap) prepareComponentsToSyncFromNetwork() error {
 	return nil
 }
 
+// syncEpochStartMeta gets the epoch start meta block from the network. When importing a bootstrap snapshot, the epoch
+// start meta block is taken from the snapshot if a trusted hash was provided, otherwise the snapshot is checked against
+// the epoch start meta block from the network
+func (e *epochStartBootstrap) syncEpochStartMeta() (*block.MetaBlock, error) {
+	if len(e.importSnapshotDirectory) == 0 {
+		return e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	}
+
+	err := e.prepareComponentsToSyncFromSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(e.trustedEpochStartMetaHash) > 0 {
+		err = e.snapshot.checkEpochStartMeta(e.trustedEpochStartMetaHash)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debug("start in epoch bootstrap: using the epoch start meta block from snapshot", "hash", e.trustedEpochStartMetaHash)
+		return e.snapshot.epochStartMeta(e.trustedEpochStartMetaHash)
+	}
+
+	epochStartMeta, err := e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartMetaHash, err := core.CalculateHash(e.marshalizer, e.hasher, epochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.snapshot.checkEpochStartMeta(epochStartMetaHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochStartMeta, nil
+}
+
+// prepareComponentsToSyncFromSnapshot loads the bootstrap snapshot and replaces the request handler with one serving
+// the data from the snapshot
+func (e *epochStartBootstrap) prepareComponentsToSyncFromSnapshot() error {
+	var err error
+	e.snapshot, err = loadBootstrapSnapshot(e.importSnapshotDirectory, e.marshalizer, e.hasher)
+	if err != nil {
+		return err
+	}
+
+	e.requestHandler = newSnapshotRequestHandler(e.requestHandler, e.snapshot, e.dataPool, e.marshalizer, e.hasher)
+
+	return nil
+}
+
+func (e *epochStartBootstrap) closeSnapshot() {
+	if e.snapshot == nil {
+		return
+	}
+
+	e.snapshot.close()
+}
+
 func (e *epochStartBootstrap) createSyncers() error {
 	var err error
 
@@ -514,6 +618,7 @@ func (e *epochStartBootstrap) createSyncers() error {
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
 		TxSignHasher:              e.txSignHasher,
 		EpochNotifier:             e.epochNotifier,
+		PeersLatencyHandler:       e.peersLatencyHandler,
 	}
 
 	e.interceptorContainer, err = factoryInterceptors.NewEpochStartInterceptorsContainer(args)
@@ -590,6 +695,7 @@ func (e *epochStartBootstrap) requestAndProcessing() (Parameters, error) {
 		return Parameters{}, err
 	}
 	log.Debug("start in epoch bootstrap: got shard headers and previous epoch start meta block")
+	e.checkpoint.addHeaders(e.syncedHeaders)
 
 	prevEpochStartMetaHash := e.epochStartMeta.EpochStart.Economics.PrevEpochStartHash
 	prevEpochStartMeta, ok := e.syncedHeaders[string(prevEpochStartMetaHash)].(*block.MetaBlock)
@@ -640,6 +746,7 @@ func (e *epochStartBootstrap) requestAndProcessing() (Parameters, error) {
 
 	log.Debug("removing cached received trie nodes")
 	e.dataPool.TrieNodes().Clear()
+	e.checkpoint.remove()
 
 	parameters := Parameters{
 		Epoch:       e.baseData.lastEpoch,
@@ -696,13 +803,13 @@ func (e *epochStartBootstrap) requestAndProcessForMeta() error {
 	var err error
 
 	log.Debug("start in epoch bootstrap: started syncPeerAccountsState")
-	err = e.syncPeerAccountsState(e.epochStartMeta.ValidatorStatsRootHash)
+	err = e.syncStateFromCheckpoint(e.epochStartMeta.ValidatorStatsRootHash, e.syncPeerAccountsState)
 	if err != nil {
 		return err
 	}
 	log.Debug("start in epoch bootstrap: syncUserAccountsState")
 
-	err = e.syncUserAccountsState(e.epochStartMeta.RootHash)
+	err = e.syncStateFromCheckpoint(e.epochStartMeta.RootHash, e.syncUserAccountsState)
 	if err != nil {
 		return err
 	}
@@ -791,6 +898,7 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 	for hash, hdr := range neededHeaders {
 		e.syncedHeaders[hash] = hdr
 	}
+	e.checkpoint.addHeaders(neededHeaders)
 
 	ownShardHdr, ok := e.syncedHeaders[string(epochStartData.HeaderHash)].(*block.Header)
 	if !ok {
@@ -798,7 +906,7 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 	}
 
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState")
-	err = e.syncUserAccountsState(ownShardHdr.RootHash)
+	err = e.syncStateFromCheckpoint(ownShardHdr.RootHash, e.syncUserAccountsState)
 	if err != nil {
 		return err
 	}
@@ -835,7 +943,38 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 	return nil
 }
 
-func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+// restoreCheckpointHeaders adds the headers recorded by the interrupted bootstrap in the headers pool so the headers
+// syncers do not request them again
+func (e *epochStartBootstrap) restoreCheckpointHeaders() {
+	headers := e.checkpoint.savedHeaders()
+	for hash, hdr := range headers {
+		e.dataPool.Headers().AddHeader([]byte(hash), hdr)
+	}
+
+	log.Debug("start in epoch bootstrap: restored headers from checkpoint", "num headers", len(headers))
+}
+
+// syncStateFromCheckpoint syncs a state recording the progress in the bootstrap checkpoint. If the bootstrap was resumed,
+// the state is synced once more without the checkpoint: the whole trie is walked in the database and the trie nodes
+// lost when the previous run was interrupted are requested again
+func (e *epochStartBootstrap) syncStateFromCheckpoint(
+	rootHash []byte,
+	syncState func(rootHash []byte, checkpointHandler data.TrieSyncCheckpointHandler) error,
+) error {
+	err := syncState(rootHash, e.checkpoint)
+	if err != nil {
+		return err
+	}
+	if !e.checkpoint.isResumed() {
+		return nil
+	}
+
+	log.Debug("start in epoch bootstrap: verifying the state synced from checkpoint", "root hash", rootHash)
+
+	return syncState(rootHash, trie.NewDisabledTrieSyncCheckpoint())
+}
+
+func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte, checkpointHandler data.TrieSyncCheckpointHandler) error {
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
 		return err
@@ -852,6 +991,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			CheckpointHandler:         checkpointHandler,
 		},
 		ShardId:   e.shardCoordinator.SelfId(),
 		Throttler: thr,
@@ -878,6 +1018,8 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 		Hasher:                   e.hasher,
 		PathManager:              e.pathManager,
 		TrieStorageManagerConfig: e.generalConfig.TrieStorageManagerConfig,
+		TrieNodesCache:           e.trieNodesCache,
+		StateRetentionCfg:        e.generalConfig.StateTriesConfig.StateRetention,
 	}
 	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
 	if err != nil {
@@ -913,7 +1055,7 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 	return nil
 }
 
-func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
+func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte, checkpointHandler data.TrieSyncCheckpointHandler) error {
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.hasher,
@@ -925,6 +1067,7 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			CheckpointHandler:         checkpointHandler,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -977,6 +1120,11 @@ func (e *epochStartBootstrap) createRequestHandler() error {
 		return err
 	}
 
+	err = e.setPeersLatencyHandlerOnTrieNodesResolvers(container)
+	if err != nil {
+		return err
+	}
+
 	finder, err := containers.NewResolversFinder(container, e.shardCoordinator)
 	if err != nil {
 		return err
@@ -994,6 +1142,31 @@ func (e *epochStartBootstrap) createRequestHandler() error {
 	return err
 }
 
+// setPeersLatencyHandlerOnTrieNodesResolvers makes the trie nodes requests go more often to the peers answering faster
+func (e *epochStartBootstrap) setPeersLatencyHandlerOnTrieNodesResolvers(container dataRetriever.ResolversContainer) error {
+	var err error
+	container.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		_, isTrieNodesResolver := resolver.(dataRetriever.TrieNodesResolver)
+		if !isTrieNodesResolver {
+			return true
+		}
+		setter, ok := resolver.(peersLatencyHandlerSetter)
+		if !ok {
+			return true
+		}
+
+		err = setter.SetPeersLatencyHandler(e.peersLatencyHandler)
+		if err != nil {
+			err = fmt.Errorf("%w for resolver %s", err, key)
+			return false
+		}
+
+		return true
+	})
+
+	return err
+}
+
 func (e *epochStartBootstrap) setEpochStartMetrics() {
 	if e.epochStartMeta != nil {
 		e.statusHandler.SetUInt64Value(core.MetricNonceAtEpochStart, e.epochStartMeta.Nonce)
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
@@ -146,6 +147,7 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 		HeaderIntegrityVerifier:    &mock.HeaderIntegrityVerifierStub{},
 		TxSignHasher:               &mock.HasherMock{},
 		EpochNotifier:              &mock.EpochNotifierStub{},
+		TrieNodesCache:             trie.NewDisabledTrieNodesCache(),
 	}
 }
 
@@ -181,6 +183,17 @@ func TestNewEpochStartBootstrap_NilEpochNotifierShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, epochStart.ErrNilEpochNotifier))
 }
 
+func TestNewEpochStartBootstrap_NilTrieNodesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.TrieNodesCache = nil
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	assert.Nil(t, epochStartProvider)
+	assert.True(t, errors.Is(err, epochStart.ErrNilTrieNodesCache))
+}
+
 func TestNewEpochStartBootstrap_InvalidMaxHardCapForMissingNodesShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -203,6 +216,17 @@ func TestNewEpochStartBootstrap_InvalidNumConcurrentTrieSyncersShouldErr(t *test
 	assert.True(t, errors.Is(err, epochStart.ErrInvalidNumConcurrentTrieSyncers))
 }
 
+func TestNewEpochStartBootstrap_TrustedHashWithoutSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.TrustedEpochStartMetaHash = []byte("epoch start meta hash")
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	assert.Nil(t, epochStartProvider)
+	assert.True(t, errors.Is(err, epochStart.ErrTrustedHashWithoutSnapshot))
+}
+
 func TestIsStartInEpochZero(t *testing.T) {
 	t.Parallel()
 
@@ -423,7 +447,7 @@ func TestSyncPeerAccountsState_NilRequestHandlerErr(t *testing.T) {
 	}
 	_ = epochStartProvider.createTriesComponentsForShardId(args.GenesisShardCoordinator.SelfId())
 	rootHash := []byte("rootHash")
-	err := epochStartProvider.syncPeerAccountsState(rootHash)
+	err := epochStartProvider.syncPeerAccountsState(rootHash, trie.NewDisabledTrieSyncCheckpoint())
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
@@ -452,7 +476,7 @@ func TestSyncUserAccountsState(t *testing.T) {
 	}
 	_ = epochStartProvider.createTriesComponentsForShardId(args.GenesisShardCoordinator.SelfId())
 	rootHash := []byte("rootHash")
-	err := epochStartProvider.syncUserAccountsState(rootHash)
+	err := epochStartProvider.syncUserAccountsState(rootHash, trie.NewDisabledTrieSyncCheckpoint())
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
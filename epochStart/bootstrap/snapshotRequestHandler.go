@@ -0,0 +1,141 @@
+package bootstrap
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.RequestHandler = (*snapshotRequestHandler)(nil)
+
+// snapshotRequestHandler serves the requested headers, mini blocks and trie nodes from a bootstrap snapshot, adding them
+// in the data pool as the interceptors do, and requests from the network only the data missing from the snapshot
+type snapshotRequestHandler struct {
+	process.RequestHandler
+	snapshot    *bootstrapSnapshot
+	dataPool    dataRetriever.PoolsHolder
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+func newSnapshotRequestHandler(
+	networkRequestHandler process.RequestHandler,
+	snapshot *bootstrapSnapshot,
+	dataPool dataRetriever.PoolsHolder,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) *snapshotRequestHandler {
+	return &snapshotRequestHandler{
+		RequestHandler: networkRequestHandler,
+		snapshot:       snapshot,
+		dataPool:       dataPool,
+		marshalizer:    marshalizer,
+		hasher:         hasher,
+	}
+}
+
+// RequestShardHeader adds the shard header from the snapshot in the pool or requests it from the network
+func (srh *snapshotRequestHandler) RequestShardHeader(shardID uint32, hash []byte) {
+	if srh.addHeaderFromSnapshot(hash) {
+		return
+	}
+
+	srh.RequestHandler.RequestShardHeader(shardID, hash)
+}
+
+// RequestMetaHeader adds the meta header from the snapshot in the pool or requests it from the network
+func (srh *snapshotRequestHandler) RequestMetaHeader(hash []byte) {
+	if srh.addHeaderFromSnapshot(hash) {
+		return
+	}
+
+	srh.RequestHandler.RequestMetaHeader(hash)
+}
+
+func (srh *snapshotRequestHandler) addHeaderFromSnapshot(hash []byte) bool {
+	header, found := srh.snapshot.header(hash)
+	if !found {
+		return false
+	}
+
+	srh.dataPool.Headers().AddHeader(hash, header)
+	return true
+}
+
+// RequestMiniBlock adds the mini block from the snapshot in the pool or requests it from the network
+func (srh *snapshotRequestHandler) RequestMiniBlock(destShardID uint32, miniblockHash []byte) {
+	if srh.addMiniBlockFromSnapshot(miniblockHash) {
+		return
+	}
+
+	srh.RequestHandler.RequestMiniBlock(destShardID, miniblockHash)
+}
+
+// RequestMiniBlocks adds the mini blocks from the snapshot in the pool and requests the other ones from the network
+func (srh *snapshotRequestHandler) RequestMiniBlocks(destShardID uint32, miniblocksHashes [][]byte) {
+	missingHashes := make([][]byte, 0)
+	for _, hash := range miniblocksHashes {
+		if !srh.addMiniBlockFromSnapshot(hash) {
+			missingHashes = append(missingHashes, hash)
+		}
+	}
+	if len(missingHashes) == 0 {
+		return
+	}
+
+	srh.RequestHandler.RequestMiniBlocks(destShardID, missingHashes)
+}
+
+func (srh *snapshotRequestHandler) addMiniBlockFromSnapshot(hash []byte) bool {
+	miniBlock, found := srh.snapshot.miniBlock(hash)
+	if !found {
+		return false
+	}
+
+	srh.dataPool.MiniBlocks().HasOrAdd(hash, miniBlock, miniBlock.Size())
+	return true
+}
+
+// RequestTrieNodes adds the trie nodes from the snapshot in the pool and requests the other ones from the network
+func (srh *snapshotRequestHandler) RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string) {
+	missingHashes := make([][]byte, 0)
+	for _, hash := range hashes {
+		if !srh.addTrieNodeFromSnapshot(hash) {
+			missingHashes = append(missingHashes, hash)
+		}
+	}
+	if len(missingHashes) == 0 {
+		return
+	}
+
+	srh.RequestHandler.RequestTrieNodes(destShardID, missingHashes, topic)
+}
+
+func (srh *snapshotRequestHandler) addTrieNodeFromSnapshot(hash []byte) bool {
+	buff, found := srh.snapshot.trieNode(hash)
+	if !found {
+		return false
+	}
+
+	interceptedNode, err := trie.NewInterceptedTrieNode(buff, srh.marshalizer, srh.hasher)
+	if err != nil {
+		log.Warn("snapshotRequestHandler: invalid trie node in snapshot", "hash", hash, "error", err)
+		return false
+	}
+	if !bytes.Equal(interceptedNode.Hash(), hash) {
+		log.Warn("snapshotRequestHandler: trie node in snapshot does not match its hash", "hash", hash)
+		return false
+	}
+
+	srh.dataPool.TrieNodes().Put(hash, interceptedNode, interceptedNode.SizeInBytes()+len(hash))
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srh *snapshotRequestHandler) IsInterfaceNil() bool {
+	return srh == nil
+}
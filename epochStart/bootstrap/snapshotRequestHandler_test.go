@@ -0,0 +1,105 @@
+package bootstrap
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRequestHandler_ShouldServeTheDataFromSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestSnapshot(t)
+	defer func() {
+		_ = os.RemoveAll(ts.directory)
+	}()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	bs, err := loadBootstrapSnapshot(ts.directory, marshalizer, hasher)
+	require.Nil(t, err)
+	defer bs.close()
+
+	networkRequestHandler := &mock.RequestHandlerStub{
+		RequestShardHeaderCalled: func(shardId uint32, hash []byte) {
+			assert.Fail(t, "should have not requested the shard header")
+		},
+		RequestMetaHeaderCalled: func(hash []byte) {
+			assert.Fail(t, "should have not requested the meta header")
+		},
+		RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblockHashes [][]byte) {
+			assert.Fail(t, "should have not requested the mini blocks")
+		},
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Fail(t, "should have not requested the trie nodes")
+		},
+	}
+	dataPool := testscommon.NewPoolsHolderMock()
+	srh := newSnapshotRequestHandler(networkRequestHandler, bs, dataPool, marshalizer, hasher)
+	assert.False(t, srh.IsInterfaceNil())
+
+	srh.RequestShardHeader(1, ts.shardHeaderHash)
+	srh.RequestMetaHeader(ts.epochStartMetaHash)
+	srh.RequestMiniBlock(0, ts.miniBlockHash)
+	srh.RequestMiniBlocks(0, [][]byte{ts.miniBlockHash})
+	srh.RequestTrieNodes(0, [][]byte{ts.trieRootHash}, "topic")
+
+	header, err := dataPool.Headers().GetHeaderByHash(ts.shardHeaderHash)
+	assert.Nil(t, err)
+	assert.Equal(t, ts.shardHeader, header)
+	header, err = dataPool.Headers().GetHeaderByHash(ts.epochStartMetaHash)
+	assert.Nil(t, err)
+	assert.Equal(t, ts.epochStartMeta, header)
+	assert.True(t, dataPool.MiniBlocks().Has(ts.miniBlockHash))
+	assert.True(t, dataPool.TrieNodes().Has(ts.trieRootHash))
+}
+
+func TestSnapshotRequestHandler_MissingOrInvalidDataShouldBeRequestedFromNetwork(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestSnapshot(t)
+	defer func() {
+		_ = os.RemoveAll(ts.directory)
+	}()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	bs, err := loadBootstrapSnapshot(ts.directory, marshalizer, hasher)
+	require.Nil(t, err)
+	defer bs.close()
+
+	requestedHeaders := make([][]byte, 0)
+	var requestedMiniBlocks [][]byte
+	var requestedTrieNodes [][]byte
+	networkRequestHandler := &mock.RequestHandlerStub{
+		RequestShardHeaderCalled: func(shardId uint32, hash []byte) {
+			requestedHeaders = append(requestedHeaders, hash)
+		},
+		RequestMetaHeaderCalled: func(hash []byte) {
+			requestedHeaders = append(requestedHeaders, hash)
+		},
+		RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblockHashes [][]byte) {
+			requestedMiniBlocks = miniblockHashes
+		},
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			requestedTrieNodes = hashes
+		},
+	}
+	dataPool := testscommon.NewPoolsHolderMock()
+	srh := newSnapshotRequestHandler(networkRequestHandler, bs, dataPool, marshalizer, hasher)
+
+	srh.RequestShardHeader(1, []byte("missing shard header"))
+	srh.RequestMetaHeader([]byte("missing meta header"))
+	srh.RequestMiniBlocks(0, [][]byte{ts.miniBlockHash, []byte("missing mini block")})
+	srh.RequestTrieNodes(0, [][]byte{ts.trieRootHash, []byte("invalid node hash"), []byte("missing node")}, "topic")
+
+	assert.Equal(t, [][]byte{[]byte("missing shard header"), []byte("missing meta header")}, requestedHeaders)
+	assert.Equal(t, [][]byte{[]byte("missing mini block")}, requestedMiniBlocks)
+	assert.Equal(t, [][]byte{[]byte("invalid node hash"), []byte("missing node")}, requestedTrieNodes)
+	assert.True(t, dataPool.MiniBlocks().Has(ts.miniBlockHash))
+	assert.False(t, dataPool.TrieNodes().Has([]byte("invalid node hash")))
+}
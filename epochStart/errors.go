@@ -236,9 +236,15 @@ var ErrInvalidMinNumberOfNodes = errors.New("minimum number of nodes invalid")
 // ErrNilEpochNotifier signals that the provided EpochNotifier is nil
 var ErrNilEpochNotifier = errors.New("nil EpochNotifier")
 
+// ErrNilTrieNodesCache signals that a nil trie nodes cache has been provided
+var ErrNilTrieNodesCache = errors.New("nil trie nodes cache")
+
 // ErrCouldNotInitDelegationSystemSC signals that delegation system sc init failed
 var ErrCouldNotInitDelegationSystemSC = errors.New("could not init delegation system sc")
 
+// ErrCouldNotInitScheduledTxSystemSC signals that scheduled transactions system sc init failed
+var ErrCouldNotInitScheduledTxSystemSC = errors.New("could not init scheduled transactions system sc")
+
 // ErrNilLocalTxCache signals that nil local tx cache has been provided
 var ErrNilLocalTxCache = errors.New("nil local tx cache")
 
@@ -313,3 +319,12 @@ var ErrResetLastUnJailedFromQueue = errors.New("reset last unjailed from queue f
 
 // ErrEmptyESDTOwnerAddress signals that an empty ESDT owner address was provided
 var ErrEmptyESDTOwnerAddress = errors.New("empty ESDT owner address")
+
+// ErrEpochStartMetaNotInSnapshot signals that the bootstrap snapshot does not contain the expected epoch start meta block
+var ErrEpochStartMetaNotInSnapshot = errors.New("epoch start meta block not found in the bootstrap snapshot")
+
+// ErrSnapshotEpochStartMetaMismatch signals that the bootstrap snapshot was created for another epoch start meta block
+var ErrSnapshotEpochStartMetaMismatch = errors.New("bootstrap snapshot created for another epoch start meta block")
+
+// ErrTrustedHashWithoutSnapshot signals that a trusted epoch start meta block hash was provided without a bootstrap snapshot
+var ErrTrustedHashWithoutSnapshot = errors.New("trusted epoch start meta block hash provided without a bootstrap snapshot")
@@ -46,6 +46,7 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 	StakingV2EnableEpoch                   uint32
 	CorrectLastUnJailEnableEpoch           uint32
 	ESDTEnableEpoch                        uint32
+	ScheduledTxEnableEpoch                 uint32
 	MaxNodesEnableConfig                   []config.MaxNodesChangeConfig
 	ESDTOwnerAddressBytes                  []byte
 
@@ -75,6 +76,7 @@ type systemSCProcessor struct {
 	stakingV2EnableEpoch           uint32
 	correctLastUnJailEpoch         uint32
 	esdtEnableEpoch                uint32
+	scheduledTxEnableEpoch         uint32
 	maxNodesEnableConfig           []config.MaxNodesChangeConfig
 	maxNodes                       uint32
 	flagSwitchJailedWaiting        atomic.Flag
@@ -86,6 +88,7 @@ type systemSCProcessor struct {
 	flagCorrectLastUnjailedEnabled atomic.Flag
 	flagCorrectNumNodesToStake     atomic.Flag
 	flagESDTEnabled                atomic.Flag
+	flagScheduledTxEnabled         atomic.Flag
 	esdtOwnerAddressBytes          []byte
 	mapNumSwitchedPerShard         map[uint32]uint32
 	mapNumSwitchablePerShard       map[uint32]uint32
@@ -174,6 +177,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		delegationEnableEpoch:    args.DelegationEnableEpoch,
 		stakingV2EnableEpoch:     args.StakingV2EnableEpoch,
 		esdtEnableEpoch:          args.ESDTEnableEpoch,
+		scheduledTxEnableEpoch:   args.ScheduledTxEnableEpoch,
 		stakingDataProvider:      args.StakingDataProvider,
 		nodesConfigProvider:      args.NodesConfigProvider,
 		shardCoordinator:         args.ShardCoordinator,
@@ -232,6 +236,13 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(
 		}
 	}
 
+	if s.flagScheduledTxEnabled.IsSet() {
+		err := s.initScheduledTxSystemSC()
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.flagCorrectNumNodesToStake.IsSet() {
 		err := s.cleanAdditionalQueue()
 		if err != nil {
@@ -1163,6 +1174,39 @@ func (s *systemSCProcessor) initDelegationSystemSC() error {
 	return nil
 }
 
+func (s *systemSCProcessor) initScheduledTxSystemSC() error {
+	codeMetaData := &vmcommon.CodeMetadata{
+		Upgradeable: false,
+		Payable:     false,
+		Readable:    true,
+	}
+
+	vmInput := &vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ScheduledTxSCAddress,
+			Arguments:  [][]byte{},
+			CallValue:  big.NewInt(0),
+		},
+		ContractCode:         vm.ScheduledTxSCAddress,
+		ContractCodeMetadata: codeMetaData.ToBytes(),
+	}
+
+	vmOutput, err := s.systemVM.RunSmartContractCreate(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return epochStart.ErrCouldNotInitScheduledTxSystemSC
+	}
+
+	err = s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	return s.updateSystemSCContractsCode(vmInput.ContractCodeMetadata)
+}
+
 func (s *systemSCProcessor) updateSystemSCContractsCode(contractMetadata []byte) error {
 	contractsToUpdate := make([][]byte, 0)
 	contractsToUpdate = append(contractsToUpdate, vm.StakingSCAddress)
@@ -1171,6 +1215,7 @@ func (s *systemSCProcessor) updateSystemSCContractsCode(contractMetadata []byte)
 	contractsToUpdate = append(contractsToUpdate, vm.ESDTSCAddress)
 	contractsToUpdate = append(contractsToUpdate, vm.DelegationManagerSCAddress)
 	contractsToUpdate = append(contractsToUpdate, vm.FirstDelegationSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.ScheduledTxSCAddress)
 
 	for _, address := range contractsToUpdate {
 		userAcc, err := s.getUserAccount(address)
@@ -1440,4 +1485,8 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32) {
 
 	s.flagESDTEnabled.Toggle(epoch == s.esdtEnableEpoch)
 	log.Debug("systemSCProcessor: ESDT", "enabled", s.flagESDTEnabled.IsSet())
+
+	// only toggle on exact epoch as init should be called only once
+	s.flagScheduledTxEnabled.Toggle(epoch == s.scheduledTxEnableEpoch)
+	log.Debug("systemSCProcessor: scheduled transactions", "enabled", epoch >= s.scheduledTxEnableEpoch)
 }
@@ -926,6 +926,14 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 				MinServiceFee: 0,
 				MaxServiceFee: 100,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		ValidatorAccountsDB: peerAccountsDB,
 		ChanceComputer:      &mock.ChanceComputerStub{},
@@ -961,9 +969,10 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 				return 63
 			},
 		},
-		ShardCoordinator:      shardCoordinator,
-		ESDTOwnerAddressBytes: bytes.Repeat([]byte{1}, 32),
-		ESDTEnableEpoch:       1000000,
+		ShardCoordinator:       shardCoordinator,
+		ESDTOwnerAddressBytes:  bytes.Repeat([]byte{1}, 32),
+		ESDTEnableEpoch:        1000000,
+		ScheduledTxEnableEpoch: 1000000,
 	}
 	return args, metaVmFactory.SystemSmartContractContainer()
 }
@@ -1028,6 +1037,32 @@ func TestSystemSCProcessor_ProcessSystemSmartContractInitDelegationMgr(t *testin
 	assert.NotNil(t, userAcc.GetCodeMetadata())
 }
 
+func TestSystemSCProcessor_ProcessSystemSmartContractInitScheduledTx(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	args.ScheduledTxEnableEpoch = 1
+	s, _ := NewSystemSCProcessor(args)
+	assert.False(t, s.flagScheduledTxEnabled.IsSet())
+
+	args.EpochNotifier.CheckEpoch(1)
+	assert.True(t, s.flagScheduledTxEnabled.IsSet())
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	err := s.ProcessSystemSmartContract(validatorInfos, 1, 1)
+	assert.Nil(t, err)
+
+	acc, err := s.userAccountsDB.GetExistingAccount(vm.ScheduledTxSCAddress)
+	assert.Nil(t, err)
+
+	userAcc, _ := acc.(state.UserAccountHandler)
+	assert.Equal(t, vm.ScheduledTxSCAddress, userAcc.GetOwnerAddress())
+	assert.NotNil(t, userAcc.GetCodeMetadata())
+
+	args.EpochNotifier.CheckEpoch(2)
+	assert.False(t, s.flagScheduledTxEnabled.IsSet())
+}
+
 func TestSystemSCProcessor_ProcessDelegationRewardsNothingToExecute(t *testing.T) {
 	t.Parallel()
 
@@ -13,6 +13,7 @@ type RequestHandlerStub struct {
 	RequestRewardTxHandlerCalled       func(destShardID uint32, txHashes [][]byte)
 	RequestMiniBlocksHandlerCalled     func(destShardID uint32, miniblockHashes [][]byte)
 	RequestStartOfEpochMetaBlockCalled func(epoch uint32)
+	RequestTrieNodesCalled             func(destShardID uint32, hashes [][]byte, topic string)
 	SetNumPeersToQueryCalled           func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled           func(key string) (int, int, error)
 }
@@ -28,7 +29,11 @@ func (rhs *RequestHandlerStub) RequestMiniBlock(_ uint32, _ []byte) {
 }
 
 // RequestTrieNodes -
-func (rhs *RequestHandlerStub) RequestTrieNodes(_ uint32, _ [][]byte, _ string) {
+func (rhs *RequestHandlerStub) RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string) {
+	if rhs.RequestTrieNodesCalled == nil {
+		return
+	}
+	rhs.RequestTrieNodesCalled(destShardID, hashes, topic)
 }
 
 // SetNumPeersToQuery -
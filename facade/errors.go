@@ -25,3 +25,18 @@ var ErrNilAccountState = errors.New("nil account state")
 
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrNilHealthService signals that the health service was not set
+var ErrNilHealthService = errors.New("nil health service")
+
+// ErrNilLogProfileManager signals that the log profile manager was not set
+var ErrNilLogProfileManager = errors.New("nil log profile manager")
+
+// ErrNilProfilesCapturer signals that the profiles capturer was not set, as the profiling is disabled
+var ErrNilProfilesCapturer = errors.New("nil profiles capturer, profiling is disabled")
+
+// ErrNilTrieStatisticsProvider signals that the trie statistics provider was not set
+var ErrNilTrieStatisticsProvider = errors.New("nil trie statistics provider")
+
+// ErrNilStateRetentionReporter signals that the state retention reporter was not set
+var ErrNilStateRetentionReporter = errors.New("nil state retention reporter")
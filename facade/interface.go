@@ -4,15 +4,20 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 )
 
 // NodeHandler contains all functions that a node should contain.
@@ -35,6 +40,9 @@ type NodeHandler interface {
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 
+	// GetESDTTokenProperties returns the properties and the metadata of an issued esdt token
+	GetESDTTokenProperties(tokenID string) (*api.ESDTTokenProperties, error)
+
 	// GetESDTData returns the esdt data from a given account, given key and given nonce
 	GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error)
 
@@ -106,3 +114,44 @@ type HardforkTrigger interface {
 	IsSelfTrigger() bool
 	IsInterfaceNil() bool
 }
+
+// HealthService defines the liveness and readiness probes of the node
+type HealthService interface {
+	CheckLiveness() health.Report
+	CheckReadiness() health.Report
+	IsInterfaceNil() bool
+}
+
+// AlertsProvider defines the component which holds the state of the alerting rules
+type AlertsProvider interface {
+	GetAlerts() []alerting.Alert
+	IsInterfaceNil() bool
+}
+
+// ProfilesCapturer defines the component which captures and stores runtime profiles snapshots
+type ProfilesCapturer interface {
+	TriggerCapture() (string, error)
+	ListSnapshots() ([]profiling.SnapshotInfo, error)
+	GetSnapshotFilePath(name string, file string) (string, error)
+	IsInterfaceNil() bool
+}
+
+// TrieStatisticsProvider defines the component which computes the statistics of the node tries on request
+type TrieStatisticsProvider interface {
+	GetTrieStatistics(trieID string, rootHash []byte) (*inspector.TrieReport, error)
+	IsInterfaceNil() bool
+}
+
+// StateRetentionReporter defines the component which reports the state retention policy and the disk usage of the
+// retained states
+type StateRetentionReporter interface {
+	GetStateRetentionReport() (*inspector.StateRetentionReport, error)
+	IsInterfaceNil() bool
+}
+
+// LogProfileManager defines the component able to change the logger profile of the node at runtime
+type LogProfileManager interface {
+	GetProfileState() logging.ProfileState
+	SetProfile(update logging.ProfileUpdate) (logging.ProfileState, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
+
+// AlertsProviderStub -
+type AlertsProviderStub struct {
+	GetAlertsCalled func() []alerting.Alert
+}
+
+// GetAlerts -
+func (aps *AlertsProviderStub) GetAlerts() []alerting.Alert {
+	if aps.GetAlertsCalled != nil {
+		return aps.GetAlertsCalled()
+	}
+
+	return make([]alerting.Alert, 0)
+}
+
+// IsInterfaceNil -
+func (aps *AlertsProviderStub) IsInterfaceNil() bool {
+	return aps == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/health"
+
+// HealthServiceStub -
+type HealthServiceStub struct {
+	CheckLivenessCalled  func() health.Report
+	CheckReadinessCalled func() health.Report
+}
+
+// CheckLiveness -
+func (hss *HealthServiceStub) CheckLiveness() health.Report {
+	if hss.CheckLivenessCalled != nil {
+		return hss.CheckLivenessCalled()
+	}
+
+	return health.Report{Healthy: true}
+}
+
+// CheckReadiness -
+func (hss *HealthServiceStub) CheckReadiness() health.Report {
+	if hss.CheckReadinessCalled != nil {
+		return hss.CheckReadinessCalled()
+	}
+
+	return health.Report{Healthy: true}
+}
+
+// IsInterfaceNil -
+func (hss *HealthServiceStub) IsInterfaceNil() bool {
+	return hss == nil
+}
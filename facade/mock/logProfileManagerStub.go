@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core/logging"
+
+// LogProfileManagerStub -
+type LogProfileManagerStub struct {
+	GetProfileStateCalled func() logging.ProfileState
+	SetProfileCalled      func(update logging.ProfileUpdate) (logging.ProfileState, error)
+}
+
+// GetProfileState -
+func (lpms *LogProfileManagerStub) GetProfileState() logging.ProfileState {
+	if lpms.GetProfileStateCalled != nil {
+		return lpms.GetProfileStateCalled()
+	}
+
+	return logging.ProfileState{}
+}
+
+// SetProfile -
+func (lpms *LogProfileManagerStub) SetProfile(update logging.ProfileUpdate) (logging.ProfileState, error) {
+	if lpms.SetProfileCalled != nil {
+		return lpms.SetProfileCalled(update)
+	}
+
+	return logging.ProfileState{}, nil
+}
+
+// IsInterfaceNil -
+func (lpms *LogProfileManagerStub) IsInterfaceNil() bool {
+	return lpms == nil
+}
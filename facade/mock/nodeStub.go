@@ -45,6 +45,7 @@ type NodeStub struct {
 	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
 	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
+	GetESDTTokenPropertiesCalled                   func(tokenID string) (*api.ESDTTokenProperties, error)
 }
 
 // GetUsername -
@@ -209,6 +210,14 @@ func (ns *NodeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return make([]string, 0), nil
 }
 
+// GetESDTTokenProperties -
+func (ns *NodeStub) GetESDTTokenProperties(tokenID string) (*api.ESDTTokenProperties, error) {
+	if ns.GetESDTTokenPropertiesCalled != nil {
+		return ns.GetESDTTokenPropertiesCalled(tokenID)
+	}
+	return &api.ESDTTokenProperties{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
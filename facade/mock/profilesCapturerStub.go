@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core/profiling"
+
+// ProfilesCapturerStub -
+type ProfilesCapturerStub struct {
+	TriggerCaptureCalled      func() (string, error)
+	ListSnapshotsCalled       func() ([]profiling.SnapshotInfo, error)
+	GetSnapshotFilePathCalled func(name string, file string) (string, error)
+}
+
+// TriggerCapture -
+func (pcs *ProfilesCapturerStub) TriggerCapture() (string, error) {
+	if pcs.TriggerCaptureCalled != nil {
+		return pcs.TriggerCaptureCalled()
+	}
+
+	return "", nil
+}
+
+// ListSnapshots -
+func (pcs *ProfilesCapturerStub) ListSnapshots() ([]profiling.SnapshotInfo, error) {
+	if pcs.ListSnapshotsCalled != nil {
+		return pcs.ListSnapshotsCalled()
+	}
+
+	return make([]profiling.SnapshotInfo, 0), nil
+}
+
+// GetSnapshotFilePath -
+func (pcs *ProfilesCapturerStub) GetSnapshotFilePath(name string, file string) (string, error) {
+	if pcs.GetSnapshotFilePathCalled != nil {
+		return pcs.GetSnapshotFilePathCalled(name, file)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (pcs *ProfilesCapturerStub) IsInterfaceNil() bool {
+	return pcs == nil
+}
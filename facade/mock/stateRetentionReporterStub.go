@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/trie/inspector"
+
+// StateRetentionReporterStub -
+type StateRetentionReporterStub struct {
+	GetStateRetentionReportCalled func() (*inspector.StateRetentionReport, error)
+}
+
+// GetStateRetentionReport -
+func (srrs *StateRetentionReporterStub) GetStateRetentionReport() (*inspector.StateRetentionReport, error) {
+	if srrs.GetStateRetentionReportCalled != nil {
+		return srrs.GetStateRetentionReportCalled()
+	}
+
+	return &inspector.StateRetentionReport{}, nil
+}
+
+// IsInterfaceNil -
+func (srrs *StateRetentionReporterStub) IsInterfaceNil() bool {
+	return srrs == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/trie/inspector"
+
+// TrieStatisticsProviderStub -
+type TrieStatisticsProviderStub struct {
+	GetTrieStatisticsCalled func(trieID string, rootHash []byte) (*inspector.TrieReport, error)
+}
+
+// GetTrieStatistics -
+func (tsps *TrieStatisticsProviderStub) GetTrieStatistics(trieID string, rootHash []byte) (*inspector.TrieReport, error) {
+	if tsps.GetTrieStatisticsCalled != nil {
+		return tsps.GetTrieStatisticsCalled(trieID, rootHash)
+	}
+
+	return &inspector.TrieReport{}, nil
+}
+
+// IsInterfaceNil -
+func (tsps *TrieStatisticsProviderStub) IsInterfaceNil() bool {
+	return tsps == nil
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -27,12 +29,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 )
 
 // DefaultRestInterface is the default interface the rest API will start on if not specified
@@ -75,6 +80,12 @@ type nodeFacade struct {
 	apiResolver            ApiResolver
 	syncer                 ntp.SyncTimer
 	tpsBenchmark           *statistics.TpsBenchmark
+	healthService          HealthService
+	alertsProvider         AlertsProvider
+	logProfileManager      LogProfileManager
+	profilesCapturer       ProfilesCapturer
+	trieStatsProvider      TrieStatisticsProvider
+	stateRetentionReporter StateRetentionReporter
 	txSimulatorProc        TransactionSimulatorProcessor
 	config                 config.FacadeConfig
 	apiRoutesConfig        config.ApiRoutesConfig
@@ -164,6 +175,36 @@ func (nf *nodeFacade) SetTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) {
 	nf.tpsBenchmark = tpsBenchmark
 }
 
+// SetHealthService sets the service providing the liveness and readiness reports
+func (nf *nodeFacade) SetHealthService(healthService HealthService) {
+	nf.healthService = healthService
+}
+
+// SetAlertsProvider sets the component holding the state of the alerting rules
+func (nf *nodeFacade) SetAlertsProvider(alertsProvider AlertsProvider) {
+	nf.alertsProvider = alertsProvider
+}
+
+// SetLogProfileManager sets the component able to change the logger profile of the node
+func (nf *nodeFacade) SetLogProfileManager(logProfileManager LogProfileManager) {
+	nf.logProfileManager = logProfileManager
+}
+
+// SetProfilesCapturer sets the component which captures the runtime profiles snapshots
+func (nf *nodeFacade) SetProfilesCapturer(profilesCapturer ProfilesCapturer) {
+	nf.profilesCapturer = profilesCapturer
+}
+
+// SetTrieStatisticsProvider sets the component which computes the statistics of the node tries
+func (nf *nodeFacade) SetTrieStatisticsProvider(trieStatsProvider TrieStatisticsProvider) {
+	nf.trieStatsProvider = trieStatsProvider
+}
+
+// SetStateRetentionReporter sets the component which reports the disk usage of the retained states
+func (nf *nodeFacade) SetStateRetentionReporter(stateRetentionReporter StateRetentionReporter) {
+	nf.stateRetentionReporter = stateRetentionReporter
+}
+
 // TpsBenchmark returns the tps benchmark handler
 func (nf *nodeFacade) TpsBenchmark() *statistics.TpsBenchmark {
 	return nf.tpsBenchmark
@@ -240,7 +281,13 @@ func (nf *nodeFacade) CreateMiddlewareLimiters() ([]api.MiddlewareProcessor, err
 		return nil, err
 	}
 
-	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter}, nil
+	apiKeyAuthenticator, err := middleware.NewAPIKeyAuthenticator(nf.apiRoutesConfig.Auth)
+	if err != nil {
+		return nil, err
+	}
+	go nf.sourceLimiterReset(apiKeyAuthenticator)
+
+	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter, apiKeyAuthenticator}, nil
 }
 
 func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
@@ -292,6 +339,11 @@ func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return nf.node.GetAllIssuedESDTs(tokenType)
 }
 
+// GetESDTTokenProperties returns the properties and the metadata of an issued esdt token
+func (nf *nodeFacade) GetESDTTokenProperties(tokenID string) (*apiData.ESDTTokenProperties, error) {
+	return nf.node.GetESDTTokenProperties(tokenID)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -469,6 +521,109 @@ func (nf *nodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 	return nf.peerState.GetNumCheckpoints()
 }
 
+// GetLivenessReport returns the result of the liveness checks of the node
+func (nf *nodeFacade) GetLivenessReport() health.Report {
+	if check.IfNil(nf.healthService) {
+		return createMissingHealthServiceReport()
+	}
+
+	return nf.healthService.CheckLiveness()
+}
+
+// GetReadinessReport returns the result of the readiness checks of the node
+func (nf *nodeFacade) GetReadinessReport() health.Report {
+	if check.IfNil(nf.healthService) {
+		return createMissingHealthServiceReport()
+	}
+
+	return nf.healthService.CheckReadiness()
+}
+
+// GetAlerts returns the state of the alerting rules, an empty list being returned if alerting is disabled
+func (nf *nodeFacade) GetAlerts() []alerting.Alert {
+	if check.IfNil(nf.alertsProvider) {
+		return make([]alerting.Alert, 0)
+	}
+
+	return nf.alertsProvider.GetAlerts()
+}
+
+// GetLogProfile returns the current logger profile of the node and the pending revert of a temporary profile, if any
+func (nf *nodeFacade) GetLogProfile() (logging.ProfileState, error) {
+	if check.IfNil(nf.logProfileManager) {
+		return logging.ProfileState{}, ErrNilLogProfileManager
+	}
+
+	return nf.logProfileManager.GetProfileState(), nil
+}
+
+// SetLogProfile changes the logger profile of the node, temporarily if the update has a TTL
+func (nf *nodeFacade) SetLogProfile(update logging.ProfileUpdate) (logging.ProfileState, error) {
+	if check.IfNil(nf.logProfileManager) {
+		return logging.ProfileState{}, ErrNilLogProfileManager
+	}
+
+	return nf.logProfileManager.SetProfile(update)
+}
+
+// TriggerProfilesCapture starts capturing the runtime profiles and returns the name of the snapshot holding them
+func (nf *nodeFacade) TriggerProfilesCapture() (string, error) {
+	if check.IfNil(nf.profilesCapturer) {
+		return "", ErrNilProfilesCapturer
+	}
+
+	return nf.profilesCapturer.TriggerCapture()
+}
+
+// GetProfilesSnapshots returns the stored runtime profiles snapshots, newest first
+func (nf *nodeFacade) GetProfilesSnapshots() ([]profiling.SnapshotInfo, error) {
+	if check.IfNil(nf.profilesCapturer) {
+		return nil, ErrNilProfilesCapturer
+	}
+
+	return nf.profilesCapturer.ListSnapshots()
+}
+
+// GetProfileFilePath returns the path of a file of a stored runtime profiles snapshot
+func (nf *nodeFacade) GetProfileFilePath(name string, file string) (string, error) {
+	if check.IfNil(nf.profilesCapturer) {
+		return "", ErrNilProfilesCapturer
+	}
+
+	return nf.profilesCapturer.GetSnapshotFilePath(name, file)
+}
+
+// GetTrieStatistics computes the statistics of the trie with the provided identifier for the provided root hash
+func (nf *nodeFacade) GetTrieStatistics(trieID string, rootHash []byte) (*inspector.TrieReport, error) {
+	if check.IfNil(nf.trieStatsProvider) {
+		return nil, ErrNilTrieStatisticsProvider
+	}
+
+	return nf.trieStatsProvider.GetTrieStatistics(trieID, rootHash)
+}
+
+// GetStateRetentionReport returns the state retention policy and the disk usage of the retained states
+func (nf *nodeFacade) GetStateRetentionReport() (*inspector.StateRetentionReport, error) {
+	if check.IfNil(nf.stateRetentionReporter) {
+		return nil, ErrNilStateRetentionReporter
+	}
+
+	return nf.stateRetentionReporter.GetStateRetentionReport()
+}
+
+func createMissingHealthServiceReport() health.Report {
+	return health.Report{
+		Healthy: false,
+		Checks: []health.CheckResult{
+			{
+				Name:    "health service",
+				Healthy: false,
+				Error:   ErrNilHealthService.Error(),
+			},
+		},
+	}
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
@@ -12,17 +12,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie/inspector"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -769,6 +774,25 @@ func TestNodeFacade_GetAllIssuedESDTsWithError(t *testing.T) {
 	assert.Equal(t, err, localErr)
 }
 
+func TestNodeFacade_GetESDTTokenProperties(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := &api.ESDTTokenProperties{TokenIdentifier: "TCK-01a2b3"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetESDTTokenPropertiesCalled: func(tokenID string) (*api.ESDTTokenProperties, error) {
+			assert.Equal(t, expectedValue.TokenIdentifier, tokenID)
+			return expectedValue, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetESDTTokenProperties(expectedValue.TokenIdentifier)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, res)
+}
+
 func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	t.Parallel()
 
@@ -840,3 +864,157 @@ func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, called)
 }
+
+func TestNodeFacade_GetLivenessAndReadinessReport(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+
+	report := nf.GetReadinessReport()
+	assert.False(t, report.Healthy)
+	assert.Equal(t, ErrNilHealthService.Error(), report.Checks[0].Error)
+	assert.False(t, nf.GetLivenessReport().Healthy)
+
+	readinessReport := health.Report{
+		Healthy: false,
+		Checks:  []health.CheckResult{{Name: "sync state", Error: "node is not synchronized"}},
+	}
+	nf.SetHealthService(&mock.HealthServiceStub{
+		CheckReadinessCalled: func() health.Report {
+			return readinessReport
+		},
+	})
+
+	assert.True(t, nf.GetLivenessReport().Healthy)
+	assert.Equal(t, readinessReport, nf.GetReadinessReport())
+}
+
+func TestNodeFacade_GetAlerts(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	assert.Equal(t, 0, len(nf.GetAlerts()))
+
+	alerts := []alerting.Alert{{Name: "LowPeerCount", State: alerting.StatePending}}
+	nf.SetAlertsProvider(&mock.AlertsProviderStub{
+		GetAlertsCalled: func() []alerting.Alert {
+			return alerts
+		},
+	})
+
+	assert.Equal(t, alerts, nf.GetAlerts())
+}
+
+func TestNodeFacade_LogProfile(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	_, err := nf.GetLogProfile()
+	assert.Equal(t, ErrNilLogProfileManager, err)
+	_, err = nf.SetLogProfile(logging.ProfileUpdate{})
+	assert.Equal(t, ErrNilLogProfileManager, err)
+
+	state := logging.ProfileState{
+		Current: logging.ProfileData{LogLevelPatterns: "*:INFO,process/sync:DEBUG"},
+	}
+	var receivedUpdate logging.ProfileUpdate
+	nf.SetLogProfileManager(&mock.LogProfileManagerStub{
+		GetProfileStateCalled: func() logging.ProfileState {
+			return state
+		},
+		SetProfileCalled: func(update logging.ProfileUpdate) (logging.ProfileState, error) {
+			receivedUpdate = update
+			return state, nil
+		},
+	})
+
+	retrievedState, err := nf.GetLogProfile()
+	assert.Nil(t, err)
+	assert.Equal(t, state, retrievedState)
+
+	update := logging.ProfileUpdate{TTL: time.Minute * 10}
+	retrievedState, err = nf.SetLogProfile(update)
+	assert.Nil(t, err)
+	assert.Equal(t, state, retrievedState)
+	assert.Equal(t, update, receivedUpdate)
+}
+
+func TestNodeFacade_Profiles(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	_, err := nf.TriggerProfilesCapture()
+	assert.Equal(t, ErrNilProfilesCapturer, err)
+	_, err = nf.GetProfilesSnapshots()
+	assert.Equal(t, ErrNilProfilesCapturer, err)
+	_, err = nf.GetProfileFilePath("name", "heap.pprof")
+	assert.Equal(t, ErrNilProfilesCapturer, err)
+
+	snapshots := []profiling.SnapshotInfo{{Name: "20201231_235959_000__on-demand"}}
+	nf.SetProfilesCapturer(&mock.ProfilesCapturerStub{
+		TriggerCaptureCalled: func() (string, error) {
+			return snapshots[0].Name, nil
+		},
+		ListSnapshotsCalled: func() ([]profiling.SnapshotInfo, error) {
+			return snapshots, nil
+		},
+		GetSnapshotFilePathCalled: func(name string, file string) (string, error) {
+			return name + "/" + file, nil
+		},
+	})
+
+	name, err := nf.TriggerProfilesCapture()
+	assert.Nil(t, err)
+	assert.Equal(t, snapshots[0].Name, name)
+
+	retrievedSnapshots, err := nf.GetProfilesSnapshots()
+	assert.Nil(t, err)
+	assert.Equal(t, snapshots, retrievedSnapshots)
+
+	path, err := nf.GetProfileFilePath("name", "heap.pprof")
+	assert.Nil(t, err)
+	assert.Equal(t, "name/heap.pprof", path)
+}
+
+func TestNodeFacade_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	report, err := nf.GetTrieStatistics("userAccount", nil)
+	assert.Nil(t, report)
+	assert.Equal(t, ErrNilTrieStatisticsProvider, err)
+
+	expectedReport := &inspector.TrieReport{NumAccounts: 3}
+	rootHash := []byte("root hash")
+	nf.SetTrieStatisticsProvider(&mock.TrieStatisticsProviderStub{
+		GetTrieStatisticsCalled: func(trieID string, providedRootHash []byte) (*inspector.TrieReport, error) {
+			assert.Equal(t, "userAccount", trieID)
+			assert.Equal(t, rootHash, providedRootHash)
+			return expectedReport, nil
+		},
+	})
+
+	report, err = nf.GetTrieStatistics("userAccount", rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReport, report)
+}
+
+func TestNodeFacade_GetStateRetentionReport(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	report, err := nf.GetStateRetentionReport()
+	assert.Nil(t, report)
+	assert.Equal(t, ErrNilStateRetentionReporter, err)
+
+	expectedReport := &inspector.StateRetentionReport{Policy: "archive"}
+	nf.SetStateRetentionReporter(&mock.StateRetentionReporterStub{
+		GetStateRetentionReportCalled: func() (*inspector.StateRetentionReport, error) {
+			return expectedReport, nil
+		},
+	})
+
+	report, err = nf.GetStateRetentionReport()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReport, report)
+}
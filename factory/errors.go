@@ -17,6 +17,9 @@ var ErrNilShardCoordinator = errors.New("nil shard coordinator provided")
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager provided")
 
+// ErrNilTrieNodesCache signals that a nil trie nodes cache has been provided
+var ErrNilTrieNodesCache = errors.New("nil trie nodes cache provided")
+
 // ErrNilMarshalizer signals that a nil marshalizer has been provided
 var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
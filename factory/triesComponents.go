@@ -22,6 +22,7 @@ type TriesComponentsFactoryArgs struct {
 	PathManager      storage.PathManagerHandler
 	ShardCoordinator sharding.Coordinator
 	Config           config.Config
+	TrieNodesCache   data.TrieNodesCacher
 }
 
 type triesComponentsFactory struct {
@@ -30,6 +31,7 @@ type triesComponentsFactory struct {
 	pathManager      storage.PathManagerHandler
 	shardCoordinator sharding.Coordinator
 	config           config.Config
+	trieNodesCache   data.TrieNodesCacher
 }
 
 // NewTriesComponentsFactory return a new instance of tries components factory
@@ -46,6 +48,9 @@ func NewTriesComponentsFactory(args TriesComponentsFactoryArgs) (*triesComponent
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if check.IfNil(args.TrieNodesCache) {
+		return nil, ErrNilTrieNodesCache
+	}
 
 	return &triesComponentsFactory{
 		config:           args.Config,
@@ -53,6 +58,7 @@ func NewTriesComponentsFactory(args TriesComponentsFactoryArgs) (*triesComponent
 		hasher:           args.Hasher,
 		pathManager:      args.PathManager,
 		shardCoordinator: args.ShardCoordinator,
+		trieNodesCache:   args.TrieNodesCache,
 	}, nil
 }
 
@@ -66,6 +72,8 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 		Hasher:                   tcf.hasher,
 		PathManager:              tcf.pathManager,
 		TrieStorageManagerConfig: tcf.config.TrieStorageManagerConfig,
+		TrieNodesCache:           tcf.trieNodesCache,
+		StateRetentionCfg:        tcf.config.StateTriesConfig.StateRetention,
 	}
 	shardIDString := convertShardIDToString(tcf.shardCoordinator.SelfId())
 
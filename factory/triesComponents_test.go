@@ -3,6 +3,7 @@ package factory_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -49,6 +50,16 @@ func TestNewTriesComponentsFactory_NilShardCoordinatorShouldErr(t *testing.T) {
 	require.Equal(t, factory.ErrNilShardCoordinator, err)
 }
 
+func TestNewTriesComponentsFactory_NilTrieNodesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getTriesArgs()
+	args.TrieNodesCache = nil
+	tcf, err := factory.NewTriesComponentsFactory(args)
+	require.Nil(t, tcf)
+	require.Equal(t, factory.ErrNilTrieNodesCache, err)
+}
+
 func TestNewTriesComponentsFactory_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -76,5 +87,6 @@ func getTriesArgs() factory.TriesComponentsFactoryArgs {
 		PathManager:      &mock.PathManagerStub{},
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
 		Config:           testscommon.GetGeneralConfig(),
+		TrieNodesCache:   trie.NewDisabledTrieNodesCache(),
 	}
 }
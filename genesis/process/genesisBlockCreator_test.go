@@ -99,6 +99,14 @@ func createMockArgument(
 				MinServiceFee: 0,
 				MaxServiceFee: 100,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		TrieStorageManagers: trieStorageManagers,
 		BlockSignKeyGen:     &mock.KeyGenMock{},
@@ -0,0 +1,184 @@
+package health
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-logger/check"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// CheckResult holds the outcome of one liveness or readiness check
+type CheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Report holds the outcome of all the liveness or readiness checks. The node is healthy if all the checks passed
+type Report struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []CheckResult `json:"checks"`
+}
+
+func newCheckResult(name string, err error) CheckResult {
+	result := CheckResult{
+		Name:    name,
+		Healthy: err == nil,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+type syncStateCheck struct {
+	provider nodeStateProvider
+}
+
+// NewSyncStateCheck creates a readiness check which passes only if the bootstrapper considers the node synchronized
+func NewSyncStateCheck(provider nodeStateProvider) (*syncStateCheck, error) {
+	if check.IfNil(provider) {
+		return nil, errNilNodeStateProvider
+	}
+
+	return &syncStateCheck{
+		provider: provider,
+	}, nil
+}
+
+// HealthCheckName returns the name of the check
+func (ssc *syncStateCheck) HealthCheckName() string {
+	return "sync state"
+}
+
+// CheckReadiness returns an error if the node is not synchronized
+func (ssc *syncStateCheck) CheckReadiness() error {
+	if ssc.provider.GetNodeState() != core.NsSynchronized {
+		return errNodeNotSynchronized
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssc *syncStateCheck) IsInterfaceNil() bool {
+	return ssc == nil
+}
+
+type nonceLagCheck struct {
+	provider    statusMetricsProvider
+	maxNonceLag uint64
+}
+
+// NewNonceLagCheck creates a readiness check which passes only if the current nonce of the node is at most
+// maxNonceLag blocks behind the highest nonce seen on the network
+func NewNonceLagCheck(provider statusMetricsProvider, maxNonceLag uint64) (*nonceLagCheck, error) {
+	if check.IfNil(provider) {
+		return nil, errNilStatusMetricsProvider
+	}
+
+	return &nonceLagCheck{
+		provider:    provider,
+		maxNonceLag: maxNonceLag,
+	}, nil
+}
+
+// HealthCheckName returns the name of the check
+func (nlc *nonceLagCheck) HealthCheckName() string {
+	return "nonce lag"
+}
+
+// CheckReadiness returns an error if the node is too many blocks behind the network
+func (nlc *nonceLagCheck) CheckReadiness() error {
+	metrics := nlc.provider.StatusMetricsMapWithoutP2P()
+	nonce, _ := metrics[core.MetricNonce].(uint64)
+	highestNonce, _ := metrics[core.MetricProbableHighestNonce].(uint64)
+	if highestNonce <= nonce {
+		return nil
+	}
+
+	lag := highestNonce - nonce
+	if lag > nlc.maxNonceLag {
+		return fmt.Errorf("%w: %d blocks behind, maximum %d", errNonceLagTooHigh, lag, nlc.maxNonceLag)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nlc *nonceLagCheck) IsInterfaceNil() bool {
+	return nlc == nil
+}
+
+type connectedPeersCheck struct {
+	provider          connectedPeersProvider
+	minConnectedPeers int
+}
+
+// NewConnectedPeersCheck creates a readiness check which passes only if the node is connected to at least
+// minConnectedPeers peers
+func NewConnectedPeersCheck(provider connectedPeersProvider, minConnectedPeers int) (*connectedPeersCheck, error) {
+	if check.IfNil(provider) {
+		return nil, errNilConnectedPeersProvider
+	}
+
+	return &connectedPeersCheck{
+		provider:          provider,
+		minConnectedPeers: minConnectedPeers,
+	}, nil
+}
+
+// HealthCheckName returns the name of the check
+func (cpc *connectedPeersCheck) HealthCheckName() string {
+	return "connected peers"
+}
+
+// CheckReadiness returns an error if the node is not connected to enough peers
+func (cpc *connectedPeersCheck) CheckReadiness() error {
+	numConnectedPeers := len(cpc.provider.ConnectedPeers())
+	if numConnectedPeers < cpc.minConnectedPeers {
+		return fmt.Errorf("%w: %d connected, minimum %d", errNotEnoughConnectedPeers, numConnectedPeers, cpc.minConnectedPeers)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cpc *connectedPeersCheck) IsInterfaceNil() bool {
+	return cpc == nil
+}
+
+type trieSyncCheck struct {
+	finished uint32
+}
+
+// NewTrieSyncCheck creates a readiness check which passes only after SetTrieSyncFinished was called
+func NewTrieSyncCheck() *trieSyncCheck {
+	return &trieSyncCheck{}
+}
+
+// SetTrieSyncFinished marks the state tries as synchronized
+func (tsc *trieSyncCheck) SetTrieSyncFinished() {
+	atomic.StoreUint32(&tsc.finished, 1)
+}
+
+// HealthCheckName returns the name of the check
+func (tsc *trieSyncCheck) HealthCheckName() string {
+	return "trie sync"
+}
+
+// CheckReadiness returns an error if the state tries are not synchronized yet
+func (tsc *trieSyncCheck) CheckReadiness() error {
+	if atomic.LoadUint32(&tsc.finished) == 0 {
+		return errTrieSyncNotFinished
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsc *trieSyncCheck) IsInterfaceNil() bool {
+	return tsc == nil
+}
@@ -0,0 +1,77 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncStateCheck(t *testing.T) {
+	t.Parallel()
+
+	ssc, err := NewSyncStateCheck(nil)
+	require.Nil(t, ssc)
+	require.Equal(t, errNilNodeStateProvider, err)
+
+	provider := &dummyNodeStateProvider{state: core.NsNotCalculated}
+	ssc, err = NewSyncStateCheck(provider)
+	require.Nil(t, err)
+	require.Equal(t, errNodeNotSynchronized, ssc.CheckReadiness())
+
+	provider.state = core.NsNotSynchronized
+	require.Equal(t, errNodeNotSynchronized, ssc.CheckReadiness())
+
+	provider.state = core.NsSynchronized
+	require.Nil(t, ssc.CheckReadiness())
+}
+
+func TestNonceLagCheck(t *testing.T) {
+	t.Parallel()
+
+	nlc, err := NewNonceLagCheck(nil, 10)
+	require.Nil(t, nlc)
+	require.Equal(t, errNilStatusMetricsProvider, err)
+
+	provider := &dummyStatusMetricsProvider{metrics: map[string]interface{}{}}
+	nlc, err = NewNonceLagCheck(provider, 10)
+	require.Nil(t, err)
+	require.Nil(t, nlc.CheckReadiness())
+
+	provider.metrics[core.MetricNonce] = uint64(100)
+	provider.metrics[core.MetricProbableHighestNonce] = uint64(110)
+	require.Nil(t, nlc.CheckReadiness())
+
+	provider.metrics[core.MetricProbableHighestNonce] = uint64(111)
+	require.True(t, errors.Is(nlc.CheckReadiness(), errNonceLagTooHigh))
+
+	provider.metrics[core.MetricProbableHighestNonce] = uint64(90)
+	require.Nil(t, nlc.CheckReadiness())
+}
+
+func TestConnectedPeersCheck(t *testing.T) {
+	t.Parallel()
+
+	cpc, err := NewConnectedPeersCheck(nil, 2)
+	require.Nil(t, cpc)
+	require.Equal(t, errNilConnectedPeersProvider, err)
+
+	provider := &dummyConnectedPeersProvider{peers: []core.PeerID{"a"}}
+	cpc, err = NewConnectedPeersCheck(provider, 2)
+	require.Nil(t, err)
+	require.True(t, errors.Is(cpc.CheckReadiness(), errNotEnoughConnectedPeers))
+
+	provider.peers = append(provider.peers, "b")
+	require.Nil(t, cpc.CheckReadiness())
+}
+
+func TestTrieSyncCheck(t *testing.T) {
+	t.Parallel()
+
+	tsc := NewTrieSyncCheck()
+	require.Equal(t, errTrieSyncNotFinished, tsc.CheckReadiness())
+
+	tsc.SetTrieSyncFinished()
+	require.Nil(t, tsc.CheckReadiness())
+}
@@ -6,3 +6,10 @@ import (
 
 var errNilComponent = errors.New("component is nil")
 var errNotDiagnosableComponent = errors.New("component is not diagnosable")
+var errNilNodeStateProvider = errors.New("nil node state provider")
+var errNilStatusMetricsProvider = errors.New("nil status metrics provider")
+var errNilConnectedPeersProvider = errors.New("nil connected peers provider")
+var errNodeNotSynchronized = errors.New("node is not synchronized")
+var errNonceLagTooHigh = errors.New("nonce lag behind the network is too high")
+var errNotEnoughConnectedPeers = errors.New("not enough connected peers")
+var errTrieSyncNotFinished = errors.New("trie sync has not finished")
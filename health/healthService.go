@@ -23,6 +23,9 @@ type healthService struct {
 	records                             *records
 	diagnosableComponents               []diagnosable
 	diagnosableComponentsMutex          sync.RWMutex
+	livenessCheckers                    []livenessChecker
+	readinessCheckers                   []readinessChecker
+	mutCheckers                         sync.RWMutex
 	clock                               clock
 	memory                              memory
 	onMonitorContinuouslyBeginIteration func()
@@ -42,6 +45,8 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 		cancelFunction:                      func() {},
 		records:                             recordsObj,
 		diagnosableComponents:               make([]diagnosable, 0),
+		livenessCheckers:                    make([]livenessChecker, 0),
+		readinessCheckers:                   make([]readinessChecker, 0),
 		clock:                               &realClock{},
 		memory:                              &realMemory{},
 		onMonitorContinuouslyBeginIteration: func() {},
@@ -49,7 +54,8 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 	}
 }
 
-// RegisterComponent registers a diagnosable component
+// RegisterComponent registers a component which can be diagnosed or which takes part in the liveness or
+// readiness probes of the node
 func (h *healthService) RegisterComponent(component interface{}) {
 	err := h.doRegisterComponent(component)
 	if err != nil {
@@ -58,20 +64,68 @@ func (h *healthService) RegisterComponent(component interface{}) {
 }
 
 func (h *healthService) doRegisterComponent(component interface{}) error {
-	asDiagnosable, ok := component.(diagnosable)
-	if !ok {
+	asDiagnosable, isDiagnosable := component.(diagnosable)
+	asLivenessChecker, isLivenessChecker := component.(livenessChecker)
+	asReadinessChecker, isReadinessChecker := component.(readinessChecker)
+	if !isDiagnosable && !isLivenessChecker && !isReadinessChecker {
 		return errNotDiagnosableComponent
 	}
-	if check.IfNil(asDiagnosable) {
+	if check.IfNilReflect(component) {
 		return errNilComponent
 	}
 
-	h.diagnosableComponentsMutex.Lock()
-	h.diagnosableComponents = append(h.diagnosableComponents, asDiagnosable)
-	h.diagnosableComponentsMutex.Unlock()
+	if isDiagnosable {
+		h.diagnosableComponentsMutex.Lock()
+		h.diagnosableComponents = append(h.diagnosableComponents, asDiagnosable)
+		h.diagnosableComponentsMutex.Unlock()
+	}
+
+	h.mutCheckers.Lock()
+	if isLivenessChecker {
+		h.livenessCheckers = append(h.livenessCheckers, asLivenessChecker)
+	}
+	if isReadinessChecker {
+		h.readinessCheckers = append(h.readinessCheckers, asReadinessChecker)
+	}
+	h.mutCheckers.Unlock()
+
 	return nil
 }
 
+// CheckLiveness runs all the registered liveness checks
+func (h *healthService) CheckLiveness() Report {
+	h.mutCheckers.RLock()
+	defer h.mutCheckers.RUnlock()
+
+	report := Report{
+		Healthy: true,
+		Checks:  make([]CheckResult, 0, len(h.livenessCheckers)),
+	}
+	for _, checker := range h.livenessCheckers {
+		result := newCheckResult(checker.HealthCheckName(), checker.CheckLiveness())
+		report.Healthy = report.Healthy && result.Healthy
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
+// CheckReadiness runs all the registered liveness and readiness checks, as a node which is not alive is not ready
+func (h *healthService) CheckReadiness() Report {
+	report := h.CheckLiveness()
+
+	h.mutCheckers.RLock()
+	defer h.mutCheckers.RUnlock()
+
+	for _, checker := range h.readinessCheckers {
+		result := newCheckResult(checker.HealthCheckName(), checker.CheckReadiness())
+		report.Healthy = report.Healthy && result.Healthy
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
 // Start starts the health service
 func (h *healthService) Start() {
 	log.Info("healthService.Start()")
@@ -2,6 +2,7 @@ package health
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -36,6 +37,36 @@ func TestHealthService_DiagnoseComponents(t *testing.T) {
 	require.Equal(t, 1, int(b.numShallowDiagnoses.Get()))
 }
 
+func TestHealthService_CheckLivenessAndReadiness(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	report := h.CheckReadiness()
+	require.True(t, report.Healthy)
+	require.Equal(t, 0, len(report.Checks))
+
+	alive := &dummyChecker{name: "alive", readinessError: errors.New("syncing")}
+	ready := &dummyReadinessChecker{name: "ready"}
+	h.RegisterComponent(alive)
+	h.RegisterComponent(ready)
+
+	report = h.CheckLiveness()
+	require.True(t, report.Healthy)
+	require.Equal(t, []CheckResult{{Name: "alive", Healthy: true}}, report.Checks)
+
+	report = h.CheckReadiness()
+	require.False(t, report.Healthy)
+	require.Equal(t, []CheckResult{
+		{Name: "alive", Healthy: true},
+		{Name: "alive", Healthy: false, Error: "syncing"},
+		{Name: "ready", Healthy: true},
+	}, report.Checks)
+
+	alive.livenessError = errors.New("stuck")
+	alive.readinessError = nil
+	require.False(t, h.CheckLiveness().Healthy)
+	require.False(t, h.CheckReadiness().Healthy)
+}
+
 func TestHealthService_MonitorMemory(t *testing.T) {
 	h := newHealthServiceToTest(42, 1)
 
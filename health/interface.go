@@ -3,6 +3,8 @@ package health
 import (
 	"runtime"
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
 )
 
 // diagnosable is an internal interface, which external components can implement in order to be "diagnosed" by the health service
@@ -28,3 +30,43 @@ type clock interface {
 type memory interface {
 	getStats() runtime.MemStats
 }
+
+// livenessChecker is an internal interface, which external components can implement in order to take part in the
+// liveness probe of the node. A failing check means the node should be restarted
+type livenessChecker interface {
+	HealthCheckName() string
+	CheckLiveness() error
+	IsInterfaceNil() bool
+}
+
+// readinessChecker is an internal interface, which external components can implement in order to take part in the
+// readiness probe of the node. A failing check means the node should not receive traffic yet
+type readinessChecker interface {
+	HealthCheckName() string
+	CheckReadiness() error
+	IsInterfaceNil() bool
+}
+
+// nodeStateProvider is able to tell if the node is synchronized
+type nodeStateProvider interface {
+	GetNodeState() core.NodeState
+	IsInterfaceNil() bool
+}
+
+// statusMetricsProvider returns the status metrics of the node
+type statusMetricsProvider interface {
+	StatusMetricsMapWithoutP2P() map[string]interface{}
+	IsInterfaceNil() bool
+}
+
+// connectedPeersProvider returns the peers the node is connected to
+type connectedPeersProvider interface {
+	ConnectedPeers() []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// ComponentsRegistrar registers the components which are diagnosed or which take part in the liveness and
+// readiness probes of the node
+type ComponentsRegistrar interface {
+	RegisterComponent(component interface{})
+}
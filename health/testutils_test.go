@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 )
 
@@ -13,6 +14,8 @@ var _ record = (*dummyRecord)(nil)
 var _ diagnosable = (*dummyDiagnosable)(nil)
 var _ memory = (*dummyMemory)(nil)
 var _ clock = (*dummyClock)(nil)
+var _ livenessChecker = (*dummyChecker)(nil)
+var _ readinessChecker = (*dummyChecker)(nil)
 
 var dummySignal struct{}
 
@@ -157,3 +160,78 @@ func (dummy *dummyClock) getEventToHappenNoLock() (eventToHappen *dummyEvent, ev
 
 	return
 }
+
+type dummyChecker struct {
+	name           string
+	livenessError  error
+	readinessError error
+}
+
+func (dummy *dummyChecker) HealthCheckName() string {
+	return dummy.name
+}
+
+func (dummy *dummyChecker) CheckLiveness() error {
+	return dummy.livenessError
+}
+
+func (dummy *dummyChecker) CheckReadiness() error {
+	return dummy.readinessError
+}
+
+func (dummy *dummyChecker) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyReadinessChecker struct {
+	name           string
+	readinessError error
+}
+
+func (dummy *dummyReadinessChecker) HealthCheckName() string {
+	return dummy.name
+}
+
+func (dummy *dummyReadinessChecker) CheckReadiness() error {
+	return dummy.readinessError
+}
+
+func (dummy *dummyReadinessChecker) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyNodeStateProvider struct {
+	state core.NodeState
+}
+
+func (dummy *dummyNodeStateProvider) GetNodeState() core.NodeState {
+	return dummy.state
+}
+
+func (dummy *dummyNodeStateProvider) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyStatusMetricsProvider struct {
+	metrics map[string]interface{}
+}
+
+func (dummy *dummyStatusMetricsProvider) StatusMetricsMapWithoutP2P() map[string]interface{} {
+	return dummy.metrics
+}
+
+func (dummy *dummyStatusMetricsProvider) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyConnectedPeersProvider struct {
+	peers []core.PeerID
+}
+
+func (dummy *dummyConnectedPeersProvider) ConnectedPeers() []core.PeerID {
+	return dummy.peers
+}
+
+func (dummy *dummyConnectedPeersProvider) IsInterfaceNil() bool {
+	return dummy == nil
+}
@@ -1,8 +1,11 @@
 package startInEpoch
 
 import (
+	"fmt"
+	"io/ioutil"
 	"math/big"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
@@ -27,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStartInEpochForAShardNodeInMultiShardedEnvironment(t *testing.T) {
@@ -159,10 +164,20 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		},
 	}
 
+	workingDir, err := ioutil.TempDir("", "startInEpoch")
+	require.Nil(t, err)
 	defer func() {
-		errRemoveDir := os.RemoveAll("Epoch_0")
+		errRemoveDir := os.RemoveAll(workingDir)
 		assert.NoError(t, errRemoveDir)
 	}()
+	pathManager := &mock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(workingDir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+		},
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			return filepath.Join(workingDir, "Static", fmt.Sprintf("Shard_%s", shardId), identifier)
+		},
+	}
 
 	genesisShardCoordinator, _ := sharding.NewMultiShardCoordinator(nodesConfig.NumberOfShards(), 0)
 
@@ -194,7 +209,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		LatestStorageDataProvider:  &mock.LatestStorageDataProviderStub{},
 		StorageUnitOpener:          &mock.UnitOpenerStub{},
 		GenesisNodesConfig:         nodesConfig,
-		PathManager:                &mock.PathManagerStub{},
+		PathManager:                pathManager,
 		WorkingDir:                 "test_directory",
 		DefaultDBPath:              "test_db",
 		DefaultEpochString:         "test_epoch",
@@ -210,6 +225,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		HeaderIntegrityVerifier:    integrationTests.CreateHeaderIntegrityVerifier(),
 		TxSignHasher:               integrationTests.TestHasher,
 		EpochNotifier:              &mock.EpochNotifierStub{},
+		TrieNodesCache:             trie.NewDisabledTrieNodesCache(),
 	}
 	epochStartBootstrap, err := bootstrap.NewEpochStartBootstrap(argsBootstrapHandler)
 	assert.Nil(t, err)
@@ -224,13 +240,16 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 	storageFactory, err := factory.NewStorageServiceFactory(
 		&generalConfig,
 		shardC,
-		&mock.PathManagerStub{},
+		pathManager,
 		notifier.NewEpochStartSubscriptionHandler(),
 		0)
 	assert.NoError(t, err)
 	storageServiceShard, err := storageFactory.CreateForMeta()
 	assert.NoError(t, err)
 	assert.NotNil(t, storageServiceShard)
+	defer func() {
+		_ = storageServiceShard.CloseAll()
+	}()
 
 	bootstrapUnit := storageServiceShard.GetStorer(dataRetriever.BootstrapUnit)
 	assert.NotNil(t, bootstrapUnit)
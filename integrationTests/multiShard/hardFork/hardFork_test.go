@@ -462,6 +462,14 @@ func hardForkImport(
 					MinServiceFee: 0,
 					MaxServiceFee: 100,
 				},
+				ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+					EnabledEpoch:           0,
+					MaxPendingTxs:          100,
+					MaxPendingTxsPerSender: 10,
+					MaxRoundsInFuture:      1000,
+					MaxExecutionsPerCall:   10,
+					DepositPerTx:           "10",
+				},
 			},
 			AccountsParser:      &mock.AccountsParserStub{},
 			SmartContractParser: &mock.SmartContractParserStub{},
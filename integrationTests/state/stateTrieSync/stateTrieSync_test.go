@@ -160,6 +160,7 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 		TrieSyncStatistics:             tss,
 		TimeoutBetweenTrieNodesCommits: timeout,
 		MaxHardCapForMissingNodes:      10000,
+		CheckpointHandler:              trie.NewDisabledTrieSyncCheckpoint(),
 	}
 	trieSyncer, _ := trie.NewDoubleListTrieSyncer(arg)
 
@@ -281,6 +282,7 @@ func TestMultipleDataTriesSync(t *testing.T) {
 			Cacher:                    nRequester.DataPool.TrieNodes(),
 			MaxTrieLevelInMemory:      200,
 			MaxHardCapForMissingNodes: 5000,
+			CheckpointHandler:         trie.NewDisabledTrieSyncCheckpoint(),
 			TrieSyncerVersion:         2,
 		},
 		ShardId:   shardID,
@@ -577,6 +577,14 @@ func CreateFullGenesisBlocks(
 				MinServiceFee: 0,
 				MaxServiceFee: 100,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		AccountsParser:      accountsParser,
 		SmartContractParser: smartContractParser,
@@ -677,6 +685,14 @@ func CreateGenesisMetaBlock(
 				MinServiceFee: 0,
 				MaxServiceFee: 100,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		BlockSignKeyGen:    &mock.KeyGenMock{},
 		ImportStartHandler: &mock.ImportStartHandlerStub{},
@@ -42,6 +42,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersLatency"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -73,6 +74,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/scheduledTxs"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -515,7 +517,9 @@ func NewTestProcessorNodeWithFullGenesis(
 	)
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
-	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
+	gasMap := arwenConfig.MakeGasMapForTests()
+	defaults.FillGasMapInternal(gasMap, 1)
+	tpn.initInnerProcessors(gasMap)
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
@@ -676,7 +680,9 @@ func (tpn *TestProcessorNode) initTestNode() {
 	)
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
-	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
+	gasMap := arwenConfig.MakeGasMapForTests()
+	defaults.FillGasMapInternal(gasMap, 1)
+	tpn.initInnerProcessors(gasMap)
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
@@ -755,9 +761,16 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		Marshalizer:      TestMarshalizer,
 		Accounts:         tpn.AccntState,
 		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
+	}
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		panic(err)
+	}
+	builtInFuncs, err := builtInFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		panic(err)
 	}
-	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
-	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
 
 	smartContractsCache := testscommon.NewCacherMock()
 
@@ -824,6 +837,14 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 					MinServiceFee: 0,
 					MaxServiceFee: 100000,
 				},
+				ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+					EnabledEpoch:           0,
+					MaxPendingTxs:          100,
+					MaxPendingTxsPerSender: 10,
+					MaxRoundsInFuture:      1000,
+					MaxExecutionsPerCall:   10,
+					DepositPerTx:           "10",
+				},
 			},
 			ValidatorAccountsDB: tpn.PeerState,
 			ChanceComputer:      tpn.NodesCoordinator,
@@ -1093,6 +1114,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			MinTransactionVersion:   tpn.MinTransactionVersion,
 			TxSignHasher:            TestHasher,
 			EpochNotifier:           tpn.EpochNotifier,
+			PeersLatencyHandler:     peersLatency.NewDisabledPeersLatencyTracker(),
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaIntercContFactArgs)
 
@@ -1157,6 +1179,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			MinTransactionVersion:   tpn.MinTransactionVersion,
 			TxSignHasher:            TestTxSignHasher,
 			EpochNotifier:           tpn.EpochNotifier,
+			PeersLatencyHandler:     peersLatency.NewDisabledPeersLatencyTracker(),
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterContFactArgs)
 
@@ -1274,9 +1297,16 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		Marshalizer:      TestMarshalizer,
 		Accounts:         tpn.AccntState,
 		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
+	}
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		panic(err)
+	}
+	builtInFuncs, err := builtInFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		panic(err)
 	}
-	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
-	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
 
 	for name, function := range TestBuiltinFunctions {
 		err := builtInFuncs.Add(name, function)
@@ -1311,7 +1341,6 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	}
 	vmFactory, _ := shard.NewVMContainerFactory(argsNewVMFactory)
 
-	var err error
 	tpn.VMContainer, err = vmFactory.Create()
 	if err != nil {
 		panic(err)
@@ -1459,9 +1488,16 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		Marshalizer:      TestMarshalizer,
 		Accounts:         tpn.AccntState,
 		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
+	}
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		panic(err)
+	}
+	builtInFuncs, err := builtInFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		panic(err)
 	}
-	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
-	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:           tpn.AccntState,
 		PubkeyConv:         TestAddressPubkeyConverter,
@@ -1532,6 +1568,14 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 				MinServiceFee: 0,
 				MaxServiceFee: 100000,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		ValidatorAccountsDB: tpn.PeerState,
 		ChanceComputer:      &mock.RaterMock{},
@@ -1904,11 +1948,27 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			NodesConfigProvider:     tpn.NodesCoordinator,
 			ShardCoordinator:        tpn.ShardCoordinator,
 			ESDTEnableEpoch:         0,
+			ScheduledTxEnableEpoch:  0,
 			ESDTOwnerAddressBytes:   vm.EndOfEpochAddress,
 		}
 		epochStartSystemSCProcessor, _ := metachain.NewSystemSCProcessor(argsEpochSystemSC)
 		tpn.EpochStartSystemSCProcessor = epochStartSystemSCProcessor
 
+		argsDueTxsExecutor := scheduledTxs.ArgsDueTxsExecutor{
+			SystemVM:             systemVM,
+			AccountsDB:           tpn.AccntState,
+			ShardCoordinator:     tpn.ShardCoordinator,
+			ScrForwarder:         tpn.ScrForwarder,
+			FeeHandler:           tpn.FeeAccumulator,
+			Marshalizer:          TestMarshalizer,
+			Hasher:               TestHasher,
+			EndOfEpochAddress:    vm.EndOfEpochAddress,
+			ScheduledTxSCAddress: vm.ScheduledTxSCAddress,
+			EnableEpoch:          0,
+			EpochNotifier:        tpn.EpochNotifier,
+		}
+		dueTxsExecutor, _ := scheduledTxs.NewDueTxsExecutor(argsDueTxsExecutor)
+
 		arguments := block.ArgMetaProcessor{
 			ArgBaseProcessor:             argumentsBase,
 			SCToProtocol:                 scToProtocolInstance,
@@ -1919,6 +1979,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
 			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			ScheduledTxsExecutor:         dueTxsExecutor,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
 )
 
 // NewTestSyncNode returns a new TestProcessorNode instance with sync capabilities
@@ -117,7 +118,9 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initResolvers()
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
-	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
+	gasMap := arwenConfig.MakeGasMapForTests()
+	defaults.FillGasMapInternal(gasMap, 1)
+	tpn.initInnerProcessors(gasMap)
 	tpn.initBlockProcessorWithSync()
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -205,6 +208,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			ScheduledTxsExecutor:         &mock.ScheduledTxsExecutorStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
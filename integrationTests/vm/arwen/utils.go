@@ -199,6 +199,7 @@ func (context *TestContext) initVMAndBlockchainHook() {
 		Marshalizer:      marshalizer,
 		Accounts:         context.Accounts,
 		ShardCoordinator: oneShardCoordinator,
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	require.Nil(context.T, err)
@@ -391,6 +391,7 @@ func CreateVMAndBlockchainHook(
 		Marshalizer:      testMarshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -460,6 +461,7 @@ func CreateVMAndBlockchainHookMeta(
 		Marshalizer:      testMarshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -556,6 +558,14 @@ func createSystemSCConfig(arg ArgEnableEpoch) *config.SystemSmartContractsConfig
 			MinServiceFee: 1,
 			MaxServiceFee: 20,
 		},
+		ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+			EnabledEpoch:           0,
+			MaxPendingTxs:          100,
+			MaxPendingTxsPerSender: 10,
+			MaxRoundsInFuture:      1000,
+			MaxExecutionsPerCall:   10,
+			DepositPerTx:           "10",
+		},
 	}
 }
 
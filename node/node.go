@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -173,6 +174,9 @@ type Node struct {
 	watchdog          core.WatchdogTimer
 	historyRepository dblookupext.HistoryRepository
 
+	mutBootstrapper syncGo.RWMutex
+	bootstrapper    process.Bootstrapper
+
 	enableSignTxWithHashEpoch uint32
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
@@ -214,6 +218,24 @@ func (n *Node) GetAppStatusHandler() core.AppStatusHandler {
 	return n.appStatusHandler
 }
 
+// GetNodeState returns the synchronization state of the node as computed by the bootstrapper. The state is
+// not calculated before the consensus is started
+func (n *Node) GetNodeState() core.NodeState {
+	n.mutBootstrapper.RLock()
+	defer n.mutBootstrapper.RUnlock()
+
+	if check.IfNil(n.bootstrapper) {
+		return core.NsNotCalculated
+	}
+
+	return n.bootstrapper.GetNodeState()
+}
+
+// GetWatchdog returns the watchdog of the node
+func (n *Node) GetWatchdog() core.WatchdogTimer {
+	return n.watchdog
+}
+
 // CreateShardedStores instantiate sharded cachers for Transactions and Headers
 func (n *Node) CreateShardedStores() error {
 	if n.shardCoordinator == nil {
@@ -278,6 +300,10 @@ func (n *Node) StartConsensus() error {
 
 	bootstrapper.StartSyncingBlocks()
 
+	n.mutBootstrapper.Lock()
+	n.bootstrapper = bootstrapper
+	n.mutBootstrapper.Unlock()
+
 	epoch := n.blkc.GetGenesisHeader().GetEpoch()
 	crtBlockHeader := n.blkc.GetCurrentBlockHeader()
 	if !check.IfNil(crtBlockHeader) {
@@ -513,6 +539,62 @@ func (n *Node) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return tokens, nil
 }
 
+// GetESDTTokenProperties returns the properties and the metadata of an issued esdt token, works only on metachain
+func (n *Node) GetESDTTokenProperties(tokenID string) (*api.ESDTTokenProperties, error) {
+	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	value, err := userAccount.DataTrieTracker().RetrieveValue([]byte(tokenID))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, api.ErrTokenNotFound
+	}
+
+	esdtToken := &systemSmartContracts.ESDTData{}
+	err = n.internalMarshalizer.Unmarshal(esdtToken, value)
+	if err != nil {
+		return nil, err
+	}
+
+	socialLinks := make([]string, 0, len(esdtToken.SocialLinks))
+	for _, link := range esdtToken.SocialLinks {
+		socialLinks = append(socialLinks, string(link))
+	}
+
+	return &api.ESDTTokenProperties{
+		TokenIdentifier: tokenID,
+		TokenName:       string(esdtToken.TokenName),
+		Ticker:          string(esdtToken.TickerName),
+		TokenType:       string(esdtToken.TokenType),
+		Owner:           n.addressPubkeyConverter.Encode(esdtToken.OwnerAddress),
+		NumDecimals:     esdtToken.NumDecimals,
+		MintedValue:     bigIntToString(esdtToken.MintedValue),
+		BurntValue:      bigIntToString(esdtToken.BurntValue),
+		IsPaused:        esdtToken.IsPaused,
+		Website:         string(esdtToken.Website),
+		Description:     string(esdtToken.Description),
+		LogoHash:        hex.EncodeToString(esdtToken.LogoHash),
+		SocialLinks:     socialLinks,
+	}, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
 // GetKeyValuePairs returns all the key-value pairs under the address
 func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
 	account, err := n.getAccountHandlerAPIAccounts(address)
@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
@@ -107,6 +108,12 @@ func TestNewNode_ApplyNilOptionShouldError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestNode_GetNodeStateBeforeConsensusShouldNotBeCalculated(t *testing.T) {
+	n, _ := node.NewNode()
+
+	assert.Equal(t, core.NsNotCalculated, n.GetNodeState())
+}
+
 func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 
 	n, _ := node.NewNode(
@@ -573,6 +580,66 @@ func TestNode_GetAllIssuedESDTs(t *testing.T) {
 
 //------- GenerateTransaction
 
+func TestNode_GetESDTTokenProperties(t *testing.T) {
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	esdtToken := []byte("TCK-RANDOM")
+	owner := bytes.Repeat([]byte{1}, 32)
+
+	esdtData := &systemSmartContracts.ESDTData{
+		TokenName:    []byte("fungible"),
+		TickerName:   []byte("TCK"),
+		TokenType:    []byte(core.FungibleESDT),
+		OwnerAddress: owner,
+		NumDecimals:  6,
+		MintedValue:  big.NewInt(1000),
+		Website:      []byte("https://elrond.com"),
+		Description:  []byte("a token"),
+		LogoHash:     []byte{0xaa, 0xbb},
+		SocialLinks:  [][]byte{[]byte("https://twitter.com/elrond")},
+	}
+	marshalledData, _ := getMarshalizer().Marshal(esdtData)
+	_ = acc.DataTrieTracker().SaveKeyValue(esdtToken, marshalledData)
+	acc.DataTrieTracker().SetDataTrie(&mock.TrieStub{
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+
+	accDB := &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+		GetExistingAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapterAPI(accDB),
+		node.WithBlockChain(&mock.BlockChainMock{GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		}}),
+	)
+
+	tokenProperties, err := n.GetESDTTokenProperties(string(esdtToken))
+	require.Nil(t, err)
+	assert.Equal(t, string(esdtToken), tokenProperties.TokenIdentifier)
+	assert.Equal(t, "fungible", tokenProperties.TokenName)
+	assert.Equal(t, "TCK", tokenProperties.Ticker)
+	assert.Equal(t, createMockPubkeyConverter().Encode(owner), tokenProperties.Owner)
+	assert.Equal(t, uint32(6), tokenProperties.NumDecimals)
+	assert.Equal(t, "1000", tokenProperties.MintedValue)
+	assert.Equal(t, "0", tokenProperties.BurntValue)
+	assert.Equal(t, "https://elrond.com", tokenProperties.Website)
+	assert.Equal(t, "a token", tokenProperties.Description)
+	assert.Equal(t, "aabb", tokenProperties.LogoHash)
+	assert.Equal(t, []string{"https://twitter.com/elrond"}, tokenProperties.SocialLinks)
+
+	_, err = n.GetESDTTokenProperties("NOT-FOUND")
+	assert.Equal(t, api.ErrTokenNotFound, err)
+}
+
 func TestGenerateTransaction_NoAddrConverterShouldError(t *testing.T) {
 	privateKey := getPrivateKey()
 	n, _ := node.NewNode(
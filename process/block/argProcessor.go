@@ -63,5 +63,6 @@ type ArgMetaProcessor struct {
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	ScheduledTxsExecutor         process.ScheduledTxsExecutionHandler
 	RewardsV2EnableEpoch         uint32
 }
@@ -13,7 +13,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/core/profiling"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -29,6 +32,13 @@ import (
 
 var log = logger.GetOrCreate("process/block")
 
+var blockProcessingDuration = prometheus.DefaultRegistry().NewHistogramVec(
+	"elrond_block_processing_duration_seconds",
+	"Duration of the block processing and commit operations.",
+	prometheus.DefaultDurationBuckets,
+	"operation", "result",
+)
+
 type hashAndHdr struct {
 	hdr  data.HeaderHandler
 	hash []byte
@@ -1109,9 +1119,13 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 	}
 }
 
-func (bp *baseProcessor) commitAll() error {
+func (bp *baseProcessor) commitAll(ctx context.Context) error {
 	for key := range bp.accountsDB {
+		_, span := tracing.StartSpan(ctx, "accountsDB.Commit")
+		span.SetAttribute("accounts", key)
 		_, err := bp.accountsDB[key].Commit()
+		span.SetError(err)
+		span.End()
 		if err != nil {
 			return err
 		}
@@ -1252,3 +1266,31 @@ func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32)
 		bp.blockTracker.AddTrackedHeader(headers[i], hashes[i])
 	}
 }
+
+// startBlockSpan starts the root span of a block processing step. The returned context holds the span and has to be
+// passed to the components processing the block, so that their spans are recorded as its children
+func startBlockSpan(name string, headerHandler data.HeaderHandler) (context.Context, *tracing.Span) {
+	ctx, span := tracing.StartSpan(context.Background(), name)
+	if check.IfNil(headerHandler) {
+		return ctx, span
+	}
+
+	span.SetAttribute("shard", headerHandler.GetShardID())
+	span.SetAttribute("epoch", headerHandler.GetEpoch())
+	span.SetAttribute("round", headerHandler.GetRound())
+	span.SetAttribute("nonce", headerHandler.GetNonce())
+	span.SetAttribute("num txs", headerHandler.GetTxCount())
+
+	return ctx, span
+}
+
+func observeBlockDuration(operation string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	duration := time.Since(start)
+	blockProcessingDuration.WithLabelValues(operation, result).Observe(duration.Seconds())
+	profiling.NotifyBlockProcessingDuration(operation, duration)
+}
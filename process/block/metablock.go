@@ -36,6 +36,7 @@ type metaProcessor struct {
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	scheduledTxsExecutor         process.ScheduledTxsExecutionHandler
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -79,6 +80,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.ScheduledTxsExecutor) {
+		return nil, process.ErrNilScheduledTxsExecutor
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -123,6 +127,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
+		scheduledTxsExecutor:         arguments.ScheduledTxsExecutor,
 		rewardsV2EnableEpoch:         arguments.RewardsV2EnableEpoch,
 	}
 
@@ -154,6 +159,22 @@ func (mp *metaProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	start := time.Now()
+	ctx, span := startBlockSpan("metaProcessor.ProcessBlock", headerHandler)
+	err := mp.processBlock(ctx, headerHandler, bodyHandler, haveTime)
+	observeBlockDuration("process", start, err)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
+func (mp *metaProcessor) processBlock(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
 
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -312,7 +333,12 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
-	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime)
+	err = mp.scheduledTxsExecutor.ExecuteDueTxs()
+	if err != nil {
+		return err
+	}
+
+	err = mp.txCoordinator.ProcessBlockTransaction(ctx, body, haveTime)
 	if err != nil {
 		return err
 	}
@@ -881,6 +907,11 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		"nonce", metaBlock.GetNonce(),
 	)
 
+	err := mp.scheduledTxsExecutor.ExecuteDueTxs()
+	if err != nil {
+		return nil, err
+	}
+
 	miniBlocks, err := mp.createMiniBlocks(haveTime)
 	if err != nil {
 		return nil, err
@@ -901,6 +932,8 @@ func (mp *metaProcessor) createMiniBlocks(
 
 	if !haveTime() {
 		log.Debug("metaProcessor.createMiniBlocks", "error", process.ErrTimeIsOut)
+		// the results of the due scheduled transactions are always included in the block
+		miniBlocks = mp.txCoordinator.CreatePostProcessMiniBlocks()
 		return &block.Body{MiniBlocks: miniBlocks}, nil
 	}
 
@@ -1089,6 +1122,21 @@ func (mp *metaProcessor) requestShardHeadersIfNeeded(
 func (mp *metaProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
+) error {
+	start := time.Now()
+	ctx, span := startBlockSpan("metaProcessor.CommitBlock", headerHandler)
+	err := mp.commitBlock(ctx, headerHandler, bodyHandler)
+	observeBlockDuration("commit", start, err)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
+func (mp *metaProcessor) commitBlock(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
 ) error {
 	var err error
 	defer func() {
@@ -1140,7 +1188,7 @@ func (mp *metaProcessor) CommitBlock(
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body, header)
 
-	err = mp.commitAll()
+	err = mp.commitAll(ctx)
 	if err != nil {
 		return err
 	}
@@ -89,6 +89,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 		EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+		ScheduledTxsExecutor:         &mock.ScheduledTxsExecutorStub{},
 	}
 	return arguments
 }
@@ -328,6 +329,17 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilScheduledTxsExecutorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.ScheduledTxsExecutor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilScheduledTxsExecutor, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2532,6 +2544,57 @@ func TestMetaProcessor_CreateBlockCreateHeaderProcessBlock(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMetaProcessor_CreateBlockBodyShouldExecuteTheDueScheduledTxsBeforeTheTransactions(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	postProcessMiniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("scr hash")}}
+	arguments := createMockMetaArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		CreateMbsAndProcessTransactionsFromMeCalled: func(haveTime func() bool) block.MiniBlockSlice {
+			calls = append(calls, "transactions")
+			return nil
+		},
+		CreatePostProcessMiniBlocksCalled: func() block.MiniBlockSlice {
+			return block.MiniBlockSlice{postProcessMiniBlock}
+		},
+	}
+	arguments.ScheduledTxsExecutor = &mock.ScheduledTxsExecutorStub{
+		ExecuteDueTxsCalled: func() error {
+			calls = append(calls, "scheduled")
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	_, err := mp.CreateBlockBody(&block.MetaBlock{Round: 10}, func() bool { return true })
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"scheduled", "transactions"}, calls)
+
+	calls = make([]string, 0)
+	bodyHandler, err := mp.CreateBlockBody(&block.MetaBlock{Round: 11}, func() bool { return false })
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"scheduled"}, calls)
+	assert.Equal(t, []*block.MiniBlock{postProcessMiniBlock}, bodyHandler.(*block.Body).MiniBlocks)
+}
+
+func TestMetaProcessor_CreateBlockBodyShouldErrWhenTheDueScheduledTxsFail(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arguments := createMockMetaArguments()
+	arguments.ScheduledTxsExecutor = &mock.ScheduledTxsExecutorStub{
+		ExecuteDueTxsCalled: func() error {
+			return expectedErr
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	bodyHandler, err := mp.CreateBlockBody(&block.MetaBlock{Round: 10}, func() bool { return true })
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, bodyHandler)
+}
+
 func TestMetaProcessor_RequestShardHeadersIfNeededShouldAddHeaderIntoTrackerPool(t *testing.T) {
 	t.Parallel()
 
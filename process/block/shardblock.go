@@ -110,6 +110,22 @@ func (sp *shardProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	start := time.Now()
+	ctx, span := startBlockSpan("shardProcessor.ProcessBlock", headerHandler)
+	err := sp.processBlock(ctx, headerHandler, bodyHandler, haveTime)
+	observeBlockDuration("process", start, err)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
+func (sp *shardProcessor) processBlock(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
 
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -249,7 +265,7 @@ func (sp *shardProcessor) ProcessBlock(
 	}()
 
 	startTime := time.Now()
-	err = sp.txCoordinator.ProcessBlockTransaction(body, haveTime)
+	err = sp.txCoordinator.ProcessBlockTransaction(ctx, body, haveTime)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to process block transaction",
 		"time [s]", elapsedTime,
@@ -747,6 +763,21 @@ func (sp *shardProcessor) createBlockBody(shardHdr *block.Header, haveTime func(
 func (sp *shardProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
+) error {
+	start := time.Now()
+	ctx, span := startBlockSpan("shardProcessor.CommitBlock", headerHandler)
+	err := sp.commitBlock(ctx, headerHandler, bodyHandler)
+	observeBlockDuration("commit", start, err)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
+func (sp *shardProcessor) commitBlock(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
 ) error {
 	var err error
 	defer func() {
@@ -825,7 +856,7 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	err = sp.commitAll()
+	err = sp.commitAll(ctx)
 	if err != nil {
 		return err
 	}
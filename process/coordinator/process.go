@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -58,7 +60,7 @@ type transactionCoordinator struct {
 	blockGasAndFeesReCheckEnableEpoch uint32
 }
 
-//TODO: Should be refactored with arguments (added task EN-8790 in Jira)
+// TODO: Should be refactored with arguments (added task EN-8790 in Jira)
 // NewTransactionCoordinator creates a transaction coordinator to run and coordinate preprocessors and processors
 func NewTransactionCoordinator(
 	hasher hashing.Hasher,
@@ -447,8 +449,10 @@ func (tc *transactionCoordinator) RemoveTxsFromPool(body *block.Body) error {
 	return errFound
 }
 
-// ProcessBlockTransaction processes transactions and updates state tries
+// ProcessBlockTransaction processes transactions and updates state tries. The tracing spans of the preprocessors are
+// recorded as children of the span held by the provided context
 func (tc *transactionCoordinator) ProcessBlockTransaction(
+	ctx context.Context,
 	body *block.Body,
 	timeRemaining func() time.Duration,
 ) error {
@@ -473,7 +477,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 	}
 
 	startTime := time.Now()
-	mbIndex, err := tc.processMiniBlocksToMe(body, haveTime)
+	mbIndex, err := tc.processMiniBlocksToMe(ctx, body, haveTime)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksToMe",
 		"time [s]", elapsedTime,
@@ -488,7 +492,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 
 	miniBlocksFromMe := body.MiniBlocks[mbIndex:]
 	startTime = time.Now()
-	err = tc.processMiniBlocksFromMe(&block.Body{MiniBlocks: miniBlocksFromMe}, haveTime)
+	err = tc.processMiniBlocksFromMe(ctx, &block.Body{MiniBlocks: miniBlocksFromMe}, haveTime)
 	elapsedTime = time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksFromMe",
 		"time [s]", elapsedTime,
@@ -501,6 +505,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 }
 
 func (tc *transactionCoordinator) processMiniBlocksFromMe(
+	ctx context.Context,
 	body *block.Body,
 	haveTime func() bool,
 ) error {
@@ -522,7 +527,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 			return process.ErrMissingPreProcessor
 		}
 
-		err := preProc.ProcessBlockTransactions(separatedBodies[blockType], haveTime)
+		err := processBlockTransactionsWithSpan(ctx, preProc, blockType, separatedBodies[blockType], haveTime)
 		if err != nil {
 			return err
 		}
@@ -532,6 +537,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 }
 
 func (tc *transactionCoordinator) processMiniBlocksToMe(
+	ctx context.Context,
 	body *block.Body,
 	haveTime func() bool,
 ) (int, error) {
@@ -549,7 +555,7 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 			return mbIndex, process.ErrMissingPreProcessor
 		}
 
-		err := preProc.ProcessBlockTransactions(&block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
+		err := processBlockTransactionsWithSpan(ctx, preProc, miniBlock.Type, &block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
 		if err != nil {
 			return mbIndex, err
 		}
@@ -558,6 +564,24 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 	return mbIndex, nil
 }
 
+func processBlockTransactionsWithSpan(
+	ctx context.Context,
+	preProc process.PreProcessor,
+	blockType block.Type,
+	body *block.Body,
+	haveTime func() bool,
+) error {
+	_, span := tracing.StartSpan(ctx, "preProcessor.ProcessBlockTransactions")
+	span.SetAttribute("block type", blockType.String())
+	span.SetAttribute("num miniblocks", len(body.MiniBlocks))
+
+	err := preProc.ProcessBlockTransactions(body, haveTime)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
 // CreateMbsAndProcessCrossShardTransactionsDstMe creates miniblocks and processes cross shard transaction
 // with destination of current shard
 func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe(
@@ -245,6 +245,9 @@ var ErrInvalidContainerKey = errors.New("element does not exist in container")
 // ErrContainerKeyAlreadyExists signals that an element was already set in the container's map
 var ErrContainerKeyAlreadyExists = errors.New("provided key already exists in container")
 
+// ErrNilPeersLatencyHandler signals that a nil peers latency handler has been provided
+var ErrNilPeersLatencyHandler = errors.New("nil peers latency handler")
+
 // ErrNilRequestHandler signals that a nil request handler interface was provided
 var ErrNilRequestHandler = errors.New("nil request handler")
 
@@ -976,3 +979,15 @@ var ErrNilBuiltInFunctionsCostHandler = errors.New("nil built in functions cost
 
 // ErrNilArgsBuiltInFunctionsConstHandler signals that a nil arguments struct for built in functions cost handler has been provided
 var ErrNilArgsBuiltInFunctionsConstHandler = errors.New("nil arguments for built in functions cost handler")
+
+// ErrNFTRoyaltiesPaymentIsDisabled signals that the NFT royalties payment built in function is disabled
+var ErrNFTRoyaltiesPaymentIsDisabled = errors.New("NFT royalties payment is disabled")
+
+// ErrNilSystemVM signals that a nil system VM has been provided
+var ErrNilSystemVM = errors.New("nil system VM")
+
+// ErrNilScheduledTxsExecutor signals that a nil scheduled transactions executor has been provided
+var ErrNilScheduledTxsExecutor = errors.New("nil scheduled transactions executor")
+
+// ErrDueScheduledTxsExecution signals that the due scheduled transactions could not be executed
+var ErrDueScheduledTxsExecution = errors.New("due scheduled transactions execution failed")
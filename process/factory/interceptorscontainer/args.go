@@ -45,6 +45,7 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	PeersLatencyHandler       dataRetriever.PeersLatencyHandler
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -81,4 +82,5 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	PeersLatencyHandler       dataRetriever.PeersLatencyHandler
 }
@@ -39,6 +39,7 @@ type baseInterceptorsContainerFactory struct {
 	whiteListHandler       process.WhiteListHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
 	addressPubkeyConverter core.PubkeyConverter
+	peersLatencyHandler    dataRetriever.PeersLatencyHandler
 }
 
 func checkBaseParams(
@@ -463,7 +464,7 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 }
 
 func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topic string) (process.Interceptor, error) {
-	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieNodes())
+	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieNodes(), bicf.peersLatencyHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +88,9 @@ func NewMetaInterceptorsContainerFactory(
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.PeersLatencyHandler) {
+		return nil, process.ErrNilPeersLatencyHandler
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:          args.ProtoMarshalizer,
@@ -134,6 +137,7 @@ func NewMetaInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		peersLatencyHandler:    args.PeersLatencyHandler,
 	}
 
 	icf := &metaInterceptorsContainerFactory{
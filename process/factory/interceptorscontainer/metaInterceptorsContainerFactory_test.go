@@ -293,6 +293,17 @@ func TestNewMetaInterceptorsContainerFactory_NilEpochNotifierShouldErr(t *testin
 	assert.Equal(t, process.ErrNilEpochNotifier, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilPeersLatencyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.PeersLatencyHandler = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeersLatencyHandler, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -530,5 +541,6 @@ func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryAr
 		MinTransactionVersion:   1,
 		TxSignHasher:            mock.HasherMock{},
 		EpochNotifier:           &mock.EpochNotifierStub{},
+		PeersLatencyHandler:     &testscommon.PeersLatencyHandlerStub{},
 	}
 }
@@ -88,6 +88,9 @@ func NewShardInterceptorsContainerFactory(
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.PeersLatencyHandler) {
+		return nil, process.ErrNilPeersLatencyHandler
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
 		ProtoMarshalizer:          args.ProtoMarshalizer,
@@ -134,6 +137,7 @@ func NewShardInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		peersLatencyHandler:    args.PeersLatencyHandler,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
@@ -147,6 +147,17 @@ func TestNewShardInterceptorsContainerFactory_NilEpochNotifierShouldErr(t *testi
 	assert.Equal(t, process.ErrNilEpochNotifier, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilPeersLatencyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.PeersLatencyHandler = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeersLatencyHandler, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -580,5 +591,6 @@ func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactory
 		MinTransactionVersion:   1,
 		TxSignHasher:            mock.HasherMock{},
 		EpochNotifier:           &mock.EpochNotifierStub{},
+		PeersLatencyHandler:     &testscommon.PeersLatencyHandlerStub{},
 	}
 }
@@ -173,6 +173,14 @@ func TestVmContainerFactory_Create(t *testing.T) {
 				MinServiceFee: 0,
 				MaxServiceFee: 100,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 		},
 		ValidatorAccountsDB: &mock.AccountsStub{},
 		ChanceComputer:      &mock.RaterMock{},
@@ -247,6 +255,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["ScheduledTxOps"] = value
 
 	return gasMap
 }
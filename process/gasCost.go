@@ -25,6 +25,7 @@ type BuiltInCost struct {
 	ESDTNFTBurn              uint64
 	ESDTNFTTransfer          uint64
 	ESDTNFTChangeCreateOwner uint64
+	ESDTNFTRoyaltiesPayment  uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
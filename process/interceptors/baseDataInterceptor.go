@@ -6,10 +6,17 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
+var interceptedMessages = prometheus.DefaultRegistry().NewCounterVec(
+	"elrond_interceptor_messages_total",
+	"Number of messages received by the interceptors.",
+	"topic", "result",
+)
+
 type baseDataInterceptor struct {
 	throttler        process.InterceptorThrottler
 	antifloodHandler process.P2PAntifloodHandler
@@ -116,3 +123,12 @@ func (bdi *baseDataInterceptor) SetInterceptedDebugHandler(handler process.Inter
 
 	return nil
 }
+
+func (bdi *baseDataInterceptor) countMessage(err error) {
+	result := "accepted"
+	if err != nil {
+		result = "rejected"
+	}
+
+	interceptedMessages.WithLabelValues(bdi.topic, result).Inc()
+}
@@ -80,6 +80,13 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := mdi.processReceivedMessage(message, fromConnectedPeer)
+	mdi.countMessage(err)
+
+	return err
+}
+
+func (mdi *MultiDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	err := mdi.preProcessMesage(message, fromConnectedPeer)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package processor
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -15,17 +16,25 @@ type interceptedTrieNodeHandler interface {
 
 // TrieNodeInterceptorProcessor is the processor used when intercepting trie nodes
 type TrieNodeInterceptorProcessor struct {
-	interceptedNodes storage.Cacher
+	interceptedNodes    storage.Cacher
+	peersLatencyHandler dataRetriever.PeersLatencyHandler
 }
 
 // NewTrieNodesInterceptorProcessor creates a new instance of TrieNodeInterceptorProcessor
-func NewTrieNodesInterceptorProcessor(interceptedNodes storage.Cacher) (*TrieNodeInterceptorProcessor, error) {
+func NewTrieNodesInterceptorProcessor(
+	interceptedNodes storage.Cacher,
+	peersLatencyHandler dataRetriever.PeersLatencyHandler,
+) (*TrieNodeInterceptorProcessor, error) {
 	if check.IfNil(interceptedNodes) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(peersLatencyHandler) {
+		return nil, process.ErrNilPeersLatencyHandler
+	}
 
 	return &TrieNodeInterceptorProcessor{
-		interceptedNodes: interceptedNodes,
+		interceptedNodes:    interceptedNodes,
+		peersLatencyHandler: peersLatencyHandler,
 	}, nil
 }
 
@@ -35,12 +44,13 @@ func (tnip *TrieNodeInterceptorProcessor) Validate(_ process.InterceptedData, _
 }
 
 // Save saves the intercepted trie node in the intercepted nodes cacher
-func (tnip *TrieNodeInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID, _ string) error {
+func (tnip *TrieNodeInterceptorProcessor) Save(data process.InterceptedData, fromConnectedPeer core.PeerID, _ string) error {
 	nodeData, ok := data.(interceptedTrieNodeHandler)
 	if !ok {
 		return process.ErrWrongTypeAssertion
 	}
 
+	tnip.peersLatencyHandler.ResponseReceived(fromConnectedPeer, data.Hash())
 	tnip.interceptedNodes.Put(data.Hash(), nodeData, nodeData.SizeInBytes()+len(data.Hash()))
 	return nil
 }
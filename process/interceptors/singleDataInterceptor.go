@@ -69,6 +69,13 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := sdi.processReceivedMessage(message, fromConnectedPeer)
+	sdi.countMessage(err)
+
+	return err
+}
+
+func (sdi *SingleDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	sdi.mutDebugHandler.RLock()
 	defer sdi.mutDebugHandler.RUnlock()
 
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"time"
 
@@ -132,7 +133,7 @@ type TransactionCoordinator interface {
 	RemoveBlockDataFromPool(body *block.Body) error
 	RemoveTxsFromPool(body *block.Body) error
 
-	ProcessBlockTransaction(body *block.Body, haveTime func() time.Duration) error
+	ProcessBlockTransaction(ctx context.Context, body *block.Body, haveTime func() time.Duration) error
 
 	CreateBlockStarted()
 	CreateMbsAndProcessCrossShardTransactionsDstMe(
@@ -633,6 +634,13 @@ type SmartContractToProtocolHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ScheduledTxsExecutionHandler releases, at the start of a metachain block, the scheduled transactions which reached
+// their target round
+type ScheduledTxsExecutionHandler interface {
+	ExecuteDueTxs() error
+	IsInterfaceNil() bool
+}
+
 // PeerChangesHandler will create the peer changes data for current block and will verify them
 type PeerChangesHandler interface {
 	PeerChanges() []block.PeerData
@@ -0,0 +1,19 @@
+package mock
+
+// ScheduledTxsExecutorStub -
+type ScheduledTxsExecutorStub struct {
+	ExecuteDueTxsCalled func() error
+}
+
+// ExecuteDueTxs -
+func (s *ScheduledTxsExecutorStub) ExecuteDueTxs() error {
+	if s.ExecuteDueTxsCalled != nil {
+		return s.ExecuteDueTxsCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (s *ScheduledTxsExecutorStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,271 @@
+package scheduledTxs
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+var _ process.ScheduledTxsExecutionHandler = (*dueTxsExecutor)(nil)
+
+var log = logger.GetOrCreate("process/scheduledTxs")
+
+var zero = big.NewInt(0)
+
+const executeDueFunction = "executeDue"
+
+// ArgsDueTxsExecutor is the struct containing all the components needed to create a new dueTxsExecutor
+type ArgsDueTxsExecutor struct {
+	SystemVM             vmcommon.VMExecutionHandler
+	AccountsDB           state.AccountsAdapter
+	ShardCoordinator     sharding.Coordinator
+	ScrForwarder         process.IntermediateTransactionHandler
+	FeeHandler           process.TransactionFeeHandler
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+	EndOfEpochAddress    []byte
+	ScheduledTxSCAddress []byte
+	EnableEpoch          uint32
+	EpochNotifier        process.EpochNotifier
+}
+
+// dueTxsExecutor calls the scheduled transactions system smart contract at the start of each metachain block and
+// turns the released transactions into smart contract results, so that the proposer and the validators create the
+// same post process miniblocks
+type dueTxsExecutor struct {
+	systemVM             vmcommon.VMExecutionHandler
+	accountsDB           state.AccountsAdapter
+	shardCoordinator     sharding.Coordinator
+	scrForwarder         process.IntermediateTransactionHandler
+	feeHandler           process.TransactionFeeHandler
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	endOfEpochAddress    []byte
+	scheduledTxSCAddress []byte
+	enableEpoch          uint32
+	flagEnabled          atomic.Flag
+}
+
+// NewDueTxsExecutor creates the component which executes the due scheduled transactions
+func NewDueTxsExecutor(args ArgsDueTxsExecutor) (*dueTxsExecutor, error) {
+	if check.IfNilReflect(args.SystemVM) {
+		return nil, process.ErrNilSystemVM
+	}
+	if check.IfNil(args.AccountsDB) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.ScrForwarder) {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if len(args.EndOfEpochAddress) == 0 {
+		return nil, fmt.Errorf("%w for end of epoch address", process.ErrNilScAddress)
+	}
+	if len(args.ScheduledTxSCAddress) == 0 {
+		return nil, fmt.Errorf("%w for scheduled transactions address", process.ErrNilScAddress)
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &dueTxsExecutor{
+		systemVM:             args.SystemVM,
+		accountsDB:           args.AccountsDB,
+		shardCoordinator:     args.ShardCoordinator,
+		scrForwarder:         args.ScrForwarder,
+		feeHandler:           args.FeeHandler,
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		endOfEpochAddress:    args.EndOfEpochAddress,
+		scheduledTxSCAddress: args.ScheduledTxSCAddress,
+		enableEpoch:          args.EnableEpoch,
+	}
+
+	args.EpochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// ExecuteDueTxs releases the scheduled transactions which reached their target round. It has to be called, both when
+// creating and when processing a metachain block, after the block was started and before any transaction is processed
+func (e *dueTxsExecutor) ExecuteDueTxs() error {
+	if !e.flagEnabled.IsSet() {
+		return nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  e.endOfEpochAddress,
+			Arguments:   [][]byte{},
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: e.scheduledTxSCAddress,
+		Function:      executeDueFunction,
+	}
+
+	vmOutput, err := e.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, return code %s, message %s",
+			process.ErrDueScheduledTxsExecution, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		return nil
+	}
+
+	err = e.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	scrs := make([]data.TransactionHandler, 0, 2*len(vmOutput.ReturnData))
+	for _, marshaledTx := range vmOutput.ReturnData {
+		scheduledTx := &systemSmartContracts.ScheduledTx{}
+		err = e.marshalizer.Unmarshal(scheduledTx, marshaledTx)
+		if err != nil {
+			return err
+		}
+
+		txHash, errHash := core.CalculateHash(e.marshalizer, e.hasher, scheduledTx)
+		if errHash != nil {
+			return errHash
+		}
+
+		scrs = append(scrs, e.createSmartContractResults(scheduledTx, txHash)...)
+		e.feeHandler.ProcessTransactionFee(scheduledTx.ExecutionFee, big.NewInt(0), txHash)
+
+		log.Debug("executed scheduled transaction",
+			"id", scheduledTx.ID,
+			"target round", scheduledTx.TargetRound,
+			"hash", txHash,
+		)
+	}
+
+	return e.scrForwarder.AddIntermediateTransactions(scrs)
+}
+
+func (e *dueTxsExecutor) createSmartContractResults(
+	scheduledTx *systemSmartContracts.ScheduledTx,
+	txHash []byte,
+) []data.TransactionHandler {
+	scrs := make([]data.TransactionHandler, 0, 2)
+	scrs = append(scrs, &smartContractResult.SmartContractResult{
+		Value:          big.NewInt(0).Set(valueOrZero(scheduledTx.Value)),
+		RcvAddr:        scheduledTx.Destination,
+		SndAddr:        e.scheduledTxSCAddress,
+		Data:           scheduledTx.CallData,
+		GasLimit:       scheduledTx.GasLimit,
+		GasPrice:       scheduledTx.GasPrice,
+		PrevTxHash:     txHash,
+		OriginalTxHash: txHash,
+		OriginalSender: scheduledTx.Sender,
+		CallType:       vmcommon.DirectCall,
+	})
+
+	deposit := valueOrZero(scheduledTx.Deposit)
+	if deposit.Cmp(zero) > 0 {
+		scrs = append(scrs, &smartContractResult.SmartContractResult{
+			Value:          big.NewInt(0).Set(deposit),
+			RcvAddr:        scheduledTx.Sender,
+			SndAddr:        e.scheduledTxSCAddress,
+			PrevTxHash:     txHash,
+			OriginalTxHash: txHash,
+			CallType:       vmcommon.DirectCall,
+		})
+	}
+
+	return scrs
+}
+
+// processSCOutputAccounts saves the changes of the metachain accounts. The transfers to the accounts from shards are
+// carried by the created smart contract results, while the fees paid to the caller are accounted as block fees
+func (e *dueTxsExecutor) processSCOutputAccounts(vmOutput *vmcommon.VMOutput) error {
+	outputAccounts := process.SortVMOutputInsideData(vmOutput)
+	for _, outAcc := range outputAccounts {
+		if bytes.Equal(outAcc.Address, e.endOfEpochAddress) {
+			continue
+		}
+		if e.shardCoordinator.ComputeId(outAcc.Address) != e.shardCoordinator.SelfId() {
+			continue
+		}
+
+		account, err := e.accountsDB.LoadAccount(outAcc.Address)
+		if err != nil {
+			return err
+		}
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		storageUpdates := process.GetSortedStorageUpdates(outAcc)
+		for _, storeUpdate := range storageUpdates {
+			err = userAccount.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		if outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Cmp(zero) != 0 {
+			err = userAccount.AddToBalance(outAcc.BalanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = e.accountsDB.SaveAccount(userAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return zero
+	}
+
+	return value
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *dueTxsExecutor) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("dueTxsExecutor: scheduled transactions", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *dueTxsExecutor) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,262 @@
+package scheduledTxs
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scheduledTxSender = []byte("sender address for scheduled txs")
+var scheduledTxDestination = []byte("destination address scheduled tx")
+
+func createMockArgsDueTxsExecutor() ArgsDueTxsExecutor {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, vm.ScheduledTxSCAddress) || bytes.Equal(address, vm.EndOfEpochAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+
+	return ArgsDueTxsExecutor{
+		SystemVM:             &mock.VMExecutionHandlerStub{},
+		AccountsDB:           &mock.AccountsStub{},
+		ShardCoordinator:     shardCoordinator,
+		ScrForwarder:         &mock.IntermediateTransactionHandlerMock{},
+		FeeHandler:           &mock.FeeAccumulatorStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Hasher:               &mock.HasherMock{},
+		EndOfEpochAddress:    vm.EndOfEpochAddress,
+		ScheduledTxSCAddress: vm.ScheduledTxSCAddress,
+		EnableEpoch:          0,
+		EpochNotifier:        &mock.EpochNotifierStub{},
+	}
+}
+
+func createExecutedScheduledTx(id uint64) *systemSmartContracts.ScheduledTx {
+	return &systemSmartContracts.ScheduledTx{
+		ID:           id,
+		Sender:       scheduledTxSender,
+		Destination:  scheduledTxDestination,
+		Value:        big.NewInt(100),
+		ExecutionFee: big.NewInt(20),
+		GasLimit:     10,
+		GasPrice:     2,
+		TargetRound:  30,
+		CallData:     []byte("function@01"),
+		Deposit:      big.NewInt(5),
+	}
+}
+
+func TestNewDueTxsExecutor_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsDueTxsExecutor)
+		expectedErr error
+	}{
+		{"nil system VM", func(args *ArgsDueTxsExecutor) { args.SystemVM = nil }, process.ErrNilSystemVM},
+		{"nil accounts", func(args *ArgsDueTxsExecutor) { args.AccountsDB = nil }, process.ErrNilAccountsAdapter},
+		{"nil shard coordinator", func(args *ArgsDueTxsExecutor) { args.ShardCoordinator = nil }, process.ErrNilShardCoordinator},
+		{"nil scr forwarder", func(args *ArgsDueTxsExecutor) { args.ScrForwarder = nil }, process.ErrNilIntermediateTransactionHandler},
+		{"nil fee handler", func(args *ArgsDueTxsExecutor) { args.FeeHandler = nil }, process.ErrNilEconomicsFeeHandler},
+		{"nil marshalizer", func(args *ArgsDueTxsExecutor) { args.Marshalizer = nil }, process.ErrNilMarshalizer},
+		{"nil hasher", func(args *ArgsDueTxsExecutor) { args.Hasher = nil }, process.ErrNilHasher},
+		{"nil end of epoch address", func(args *ArgsDueTxsExecutor) { args.EndOfEpochAddress = nil }, process.ErrNilScAddress},
+		{"nil scheduled tx address", func(args *ArgsDueTxsExecutor) { args.ScheduledTxSCAddress = nil }, process.ErrNilScAddress},
+		{"nil epoch notifier", func(args *ArgsDueTxsExecutor) { args.EpochNotifier = nil }, process.ErrNilEpochNotifier},
+	}
+
+	for _, test := range tests {
+		args := createMockArgsDueTxsExecutor()
+		test.modify(&args)
+
+		e, err := NewDueTxsExecutor(args)
+		assert.True(t, check.IfNil(e), test.name)
+		assert.True(t, errors.Is(err, test.expectedErr), test.name)
+	}
+}
+
+func TestNewDueTxsExecutor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewDueTxsExecutor(createMockArgsDueTxsExecutor())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(e))
+}
+
+func TestDueTxsExecutor_ExecuteDueTxsNotEnabledShouldNotCallTheContract(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDueTxsExecutor()
+	args.EnableEpoch = 1
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			require.Fail(t, "should have not called the contract")
+			return nil, nil
+		},
+	}
+	e, _ := NewDueTxsExecutor(args)
+
+	err := e.ExecuteDueTxs()
+	assert.Nil(t, err)
+}
+
+func TestDueTxsExecutor_ExecuteDueTxsContractErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsDueTxsExecutor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	e, _ := NewDueTxsExecutor(args)
+	assert.Equal(t, expectedErr, e.ExecuteDueTxs())
+
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	e, _ = NewDueTxsExecutor(args)
+	assert.True(t, errors.Is(e.ExecuteDueTxs(), process.ErrDueScheduledTxsExecution))
+}
+
+func TestDueTxsExecutor_ExecuteDueTxsNothingDueShouldNotChangeAnything(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDueTxsExecutor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	args.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			require.Fail(t, "should have not added results")
+			return nil
+		},
+	}
+	args.AccountsDB = &mock.AccountsStub{
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			require.Fail(t, "should have not saved accounts")
+			return nil
+		},
+	}
+	e, _ := NewDueTxsExecutor(args)
+
+	err := e.ExecuteDueTxs()
+	assert.Nil(t, err)
+}
+
+func TestDueTxsExecutor_ExecuteDueTxsShouldCreateResultsAndAccountTheFees(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDueTxsExecutor()
+	marshalizer := args.Marshalizer
+	scheduledTx := createExecutedScheduledTx(7)
+	marshaledTx, _ := marshalizer.Marshal(scheduledTx)
+	expectedTxHash, _ := core.CalculateHash(marshalizer, args.Hasher, scheduledTx)
+
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, vm.EndOfEpochAddress, input.CallerAddr)
+			assert.Equal(t, vm.ScheduledTxSCAddress, input.RecipientAddr)
+			assert.Equal(t, executeDueFunction, input.Function)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{marshaledTx},
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(vm.ScheduledTxSCAddress): {
+						Address:        vm.ScheduledTxSCAddress,
+						BalanceDelta:   big.NewInt(-125),
+						StorageUpdates: map[string]*vmcommon.StorageUpdate{"key": {Offset: []byte("key"), Data: nil}},
+					},
+					string(scheduledTxDestination): {Address: scheduledTxDestination, BalanceDelta: big.NewInt(100)},
+					string(scheduledTxSender):      {Address: scheduledTxSender, BalanceDelta: big.NewInt(5)},
+					string(vm.EndOfEpochAddress):   {Address: vm.EndOfEpochAddress, BalanceDelta: big.NewInt(20)},
+				},
+			}, nil
+		},
+	}
+
+	scAccount, _ := state.NewUserAccount(vm.ScheduledTxSCAddress)
+	_ = scAccount.AddToBalance(big.NewInt(1000))
+	savedAccounts := make([][]byte, 0)
+	args.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return scAccount, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccounts = append(savedAccounts, account.AddressBytes())
+			return nil
+		},
+	}
+
+	var addedResults []data.TransactionHandler
+	args.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			addedResults = txs
+			return nil
+		},
+	}
+
+	accountedFees := big.NewInt(0)
+	args.FeeHandler = &mock.FeeAccumulatorStub{
+		ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+			assert.Equal(t, expectedTxHash, hash)
+			accountedFees.Add(accountedFees, cost)
+		},
+	}
+	e, _ := NewDueTxsExecutor(args)
+
+	err := e.ExecuteDueTxs()
+	require.Nil(t, err)
+
+	assert.Equal(t, [][]byte{vm.ScheduledTxSCAddress}, savedAccounts)
+	assert.Equal(t, big.NewInt(875), scAccount.GetBalance())
+	assert.Equal(t, big.NewInt(20), accountedFees)
+
+	expectedResults := []data.TransactionHandler{
+		&smartContractResult.SmartContractResult{
+			Value:          big.NewInt(100),
+			RcvAddr:        scheduledTxDestination,
+			SndAddr:        vm.ScheduledTxSCAddress,
+			Data:           []byte("function@01"),
+			GasLimit:       10,
+			GasPrice:       2,
+			PrevTxHash:     expectedTxHash,
+			OriginalTxHash: expectedTxHash,
+			OriginalSender: scheduledTxSender,
+			CallType:       vmcommon.DirectCall,
+		},
+		&smartContractResult.SmartContractResult{
+			Value:          big.NewInt(5),
+			RcvAddr:        scheduledTxSender,
+			SndAddr:        vm.ScheduledTxSCAddress,
+			PrevTxHash:     expectedTxHash,
+			OriginalTxHash: expectedTxHash,
+			CallType:       vmcommon.DirectCall,
+		},
+	}
+	assert.Equal(t, expectedResults, addedResults)
+}
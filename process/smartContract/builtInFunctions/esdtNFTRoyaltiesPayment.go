@@ -0,0 +1,331 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTRoyaltiesPayment)(nil)
+
+const numArgumentsESDTNFTRoyaltiesPayment = 5
+
+// egldRoyaltiesPaymentIdentifier is the payment token identifier used to split an EGLD payment sent as call value
+const egldRoyaltiesPaymentIdentifier = "EGLD"
+
+type royaltiesPayee struct {
+	address []byte
+	value   *big.Int
+}
+
+type esdtNFTRoyaltiesPayment struct {
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	payableHandler   process.PayableHandler
+	funcGasCost      uint64
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTRoyaltiesPaymentFunc returns the esdt NFT royalties payment built-in function component
+func NewESDTNFTRoyaltiesPaymentFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTRoyaltiesPayment, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTRoyaltiesPayment{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		payableHandler:   &disabledPayableHandler{},
+		funcGasCost:      funcGasCost,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		enableEpoch:      enableEpoch,
+		mutExecution:     sync.RWMutex{},
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+func (e *esdtNFTRoyaltiesPayment) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTRoyaltiesPayment) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("ESDT NFT royalties payment", "enabled", e.flagEnabled.IsSet())
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTRoyaltiesPayment) SetNewGasConfig(gasCost *process.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTRoyaltiesPayment
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction splits a fungible ESDT or an EGLD payment for an NFT between the seller and the NFT creator,
+// according to the royalties stored in the NFT metadata. The caller must hold the NFT (e.g. a marketplace
+// keeping it in escrow) as the creator and royalties are read from the caller's account.
+// Requires 5 arguments:
+// arg0 - NFT token identifier
+// arg1 - NFT nonce
+// arg2 - payment token identifier (fungible ESDT) or EGLD
+// arg3 - payment amount, equal to the call value for EGLD payments
+// arg4 - seller address
+// cross-shard payees are credited through ESDTTransfer smart contract results or through EGLD transfers
+func (e *esdtNFTRoyaltiesPayment) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrNFTRoyaltiesPaymentIsDisabled
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil {
+		return nil, process.ErrNilValue
+	}
+	if len(vmInput.Arguments) != numArgumentsESDTNFTRoyaltiesPayment {
+		return nil, process.ErrInvalidArguments
+	}
+	isEGLDPayment := bytes.Equal(vmInput.Arguments[2], []byte(egldRoyaltiesPaymentIdentifier))
+	if !isEGLDPayment && vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntSnd) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	sellerAddress := vmInput.Arguments[4]
+	err := e.checkPayeeAddress(sellerAddress, vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(sellerAddress, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, can not pay to self", process.ErrInvalidArguments)
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	nftTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	nftData, err := getESDTNFTTokenOnSender(acntSnd, nftTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if nftData.TokenMetaData.Royalties > core.MaxRoyalty {
+		return nil, process.ErrInvalidArguments
+	}
+	creatorAddress := nftData.TokenMetaData.Creator
+	err = e.checkPayeeAddress(creatorAddress, vmInput.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if amount.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	// the EGLD call value was already debited from the caller by the smart contract processor
+	paymentTokenKey := append(e.keyPrefix, vmInput.Arguments[2]...)
+	if isEGLDPayment && amount.Cmp(vmInput.CallValue) != 0 {
+		return nil, fmt.Errorf("%w, the EGLD payment amount must be sent as call value", process.ErrInvalidArguments)
+	}
+	if !isEGLDPayment {
+		err = addToESDTBalance(vmInput.CallerAddr, acntSnd, paymentTokenKey, big.NewInt(0).Neg(amount), e.marshalizer, e.pauseHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+
+	payees := computeRoyaltiesPayees(amount, nftData.TokenMetaData.Royalties, creatorAddress, sellerAddress)
+	for _, payee := range payees {
+		err = e.payTo(acntSnd, vmInput, paymentTokenKey, isEGLDPayment, payee, vmOutput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTRoyaltiesPayment) checkPayeeAddress(address []byte, callerAddress []byte) error {
+	if len(address) != len(callerAddress) {
+		return fmt.Errorf("%w, not a valid payee address", process.ErrInvalidArguments)
+	}
+	if e.shardCoordinator.ComputeId(address) == core.MetachainShardId {
+		return process.ErrInvalidRcvAddr
+	}
+
+	return nil
+}
+
+func computeRoyaltiesPayees(amount *big.Int, royalties uint32, creator []byte, seller []byte) []*royaltiesPayee {
+	royaltiesValue := big.NewInt(0).Mul(amount, big.NewInt(int64(royalties)))
+	royaltiesValue.Div(royaltiesValue, big.NewInt(int64(core.MaxRoyalty)))
+	sellerValue := big.NewInt(0).Sub(amount, royaltiesValue)
+
+	if bytes.Equal(creator, seller) || royaltiesValue.Cmp(zero) == 0 {
+		return []*royaltiesPayee{{address: seller, value: amount}}
+	}
+
+	payees := []*royaltiesPayee{{address: creator, value: royaltiesValue}}
+	if sellerValue.Cmp(zero) > 0 {
+		payees = append(payees, &royaltiesPayee{address: seller, value: sellerValue})
+	}
+
+	return payees
+}
+
+func (e *esdtNFTRoyaltiesPayment) payTo(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	paymentTokenKey []byte,
+	isEGLDPayment bool,
+	payee *royaltiesPayee,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	if bytes.Equal(payee.address, vmInput.CallerAddr) {
+		return e.addToPayeeBalance(vmInput, acntSnd, paymentTokenKey, isEGLDPayment, payee.value)
+	}
+
+	if e.shardCoordinator.SelfId() != e.shardCoordinator.ComputeId(payee.address) {
+		addRoyaltiesTransferToVMOutput(vmInput, payee, isEGLDPayment, vmOutput)
+		return nil
+	}
+
+	isPayable, err := e.payableHandler.IsPayable(payee.address)
+	if err != nil {
+		return err
+	}
+	if !isPayable {
+		return process.ErrAccountNotPayable
+	}
+
+	accountHandler, err := e.accounts.LoadAccount(payee.address)
+	if err != nil {
+		return err
+	}
+	userAccount, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addToPayeeBalance(vmInput, userAccount, paymentTokenKey, isEGLDPayment, payee.value)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(userAccount)
+}
+
+func (e *esdtNFTRoyaltiesPayment) addToPayeeBalance(
+	vmInput *vmcommon.ContractCallInput,
+	payeeAccount state.UserAccountHandler,
+	paymentTokenKey []byte,
+	isEGLDPayment bool,
+	value *big.Int,
+) error {
+	if isEGLDPayment {
+		return payeeAccount.AddToBalance(value)
+	}
+
+	return addToESDTBalance(vmInput.CallerAddr, payeeAccount, paymentTokenKey, value, e.marshalizer, e.pauseHandler)
+}
+
+func addRoyaltiesTransferToVMOutput(
+	vmInput *vmcommon.ContractCallInput,
+	payee *royaltiesPayee,
+	isEGLDPayment bool,
+	vmOutput *vmcommon.VMOutput,
+) {
+	outTransfer := vmcommon.OutputTransfer{
+		Value:         big.NewInt(0),
+		GasLimit:      0,
+		GasLocked:     vmInput.GasLocked,
+		CallType:      vmInput.CallType,
+		SenderAddress: vmInput.CallerAddr,
+	}
+	if isEGLDPayment {
+		outTransfer.Value.Set(payee.value)
+	} else {
+		esdtTransferTxData := core.BuiltInFunctionESDTTransfer
+		esdtTransferTxData += "@" + hex.EncodeToString(vmInput.Arguments[2])
+		esdtTransferTxData += "@" + hex.EncodeToString(payee.value.Bytes())
+		outTransfer.Data = []byte(esdtTransferTxData)
+	}
+	if vmOutput.OutputAccounts == nil {
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	}
+	vmOutput.OutputAccounts[string(payee.address)] = &vmcommon.OutputAccount{
+		Address:         payee.address,
+		OutputTransfers: []vmcommon.OutputTransfer{outTransfer},
+	}
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTRoyaltiesPayment) IsInterfaceNil() bool {
+	return e == nil
+}
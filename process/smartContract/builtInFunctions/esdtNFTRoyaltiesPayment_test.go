@@ -0,0 +1,383 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	royaltiesNFTName     = []byte("NFT-abcdef")
+	royaltiesPaymentName = []byte("PAY-abcdef")
+)
+
+func createRoyaltiesPaymentWithMockArguments(shardID uint32, numShards uint32) *esdtNFTRoyaltiesPayment {
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(numShards, shardID)
+	trieStoreManager := createTrieStorageManager(createMemUnit(), marshalizer, hasher)
+	tr, _ := trie.NewTrie(trieStoreManager, marshalizer, hasher, 6)
+	accounts, _ := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+
+	royaltiesPayment, _ := NewESDTNFTRoyaltiesPaymentFunc(
+		1,
+		marshalizer,
+		&mock.PauseHandlerStub{},
+		accounts,
+		shardCoordinator,
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	_ = royaltiesPayment.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(address []byte) (bool, error) {
+			return true, nil
+		},
+	})
+
+	return royaltiesPayment
+}
+
+func createNFTWithRoyalties(
+	nonce uint64,
+	creator []byte,
+	royalties uint32,
+	marshalizer marshal.Marshalizer,
+	account state.UserAccountHandler,
+) {
+	tokenKey := append(keyPrefix, royaltiesNFTName...)
+	esdtData := &esdt.ESDigitalToken{
+		Type:  uint32(core.NonFungible),
+		Value: big.NewInt(1),
+		TokenMetaData: &esdt.MetaData{
+			Nonce:     nonce,
+			Creator:   creator,
+			Royalties: royalties,
+			Hash:      []byte("NFT hash"),
+		},
+	}
+	buff, _ := marshalizer.Marshal(esdtData)
+
+	_ = account.DataTrieTracker().SaveKeyValue(computeESDTNFTTokenKey(tokenKey, nonce), buff)
+}
+
+func setFungibleBalance(value *big.Int, marshalizer marshal.Marshalizer, account state.UserAccountHandler) {
+	esdtData := &esdt.ESDigitalToken{Type: uint32(core.Fungible), Value: value}
+	buff, _ := marshalizer.Marshal(esdtData)
+
+	_ = account.DataTrieTracker().SaveKeyValue(append(keyPrefix, royaltiesPaymentName...), buff)
+}
+
+func getFungibleBalance(t *testing.T, e *esdtNFTRoyaltiesPayment, address []byte) *big.Int {
+	account, err := e.accounts.LoadAccount(address)
+	require.Nil(t, err)
+
+	esdtData, err := getESDTDataFromKey(account.(state.UserAccountHandler), append(keyPrefix, royaltiesPaymentName...), e.marshalizer)
+	require.Nil(t, err)
+
+	return esdtData.Value
+}
+
+func createRoyaltiesPaymentVmInput(caller []byte, amount *big.Int, seller []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:   big.NewInt(0),
+			CallerAddr:  caller,
+			Arguments:   [][]byte{royaltiesNFTName, big.NewInt(1).Bytes(), royaltiesPaymentName, amount.Bytes(), seller},
+			GasProvided: 10,
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func prepareRoyaltiesPaymentCaller(
+	t *testing.T,
+	e *esdtNFTRoyaltiesPayment,
+	caller []byte,
+	creator []byte,
+	royalties uint32,
+	balance *big.Int,
+) state.UserAccountHandler {
+	account, err := e.accounts.LoadAccount(caller)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	createNFTWithRoyalties(1, creator, royalties, e.marshalizer, userAccount)
+	setFungibleBalance(balance, e.marshalizer, userAccount)
+	_ = e.accounts.SaveAccount(userAccount)
+	_, _ = e.accounts.Commit()
+
+	account, err = e.accounts.LoadAccount(caller)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler)
+}
+
+func TestNewESDTNFTRoyaltiesPaymentFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewESDTNFTRoyaltiesPaymentFunc(0, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, e)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	e, err = NewESDTNFTRoyaltiesPaymentFunc(0, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, e)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	e, err = NewESDTNFTRoyaltiesPaymentFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, e)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	e, err = NewESDTNFTRoyaltiesPaymentFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, e)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+
+	e, err = NewESDTNFTRoyaltiesPaymentFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, nil)
+	assert.Nil(t, e)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewESDTNFTRoyaltiesPaymentFunc(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewESDTNFTRoyaltiesPaymentFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, &mock.ShardCoordinatorStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(e))
+	assert.True(t, e.flagEnabled.IsSet())
+
+	err = e.setPayableHandler(nil)
+	assert.Equal(t, process.ErrNilPayableHandler, err)
+
+	gasCost := createMockGasCost()
+	e.SetNewGasConfig(&gasCost)
+	assert.Equal(t, gasCost.BuiltInCost.ESDTNFTRoyaltiesPayment, e.funcGasCost)
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	e.enableEpoch = 2
+	e.EpochConfirmed(1)
+
+	caller := bytes.Repeat([]byte{2}, 32)
+	vmOutput, err := e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(100), bytes.Repeat([]byte{4}, 32)))
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrNFTRoyaltiesPaymentIsDisabled, err)
+
+	e.EpochConfirmed(2)
+	assert.True(t, e.flagEnabled.IsSet())
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	caller := bytes.Repeat([]byte{2}, 32)
+	seller := bytes.Repeat([]byte{4}, 32)
+
+	vmInput := createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller)
+	vmInput.CallValue = big.NewInt(1)
+	_, err := e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller)
+	vmInput.Arguments = vmInput.Arguments[:4]
+	_, err = e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller)
+	vmInput.RecipientAddr = seller
+	_, err = e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller)
+	_, err = e.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), []byte("short"))
+	_, err = e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidArguments))
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), caller)
+	_, err = e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidArguments))
+
+	vmInput = createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller)
+	vmInput.GasProvided = 0
+	_, err = e.ProcessBuiltinFunction(&mock.UserAccountStub{}, nil, vmInput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionCallerWithoutNFTShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	caller := bytes.Repeat([]byte{2}, 32)
+	account, _ := e.accounts.LoadAccount(caller)
+
+	vmInput := createRoyaltiesPaymentVmInput(caller, big.NewInt(100), bytes.Repeat([]byte{4}, 32))
+	_, err := e.ProcessBuiltinFunction(account.(state.UserAccountHandler), nil, vmInput)
+	assert.Equal(t, process.ErrNewNFTDataOnSenderAddress, err)
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionInsufficientFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	caller := bytes.Repeat([]byte{2}, 32)
+	creator := bytes.Repeat([]byte{3}, 32)
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, e, caller, creator, 1000, big.NewInt(50))
+
+	_, err := e.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(100), seller))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	_, err = e.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(0), seller))
+	assert.Equal(t, process.ErrNegativeValue, err)
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionSameShardShouldSplitPayment(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	caller := bytes.Repeat([]byte{2}, 32)
+	creator := bytes.Repeat([]byte{3}, 32)
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, e, caller, creator, 1250, big.NewInt(1000))
+
+	vmOutput, err := e.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(800), seller))
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(9), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+
+	_ = e.accounts.SaveAccount(sender)
+	_, _ = e.accounts.Commit()
+
+	assert.Equal(t, big.NewInt(200), getFungibleBalance(t, e, caller))
+	assert.Equal(t, big.NewInt(100), getFungibleBalance(t, e, creator))
+	assert.Equal(t, big.NewInt(700), getFungibleBalance(t, e, seller))
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionSellerIsCreatorShouldPayAllToSeller(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	caller := bytes.Repeat([]byte{2}, 32)
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, e, caller, seller, 1250, big.NewInt(1000))
+
+	_, err := e.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(1000), seller))
+	require.Nil(t, err)
+
+	_ = e.accounts.SaveAccount(sender)
+	_, _ = e.accounts.Commit()
+
+	assert.Equal(t, big.NewInt(0), getFungibleBalance(t, e, caller))
+	assert.Equal(t, big.NewInt(1000), getFungibleBalance(t, e, seller))
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 1)
+	_ = e.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(address []byte) (bool, error) {
+			return false, nil
+		},
+	})
+	caller := bytes.Repeat([]byte{2}, 32)
+	creator := bytes.Repeat([]byte{3}, 32)
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, e, caller, creator, 1250, big.NewInt(1000))
+
+	_, err := e.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(800), seller))
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionCrossShardCreatorShouldCreateTransfer(t *testing.T) {
+	t.Parallel()
+
+	royaltiesSenderShard := createRoyaltiesPaymentWithMockArguments(0, 2)
+	caller := bytes.Repeat([]byte{2}, 32)
+	creator := bytes.Repeat([]byte{1}, 32) // creator is in shard 1
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, royaltiesSenderShard, caller, creator, 500, big.NewInt(1000))
+
+	vmOutput, err := royaltiesSenderShard.ProcessBuiltinFunction(sender, nil, createRoyaltiesPaymentVmInput(caller, big.NewInt(1000), seller))
+	require.Nil(t, err)
+
+	_ = royaltiesSenderShard.accounts.SaveAccount(sender)
+	_, _ = royaltiesSenderShard.accounts.Commit()
+
+	assert.Equal(t, big.NewInt(0), getFungibleBalance(t, royaltiesSenderShard, caller))
+	assert.Equal(t, big.NewInt(950), getFungibleBalance(t, royaltiesSenderShard, seller))
+
+	funcName, args := extractScResultsFromVmOutput(t, vmOutput)
+	assert.Equal(t, core.BuiltInFunctionESDTTransfer, funcName)
+	require.Equal(t, 2, len(args))
+	assert.Equal(t, royaltiesPaymentName, args[0])
+	assert.Equal(t, big.NewInt(50).Bytes(), args[1])
+	assert.Equal(t, caller, vmOutput.OutputAccounts[string(creator)].OutputTransfers[0].SenderAddress)
+}
+
+func createEGLDRoyaltiesPaymentVmInput(caller []byte, amount *big.Int, callValue *big.Int, seller []byte) *vmcommon.ContractCallInput {
+	vmInput := createRoyaltiesPaymentVmInput(caller, amount, seller)
+	vmInput.CallValue = callValue
+	vmInput.Arguments[2] = []byte(egldRoyaltiesPaymentIdentifier)
+
+	return vmInput
+}
+
+func getEGLDBalance(t *testing.T, e *esdtNFTRoyaltiesPayment, address []byte) *big.Int {
+	account, err := e.accounts.LoadAccount(address)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler).GetBalance()
+}
+
+func TestEsdtNFTRoyaltiesPayment_ProcessBuiltinFunctionEGLDPaymentShouldSplitTheCallValue(t *testing.T) {
+	t.Parallel()
+
+	e := createRoyaltiesPaymentWithMockArguments(0, 2)
+	caller := bytes.Repeat([]byte{2}, 32)
+	creator := bytes.Repeat([]byte{1}, 32) // creator is in shard 1
+	seller := bytes.Repeat([]byte{4}, 32)
+	sender := prepareRoyaltiesPaymentCaller(t, e, caller, creator, 1250, big.NewInt(1000))
+
+	vmInput := createEGLDRoyaltiesPaymentVmInput(caller, big.NewInt(800), big.NewInt(700), seller)
+	_, err := e.ProcessBuiltinFunction(sender, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidArguments))
+
+	vmInput = createEGLDRoyaltiesPaymentVmInput(caller, big.NewInt(800), big.NewInt(800), seller)
+	vmOutput, err := e.ProcessBuiltinFunction(sender, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	_ = e.accounts.SaveAccount(sender)
+	_, _ = e.accounts.Commit()
+
+	assert.Equal(t, big.NewInt(1000), getFungibleBalance(t, e, caller))
+	assert.Equal(t, big.NewInt(700), getEGLDBalance(t, e, seller))
+
+	require.Equal(t, 1, len(vmOutput.OutputAccounts))
+	outTransfer := vmOutput.OutputAccounts[string(creator)].OutputTransfers[0]
+	assert.Equal(t, big.NewInt(100), outTransfer.Value)
+	assert.Equal(t, 0, len(outTransfer.Data))
+	assert.Equal(t, caller, outTransfer.SenderAddress)
+}
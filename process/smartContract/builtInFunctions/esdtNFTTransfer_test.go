@@ -122,6 +122,7 @@ func createMockGasCost() process.GasCost {
 			ESDTNFTBurn:              170,
 			ESDTNFTTransfer:          180,
 			ESDTNFTChangeCreateOwner: 190,
+			ESDTNFTRoyaltiesPayment:  200,
 		},
 	}
 }
@@ -21,6 +21,9 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	Marshalizer          marshal.Marshalizer
 	Accounts             state.AccountsAdapter
 	ShardCoordinator     sharding.Coordinator
+	EpochNotifier        process.EpochNotifier
+
+	ESDTNFTRoyaltiesPaymentEnableEpoch uint32
 }
 
 type builtInFuncFactory struct {
@@ -31,6 +34,9 @@ type builtInFuncFactory struct {
 	builtInFunctions     process.BuiltInFunctionContainer
 	gasConfig            *process.GasCost
 	shardCoordinator     sharding.Coordinator
+	epochNotifier        process.EpochNotifier
+
+	esdtNFTRoyaltiesPaymentEnableEpoch uint32
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -50,6 +56,9 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
 		mapDNSAddresses:      args.MapDNSAddresses,
@@ -57,6 +66,9 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 		marshalizer:          args.Marshalizer,
 		accounts:             args.Accounts,
 		shardCoordinator:     args.ShardCoordinator,
+		epochNotifier:        args.EpochNotifier,
+
+		esdtNFTRoyaltiesPaymentEnableEpoch: args.ESDTNFTRoyaltiesPaymentEnableEpoch,
 	}
 
 	var err error
@@ -270,6 +282,23 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewESDTNFTRoyaltiesPaymentFunc(
+		b.gasConfig.BuiltInCost.ESDTNFTRoyaltiesPayment,
+		b.marshalizer,
+		pauseFunc,
+		b.accounts,
+		b.shardCoordinator,
+		b.esdtNFTRoyaltiesPaymentEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTRoyaltiesPayment, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -340,6 +369,23 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 		return err
 	}
 
+	builtInFunc, err = container.Get(core.BuiltInFunctionESDTNFTRoyaltiesPayment)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtNFTRoyaltiesPaymentFunc, ok := builtInFunc.(*esdtNFTRoyaltiesPayment)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = esdtNFTRoyaltiesPaymentFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
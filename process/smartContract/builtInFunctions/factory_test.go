@@ -22,6 +22,7 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &mock.AccountsStub{},
 		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(1),
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -67,6 +68,7 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["ESDTNFTBurn"] = value
 	gasMap["ESDTNFTTransfer"] = value
 	gasMap["ESDTNFTChangeCreateOwner"] = value
+	gasMap["ESDTNFTRoyaltiesPayment"] = value
 
 	return gasMap
 }
@@ -86,12 +88,18 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Nil(t, err)
 	container, err := factory.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 20)
+	assert.Equal(t, len(container.Keys()), 21)
 
 	err = SetPayableHandler(container, &mock.BlockChainHookHandlerMock{})
 	assert.Nil(t, err)
@@ -128,6 +136,7 @@ func TestBuiltInFuncFactory_GasScheduleChange(t *testing.T) {
 		"ESDTNFTBurn":              100,
 		"ESDTNFTTransfer":          100,
 		"ESDTNFTChangeCreateOwner": 100,
+		"ESDTNFTRoyaltiesPayment":  100,
 	}
 	gasMap := map[string]map[string]uint64{
 		core.BaseOperationCost: baseOpCosts,
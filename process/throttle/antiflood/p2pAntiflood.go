@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
@@ -18,6 +19,12 @@ const unidentifiedTopic = "unidentifier topic"
 var log = logger.GetOrCreate("process/throttle/antiflood")
 var _ process.P2PAntifloodHandler = (*p2pAntiflood)(nil)
 
+var rejectedMessages = prometheus.DefaultRegistry().NewCounterVec(
+	"elrond_antiflood_rejected_messages_total",
+	"Number of messages rejected by the antiflood component.",
+	"reason",
+)
+
 type p2pAntiflood struct {
 	blacklistHandler    process.PeerBlackListCacher
 	floodPreventers     []process.FloodPreventer
@@ -72,6 +79,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	if lastErrFound != nil {
+		rejectedMessages.WithLabelValues("peer_quota").Inc()
 		af.recordDebugEvent(
 			fromConnectedPeer,
 			message.Topic(),
@@ -86,6 +94,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 
 	originatorIsBlacklisted := af.blacklistHandler.Has(message.Peer())
 	if originatorIsBlacklisted {
+		rejectedMessages.WithLabelValues("originator_blacklisted").Inc()
 		af.recordDebugEvent(message.Peer(), message.Topic(), 1, uint64(len(message.Data())), message.SeqNo(), true)
 		return fmt.Errorf("%w for pid %s", process.ErrOriginatorIsBlacklisted, message.Peer().Pretty())
 	}
@@ -189,6 +198,7 @@ func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string
 			"topic", topic,
 		)
 
+		rejectedMessages.WithLabelValues("topic_quota").Add(float64(numMessages))
 		af.recordDebugEvent(peer, topic, numMessages, totalSize, sequence, af.blacklistHandler.Has(peer))
 
 		return fmt.Errorf("%w in p2pAntiflood for connected peer %s",
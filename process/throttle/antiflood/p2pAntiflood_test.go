@@ -2,12 +2,14 @@ package antiflood_test
 
 import (
 	"errors"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/prometheus"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -267,6 +269,8 @@ func TestP2pAntiflood_CanProcessMessagesOriginatorIsBlacklistedShouldErr(t *test
 	err := afm.CanProcessMessage(message, identifier)
 
 	assert.True(t, errors.Is(err, process.ErrOriginatorIsBlacklisted))
+	metrics := prometheus.DefaultRegistry().String()
+	assert.True(t, strings.Contains(metrics, `elrond_antiflood_rejected_messages_total{reason="originator_blacklisted"}`))
 }
 
 func TestP2pAntiflood_ResetForTopicSetMaxMessagesShouldWork(t *testing.T) {
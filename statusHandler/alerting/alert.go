@@ -0,0 +1,27 @@
+package alerting
+
+// AlertState is the state of an alerting rule
+type AlertState string
+
+const (
+	// StateInactive is the state of a rule whose condition does not hold
+	StateInactive AlertState = "inactive"
+	// StatePending is the state of a rule whose condition holds for less than the configured duration
+	StatePending AlertState = "pending"
+	// StateFiring is the state of a rule whose condition holds for at least the configured duration
+	StateFiring AlertState = "firing"
+	// StateResolved is the state notified when the condition of a firing rule stops holding
+	StateResolved AlertState = "resolved"
+)
+
+// Alert holds the current state of an alerting rule
+type Alert struct {
+	Name        string     `json:"name"`
+	Severity    string     `json:"severity"`
+	Condition   string     `json:"condition"`
+	State       AlertState `json:"state"`
+	Value       float64    `json:"value"`
+	Threshold   float64    `json:"threshold"`
+	ActiveSince int64      `json:"activeSince,omitempty"`
+	ResolvedAt  int64      `json:"resolvedAt,omitempty"`
+}
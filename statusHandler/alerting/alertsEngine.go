@@ -0,0 +1,256 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+var log = logger.GetOrCreate("statusHandler/alerting")
+
+// ArgsAlertsEngine is the DTO used to create a new alerts engine
+type ArgsAlertsEngine struct {
+	Config config.AlertingConfig
+	Sinks  []AlertSink
+}
+
+// alertsEngine is an AppStatusHandler which keeps the numeric values of the status metrics and periodically
+// evaluates the configured rules over them, notifying the sinks when an alert starts firing or gets resolved
+type alertsEngine struct {
+	mutMetrics sync.RWMutex
+	metrics    map[string]float64
+
+	mutRules sync.RWMutex
+	rules    []*rule
+
+	sinks              []AlertSink
+	evaluationInterval time.Duration
+	getTimeHandler     func() time.Time
+
+	mutStatusHandler sync.RWMutex
+	statusHandler    core.AppStatusHandler
+
+	cancelFunc func()
+}
+
+// NewAlertsEngine creates a new alerts engine
+func NewAlertsEngine(args ArgsAlertsEngine) (*alertsEngine, error) {
+	if args.Config.EvaluationIntervalInSeconds == 0 {
+		return nil, ErrInvalidEvaluationInterval
+	}
+	for _, sink := range args.Sinks {
+		if check.IfNil(sink) {
+			return nil, ErrNilAlertSink
+		}
+	}
+
+	rules := make([]*rule, 0, len(args.Config.Rules))
+	names := make(map[string]struct{})
+	for _, ruleConfig := range args.Config.Rules {
+		r, err := newRule(ruleConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		_, exists := names[ruleConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedRuleName, ruleConfig.Name)
+		}
+		names[ruleConfig.Name] = struct{}{}
+		rules = append(rules, r)
+	}
+
+	return &alertsEngine{
+		metrics:            make(map[string]float64),
+		rules:              rules,
+		sinks:              args.Sinks,
+		evaluationInterval: time.Duration(args.Config.EvaluationIntervalInSeconds) * time.Second,
+		getTimeHandler:     time.Now,
+		statusHandler:      statusHandler.NewNilStatusHandler(),
+		cancelFunc:         func() {},
+	}, nil
+}
+
+// SetStatusHandler sets the handler on which the names of the firing alerts are published
+func (ae *alertsEngine) SetStatusHandler(handler core.AppStatusHandler) error {
+	if check.IfNil(handler) {
+		return statusHandler.ErrNilAppStatusHandler
+	}
+
+	ae.mutStatusHandler.Lock()
+	ae.statusHandler = handler
+	ae.mutStatusHandler.Unlock()
+
+	return nil
+}
+
+// StartEvaluation starts the periodic evaluation of the rules. It should be called only once
+func (ae *alertsEngine) StartEvaluation() {
+	var ctx context.Context
+	ctx, ae.cancelFunc = context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(ae.evaluationInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("alerts engine evaluation loop is closing...")
+				return
+			case <-ticker.C:
+				ae.evaluate()
+			}
+		}
+	}()
+}
+
+func (ae *alertsEngine) evaluate() {
+	metrics := ae.metricsSnapshot()
+	now := ae.getTimeHandler()
+
+	notifications := make([]Alert, 0)
+	firingNames := make([]string, 0)
+
+	ae.mutRules.Lock()
+	for _, r := range ae.rules {
+		shouldNotify := r.evaluate(metrics, now)
+		if r.state == StateFiring {
+			firingNames = append(firingNames, r.config.Name)
+		}
+		if !shouldNotify {
+			continue
+		}
+
+		alert := r.alert()
+		if alert.State == StateInactive {
+			alert.State = StateResolved
+			alert.ResolvedAt = now.Unix()
+		}
+		notifications = append(notifications, alert)
+	}
+	ae.mutRules.Unlock()
+
+	ae.mutStatusHandler.RLock()
+	ae.statusHandler.SetStringValue(core.MetricActiveAlerts, strings.Join(firingNames, ","))
+	ae.mutStatusHandler.RUnlock()
+
+	for _, alert := range notifications {
+		ae.notify(alert)
+	}
+}
+
+func (ae *alertsEngine) notify(alert Alert) {
+	for _, sink := range ae.sinks {
+		err := sink.Send(alert)
+		if err != nil {
+			log.Warn("cannot send alert", "alert", alert.Name, "state", alert.State, "error", err)
+		}
+	}
+}
+
+func (ae *alertsEngine) metricsSnapshot() map[string]float64 {
+	ae.mutMetrics.RLock()
+	defer ae.mutMetrics.RUnlock()
+
+	snapshot := make(map[string]float64, len(ae.metrics))
+	for key, value := range ae.metrics {
+		snapshot[key] = value
+	}
+
+	return snapshot
+}
+
+// GetAlerts returns the current state of all the rules, in the configured order
+func (ae *alertsEngine) GetAlerts() []Alert {
+	ae.mutRules.RLock()
+	defer ae.mutRules.RUnlock()
+
+	alerts := make([]Alert, 0, len(ae.rules))
+	for _, r := range ae.rules {
+		alerts = append(alerts, r.alert())
+	}
+
+	return alerts
+}
+
+// Increment method - will increment the value for a key, if the key was set before
+func (ae *alertsEngine) Increment(key string) {
+	ae.AddUint64(key, 1)
+}
+
+// AddUint64 method - will increase the value for a key, if the key was set before
+func (ae *alertsEngine) AddUint64(key string, value uint64) {
+	ae.mutMetrics.Lock()
+	defer ae.mutMetrics.Unlock()
+
+	current, ok := ae.metrics[key]
+	if !ok {
+		return
+	}
+	ae.metrics[key] = current + float64(value)
+}
+
+// Decrement method - will decrement the value for a key, if the key was set before and it is positive
+func (ae *alertsEngine) Decrement(key string) {
+	ae.mutMetrics.Lock()
+	defer ae.mutMetrics.Unlock()
+
+	current, ok := ae.metrics[key]
+	if !ok || current <= 0 {
+		return
+	}
+	ae.metrics[key] = current - 1
+}
+
+// SetInt64Value method - will update the value for a key
+func (ae *alertsEngine) SetInt64Value(key string, value int64) {
+	ae.setValue(key, float64(value))
+}
+
+// SetUInt64Value method - will update the value for a key
+func (ae *alertsEngine) SetUInt64Value(key string, value uint64) {
+	ae.setValue(key, float64(value))
+}
+
+// SetStringValue method - will update the value for a key if the provided string is numeric
+func (ae *alertsEngine) SetStringValue(key string, value string) {
+	numericValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+
+	ae.setValue(key, numericValue)
+}
+
+func (ae *alertsEngine) setValue(key string, value float64) {
+	ae.mutMetrics.Lock()
+	ae.metrics[key] = value
+	ae.mutMetrics.Unlock()
+}
+
+// Close stops the evaluation and closes the sinks
+func (ae *alertsEngine) Close() {
+	ae.cancelFunc()
+
+	for _, sink := range ae.sinks {
+		err := sink.Close()
+		if err != nil {
+			log.Warn("cannot close alert sink", "error", err)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ae *alertsEngine) IsInterfaceNil() bool {
+	return ae == nil
+}
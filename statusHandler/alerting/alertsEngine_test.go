@@ -0,0 +1,341 @@
+package alerting_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type alertSinkStub struct {
+	mut    sync.Mutex
+	alerts []alerting.Alert
+	closed bool
+}
+
+func (ass *alertSinkStub) Send(alert alerting.Alert) error {
+	ass.mut.Lock()
+	ass.alerts = append(ass.alerts, alert)
+	ass.mut.Unlock()
+
+	return nil
+}
+
+func (ass *alertSinkStub) Close() error {
+	ass.closed = true
+	return nil
+}
+
+func (ass *alertSinkStub) IsInterfaceNil() bool {
+	return ass == nil
+}
+
+func (ass *alertSinkStub) sent() []alerting.Alert {
+	ass.mut.Lock()
+	defer ass.mut.Unlock()
+
+	return append([]alerting.Alert(nil), ass.alerts...)
+}
+
+type engineHandler interface {
+	core.AppStatusHandler
+	SetGetTimeHandler(handler func() time.Time)
+	SetStatusHandler(handler core.AppStatusHandler) error
+	StartEvaluation()
+	Evaluate()
+	GetAlerts() []alerting.Alert
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (fc *fakeClock) get() time.Time {
+	return fc.now
+}
+
+func (fc *fakeClock) advance(duration time.Duration) {
+	fc.now = fc.now.Add(duration)
+}
+
+func createArgs(rules ...config.AlertRuleConfig) alerting.ArgsAlertsEngine {
+	return alerting.ArgsAlertsEngine{
+		Config: config.AlertingConfig{
+			Enabled:                     true,
+			EvaluationIntervalInSeconds: 1,
+			Rules:                       rules,
+		},
+		Sinks: []alerting.AlertSink{&alertSinkStub{}},
+	}
+}
+
+func createEngine(t *testing.T, sink *alertSinkStub, rules ...config.AlertRuleConfig) (engineHandler, *fakeClock) {
+	args := createArgs(rules...)
+	args.Sinks = []alerting.AlertSink{sink}
+	ae, err := alerting.NewAlertsEngine(args)
+	require.Nil(t, err)
+
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	ae.SetGetTimeHandler(clock.get)
+
+	return ae, clock
+}
+
+func lowPeersRule() config.AlertRuleConfig {
+	return config.AlertRuleConfig{
+		Name:              "LowPeerCount",
+		Metric:            core.MetricNumConnectedPeers,
+		Function:          "value",
+		Operator:          "<",
+		Threshold:         3,
+		DurationInSeconds: 60,
+		Severity:          "warning",
+	}
+}
+
+func TestNewAlertsEngine_InvalidEvaluationIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.Config.EvaluationIntervalInSeconds = 0
+	ae, err := alerting.NewAlertsEngine(args)
+
+	assert.True(t, check.IfNil(ae))
+	assert.Equal(t, alerting.ErrInvalidEvaluationInterval, err)
+}
+
+func TestNewAlertsEngine_NilSinkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.Sinks = []alerting.AlertSink{nil}
+	ae, err := alerting.NewAlertsEngine(args)
+
+	assert.True(t, check.IfNil(ae))
+	assert.Equal(t, alerting.ErrNilAlertSink, err)
+}
+
+func TestNewAlertsEngine_InvalidRulesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		modify      func(cfg *config.AlertRuleConfig)
+		expectedErr error
+	}{
+		{"empty name", func(cfg *config.AlertRuleConfig) { cfg.Name = " " }, alerting.ErrEmptyRuleName},
+		{"invalid expression", func(cfg *config.AlertRuleConfig) { cfg.Metric = "a -" }, alerting.ErrInvalidMetricExpression},
+		{"invalid operator", func(cfg *config.AlertRuleConfig) { cfg.Operator = "=<" }, alerting.ErrInvalidOperator},
+		{"invalid function", func(cfg *config.AlertRuleConfig) { cfg.Function = "rate" }, alerting.ErrInvalidFunction},
+		{"increase without window", func(cfg *config.AlertRuleConfig) { cfg.Function = "increase" }, alerting.ErrInvalidWindow},
+	}
+
+	for _, tc := range testCases {
+		cfg := lowPeersRule()
+		tc.modify(&cfg)
+		ae, err := alerting.NewAlertsEngine(createArgs(cfg))
+
+		assert.True(t, check.IfNil(ae), tc.name)
+		assert.True(t, errors.Is(err, tc.expectedErr), tc.name)
+	}
+}
+
+func TestNewAlertsEngine_DuplicatedRuleNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ae, err := alerting.NewAlertsEngine(createArgs(lowPeersRule(), lowPeersRule()))
+
+	assert.True(t, check.IfNil(ae))
+	assert.True(t, errors.Is(err, alerting.ErrDuplicatedRuleName))
+}
+
+func TestNewAlertsEngine_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ae, err := alerting.NewAlertsEngine(createArgs(lowPeersRule()))
+
+	assert.False(t, check.IfNil(ae))
+	assert.Nil(t, err)
+	alerts := ae.GetAlerts()
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, alerting.StateInactive, alerts[0].State)
+	assert.Equal(t, "value(erd_num_connected_peers) < 3", alerts[0].Condition)
+}
+
+func TestParseMetricExpression(t *testing.T) {
+	t.Parallel()
+
+	numTerms, err := alerting.ParseMetricExpression("a")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numTerms)
+
+	numTerms, err = alerting.ParseMetricExpression("a - b + c")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numTerms)
+
+	for _, expression := range []string{"", "a b", "- a", "a + - b", "a +"} {
+		_, err = alerting.ParseMetricExpression(expression)
+		assert.True(t, errors.Is(err, alerting.ErrInvalidMetricExpression), expression)
+	}
+}
+
+func TestAlertsEngine_FiresAfterDurationAndResolves(t *testing.T) {
+	t.Parallel()
+
+	sink := &alertSinkStub{}
+	ae, clock := createEngine(t, sink, lowPeersRule())
+
+	ae.Evaluate()
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State, "no data yet")
+
+	ae.SetUInt64Value(core.MetricNumConnectedPeers, 1)
+	ae.Evaluate()
+	alert := ae.GetAlerts()[0]
+	assert.Equal(t, alerting.StatePending, alert.State)
+	assert.Equal(t, clock.now.Unix(), alert.ActiveSince)
+	assert.Equal(t, 0, len(sink.sent()))
+
+	clock.advance(time.Minute)
+	ae.Evaluate()
+	assert.Equal(t, alerting.StateFiring, ae.GetAlerts()[0].State)
+	require.Equal(t, 1, len(sink.sent()))
+	assert.Equal(t, alerting.StateFiring, sink.sent()[0].State)
+	assert.Equal(t, float64(1), sink.sent()[0].Value)
+
+	clock.advance(time.Minute)
+	ae.Evaluate()
+	assert.Equal(t, 1, len(sink.sent()), "firing alerts should be notified only once")
+
+	ae.SetUInt64Value(core.MetricNumConnectedPeers, 10)
+	ae.Evaluate()
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State)
+	require.Equal(t, 2, len(sink.sent()))
+	assert.Equal(t, alerting.StateResolved, sink.sent()[1].State)
+	assert.Equal(t, clock.now.Unix(), sink.sent()[1].ResolvedAt)
+}
+
+func TestAlertsEngine_PendingAlertResolvedShouldNotNotify(t *testing.T) {
+	t.Parallel()
+
+	sink := &alertSinkStub{}
+	ae, clock := createEngine(t, sink, lowPeersRule())
+
+	ae.SetInt64Value(core.MetricNumConnectedPeers, 1)
+	ae.Evaluate()
+	clock.advance(time.Second * 30)
+	ae.SetStringValue(core.MetricNumConnectedPeers, "5")
+	ae.Evaluate()
+
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State)
+	assert.Equal(t, 0, len(sink.sent()))
+}
+
+func TestAlertsEngine_IncreaseOverDifferenceExpression(t *testing.T) {
+	t.Parallel()
+
+	missedBlocksRule := config.AlertRuleConfig{
+		Name:            "MissedBlocks",
+		Metric:          core.MetricCountLeader + " - " + core.MetricCountAcceptedBlocks,
+		Function:        "increase",
+		WindowInSeconds: 600,
+		Operator:        ">",
+		Threshold:       0,
+	}
+	sink := &alertSinkStub{}
+	ae, clock := createEngine(t, sink, missedBlocksRule)
+
+	ae.SetUInt64Value(core.MetricCountLeader, 0)
+	ae.SetUInt64Value(core.MetricCountAcceptedBlocks, 0)
+	ae.Increment(core.MetricCountLeader)
+	ae.Increment(core.MetricCountAcceptedBlocks)
+	ae.Evaluate()
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State)
+
+	clock.advance(time.Minute)
+	ae.Increment(core.MetricCountLeader)
+	ae.Evaluate()
+	alert := ae.GetAlerts()[0]
+	assert.Equal(t, alerting.StateFiring, alert.State)
+	assert.Equal(t, float64(1), alert.Value)
+	assert.Equal(t, 1, len(sink.sent()))
+
+	clock.advance(time.Minute * 11)
+	ae.Evaluate()
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State, "the missed block left the window")
+	assert.Equal(t, 2, len(sink.sent()))
+}
+
+func TestAlertsEngine_IncrementOnUnsetMetricShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	sink := &alertSinkStub{}
+	ae, _ := createEngine(t, sink, lowPeersRule())
+
+	ae.Increment(core.MetricNumConnectedPeers)
+	ae.AddUint64(core.MetricNumConnectedPeers, 2)
+	ae.Decrement(core.MetricNumConnectedPeers)
+	ae.SetStringValue(core.MetricNumConnectedPeers, "not a number")
+	ae.Evaluate()
+
+	assert.Equal(t, alerting.StateInactive, ae.GetAlerts()[0].State)
+}
+
+func TestAlertsEngine_PublishesFiringAlertNames(t *testing.T) {
+	t.Parallel()
+
+	rule := lowPeersRule()
+	rule.DurationInSeconds = 0
+	ae, _ := createEngine(t, &alertSinkStub{}, rule)
+
+	published := ""
+	err := ae.SetStatusHandler(&mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			if key == core.MetricActiveAlerts {
+				published = value
+			}
+		},
+	})
+	require.Nil(t, err)
+
+	ae.SetUInt64Value(core.MetricNumConnectedPeers, 0)
+	ae.Evaluate()
+	assert.Equal(t, "LowPeerCount", published)
+
+	ae.SetUInt64Value(core.MetricNumConnectedPeers, 5)
+	ae.Evaluate()
+	assert.Equal(t, "", published)
+}
+
+func TestAlertsEngine_SetNilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ae, _ := alerting.NewAlertsEngine(createArgs())
+
+	assert.Equal(t, statusHandler.ErrNilAppStatusHandler, ae.SetStatusHandler(nil))
+}
+
+func TestAlertsEngine_StartEvaluationAndClose(t *testing.T) {
+	t.Parallel()
+
+	rule := lowPeersRule()
+	rule.DurationInSeconds = 0
+	sink := &alertSinkStub{}
+	ae, _ := createEngine(t, sink, rule)
+
+	ae.SetUInt64Value(core.MetricNumConnectedPeers, 0)
+	ae.StartEvaluation()
+	time.Sleep(time.Millisecond * 1500)
+	ae.Close()
+
+	assert.Equal(t, 1, len(sink.sent()))
+	assert.True(t, sink.closed)
+}
@@ -0,0 +1,39 @@
+package alerting
+
+import "errors"
+
+// ErrInvalidEvaluationInterval signals that an invalid evaluation interval was provided
+var ErrInvalidEvaluationInterval = errors.New("invalid evaluation interval")
+
+// ErrEmptyRuleName signals that a rule without a name was provided
+var ErrEmptyRuleName = errors.New("empty rule name")
+
+// ErrDuplicatedRuleName signals that two rules have the same name
+var ErrDuplicatedRuleName = errors.New("duplicated rule name")
+
+// ErrInvalidMetricExpression signals that the metric expression of a rule can not be parsed
+var ErrInvalidMetricExpression = errors.New("invalid metric expression")
+
+// ErrInvalidOperator signals that a rule uses an unknown comparison operator
+var ErrInvalidOperator = errors.New("invalid operator")
+
+// ErrInvalidFunction signals that a rule uses an unknown function
+var ErrInvalidFunction = errors.New("invalid function")
+
+// ErrInvalidWindow signals that a rule using the increase function has no window
+var ErrInvalidWindow = errors.New("invalid window")
+
+// ErrNilAlertSink signals that a nil alert sink was provided
+var ErrNilAlertSink = errors.New("nil alert sink")
+
+// ErrInvalidSinkType signals that an unknown sink type was configured
+var ErrInvalidSinkType = errors.New("invalid sink type")
+
+// ErrEmptyWebhookURL signals that a webhook sink was configured without an URL
+var ErrEmptyWebhookURL = errors.New("empty webhook URL")
+
+// ErrEmptyScriptPath signals that a script sink was configured without a script path
+var ErrEmptyScriptPath = errors.New("empty script path")
+
+// ErrWebhookFailed signals that the webhook endpoint did not accept the alert
+var ErrWebhookFailed = errors.New("webhook failed")
@@ -0,0 +1,17 @@
+package alerting
+
+import "time"
+
+func (ae *alertsEngine) SetGetTimeHandler(handler func() time.Time) {
+	ae.getTimeHandler = handler
+}
+
+func (ae *alertsEngine) Evaluate() {
+	ae.evaluate()
+}
+
+func ParseMetricExpression(expression string) (int, error) {
+	terms, err := parseMetricExpression(expression)
+
+	return len(terms), err
+}
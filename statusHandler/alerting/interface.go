@@ -0,0 +1,8 @@
+package alerting
+
+// AlertSink defines a destination of the alert notifications
+type AlertSink interface {
+	Send(alert Alert) error
+	Close() error
+	IsInterfaceNil() bool
+}
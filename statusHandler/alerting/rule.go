@@ -0,0 +1,203 @@
+package alerting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	functionValue    = "value"
+	functionIncrease = "increase"
+)
+
+var operators = map[string]func(value float64, threshold float64) bool{
+	">":  func(value float64, threshold float64) bool { return value > threshold },
+	">=": func(value float64, threshold float64) bool { return value >= threshold },
+	"<":  func(value float64, threshold float64) bool { return value < threshold },
+	"<=": func(value float64, threshold float64) bool { return value <= threshold },
+	"==": func(value float64, threshold float64) bool { return value == threshold },
+	"!=": func(value float64, threshold float64) bool { return value != threshold },
+}
+
+type metricTerm struct {
+	name string
+	sign float64
+}
+
+type sample struct {
+	timestamp time.Time
+	value     float64
+}
+
+// rule holds the configuration and the evaluation state of an alerting rule. It is not concurrent safe, the
+// engine serializing the evaluations
+type rule struct {
+	config      config.AlertRuleConfig
+	terms       []metricTerm
+	compare     func(value float64, threshold float64) bool
+	useIncrease bool
+	window      time.Duration
+	duration    time.Duration
+
+	samples     []sample
+	state       AlertState
+	value       float64
+	activeSince time.Time
+}
+
+func newRule(cfg config.AlertRuleConfig) (*rule, error) {
+	if len(strings.TrimSpace(cfg.Name)) == 0 {
+		return nil, ErrEmptyRuleName
+	}
+
+	terms, err := parseMetricExpression(cfg.Metric)
+	if err != nil {
+		return nil, fmt.Errorf("%w for rule %s", err, cfg.Name)
+	}
+
+	compare, ok := operators[cfg.Operator]
+	if !ok {
+		return nil, fmt.Errorf("%w %q for rule %s", ErrInvalidOperator, cfg.Operator, cfg.Name)
+	}
+
+	useIncrease := false
+	switch cfg.Function {
+	case "", functionValue:
+	case functionIncrease:
+		if cfg.WindowInSeconds == 0 {
+			return nil, fmt.Errorf("%w for rule %s", ErrInvalidWindow, cfg.Name)
+		}
+		useIncrease = true
+	default:
+		return nil, fmt.Errorf("%w %q for rule %s", ErrInvalidFunction, cfg.Function, cfg.Name)
+	}
+
+	return &rule{
+		config:      cfg,
+		terms:       terms,
+		compare:     compare,
+		useIncrease: useIncrease,
+		window:      time.Duration(cfg.WindowInSeconds) * time.Second,
+		duration:    time.Duration(cfg.DurationInSeconds) * time.Second,
+		state:       StateInactive,
+	}, nil
+}
+
+// parseMetricExpression parses expressions like "metric_a" or "metric_a - metric_b + metric_c"
+func parseMetricExpression(expression string) ([]metricTerm, error) {
+	tokens := strings.Fields(expression)
+	if len(tokens)%2 == 0 {
+		return nil, fmt.Errorf("%w %q", ErrInvalidMetricExpression, expression)
+	}
+
+	terms := make([]metricTerm, 0, len(tokens)/2+1)
+	sign := float64(1)
+	for i, token := range tokens {
+		isOperatorPosition := i%2 == 1
+		isOperator := token == "+" || token == "-"
+		if isOperatorPosition != isOperator {
+			return nil, fmt.Errorf("%w %q", ErrInvalidMetricExpression, expression)
+		}
+
+		if isOperator {
+			sign = 1
+			if token == "-" {
+				sign = -1
+			}
+			continue
+		}
+
+		terms = append(terms, metricTerm{name: token, sign: sign})
+	}
+
+	return terms, nil
+}
+
+// computeValue returns the value of the rule's expression, false being returned if one of the metrics was not
+// reported yet
+func (r *rule) computeValue(metrics map[string]float64, now time.Time) (float64, bool) {
+	current := float64(0)
+	for _, term := range r.terms {
+		metricValue, ok := metrics[term.name]
+		if !ok {
+			return 0, false
+		}
+		current += term.sign * metricValue
+	}
+
+	if !r.useIncrease {
+		return current, true
+	}
+
+	r.samples = append(r.samples, sample{timestamp: now, value: current})
+	firstInWindow := 0
+	for firstInWindow < len(r.samples)-1 && now.Sub(r.samples[firstInWindow].timestamp) > r.window {
+		firstInWindow++
+	}
+	r.samples = r.samples[firstInWindow:]
+
+	increase := current - r.samples[0].value
+	if increase < 0 {
+		// the metric was reset, as it happens on a node restart
+		increase = current
+	}
+
+	return increase, true
+}
+
+// evaluate updates the state of the rule and returns true if the change should be notified to the sinks
+func (r *rule) evaluate(metrics map[string]float64, now time.Time) bool {
+	value, ok := r.computeValue(metrics, now)
+	if !ok {
+		return false
+	}
+	r.value = value
+
+	if !r.compare(value, r.config.Threshold) {
+		wasFiring := r.state == StateFiring
+		r.state = StateInactive
+		r.activeSince = time.Time{}
+
+		return wasFiring
+	}
+
+	if r.state == StateInactive {
+		r.state = StatePending
+		r.activeSince = now
+	}
+	if r.state == StatePending && now.Sub(r.activeSince) >= r.duration {
+		r.state = StateFiring
+
+		return true
+	}
+
+	return false
+}
+
+func (r *rule) condition() string {
+	function := functionValue
+	if r.useIncrease {
+		function = fmt.Sprintf("%s[%ds]", functionIncrease, r.config.WindowInSeconds)
+	}
+
+	return fmt.Sprintf("%s(%s) %s %v", function, r.config.Metric, r.config.Operator, r.config.Threshold)
+}
+
+func (r *rule) alert() Alert {
+	alert := Alert{
+		Name:      r.config.Name,
+		Severity:  r.config.Severity,
+		Condition: r.condition(),
+		State:     r.state,
+		Value:     r.value,
+		Threshold: r.config.Threshold,
+	}
+	if !r.activeSince.IsZero() {
+		alert.ActiveSince = r.activeSince.Unix()
+	}
+
+	return alert
+}
@@ -0,0 +1,189 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	sinkTypeLog     = "log"
+	sinkTypeWebhook = "webhook"
+	sinkTypeScript  = "script"
+
+	defaultSinkTimeout = 10 * time.Second
+)
+
+// CreateSinks creates the alert sinks described by the provided configuration
+func CreateSinks(sinksConfig []config.AlertSinkConfig) ([]AlertSink, error) {
+	sinks := make([]AlertSink, 0, len(sinksConfig))
+	for _, sinkConfig := range sinksConfig {
+		sink, err := createSink(sinkConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
+func createSink(sinkConfig config.AlertSinkConfig) (AlertSink, error) {
+	timeout := time.Duration(sinkConfig.TimeoutInSeconds) * time.Second
+	if timeout == 0 {
+		timeout = defaultSinkTimeout
+	}
+
+	switch sinkConfig.Type {
+	case sinkTypeLog:
+		return NewLogSink(), nil
+	case sinkTypeWebhook:
+		return NewWebhookSink(sinkConfig.URL, timeout)
+	case sinkTypeScript:
+		return NewScriptSink(sinkConfig.ScriptPath, timeout)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrInvalidSinkType, sinkConfig.Type)
+	}
+}
+
+type logSink struct {
+}
+
+// NewLogSink creates a sink which writes the alerts in the node's log
+func NewLogSink() *logSink {
+	return &logSink{}
+}
+
+// Send writes the alert in the log, firing alerts being logged as warnings
+func (ls *logSink) Send(alert Alert) error {
+	args := []interface{}{
+		"name", alert.Name,
+		"severity", alert.Severity,
+		"condition", alert.Condition,
+		"value", alert.Value,
+	}
+	if alert.State == StateFiring {
+		log.Warn("alert firing", args...)
+		return nil
+	}
+
+	log.Info("alert resolved", args...)
+	return nil
+}
+
+// Close does nothing as the sink does not hold resources
+func (ls *logSink) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ls *logSink) IsInterfaceNil() bool {
+	return ls == nil
+}
+
+type webhookSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookSink creates a sink which POSTs the alerts, JSON encoded, to the provided URL
+func NewWebhookSink(url string, timeout time.Duration) (*webhookSink, error) {
+	if len(url) == 0 {
+		return nil, ErrEmptyWebhookURL
+	}
+
+	return &webhookSink{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Send posts the alert to the webhook URL
+func (ws *webhookSink) Send(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ws.httpClient.Post(ws.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w, status code %d", ErrWebhookFailed, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close does nothing as the sink does not hold resources
+func (ws *webhookSink) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ws *webhookSink) IsInterfaceNil() bool {
+	return ws == nil
+}
+
+type scriptSink struct {
+	scriptPath string
+	timeout    time.Duration
+}
+
+// NewScriptSink creates a sink which runs the provided executable for each alert, the alert being provided
+// JSON encoded on the standard input of the script
+func NewScriptSink(scriptPath string, timeout time.Duration) (*scriptSink, error) {
+	if len(scriptPath) == 0 {
+		return nil, ErrEmptyScriptPath
+	}
+
+	return &scriptSink{
+		scriptPath: scriptPath,
+		timeout:    timeout,
+	}, nil
+}
+
+// Send runs the script, killing it if it does not finish in time
+func (ss *scriptSink) Send(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ss.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, ss.scriptPath)
+	cmd.Stdin = bytes.NewReader(body)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w, output: %s", err, string(output))
+	}
+
+	return nil
+}
+
+// Close does nothing as the sink does not hold resources
+func (ss *scriptSink) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *scriptSink) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,147 @@
+package alerting_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/alerting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFiringAlert() alerting.Alert {
+	return alerting.Alert{
+		Name:      "LowPeerCount",
+		Severity:  "warning",
+		Condition: "value(erd_num_connected_peers) < 3",
+		State:     alerting.StateFiring,
+		Value:     1,
+		Threshold: 3,
+	}
+}
+
+func TestCreateSinks(t *testing.T) {
+	t.Parallel()
+
+	sinks, err := alerting.CreateSinks([]config.AlertSinkConfig{
+		{Type: "log"},
+		{Type: "webhook", URL: "http://localhost:8080/alerts"},
+		{Type: "script", ScriptPath: "/usr/local/bin/notify", TimeoutInSeconds: 5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(sinks))
+
+	_, err = alerting.CreateSinks([]config.AlertSinkConfig{{Type: "email"}})
+	assert.True(t, errors.Is(err, alerting.ErrInvalidSinkType))
+
+	_, err = alerting.CreateSinks([]config.AlertSinkConfig{{Type: "webhook"}})
+	assert.Equal(t, alerting.ErrEmptyWebhookURL, err)
+
+	_, err = alerting.CreateSinks([]config.AlertSinkConfig{{Type: "script"}})
+	assert.Equal(t, alerting.ErrEmptyScriptPath, err)
+}
+
+func TestLogSink_Send(t *testing.T) {
+	t.Parallel()
+
+	ls := alerting.NewLogSink()
+	assert.False(t, check.IfNil(ls))
+
+	alert := createFiringAlert()
+	assert.Nil(t, ls.Send(alert))
+	alert.State = alerting.StateResolved
+	assert.Nil(t, ls.Send(alert))
+	assert.Nil(t, ls.Close())
+}
+
+func TestWebhookSink_SendShouldPostTheAlert(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan alerting.Alert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		alert := alerting.Alert{}
+		err := json.NewDecoder(r.Body).Decode(&alert)
+		assert.Nil(t, err)
+		received <- alert
+	}))
+	defer server.Close()
+
+	ws, err := alerting.NewWebhookSink(server.URL, time.Second)
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(ws))
+
+	alert := createFiringAlert()
+	err = ws.Send(alert)
+	assert.Nil(t, err)
+	assert.Equal(t, alert, <-received)
+}
+
+func TestWebhookSink_SendNotAcceptedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	ws, _ := alerting.NewWebhookSink(server.URL, time.Second)
+	err := ws.Send(createFiringAlert())
+
+	assert.True(t, errors.Is(err, alerting.ErrWebhookFailed))
+}
+
+func TestScriptSink_SendShouldProvideTheAlertOnStdin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test uses a shell script")
+	}
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "alerting")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	outputPath := filepath.Join(dir, "alert.json")
+	scriptPath := filepath.Join(dir, "notify.sh")
+	script := "#!/bin/sh\ncat > " + outputPath + "\n"
+	err = ioutil.WriteFile(scriptPath, []byte(script), 0700)
+	require.Nil(t, err)
+
+	ss, err := alerting.NewScriptSink(scriptPath, time.Second*5)
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(ss))
+
+	alert := createFiringAlert()
+	err = ss.Send(alert)
+	require.Nil(t, err)
+
+	content, err := ioutil.ReadFile(outputPath)
+	require.Nil(t, err)
+	written := alerting.Alert{}
+	err = json.Unmarshal(content, &written)
+	assert.Nil(t, err)
+	assert.Equal(t, alert, written)
+}
+
+func TestScriptSink_SendMissingScriptShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, _ := alerting.NewScriptSink("/missing/notify.sh", time.Second)
+	err := ss.Send(createFiringAlert())
+
+	assert.NotNil(t, err)
+}
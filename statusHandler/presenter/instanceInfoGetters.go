@@ -55,6 +55,17 @@ func (psh *PresenterStatusHandler) GetCountAcceptedBlocks() uint64 {
 	return psh.getFromCacheAsUint64(core.MetricCountAcceptedBlocks)
 }
 
+// GetActiveAlerts will return the comma separated names of the alerts which are currently firing or an empty
+// string if there are none
+func (psh *PresenterStatusHandler) GetActiveAlerts() string {
+	activeAlerts := psh.getFromCacheAsString(core.MetricActiveAlerts)
+	if activeAlerts == metricNotAvailable {
+		return ""
+	}
+
+	return activeAlerts
+}
+
 // CheckSoftwareVersion will check if node is the latest version and will return latest stable version
 func (psh *PresenterStatusHandler) CheckSoftwareVersion() (bool, string) {
 	latestStableVersion := psh.getFromCacheAsString(core.MetricLatestTagSoftwareVersion)
@@ -219,3 +219,13 @@ func TestPresenterStatusHandler_CalculateRewardsPerHourShouldWork(t *testing.T)
 	result := presenterStatusHandler.CalculateRewardsPerHour()
 	assert.Equal(t, expectedValue, result)
 }
+
+func TestPresenterStatusHandler_GetActiveAlerts(t *testing.T) {
+	t.Parallel()
+
+	presenterStatusHandler := NewPresenterStatusHandler()
+	assert.Equal(t, "", presenterStatusHandler.GetActiveAlerts())
+
+	presenterStatusHandler.SetStringValue(core.MetricActiveAlerts, "LowPeerCount,MissedBlocks")
+	assert.Equal(t, "LowPeerCount,MissedBlocks", presenterStatusHandler.GetActiveAlerts())
+}
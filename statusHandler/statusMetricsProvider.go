@@ -2,6 +2,7 @@ package statusHandler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -131,12 +132,20 @@ func (sm *statusMetrics) StatusP2pMetricsMap() map[string]interface{} {
 func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	shardID := sm.loadUint64Metric(core.MetricShardId)
 	metrics := sm.StatusMetricsMapWithoutP2P()
+	keys := make([]string, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
 	stringBuilder := strings.Builder{}
-	for key, value := range metrics {
+	for _, key := range keys {
+		value := metrics[key]
 		_, isUint64 := value.(uint64)
 		_, isInt64 := value.(int64)
 		isNumericValue := isUint64 || isInt64
 		if isNumericValue {
+			stringBuilder.WriteString(fmt.Sprintf("# TYPE %s gauge\n", key))
 			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, core.MetricShardId, shardID, value))
 		}
 	}
@@ -144,6 +144,20 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldBeSortedWithTypes(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value("test-key2", 2)
+	sm.SetUInt64Value("test-key1", 1)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedMetricOutput := fmt.Sprintf("# TYPE test-key1 gauge\ntest-key1{%s=\"0\"} 1\n"+
+		"# TYPE test-key2 gauge\ntest-key2{%s=\"0\"} 2\n", core.MetricShardId, core.MetricShardId)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
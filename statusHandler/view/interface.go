@@ -50,6 +50,7 @@ type Presenter interface {
 	GetNumShardHeadersProcessed() uint64
 	GetHighestFinalBlock() uint64
 	CheckSoftwareVersion() (bool, string)
+	GetActiveAlerts() string
 
 	GetNetworkSentBytesInEpoch() uint64
 	GetNetworkReceivedBytesInEpoch() uint64
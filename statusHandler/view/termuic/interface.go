@@ -4,6 +4,14 @@ package termuic
 type TermuiRender interface {
 	// RefreshData method is used to refresh data that are displayed on a grid
 	RefreshData(numMillisecondsRefreshTime int)
+	// SelectNode displays the details of the node with the provided index
+	SelectNode(index int)
+	// SelectNextNode displays the details of the next node
+	SelectNextNode()
+	// SelectPreviousNode displays the details of the previous node
+	SelectPreviousNode()
+	// ToggleSummary switches between the details of the selected node and the summary of all nodes
+	ToggleSummary()
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
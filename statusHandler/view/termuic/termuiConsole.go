@@ -24,7 +24,8 @@ var log = logger.GetOrCreate("statushandler/view/termuic")
 
 // TermuiConsole data where is store data from handler
 type TermuiConsole struct {
-	presenter                 view.Presenter
+	nodes                     []termuiRenders.NodePresenter
+	thresholds                termuiRenders.Thresholds
 	consoleRender             TermuiRender
 	grid                      *termuiRenders.DrawableContainer
 	mutRefresh                *sync.RWMutex
@@ -36,12 +37,34 @@ func NewTermuiConsole(presenter view.Presenter, refreshTimeInMilliseconds int) (
 	if check.IfNil(presenter) {
 		return nil, statusHandler.ErrNilPresenterInterface
 	}
+
+	nodes := []termuiRenders.NodePresenter{{Presenter: presenter}}
+
+	return NewMultiNodeTermuiConsole(nodes, termuiRenders.DefaultThresholds, refreshTimeInMilliseconds)
+}
+
+// NewMultiNodeTermuiConsole creates a TermuiConsole which displays the provided nodes, the user being able to switch
+// between them and to see a summary table of all of them
+func NewMultiNodeTermuiConsole(
+	nodes []termuiRenders.NodePresenter,
+	thresholds termuiRenders.Thresholds,
+	refreshTimeInMilliseconds int,
+) (*TermuiConsole, error) {
+	if len(nodes) == 0 {
+		return nil, statusHandler.ErrNilPresenterInterface
+	}
+	for _, node := range nodes {
+		if check.IfNil(node.Presenter) {
+			return nil, statusHandler.ErrNilPresenterInterface
+		}
+	}
 	if refreshTimeInMilliseconds < 1 {
 		return nil, statusHandler.ErrInvalidRefreshTimeInMilliseconds
 	}
 
 	tc := TermuiConsole{
-		presenter:                 presenter,
+		nodes:                     nodes,
+		thresholds:                thresholds,
 		mutRefresh:                &sync.RWMutex{},
 		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
 	}
@@ -72,7 +95,7 @@ func (tc *TermuiConsole) eventLoop() {
 	}
 
 	var err error
-	tc.consoleRender, err = termuiRenders.NewWidgetsRender(tc.presenter, tc.grid)
+	tc.consoleRender, err = termuiRenders.NewMultiNodeWidgetsRender(tc.nodes, tc.thresholds, tc.grid)
 	if err != nil {
 		log.Debug("nil console render", "error", err.Error())
 		return
@@ -110,9 +133,26 @@ func (tc *TermuiConsole) processUiEvents(e ui.Event, numMillisecondsRefreshTime
 		ui.Close()
 		stopApplication()
 		return
+	case "n", "<Tab>", "<Right>":
+		tc.changeView(tc.consoleRender.SelectNextNode, numMillisecondsRefreshTime)
+	case "p", "<Left>":
+		tc.changeView(tc.consoleRender.SelectPreviousNode, numMillisecondsRefreshTime)
+	case "s":
+		tc.changeView(tc.consoleRender.ToggleSummary, numMillisecondsRefreshTime)
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		index := int(e.ID[0] - '1')
+		tc.changeView(func() { tc.consoleRender.SelectNode(index) }, numMillisecondsRefreshTime)
 	}
 }
 
+func (tc *TermuiConsole) changeView(handler func(), numMillisecondsRefreshTime int) {
+	tc.mutRefresh.Lock()
+	handler()
+	tc.mutRefresh.Unlock()
+
+	tc.refreshWindow(numMillisecondsRefreshTime)
+}
+
 func (tc *TermuiConsole) doChanges(counter *uint32, numMillisecondsRefreshTime int) {
 	atomic.AddUint32(counter, 1)
 	if atomic.LoadUint32(counter) > numOfTicksBeforeRedrawing {
@@ -140,5 +180,5 @@ func (tc *TermuiConsole) refreshWindow(numMillisecondsRefreshTime int) {
 
 	tc.consoleRender.RefreshData(numMillisecondsRefreshTime)
 	ui.Clear()
-	ui.Render(tc.grid.TopLeft(), tc.grid.TopRight(), tc.grid.Bottom())
+	ui.Render(tc.grid.Items()...)
 }
@@ -8,13 +8,14 @@ const topHeight = 22
 
 // DrawableContainer defines a container of drawable object with position and dimensions
 type DrawableContainer struct {
-	topLeft   termui.Drawable
-	topRight  termui.Drawable
-	bottom    termui.Drawable
-	minHeight int
-	minWidth  int
-	maxWidth  int
-	maxHeight int
+	topLeft    termui.Drawable
+	topRight   termui.Drawable
+	bottom     termui.Drawable
+	fullScreen termui.Drawable
+	minHeight  int
+	minWidth   int
+	maxWidth   int
+	maxHeight  int
 }
 
 //NewDrawableContainer method is used to return a new NewDrawableContainer structure
@@ -53,8 +54,19 @@ func (imh *DrawableContainer) SetBottom(drawable termui.Drawable) {
 	imh.bottom = drawable
 }
 
+// SetFullScreen sets a drawable which covers the whole container, hiding the other drawables. Providing nil
+// restores the other drawables
+func (imh *DrawableContainer) SetFullScreen(drawable termui.Drawable) {
+	imh.fullScreen = drawable
+	imh.SetRectangle(imh.minWidth, imh.minHeight, imh.maxWidth, imh.maxHeight)
+}
+
 // Items returns the containing items list
 func (imh *DrawableContainer) Items() []termui.Drawable {
+	if imh.fullScreen != nil {
+		return []termui.Drawable{imh.fullScreen}
+	}
+
 	items := make([]termui.Drawable, 0)
 	items = append(items, imh.topLeft)
 	items = append(items, imh.topRight)
@@ -69,6 +81,10 @@ func (imh *DrawableContainer) SetRectangle(startWidth, startHeight, termWidth, t
 	imh.minWidth = startWidth
 	imh.minHeight = startHeight
 
+	if imh.fullScreen != nil {
+		imh.fullScreen.SetRect(startWidth, startHeight, imh.maxWidth, imh.maxHeight)
+	}
+
 	if imh.topLeft != nil {
 		imh.topLeft.SetRect(startWidth, startHeight, imh.maxWidth/2, topHeight)
 	}
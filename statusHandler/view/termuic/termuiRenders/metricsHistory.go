@@ -0,0 +1,100 @@
+package termuiRenders
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
+)
+
+// maxHistorySamples is the number of samples kept for each metric, enough to fill the width of a wide terminal
+const maxHistorySamples = 300
+
+// NodePresenter associates a node's presenter with the name under which the node is displayed
+type NodePresenter struct {
+	Name      string
+	Presenter view.Presenter
+}
+
+// Thresholds holds the limits outside which a node is highlighted as lagging
+type Thresholds struct {
+	MaxNonceLag       uint64
+	MinConnectedPeers uint64
+}
+
+// DefaultThresholds are the thresholds used when none are provided
+var DefaultThresholds = Thresholds{
+	MaxNonceLag:       5,
+	MinConnectedPeers: 3,
+}
+
+// metricsHistory keeps a rolling history of the values of a node's metrics, one sample being recorded on each
+// refresh of the console
+type metricsHistory struct {
+	blocks []float64
+	tps    []float64
+	peers  []float64
+	cpu    []float64
+
+	lastNonce          uint64
+	lastNumTxProcessed uint64
+	lastSampleTime     time.Time
+}
+
+func newMetricsHistory() *metricsHistory {
+	return &metricsHistory{}
+}
+
+func (mh *metricsHistory) record(presenter view.Presenter, now time.Time) {
+	nonce := presenter.GetNonce()
+	numTxProcessed := presenter.GetNumTxProcessed()
+
+	isFirstSample := mh.lastSampleTime.IsZero()
+	if !isFirstSample {
+		mh.blocks = appendSample(mh.blocks, float64(subtractOrZero(nonce, mh.lastNonce)))
+
+		tps := float64(0)
+		elapsedSeconds := now.Sub(mh.lastSampleTime).Seconds()
+		if elapsedSeconds > 0 {
+			tps = float64(subtractOrZero(numTxProcessed, mh.lastNumTxProcessed)) / elapsedSeconds
+		}
+		mh.tps = appendSample(mh.tps, tps)
+	}
+
+	mh.peers = appendSample(mh.peers, float64(presenter.GetNumConnectedPeers()))
+	mh.cpu = appendSample(mh.cpu, float64(presenter.GetCpuLoadPercent()))
+
+	mh.lastNonce = nonce
+	mh.lastNumTxProcessed = numTxProcessed
+	mh.lastSampleTime = now
+}
+
+func appendSample(samples []float64, value float64) []float64 {
+	samples = append(samples, value)
+	if len(samples) > maxHistorySamples {
+		samples = samples[len(samples)-maxHistorySamples:]
+	}
+
+	return samples
+}
+
+// lastSamples returns at most maxSamples of the most recent samples, as the sparklines draw from left to right and
+// cut what does not fit
+func lastSamples(samples []float64, maxSamples int) []float64 {
+	if maxSamples <= 0 {
+		return []float64{}
+	}
+	if len(samples) > maxSamples {
+		return samples[len(samples)-maxSamples:]
+	}
+
+	return samples
+}
+
+// subtractOrZero returns a - b or 0 if b is greater, as it happens when a node restarts
+func subtractOrZero(a uint64, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+
+	return a - b
+}
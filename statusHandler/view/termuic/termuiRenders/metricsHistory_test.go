@@ -0,0 +1,94 @@
+package termuiRenders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/presenter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHistory_Record(t *testing.T) {
+	t.Parallel()
+
+	psh := presenter.NewPresenterStatusHandler()
+	psh.SetUInt64Value(core.MetricNonce, 10)
+	psh.SetUInt64Value(core.MetricNumProcessedTxs, 100)
+	psh.SetUInt64Value(core.MetricNumConnectedPeers, 7)
+	psh.SetUInt64Value(core.MetricCpuLoadPercent, 40)
+
+	mh := newMetricsHistory()
+	start := time.Unix(1000, 0)
+	mh.record(psh, start)
+	assert.Equal(t, 0, len(mh.blocks))
+	assert.Equal(t, 0, len(mh.tps))
+	assert.Equal(t, []float64{7}, mh.peers)
+	assert.Equal(t, []float64{40}, mh.cpu)
+
+	psh.SetUInt64Value(core.MetricNonce, 12)
+	psh.SetUInt64Value(core.MetricNumProcessedTxs, 300)
+	mh.record(psh, start.Add(time.Second*2))
+	assert.Equal(t, []float64{2}, mh.blocks)
+	assert.Equal(t, []float64{100}, mh.tps)
+
+	// node restarted, the counters were reset
+	psh.SetUInt64Value(core.MetricNonce, 5)
+	psh.SetUInt64Value(core.MetricNumProcessedTxs, 0)
+	mh.record(psh, start.Add(time.Second*4))
+	assert.Equal(t, []float64{2, 0}, mh.blocks)
+	assert.Equal(t, []float64{100, 0}, mh.tps)
+}
+
+func TestMetricsHistory_ShouldKeepLimitedSamples(t *testing.T) {
+	t.Parallel()
+
+	samples := make([]float64, 0)
+	for i := 0; i < maxHistorySamples+10; i++ {
+		samples = appendSample(samples, float64(i))
+	}
+
+	assert.Equal(t, maxHistorySamples, len(samples))
+	assert.Equal(t, float64(10), samples[0])
+	assert.Equal(t, []float64{float64(maxHistorySamples + 8), float64(maxHistorySamples + 9)}, lastSamples(samples, 2))
+	assert.Equal(t, 0, len(lastSamples(samples, 0)))
+}
+
+func TestComputeNodeHealth(t *testing.T) {
+	t.Parallel()
+
+	thresholds := Thresholds{MaxNonceLag: 5, MinConnectedPeers: 3}
+	psh := presenter.NewPresenterStatusHandler()
+	psh.SetUInt64Value(core.MetricNonce, 100)
+	psh.SetUInt64Value(core.MetricProbableHighestNonce, 102)
+	psh.SetUInt64Value(core.MetricNumConnectedPeers, 10)
+
+	health := computeNodeHealth(psh, 100, thresholds)
+	assert.True(t, health.isHealthy())
+	assert.Equal(t, uint64(2), health.lag)
+
+	health = computeNodeHealth(psh, 110, thresholds)
+	assert.Equal(t, []string{statusLagging}, health.problems)
+	assert.Equal(t, uint64(10), health.lag)
+
+	psh.SetUInt64Value(core.MetricNumConnectedPeers, 1)
+	health = computeNodeHealth(psh, 100, thresholds)
+	assert.Equal(t, []string{statusFewPeers}, health.problems)
+}
+
+func TestComputeMaxNonceByShard(t *testing.T) {
+	t.Parallel()
+
+	createNode := func(shardId uint64, nonce uint64) NodePresenter {
+		psh := presenter.NewPresenterStatusHandler()
+		psh.SetUInt64Value(core.MetricShardId, shardId)
+		psh.SetUInt64Value(core.MetricNonce, nonce)
+
+		return NodePresenter{Presenter: psh}
+	}
+
+	nodes := []NodePresenter{createNode(0, 10), createNode(0, 15), createNode(1, 7)}
+	maxNonceByShard := computeMaxNonceByShard(nodes)
+
+	assert.Equal(t, map[uint64]uint64{0: 15, 1: 7}, maxNonceByShard)
+}
@@ -0,0 +1,124 @@
+package termuiRenders
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
+	ui "github.com/gizak/termui/v3"
+)
+
+const (
+	statusLagging  = "lagging"
+	statusFewPeers = "few peers"
+)
+
+var summaryHeader = []string{"#", "Node", "Shard", "Nonce / Highest", "Lag", "Peers", "CPU", "TPS", "Status"}
+
+// nodeHealth holds the outcome of comparing a node's metrics with the thresholds
+type nodeHealth struct {
+	lag      uint64
+	problems []string
+}
+
+func (nh *nodeHealth) isHealthy() bool {
+	return len(nh.problems) == 0
+}
+
+// computeNodeHealth compares the node's nonce both with the highest nonce the node saw on the network and with the
+// highest nonce reported by the watched nodes of the same shard, as a stuck node can also believe it is synchronized
+func computeNodeHealth(presenter view.Presenter, maxNonceInShard uint64, thresholds Thresholds) *nodeHealth {
+	nonce := presenter.GetNonce()
+	lag := core.MaxUint64(
+		subtractOrZero(presenter.GetProbableHighestNonce(), nonce),
+		subtractOrZero(maxNonceInShard, nonce),
+	)
+
+	health := &nodeHealth{
+		lag:      lag,
+		problems: make([]string, 0),
+	}
+	if lag > thresholds.MaxNonceLag {
+		health.problems = append(health.problems, statusLagging)
+	}
+	if presenter.GetNumConnectedPeers() < thresholds.MinConnectedPeers {
+		health.problems = append(health.problems, statusFewPeers)
+	}
+
+	return health
+}
+
+func computeMaxNonceByShard(nodes []NodePresenter) map[uint64]uint64 {
+	maxNonceByShard := make(map[uint64]uint64)
+	for _, node := range nodes {
+		shardId := node.Presenter.GetShardId()
+		maxNonceByShard[shardId] = core.MaxUint64(maxNonceByShard[shardId], node.Presenter.GetNonce())
+	}
+
+	return maxNonceByShard
+}
+
+func shardIdToString(shardId uint64) string {
+	if shardId == uint64(core.MetachainShardId) {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardId)
+}
+
+func (wr *WidgetsRender) prepareSummary() {
+	maxNonceByShard := computeMaxNonceByShard(wr.nodes)
+
+	rows := make([][]string, 0, len(wr.nodes)+1)
+	rows = append(rows, summaryHeader)
+	wr.summary.RowStyles = make(map[int]ui.Style)
+	wr.summary.RowStyles[0] = ui.NewStyle(ui.ColorWhite, ui.ColorClear, ui.ModifierBold)
+
+	for i, node := range wr.nodes {
+		presenter := node.Presenter
+		shardId := presenter.GetShardId()
+		health := computeNodeHealth(presenter, maxNonceByShard[shardId], wr.thresholds)
+
+		status := statusSynchronized
+		if presenter.GetIsSyncing() == 1 {
+			status = statusSyncing
+		}
+		if !health.isHealthy() {
+			status = strings.Join(health.problems, ", ")
+		}
+
+		tps := float64(0)
+		tpsHistory := wr.histories[i].tps
+		if len(tpsHistory) > 0 {
+			tps = tpsHistory[len(tpsHistory)-1]
+		}
+
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", i+1),
+			node.Name,
+			shardIdToString(shardId),
+			fmt.Sprintf("%d / %d", presenter.GetNonce(), presenter.GetProbableHighestNonce()),
+			fmt.Sprintf("%d", health.lag),
+			fmt.Sprintf("%d", presenter.GetNumConnectedPeers()),
+			fmt.Sprintf("%d%%", presenter.GetCpuLoadPercent()),
+			fmt.Sprintf("%.1f", tps),
+			status,
+		})
+
+		rowIndex := len(rows) - 1
+		switch {
+		case !health.isHealthy():
+			wr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorRed, ui.ColorClear, ui.ModifierBold)
+		case i == wr.selected:
+			wr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorYellow)
+		default:
+			wr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorGreen)
+		}
+	}
+
+	wr.summary.Title = fmt.Sprintf("Nodes summary (max nonce lag: %d, min peers: %d) - 1-9/n/p: select node, s: back to node view",
+		wr.thresholds.MaxNonceLag, wr.thresholds.MinConnectedPeers)
+	wr.summary.RowSeparator = false
+	wr.summary.Rows = rows
+}
@@ -3,6 +3,7 @@ package termuiRenders
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -31,21 +32,56 @@ type WidgetsRender struct {
 
 	networkBytesInEpoch *widgets.Gauge
 
-	presenter view.Presenter
+	history         *widgets.SparklineGroup
+	blocksSparkline *widgets.Sparkline
+	tpsSparkline    *widgets.Sparkline
+	peersSparkline  *widgets.Sparkline
+	cpuSparkline    *widgets.Sparkline
+	summary         *widgets.Table
+
+	presenter   view.Presenter
+	nodes       []NodePresenter
+	histories   []*metricsHistory
+	thresholds  Thresholds
+	selected    int
+	showSummary bool
+
+	lastHistoryRecord time.Time
 }
 
 //NewWidgetsRender method will create new WidgetsRender that display termui console
 func NewWidgetsRender(presenter view.Presenter, grid *DrawableContainer) (*WidgetsRender, error) {
-	if presenter == nil || presenter.IsInterfaceNil() {
+	nodes := []NodePresenter{{Presenter: presenter}}
+
+	return NewMultiNodeWidgetsRender(nodes, DefaultThresholds, grid)
+}
+
+// NewMultiNodeWidgetsRender creates a WidgetsRender able to display the details of each of the provided nodes, one
+// at a time, and a summary table of all of them
+func NewMultiNodeWidgetsRender(nodes []NodePresenter, thresholds Thresholds, grid *DrawableContainer) (*WidgetsRender, error) {
+	if len(nodes) == 0 {
 		return nil, statusHandler.ErrNilPresenterInterface
 	}
+	for _, node := range nodes {
+		if node.Presenter == nil || node.Presenter.IsInterfaceNil() {
+			return nil, statusHandler.ErrNilPresenterInterface
+		}
+	}
 	if grid == nil {
 		return nil, statusHandler.ErrNilGrid
 	}
 
+	histories := make([]*metricsHistory, len(nodes))
+	for i := range histories {
+		histories[i] = newMetricsHistory()
+	}
+
 	self := &WidgetsRender{
-		presenter: presenter,
-		container: grid,
+		presenter:  nodes[0].Presenter,
+		nodes:      nodes,
+		histories:  histories,
+		thresholds: thresholds,
+		container:  grid,
 	}
 	self.initWidgets()
 	self.setGrid()
@@ -53,6 +89,42 @@ func NewWidgetsRender(presenter view.Presenter, grid *DrawableContainer) (*Widge
 	return self, nil
 }
 
+// SelectNode displays the details of the node with the provided index, switching back from the summary view
+func (wr *WidgetsRender) SelectNode(index int) {
+	if index < 0 || index >= len(wr.nodes) {
+		return
+	}
+
+	wr.selected = index
+	wr.presenter = wr.nodes[index].Presenter
+	wr.setSummaryVisible(false)
+}
+
+// SelectNextNode displays the details of the next node
+func (wr *WidgetsRender) SelectNextNode() {
+	wr.SelectNode((wr.selected + 1) % len(wr.nodes))
+}
+
+// SelectPreviousNode displays the details of the previous node
+func (wr *WidgetsRender) SelectPreviousNode() {
+	wr.SelectNode((wr.selected + len(wr.nodes) - 1) % len(wr.nodes))
+}
+
+// ToggleSummary switches between the details of the selected node and the summary table of all nodes
+func (wr *WidgetsRender) ToggleSummary() {
+	wr.setSummaryVisible(!wr.showSummary)
+}
+
+func (wr *WidgetsRender) setSummaryVisible(visible bool) {
+	wr.showSummary = visible
+	if visible {
+		wr.container.SetFullScreen(wr.summary)
+		return
+	}
+
+	wr.container.SetFullScreen(nil)
+}
+
 func (wr *WidgetsRender) initWidgets() {
 	wr.instanceInfo = widgets.NewTable()
 	wr.instanceInfo.Rows = [][]string{{""}}
@@ -71,6 +143,20 @@ func (wr *WidgetsRender) initWidgets() {
 	wr.networkBytesInEpoch = widgets.NewGauge()
 
 	wr.lLog = widgets.NewList()
+
+	wr.blocksSparkline = widgets.NewSparkline()
+	wr.blocksSparkline.LineColor = ui.ColorGreen
+	wr.tpsSparkline = widgets.NewSparkline()
+	wr.tpsSparkline.LineColor = ui.ColorCyan
+	wr.peersSparkline = widgets.NewSparkline()
+	wr.peersSparkline.LineColor = ui.ColorYellow
+	wr.cpuSparkline = widgets.NewSparkline()
+	wr.cpuSparkline.LineColor = ui.ColorMagenta
+	wr.history = widgets.NewSparklineGroup(wr.blocksSparkline, wr.tpsSparkline, wr.peersSparkline, wr.cpuSparkline)
+	wr.history.Title = "History"
+
+	wr.summary = widgets.NewTable()
+	wr.summary.Rows = [][]string{summaryHeader}
 }
 
 func (wr *WidgetsRender) setGrid() {
@@ -96,7 +182,10 @@ func (wr *WidgetsRender) setGrid() {
 	)
 
 	gridBottom := ui.NewGrid()
-	gridBottom.Set(ui.NewRow(1.0, wr.lLog))
+	gridBottom.Set(ui.NewRow(1.0,
+		ui.NewCol(2.0/3, wr.lLog),
+		ui.NewCol(1.0/3, wr.history),
+	))
 
 	wr.container.SetTopLeft(gridLeft)
 	wr.container.SetTopRight(gridRight)
@@ -105,11 +194,19 @@ func (wr *WidgetsRender) setGrid() {
 
 //RefreshData method is used to prepare data that are displayed on container
 func (wr *WidgetsRender) RefreshData(numMillisecondsRefreshTime int) {
+	wr.recordHistory(numMillisecondsRefreshTime)
+
+	if wr.showSummary {
+		wr.prepareSummary()
+		return
+	}
+
 	wr.prepareInstanceInfo()
 	wr.prepareChainInfo(numMillisecondsRefreshTime)
 	wr.prepareBlockInfo()
 	wr.prepareListWithLogsForDisplay()
 	wr.prepareLoads()
+	wr.prepareHistory()
 }
 
 func (wr *WidgetsRender) prepareInstanceInfo() {
@@ -185,10 +282,21 @@ func (wr *WidgetsRender) prepareInstanceInfo() {
 	//	rows[6] = []string{""}
 	//}
 
-	rows[5] = []string{""}
+	activeAlerts := wr.presenter.GetActiveAlerts()
+	if len(activeAlerts) > 0 {
+		wr.instanceInfo.RowStyles[5] = ui.NewStyle(ui.ColorRed, ui.ColorClear, ui.ModifierBold)
+		rows[5] = []string{fmt.Sprintf("Active alerts: %s", strings.Replace(activeAlerts, ",", ", ", -1))}
+	} else {
+		wr.instanceInfo.RowStyles[5] = ui.NewStyle(ui.ColorWhite)
+		rows[5] = []string{""}
+	}
 	rows[6] = []string{""}
 
 	wr.instanceInfo.Title = "Elrond instance info"
+	if len(wr.nodes) > 1 {
+		wr.instanceInfo.Title = fmt.Sprintf("Elrond instance info - node %d/%d: %s (n/p: switch node, s: summary)",
+			wr.selected+1, len(wr.nodes), wr.nodes[wr.selected].Name)
+	}
 	wr.instanceInfo.RowSeparator = false
 	wr.instanceInfo.Rows = rows
 }
@@ -245,6 +353,14 @@ func (wr *WidgetsRender) prepareChainInfo(numMillisecondsRefreshTime int) {
 	probableHighestNonce := wr.presenter.GetProbableHighestNonce()
 	rows[5] = []string{fmt.Sprintf("Current synchronized block nonce: %d / %d",
 		nonce, probableHighestNonce)}
+	maxNonceInShard := computeMaxNonceByShard(wr.nodes)[shardId]
+	health := computeNodeHealth(wr.presenter, maxNonceInShard, wr.thresholds)
+	if health.lag > wr.thresholds.MaxNonceLag {
+		wr.chainInfo.RowStyles[5] = ui.NewStyle(ui.ColorRed, ui.ColorClear, ui.ModifierBold)
+		rows[5][0] += fmt.Sprintf(" (%d blocks behind)", health.lag)
+	} else {
+		wr.chainInfo.RowStyles[5] = ui.NewStyle(ui.ColorWhite)
+	}
 
 	rows[6] = []string{fmt.Sprintf("Current consensus round: %d / %d",
 		synchronizedRound, currentRound)}
@@ -338,6 +454,62 @@ func (wr *WidgetsRender) prepareLogLines(logData []string, size int) []string {
 	return logData
 }
 
+// recordHistory samples the metrics of all nodes, at most once per refresh interval as the data is also
+// refreshed on resize and on key presses
+func (wr *WidgetsRender) recordHistory(numMillisecondsRefreshTime int) {
+	now := time.Now()
+	refreshInterval := time.Duration(numMillisecondsRefreshTime) * time.Millisecond
+	if now.Sub(wr.lastHistoryRecord) < refreshInterval {
+		return
+	}
+	wr.lastHistoryRecord = now
+
+	for i, node := range wr.nodes {
+		wr.histories[i].record(node.Presenter, now)
+	}
+}
+
+func (wr *WidgetsRender) prepareHistory() {
+	history := wr.histories[wr.selected]
+	maxSamples := wr.history.Inner.Dx()
+
+	wr.blocksSparkline.Title = fmt.Sprintf("Nonce %d (new blocks per refresh)", wr.presenter.GetNonce())
+	setSparklineData(wr.blocksSparkline, lastSamples(history.blocks, maxSamples))
+
+	tps := lastSamples(history.tps, maxSamples)
+	currentTps := float64(0)
+	if len(tps) > 0 {
+		currentTps = tps[len(tps)-1]
+	}
+	wr.tpsSparkline.Title = fmt.Sprintf("TPS %.1f", currentTps)
+	setSparklineData(wr.tpsSparkline, tps)
+
+	numConnectedPeers := wr.presenter.GetNumConnectedPeers()
+	wr.peersSparkline.Title = fmt.Sprintf("Peers %d", numConnectedPeers)
+	wr.peersSparkline.LineColor = ui.ColorYellow
+	if numConnectedPeers < wr.thresholds.MinConnectedPeers {
+		wr.peersSparkline.LineColor = ui.ColorRed
+	}
+	setSparklineData(wr.peersSparkline, lastSamples(history.peers, maxSamples))
+
+	wr.cpuSparkline.Title = fmt.Sprintf("CPU %d%%", wr.presenter.GetCpuLoadPercent())
+	wr.cpuSparkline.MaxVal = 100
+	wr.cpuSparkline.Data = lastSamples(history.cpu, maxSamples)
+}
+
+// setSparklineData sets the data and, for an all-zero series, a maximum value so that the sparkline
+// does not divide by zero
+func setSparklineData(sparkline *widgets.Sparkline, data []float64) {
+	sparkline.Data = data
+	sparkline.MaxVal = 0
+	for _, value := range data {
+		if value > 0 {
+			return
+		}
+	}
+	sparkline.MaxVal = 1
+}
+
 func fitStringToWidth(original string, maxWidth int) string {
 	suffixString := "..."
 	numExtraPadding := 2
@@ -0,0 +1,187 @@
+package compression
+
+import (
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/golang/snappy"
+)
+
+var _ storage.Persister = (*compressedPersister)(nil)
+
+var log = logger.GetOrCreate("storage/compression")
+
+// Type represents the type of the supported value compressions
+type Type string
+
+const (
+	// NoCompression stores the new values as they are while still decoding the values compressed before, allowing the
+	// compression of a storer to be turned off
+	NoCompression Type = "None"
+	// SnappyCompression compresses the new values with snappy
+	SnappyCompression Type = "Snappy"
+)
+
+// a compressed value is written as [compressedValueMarker, codec ID, payload...]. The values written before the
+// compression was enabled are marshalled protobuf structures which never start with a 0 byte, as the 0 field number
+// is reserved, so they are read back as they are
+const (
+	compressedValueMarker = byte(0)
+	headerLength          = 2
+
+	// uncompressedCodecID frames the values which start with the marker but were not worth compressing
+	uncompressedCodecID = byte(0)
+	snappyCodecID       = byte(1)
+)
+
+type valueCodec struct {
+	id               byte
+	maxCompressedLen func(valLen int) int
+	compress         func(dst []byte, val []byte) []byte
+	decompress       func(payload []byte) ([]byte, error)
+}
+
+var snappyCodec = &valueCodec{
+	id:               snappyCodecID,
+	maxCompressedLen: snappy.MaxEncodedLen,
+	compress: func(dst []byte, val []byte) []byte {
+		return snappy.Encode(dst, val)
+	},
+	decompress: func(payload []byte) ([]byte, error) {
+		return snappy.Decode(nil, payload)
+	},
+}
+
+var decoders = map[byte]*valueCodec{
+	snappyCodecID: snappyCodec,
+}
+
+type compressedPersister struct {
+	storage.Persister
+	codec *valueCodec
+}
+
+// NewCompressedPersister wraps the provided persister so that the values are transparently compressed when written
+// and decompressed when read. The values written before the compression was enabled remain readable
+func NewCompressedPersister(persister storage.Persister, compressionType Type) (*compressedPersister, error) {
+	if check.IfNil(persister) {
+		return nil, storage.ErrNilPersister
+	}
+
+	var codec *valueCodec
+	switch compressionType {
+	case NoCompression:
+	case SnappyCompression:
+		codec = snappyCodec
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrNotSupportedCompressionType, compressionType)
+	}
+
+	return &compressedPersister{
+		Persister: persister,
+		codec:     codec,
+	}, nil
+}
+
+// WrapPersister returns the provided persister wrapped in a compressed persister, or the persister itself if no
+// compression type is set
+func WrapPersister(persister storage.Persister, compressionType Type) (storage.Persister, error) {
+	if len(compressionType) == 0 {
+		return persister, nil
+	}
+
+	return NewCompressedPersister(persister, compressionType)
+}
+
+// Put compresses the value and adds it to the wrapped persister
+func (cp *compressedPersister) Put(key, val []byte) error {
+	return cp.Persister.Put(key, cp.encode(val))
+}
+
+// Get gets the value associated to the key from the wrapped persister and decompresses it
+func (cp *compressedPersister) Get(key []byte) ([]byte, error) {
+	val, err := cp.Persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode(val)
+}
+
+// RangeKeys iterates over the wrapped persister, the handler receiving the decompressed values
+func (cp *compressedPersister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	cp.Persister.RangeKeys(func(key []byte, val []byte) bool {
+		decoded, err := decode(val)
+		if err != nil {
+			log.Warn("compressedPersister.RangeKeys: skipped value", "key", key, "error", err)
+			return true
+		}
+
+		return handler(key, decoded)
+	})
+}
+
+func (cp *compressedPersister) encode(val []byte) []byte {
+	if cp.codec != nil {
+		buff := make([]byte, headerLength+cp.codec.maxCompressedLen(len(val)))
+		payload := cp.codec.compress(buff[headerLength:], val)
+		if headerLength+len(payload) < len(val) {
+			buff[0] = compressedValueMarker
+			buff[1] = cp.codec.id
+			return buff[:headerLength+len(payload)]
+		}
+	}
+
+	if !hasMarker(val) {
+		return val
+	}
+
+	framed := make([]byte, headerLength+len(val))
+	framed[0] = compressedValueMarker
+	framed[1] = uncompressedCodecID
+	copy(framed[headerLength:], val)
+
+	return framed
+}
+
+func decode(val []byte) ([]byte, error) {
+	if !hasMarker(val) {
+		return val, nil
+	}
+	if len(val) < headerLength {
+		return nil, storage.ErrInvalidCompressedValue
+	}
+
+	codecID := val[1]
+	payload := val[headerLength:]
+	if codecID == uncompressedCodecID {
+		return payload, nil
+	}
+
+	codec, ok := decoders[codecID]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown codec %d", storage.ErrInvalidCompressedValue, codecID)
+	}
+
+	decompressed, err := codec.decompress(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", storage.ErrInvalidCompressedValue, err.Error())
+	}
+
+	return decompressed, nil
+}
+
+func hasMarker(val []byte) bool {
+	return len(val) > 0 && val[0] == compressedValueMarker
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *compressedPersister) IsInterfaceNil() bool {
+	return cp == nil
+}
@@ -0,0 +1,278 @@
+package compression
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var compressibleValue = bytes.Repeat([]byte("ESDTTransfer@"), 20)
+
+func TestNewCompressedPersister(t *testing.T) {
+	t.Parallel()
+
+	cp, err := NewCompressedPersister(nil, SnappyCompression)
+	assert.True(t, check.IfNil(cp))
+	assert.Equal(t, storage.ErrNilPersister, err)
+
+	cp, err = NewCompressedPersister(memorydb.New(), "Zstd")
+	assert.True(t, check.IfNil(cp))
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+
+	cp, err = NewCompressedPersister(memorydb.New(), SnappyCompression)
+	assert.False(t, check.IfNil(cp))
+	assert.Nil(t, err)
+}
+
+func TestWrapPersister(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	persister, err := WrapPersister(db, "")
+	assert.Nil(t, err)
+	assert.True(t, persister == db)
+
+	persister, err = WrapPersister(db, NoCompression)
+	assert.Nil(t, err)
+	_, ok := persister.(*compressedPersister)
+	assert.True(t, ok)
+}
+
+func TestCompressedPersister_PutShouldCompressOnlyWhenWorthIt(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cp, _ := NewCompressedPersister(db, SnappyCompression)
+
+	_ = cp.Put([]byte("compressible"), compressibleValue)
+	stored, _ := db.Get([]byte("compressible"))
+	assert.True(t, len(stored) < len(compressibleValue))
+	assert.Equal(t, []byte{compressedValueMarker, snappyCodecID}, stored[:headerLength])
+
+	incompressibleValue := []byte{10, 3, 1, 2, 3}
+	_ = cp.Put([]byte("incompressible"), incompressibleValue)
+	stored, _ = db.Get([]byte("incompressible"))
+	assert.Equal(t, incompressibleValue, stored)
+
+	valueWithMarker := []byte{compressedValueMarker, 7}
+	_ = cp.Put([]byte("marker"), valueWithMarker)
+	stored, _ = db.Get([]byte("marker"))
+	assert.Equal(t, []byte{compressedValueMarker, uncompressedCodecID, compressedValueMarker, 7}, stored)
+
+	for key, expected := range map[string][]byte{
+		"compressible":   compressibleValue,
+		"incompressible": incompressibleValue,
+		"marker":         valueWithMarker,
+	} {
+		recovered, err := cp.Get([]byte(key))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, recovered)
+	}
+}
+
+func TestCompressedPersister_OldAndNewValuesShouldCoexist(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_ = db.Put([]byte("old"), compressibleValue)
+
+	cp, _ := NewCompressedPersister(db, SnappyCompression)
+	_ = cp.Put([]byte("new"), compressibleValue)
+
+	recovered, err := cp.Get([]byte("old"))
+	assert.Nil(t, err)
+	assert.Equal(t, compressibleValue, recovered)
+	recovered, err = cp.Get([]byte("new"))
+	assert.Nil(t, err)
+	assert.Equal(t, compressibleValue, recovered)
+
+	cp, _ = NewCompressedPersister(db, NoCompression)
+	_ = cp.Put([]byte("uncompressed"), compressibleValue)
+	stored, _ := db.Get([]byte("uncompressed"))
+	assert.Equal(t, compressibleValue, stored)
+	recovered, err = cp.Get([]byte("new"))
+	assert.Nil(t, err)
+	assert.Equal(t, compressibleValue, recovered)
+}
+
+func TestCompressedPersister_GetInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cp, _ := NewCompressedPersister(db, SnappyCompression)
+
+	_, err := cp.Get([]byte("missing"))
+	assert.NotNil(t, err)
+
+	_ = db.Put([]byte("truncated"), []byte{compressedValueMarker})
+	_, err = cp.Get([]byte("truncated"))
+	assert.True(t, errors.Is(err, storage.ErrInvalidCompressedValue))
+
+	_ = db.Put([]byte("unknown codec"), []byte{compressedValueMarker, 200, 1})
+	_, err = cp.Get([]byte("unknown codec"))
+	assert.True(t, errors.Is(err, storage.ErrInvalidCompressedValue))
+
+	_ = db.Put([]byte("corrupted"), []byte{compressedValueMarker, snappyCodecID, 255, 255})
+	_, err = cp.Get([]byte("corrupted"))
+	assert.True(t, errors.Is(err, storage.ErrInvalidCompressedValue))
+}
+
+func TestCompressedPersister_RangeKeysShouldDecompressAndSkipInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cp, _ := NewCompressedPersister(db, SnappyCompression)
+	_ = cp.Put([]byte("compressed"), compressibleValue)
+	_ = db.Put([]byte("old"), []byte("old value"))
+	_ = db.Put([]byte("corrupted"), []byte{compressedValueMarker})
+
+	cp.RangeKeys(nil)
+
+	recovered := make(map[string][]byte)
+	cp.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, map[string][]byte{
+		"compressed": compressibleValue,
+		"old":        []byte("old value"),
+	}, recovered)
+}
+
+func createMarshalledTransactions(b *testing.B, numTxs int, dataLen int) [][]byte {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	random := rand.New(rand.NewSource(0))
+	randomBytes := func(size int) []byte {
+		buff := make([]byte, size)
+		_, _ = random.Read(buff)
+		return buff
+	}
+
+	senders := make([][]byte, 100)
+	for i := range senders {
+		senders[i] = randomBytes(32)
+	}
+
+	txs := make([][]byte, numTxs)
+	for i := range txs {
+		txData := []byte("MultiESDTNFTTransfer@" + hex.EncodeToString(senders[random.Intn(len(senders))]))
+		for len(txData) < dataLen {
+			txData = append(txData, "@"+hex.EncodeToString([]byte("TOKEN-a1b2c3"))+"@00@"+hex.EncodeToString(randomBytes(2))...)
+		}
+
+		tx := &transaction.Transaction{
+			Nonce:     uint64(i),
+			Value:     big.NewInt(random.Int63()),
+			RcvAddr:   senders[random.Intn(len(senders))],
+			SndAddr:   senders[random.Intn(len(senders))],
+			GasPrice:  1000000000,
+			GasLimit:  500000,
+			Data:      txData[:dataLen],
+			ChainID:   []byte("1"),
+			Version:   1,
+			Signature: randomBytes(64),
+		}
+
+		buff, err := marshalizer.Marshal(tx)
+		require.Nil(b, err)
+		txs[i] = buff
+	}
+
+	return txs
+}
+
+func benchmarkPut(b *testing.B, compressionType Type, dataLen int) {
+	txs := createMarshalledTransactions(b, 1000, dataLen)
+	db := memorydb.New()
+	persister, _ := WrapPersister(db, compressionType)
+
+	originalSize, storedSize := 0, 0
+	for i, tx := range txs {
+		_ = persister.Put([]byte(fmt.Sprintf("%d", i)), tx)
+		stored, _ := db.Get([]byte(fmt.Sprintf("%d", i)))
+		originalSize += len(tx)
+		storedSize += len(stored)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = persister.Put([]byte(fmt.Sprintf("%d", i%len(txs))), txs[i%len(txs)])
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(storedSize)/float64(originalSize), "size-ratio")
+}
+
+func benchmarkGet(b *testing.B, compressionType Type, dataLen int) {
+	txs := createMarshalledTransactions(b, 1000, dataLen)
+	persister, _ := WrapPersister(memorydb.New(), compressionType)
+	for i, tx := range txs {
+		_ = persister.Put([]byte(fmt.Sprintf("%d", i)), tx)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = persister.Get([]byte(fmt.Sprintf("%d", i%len(txs))))
+	}
+}
+
+func BenchmarkCompressedPersister_PutTransferTxs(b *testing.B) {
+	b.Run("uncompressed", func(b *testing.B) { benchmarkPut(b, "", 0) })
+	b.Run("snappy", func(b *testing.B) { benchmarkPut(b, SnappyCompression, 0) })
+}
+
+func BenchmarkCompressedPersister_PutSmartContractCallTxs(b *testing.B) {
+	b.Run("uncompressed", func(b *testing.B) { benchmarkPut(b, "", 512) })
+	b.Run("snappy", func(b *testing.B) { benchmarkPut(b, SnappyCompression, 512) })
+}
+
+func BenchmarkCompressedPersister_GetSmartContractCallTxs(b *testing.B) {
+	b.Run("uncompressed", func(b *testing.B) { benchmarkGet(b, "", 512) })
+	b.Run("snappy", func(b *testing.B) { benchmarkGet(b, SnappyCompression, 512) })
+}
+
+// BenchmarkCompressedPersister_LevelDBSizeOnDisk reports the size on disk of a leveldb database storing the same
+// transactions, as leveldb already compresses its blocks with snappy
+func BenchmarkCompressedPersister_LevelDBSizeOnDisk(b *testing.B) {
+	txs := createMarshalledTransactions(b, 20000, 512)
+
+	for _, compressionType := range []Type{"", SnappyCompression} {
+		b.Run(fmt.Sprintf("compression=%q", compressionType), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dir, err := ioutil.TempDir("", "compressedPersister")
+				require.Nil(b, err)
+
+				db, err := leveldb.NewSerialDB(dir, 2, 1000, 10)
+				require.Nil(b, err)
+				persister, _ := WrapPersister(db, compressionType)
+				for j, tx := range txs {
+					_ = persister.Put([]byte(fmt.Sprintf("%d", j)), tx)
+				}
+				_ = persister.Close()
+
+				size, err := core.GetDirectorySize(dir)
+				require.Nil(b, err)
+				b.ReportMetric(float64(size), "bytes-on-disk")
+
+				_ = os.RemoveAll(dir)
+			}
+		})
+	}
+}
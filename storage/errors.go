@@ -124,3 +124,14 @@ var ErrNilTimeCache = errors.New("nil time cache")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler")
 
+// ErrNilRangeKeysHandler signals that a nil handler was provided for iterating over the stored keys
+var ErrNilRangeKeysHandler = errors.New("nil range keys handler")
+
+// ErrInvalidEpochsRange signals that an epochs range ending before its start was provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrNotSupportedCompressionType is raised when an unsupported value compression type is provided
+var ErrNotSupportedCompressionType = errors.New("not supported compression type")
+
+// ErrInvalidCompressedValue is raised when a stored value carries the compression marker but can not be decoded
+var ErrInvalidCompressedValue = errors.New("invalid compressed value")
@@ -2,6 +2,7 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -27,6 +28,7 @@ func GetDBFromConfig(cfg config.DBConfig) storageUnit.DBConfig {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       compression.Type(cfg.Compression),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -16,6 +17,7 @@ type PersisterFactory struct {
 	batchDelaySeconds int
 	maxBatchSize      int
 	maxOpenFiles      int
+	compression       compression.Type
 }
 
 // NewPersisterFactory will return a new instance of a PersisterFactory
@@ -25,6 +27,7 @@ func NewPersisterFactory(config config.DBConfig) *PersisterFactory {
 		batchDelaySeconds: config.BatchDelaySeconds,
 		maxBatchSize:      config.MaxBatchSize,
 		maxOpenFiles:      config.MaxOpenFiles,
+		compression:       compression.Type(config.Compression),
 	}
 }
 
@@ -34,6 +37,21 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return nil, errors.New("invalid file path")
 	}
 
+	db, err := pf.createDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedDB, err := compression.WrapPersister(db, pf.compression)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return wrappedDB, nil
+}
+
+func (pf *PersisterFactory) createDB(path string) (storage.Persister, error) {
 	switch storageUnit.DBType(pf.dbType) {
 	case storageUnit.LvlDB:
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
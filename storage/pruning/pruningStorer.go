@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"runtime/debug"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -886,13 +885,6 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	return nil
 }
 
-// RangeKeys does nothing as it is unable to iterate over multiple persisters
-// RangeKeys -
-func (ps *PruningStorer) RangeKeys(_ func(key []byte, val []byte) bool) {
-	log.Error("improper use of PruningStorer.RangeKeys()")
-	debug.PrintStack()
-}
-
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *PruningStorer) IsInterfaceNil() bool {
 	return ps == nil
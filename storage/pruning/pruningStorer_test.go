@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -887,3 +889,176 @@ func TestDirectories(t *testing.T) {
 
 	_ = os.RemoveAll("user-directory")
 }
+
+type rangedEntry struct {
+	value string
+	epoch uint32
+}
+
+func rangeAllEntries(t *testing.T, ps *pruning.PruningStorer, options pruning.RangeKeysOptions) map[string][]rangedEntry {
+	entries := make(map[string][]rangedEntry)
+	err := ps.RangeKeysInEpochs(options, func(key []byte, val []byte, epoch uint32) bool {
+		entries[string(key)] = append(entries[string(key)], rangedEntry{value: string(val), epoch: epoch})
+		return true
+	})
+	require.Nil(t, err)
+
+	return entries
+}
+
+func createPruningStorerWithEntriesInTwoEpochs(t *testing.T) *pruning.PruningStorer {
+	ps, err := pruning.NewPruningStorer(getDefaultArgs())
+	require.Nil(t, err)
+	require.Nil(t, ps.ChangeEpochSimple(1))
+
+	require.Nil(t, ps.PutInEpoch([]byte("acc_key1"), []byte("value1_epoch0"), 0))
+	require.Nil(t, ps.PutInEpoch([]byte("acc_key2"), []byte("value2_epoch0"), 0))
+	require.Nil(t, ps.PutInEpoch([]byte("acc_key1"), []byte("value1_epoch1"), 1))
+	require.Nil(t, ps.PutInEpoch([]byte("code_key3"), []byte("value3_epoch1"), 1))
+
+	return ps
+}
+
+func TestPruningStorer_RangeKeysShouldReturnTheNewestValues(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+
+	values := make(map[string]string)
+	ps.RangeKeys(func(key []byte, val []byte) bool {
+		_, found := values[string(key)]
+		assert.False(t, found, "key %s reported twice", key)
+		values[string(key)] = string(val)
+		return true
+	})
+
+	expectedValues := map[string]string{
+		"acc_key1":  "value1_epoch1",
+		"acc_key2":  "value2_epoch0",
+		"code_key3": "value3_epoch1",
+	}
+	assert.Equal(t, expectedValues, values)
+}
+
+func TestPruningStorer_RangeKeysNilHandlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+	ps.RangeKeys(nil)
+}
+
+func TestPruningStorer_RangeKeysInEpochsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+
+	err := ps.RangeKeysInEpochs(pruning.RangeKeysOptions{}, nil)
+	assert.Equal(t, storage.ErrNilRangeKeysHandler, err)
+
+	options := pruning.RangeKeysOptions{Epochs: &pruning.EpochsRange{Start: 2, End: 1}}
+	err = ps.RangeKeysInEpochs(options, func(key []byte, val []byte, epoch uint32) bool {
+		return true
+	})
+	assert.True(t, errors.Is(err, storage.ErrInvalidEpochsRange))
+}
+
+func TestPruningStorer_RangeKeysInEpochsAllVersionsShouldReturnEachEpochValue(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+
+	entries := rangeAllEntries(t, ps, pruning.RangeKeysOptions{AllVersions: true})
+
+	expectedEntries := map[string][]rangedEntry{
+		"acc_key1": {
+			{value: "value1_epoch1", epoch: 1},
+			{value: "value1_epoch0", epoch: 0},
+		},
+		"acc_key2":  {{value: "value2_epoch0", epoch: 0}},
+		"code_key3": {{value: "value3_epoch1", epoch: 1}},
+	}
+	assert.Equal(t, expectedEntries, entries)
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldApplyTheFilters(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+
+	entries := rangeAllEntries(t, ps, pruning.RangeKeysOptions{KeyPrefix: []byte("acc_")})
+	expectedEntries := map[string][]rangedEntry{
+		"acc_key1": {{value: "value1_epoch1", epoch: 1}},
+		"acc_key2": {{value: "value2_epoch0", epoch: 0}},
+	}
+	assert.Equal(t, expectedEntries, entries)
+
+	entries = rangeAllEntries(t, ps, pruning.RangeKeysOptions{Epochs: &pruning.EpochsRange{Start: 0, End: 0}})
+	expectedEntries = map[string][]rangedEntry{
+		"acc_key1": {{value: "value1_epoch0", epoch: 0}},
+		"acc_key2": {{value: "value2_epoch0", epoch: 0}},
+	}
+	assert.Equal(t, expectedEntries, entries)
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldStopWhenTheHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	ps := createPruningStorerWithEntriesInTwoEpochs(t)
+
+	numCalls := 0
+	err := ps.RangeKeysInEpochs(pruning.RangeKeysOptions{AllVersions: true}, func(key []byte, val []byte, epoch uint32) bool {
+		numCalls++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestPruningStorer_RangeKeysInEpochsShouldIncludeClosedPersisters(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "rangeKeys")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := getDefaultArgsSerialDB()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(workingDir, fmt.Sprintf("Epoch_%d/Shard_%s/%s", epoch, shardId, identifier))
+	}}
+	// the pending batch is not iterated by the leveldb RangeKeys, so each put is written right away
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewSerialDB(path, 1, 1, 10)
+		},
+	}
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	require.Nil(t, ps.Put([]byte("key0"), []byte("value0")))
+	require.Nil(t, ps.ChangeEpochSimple(1))
+	require.Nil(t, ps.PutInEpoch([]byte("key1"), []byte("value1"), 1))
+	require.Nil(t, ps.ChangeEpochSimple(2))
+	require.Nil(t, ps.PutInEpoch([]byte("key2"), []byte("value2"), 2))
+	require.Equal(t, []uint32{2, 1}, ps.GetActivePersistersEpochs())
+
+	entries := rangeAllEntries(t, ps, pruning.RangeKeysOptions{})
+	expectedEntries := map[string][]rangedEntry{
+		"key1": {{value: "value1", epoch: 1}},
+		"key2": {{value: "value2", epoch: 2}},
+	}
+	assert.Equal(t, expectedEntries, entries)
+
+	entries = rangeAllEntries(t, ps, pruning.RangeKeysOptions{IncludeClosedPersisters: true})
+	expectedEntries["key0"] = []rangedEntry{{value: "value0", epoch: 0}}
+	assert.Equal(t, expectedEntries, entries)
+}
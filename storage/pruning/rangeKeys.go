@@ -0,0 +1,164 @@
+package pruning
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// EpochsRange holds the first and the last epochs, both included, of an iteration
+type EpochsRange struct {
+	Start uint32
+	End   uint32
+}
+
+// RangeKeysOptions configures the iteration over the keys stored in several epochs
+type RangeKeysOptions struct {
+	// IncludeClosedPersisters also iterates the persisters of the older epochs still kept on disk, opening them for
+	// the duration of the iteration
+	IncludeClosedPersisters bool
+	// AllVersions reports a key once for each epoch holding it. Otherwise each key is reported once, with the value
+	// from the newest epoch
+	AllVersions bool
+	// Epochs restricts the iteration to a range of epochs, nil meaning all the epochs
+	Epochs *EpochsRange
+	// KeyPrefix restricts the iteration to the keys starting with it
+	KeyPrefix []byte
+}
+
+func (opts *RangeKeysOptions) matchesEpoch(epoch uint32) bool {
+	if opts.Epochs == nil {
+		return true
+	}
+
+	return epoch >= opts.Epochs.Start && epoch <= opts.Epochs.End
+}
+
+// RangeKeys iterates over the keys of the active persisters, each key being reported once with the value from the
+// newest epoch. The iteration stops when the handler returns false
+func (ps *PruningStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := ps.RangeKeysInEpochs(RangeKeysOptions{}, func(key []byte, val []byte, _ uint32) bool {
+		return handler(key, val)
+	})
+	if err != nil {
+		log.Warn("PruningStorer.RangeKeys", "unit", ps.identifier, "error", err.Error())
+	}
+}
+
+// RangeKeysInEpochs iterates over the keys of the persisters selected by the options, from the newest epoch to the
+// oldest, calling the handler with each key, its value and its epoch. The iteration stops when the handler returns
+// false. Unless all the versions are requested, the reported keys are kept in memory until the iteration ends.
+// The handler must not call the storer as the epoch changes are blocked during the iteration
+func (ps *PruningStorer) RangeKeysInEpochs(
+	options RangeKeysOptions,
+	handler func(key []byte, val []byte, epoch uint32) bool,
+) error {
+	if handler == nil {
+		return storage.ErrNilRangeKeysHandler
+	}
+	if options.Epochs != nil && options.Epochs.End < options.Epochs.Start {
+		return fmt.Errorf("%w, start %d, end %d", storage.ErrInvalidEpochsRange, options.Epochs.Start, options.Epochs.End)
+	}
+
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	reportedKeys := make(map[string]struct{})
+	for _, pd := range ps.persistersToRangeUnprotected(options) {
+		shouldContinue, err := ps.rangeKeysInPersister(pd, options, reportedKeys, handler)
+		if err != nil {
+			return err
+		}
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// persistersToRangeUnprotected returns the persisters matching the options, newest epoch first
+func (ps *PruningStorer) persistersToRangeUnprotected(options RangeKeysOptions) []*persisterData {
+	persisters := make([]*persisterData, 0, len(ps.persistersMapByEpoch))
+	isActive := make(map[*persisterData]struct{})
+	for _, pd := range ps.activePersisters {
+		isActive[pd] = struct{}{}
+		if options.matchesEpoch(pd.epoch) {
+			persisters = append(persisters, pd)
+		}
+	}
+
+	if options.IncludeClosedPersisters {
+		for _, pd := range ps.persistersMapByEpoch {
+			_, found := isActive[pd]
+			if found || !options.matchesEpoch(pd.epoch) {
+				continue
+			}
+
+			persisters = append(persisters, pd)
+		}
+	}
+
+	sort.SliceStable(persisters, func(i, j int) bool {
+		return persisters[i].epoch > persisters[j].epoch
+	})
+
+	return persisters
+}
+
+func (ps *PruningStorer) rangeKeysInPersister(
+	pd *persisterData,
+	options RangeKeysOptions,
+	reportedKeys map[string]struct{},
+	handler func(key []byte, val []byte, epoch uint32) bool,
+) (bool, error) {
+	persister, closePersister, err := ps.openPersisterToRange(pd)
+	if err != nil {
+		return false, fmt.Errorf("%w while opening the persister for epoch %d of %s", err, pd.epoch, ps.identifier)
+	}
+	if persister == nil {
+		return true, nil
+	}
+	defer closePersister()
+
+	shouldContinue := true
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		if !bytes.HasPrefix(key, options.KeyPrefix) {
+			return true
+		}
+		if !options.AllVersions {
+			_, isReported := reportedKeys[string(key)]
+			if isReported {
+				return true
+			}
+			reportedKeys[string(key)] = struct{}{}
+		}
+
+		shouldContinue = handler(key, val, pd.epoch)
+		return shouldContinue
+	})
+
+	return shouldContinue, nil
+}
+
+// openPersisterToRange returns the persister to be iterated or nil if it is closed and no longer on disk, in which
+// case opening it would create an empty database
+func (ps *PruningStorer) openPersisterToRange(pd *persisterData) (storage.Persister, func(), error) {
+	if !pd.getIsClosed() {
+		return ps.createAndInitPersisterIfClosed(pd)
+	}
+
+	_, err := os.Stat(pd.path)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+
+	return ps.createAndInitPersister(pd)
+}
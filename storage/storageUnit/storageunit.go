@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -97,6 +98,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
 // BloomConfig holds the configurable elements of a bloom filter
@@ -354,6 +356,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
@@ -425,6 +428,7 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
 // NewDB creates a new database from database config
@@ -445,7 +449,7 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 		}
 
 		if err == nil {
-			return db, nil
+			return wrapWithCompression(db, argDB.Compression)
 		}
 
 		//TODO: extract this in a parameter and inject it
@@ -458,6 +462,16 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 	return db, nil
 }
 
+func wrapWithCompression(db storage.Persister, compressionType compression.Type) (storage.Persister, error) {
+	wrappedDB, err := compression.WrapPersister(db, compressionType)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return wrappedDB, nil
+}
+
 // NewBloomFilter creates a new bloom filter from bloom filter config
 func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 	var bf storage.BloomFilter
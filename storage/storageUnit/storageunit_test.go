@@ -1,6 +1,8 @@
 package storageUnit_test
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -15,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
@@ -506,6 +509,32 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfWrongCompression(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:      storageUnit.MemoryDB,
+		Compression: "Zstd",
+	}
+	persister, err := storageUnit.NewDB(arg)
+
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+	assert.Nil(t, persister)
+}
+
+func TestCreateDBFromConfWithCompressionOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:      storageUnit.MemoryDB,
+		Compression: compression.SnappyCompression,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err)
+
+	val := bytes.Repeat([]byte("compressible"), 100)
+	_ = persister.Put([]byte("key"), val)
+	recovered, err := persister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
 func TestCreateBloomFilterFromConfWrongSize(t *testing.T) {
 	bfConfig := storageUnit.BloomConfig{
 		Size:     2,
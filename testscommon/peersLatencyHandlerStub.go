@@ -0,0 +1,41 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// PeersLatencyHandlerStub -
+type PeersLatencyHandlerStub struct {
+	RequestSentCalled      func(peer core.PeerID, hashes [][]byte)
+	ResponseReceivedCalled func(peer core.PeerID, hash []byte)
+	OrderPeersCalled       func(peers []core.PeerID, randomizer dataRetriever.IntRandomizer) []core.PeerID
+}
+
+// RequestSent -
+func (plhs *PeersLatencyHandlerStub) RequestSent(peer core.PeerID, hashes [][]byte) {
+	if plhs.RequestSentCalled != nil {
+		plhs.RequestSentCalled(peer, hashes)
+	}
+}
+
+// ResponseReceived -
+func (plhs *PeersLatencyHandlerStub) ResponseReceived(peer core.PeerID, hash []byte) {
+	if plhs.ResponseReceivedCalled != nil {
+		plhs.ResponseReceivedCalled(peer, hash)
+	}
+}
+
+// OrderPeers -
+func (plhs *PeersLatencyHandlerStub) OrderPeers(peers []core.PeerID, randomizer dataRetriever.IntRandomizer) []core.PeerID {
+	if plhs.OrderPeersCalled != nil {
+		return plhs.OrderPeersCalled(peers, randomizer)
+	}
+
+	return peers
+}
+
+// IsInterfaceNil -
+func (plhs *PeersLatencyHandlerStub) IsInterfaceNil() bool {
+	return plhs == nil
+}
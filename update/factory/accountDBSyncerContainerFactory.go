@@ -137,6 +137,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			CheckpointHandler:         trie.NewDisabledTrieSyncCheckpoint(),
 		},
 		ShardId:   shardId,
 		Throttler: thr,
@@ -162,6 +163,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			CheckpointHandler:         trie.NewDisabledTrieSyncCheckpoint(),
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
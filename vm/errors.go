@@ -92,6 +92,9 @@ var ErrNilArgumentsParser = errors.New("nil arguments parser")
 // ErrNilValidatorSmartContractAddress signals that validator smart contract address is nil
 var ErrNilValidatorSmartContractAddress = errors.New("nil validator smart contract address")
 
+// ErrNilGovernanceSmartContractAddress signals that governance smart contract address is nil
+var ErrNilGovernanceSmartContractAddress = errors.New("nil governance smart contract address")
+
 // ErrInvalidStakingAccessAddress signals that invalid staking access address was provided
 var ErrInvalidStakingAccessAddress = errors.New("invalid staking access address")
 
@@ -229,3 +232,30 @@ var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
 
 // ErrNFTCreateRoleAlreadyExists signals that NFT create role already exists
 var ErrNFTCreateRoleAlreadyExists = errors.New("NFT create role already exists")
+
+// ErrInvalidStakeThreshold signals that an invalid stake threshold has been provided
+var ErrInvalidStakeThreshold = errors.New("invalid stake threshold")
+
+// ErrNoVotingPower signals that the voter has no voting power
+var ErrNoVotingPower = errors.New("address has 0 voting power")
+
+// ErrInvalidTokenMetadata signals that an invalid token metadata value has been provided
+var ErrInvalidTokenMetadata = errors.New("invalid token metadata")
+
+// ErrInvalidMaxPendingScheduledTxs signals that an invalid maximum number of pending scheduled transactions has been provided
+var ErrInvalidMaxPendingScheduledTxs = errors.New("invalid maximum number of pending scheduled transactions")
+
+// ErrInvalidMaxPendingScheduledTxsPerSender signals that an invalid maximum number of pending scheduled transactions per sender has been provided
+var ErrInvalidMaxPendingScheduledTxsPerSender = errors.New("invalid maximum number of pending scheduled transactions per sender")
+
+// ErrInvalidScheduledTxDeposit signals that an invalid deposit for a scheduled transaction has been provided
+var ErrInvalidScheduledTxDeposit = errors.New("invalid scheduled transaction deposit")
+
+// ErrInvalidMaxRoundsInFuture signals that an invalid maximum number of rounds in the future has been provided
+var ErrInvalidMaxRoundsInFuture = errors.New("invalid maximum number of rounds in the future")
+
+// ErrScheduledTxNotFound signals that the scheduled transaction was not found
+var ErrScheduledTxNotFound = errors.New("scheduled transaction not found")
+
+// ErrInvalidMaxExecutionsPerCall signals that an invalid maximum number of executions per call has been provided
+var ErrInvalidMaxExecutionsPerCall = errors.New("invalid maximum number of executions per call")
@@ -175,19 +175,21 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 
 func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, error) {
 	args := systemSmartContracts.ArgsValidatorSmartContract{
-		Eei:                      scf.systemEI,
-		SigVerifier:              scf.sigVerifier,
-		StakingSCConfig:          scf.systemSCConfig.StakingSystemSCConfig,
-		StakingSCAddress:         vm.StakingSCAddress,
-		EndOfEpochAddress:        vm.EndOfEpochAddress,
-		ValidatorSCAddress:       vm.ValidatorSCAddress,
-		GasCost:                  scf.gasCost,
-		Marshalizer:              scf.marshalizer,
-		GenesisTotalSupply:       scf.economics.GenesisTotalSupply(),
-		EpochNotifier:            scf.epochNotifier,
-		MinDeposit:               scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
-		DelegationMgrEnableEpoch: scf.systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
-		DelegationMgrSCAddress:   vm.DelegationManagerSCAddress,
+		Eei:                         scf.systemEI,
+		SigVerifier:                 scf.sigVerifier,
+		StakingSCConfig:             scf.systemSCConfig.StakingSystemSCConfig,
+		StakingSCAddress:            vm.StakingSCAddress,
+		EndOfEpochAddress:           vm.EndOfEpochAddress,
+		ValidatorSCAddress:          vm.ValidatorSCAddress,
+		GovernanceSCAddress:         vm.GovernanceSCAddress,
+		GasCost:                     scf.gasCost,
+		Marshalizer:                 scf.marshalizer,
+		GenesisTotalSupply:          scf.economics.GenesisTotalSupply(),
+		EpochNotifier:               scf.epochNotifier,
+		MinDeposit:                  scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		DelegationMgrEnableEpoch:    scf.systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
+		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
+		StakeCheckpointsEnableEpoch: scf.systemSCConfig.GovernanceSystemSCConfig.StakeWeightedVotingEnableEpoch,
 	}
 	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
 	return validatorSC, err
@@ -228,17 +230,19 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 
 func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract, error) {
 	argsDelegation := systemSmartContracts.ArgsNewDelegation{
-		DelegationSCConfig:     scf.systemSCConfig.DelegationSystemSCConfig,
-		StakingSCConfig:        scf.systemSCConfig.StakingSystemSCConfig,
-		Eei:                    scf.systemEI,
-		SigVerifier:            scf.sigVerifier,
-		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
-		StakingSCAddress:       vm.StakingSCAddress,
-		ValidatorSCAddress:     vm.ValidatorSCAddress,
-		GasCost:                scf.gasCost,
-		Marshalizer:            scf.marshalizer,
-		EpochNotifier:          scf.epochNotifier,
-		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		DelegationSCConfig:          scf.systemSCConfig.DelegationSystemSCConfig,
+		StakingSCConfig:             scf.systemSCConfig.StakingSystemSCConfig,
+		Eei:                         scf.systemEI,
+		SigVerifier:                 scf.sigVerifier,
+		DelegationMgrSCAddress:      vm.DelegationManagerSCAddress,
+		StakingSCAddress:            vm.StakingSCAddress,
+		ValidatorSCAddress:          vm.ValidatorSCAddress,
+		GovernanceSCAddress:         vm.GovernanceSCAddress,
+		GasCost:                     scf.gasCost,
+		Marshalizer:                 scf.marshalizer,
+		EpochNotifier:               scf.epochNotifier,
+		EndOfEpochAddress:           vm.EndOfEpochAddress,
+		StakeCheckpointsEnableEpoch: scf.systemSCConfig.GovernanceSystemSCConfig.StakeWeightedVotingEnableEpoch,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
 	return delegation, err
@@ -266,6 +270,20 @@ func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartCon
 	return delegationManager, err
 }
 
+func (scf *systemSCFactory) createScheduledTxContract() (vm.SystemSmartContract, error) {
+	argsScheduledTx := systemSmartContracts.ArgsNewScheduledTxSC{
+		ScheduledTxSCConfig:  scf.systemSCConfig.ScheduledTxSystemSCConfig,
+		Eei:                  scf.systemEI,
+		ScheduledTxSCAddress: vm.ScheduledTxSCAddress,
+		EndOfEpochAddress:    vm.EndOfEpochAddress,
+		GasCost:              scf.gasCost,
+		Marshalizer:          scf.marshalizer,
+		EpochNotifier:        scf.epochNotifier,
+	}
+	scheduledTx, err := systemSmartContracts.NewScheduledTxSystemSC(argsScheduledTx)
+	return scheduledTx, err
+}
+
 // CreateForGenesis instantiates all the system smart contracts and returns a container containing them to be used in the genesis process
 func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 	staking, err := scf.createStakingContract()
@@ -308,6 +326,16 @@ func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	scheduledTx, err := scf.createScheduledTxContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.ScheduledTxSCAddress, scheduledTx)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 	if err != nil {
 		return nil, err
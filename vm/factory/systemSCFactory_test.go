@@ -60,6 +60,14 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 				MinServiceFee: 0,
 				MaxServiceFee: 10000,
 			},
+			ScheduledTxSystemSCConfig: config.ScheduledTxSystemSCConfig{
+				EnabledEpoch:           0,
+				MaxPendingTxs:          100,
+				MaxPendingTxsPerSender: 10,
+				MaxRoundsInFuture:      1000,
+				MaxExecutionsPerCall:   10,
+				DepositPerTx:           "10",
+			},
 			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
 				MinCreationDeposit:  "10",
 				EnabledEpoch:        0,
@@ -246,7 +254,7 @@ func TestSystemSCFactory_Create(t *testing.T) {
 	container, err := scFactory.Create()
 	assert.Nil(t, err)
 	require.NotNil(t, container)
-	assert.Equal(t, 6, container.Len())
+	assert.Equal(t, 7, container.Len())
 }
 
 func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
@@ -257,7 +265,7 @@ func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
 
 	container, err := scFactory.CreateForGenesis()
 	assert.Nil(t, err)
-	assert.Equal(t, 4, container.Len())
+	assert.Equal(t, 5, container.Len())
 }
 
 func TestSystemSCFactory_IsInterfaceNil(t *testing.T) {
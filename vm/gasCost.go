@@ -33,6 +33,7 @@ type MetaChainSystemSCsCost struct {
 	UnBondTokens        uint64
 	DelegationMgrOps    uint64
 	GetAllNodeStates    uint64
+	ScheduledTxOps      uint64
 }
 
 // BuiltInCost defines cost for built-in methods
@@ -50,6 +51,7 @@ type BuiltInCost struct {
 	ESDTNFTBurn              uint64
 	ESDTNFTTransfer          uint64
 	ESDTNFTChangeCreateOwner uint64
+	ESDTNFTRoyaltiesPayment  uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
@@ -39,6 +39,7 @@ type delegation struct {
 	delegationMgrSCAddress []byte
 	stakingSCAddr          []byte
 	validatorSCAddr        []byte
+	governanceSCAddr       []byte
 	endOfEpochAddr         []byte
 	gasCost                vm.GasCost
 	marshalizer            marshal.Marshalizer
@@ -53,6 +54,8 @@ type delegation struct {
 	mutExecution           sync.RWMutex
 	stakingV2EnableEpoch   uint32
 	stakingV2Enabled       atomic.Flag
+	stakeCheckpointsEpoch  uint32
+	stakeCheckpoints       atomic.Flag
 }
 
 // ArgsNewDelegation defines the arguments to create the delegation smart contract
@@ -64,10 +67,14 @@ type ArgsNewDelegation struct {
 	DelegationMgrSCAddress []byte
 	StakingSCAddress       []byte
 	ValidatorSCAddress     []byte
+	GovernanceSCAddress    []byte
 	EndOfEpochAddress      []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EpochNotifier          vm.EpochNotifier
+	// StakeCheckpointsEnableEpoch is the epoch from which the active funds changes are recorded for the stake weighted
+	// voting
+	StakeCheckpointsEnableEpoch uint32
 }
 
 // NewDelegationSystemSC creates a new delegation system SC
@@ -84,6 +91,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 	if len(args.DelegationMgrSCAddress) < 1 {
 		return nil, fmt.Errorf("%w for delegation sc address", vm.ErrInvalidAddress)
 	}
+	if len(args.GovernanceSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for governance sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -104,6 +114,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		eei:                    args.Eei,
 		stakingSCAddr:          args.StakingSCAddress,
 		validatorSCAddr:        args.ValidatorSCAddress,
+		governanceSCAddr:       args.GovernanceSCAddress,
 		delegationMgrSCAddress: args.DelegationMgrSCAddress,
 		gasCost:                args.GasCost,
 		marshalizer:            args.Marshalizer,
@@ -116,6 +127,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		endOfEpochAddr:         args.EndOfEpochAddress,
 		stakingV2EnableEpoch:   args.StakingSCConfig.StakingV2Epoch,
 		stakingV2Enabled:       atomic.Flag{},
+		stakeCheckpointsEpoch:  args.StakeCheckpointsEnableEpoch,
 	}
 
 	var okValue bool
@@ -232,6 +244,10 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.setMetaData(args)
 	case "getMetaData":
 		return d.getMetaData(args)
+	case "moveOutDelegation":
+		return d.moveOutDelegation(args)
+	case "moveInDelegation":
+		return d.moveInDelegation(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -1303,6 +1319,232 @@ func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.Retur
 	return vmcommon.Ok
 }
 
+func (d *delegation) checkMoveDelegationInput(args *vmcommon.ContractCallInput, numArgs int) ([]byte, *big.Int, vmcommon.ReturnCode) {
+	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
+		d.eei.AddReturnMessage("only delegation manager can call this function")
+		return nil, nil, vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return nil, nil, vmcommon.UserError
+	}
+	if len(args.Arguments) != numArgs {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return nil, nil, vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, nil, vmcommon.OutOfGas
+	}
+
+	valueToMove := big.NewInt(0).SetBytes(args.Arguments[1])
+	if valueToMove.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("invalid value to move")
+		return nil, nil, vmcommon.UserError
+	}
+
+	return args.Arguments[0], valueToMove, vmcommon.Ok
+}
+
+// moveOutDelegation removes active stake of a delegator and moves it, inside the validator system smart contract,
+// to the destination delegation contract. Can be called only by the delegation manager.
+func (d *delegation) moveOutDelegation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	delegatorAddress, valueToMove, returnCode := d.checkMoveDelegationInput(args, 3)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	destination := args.Arguments[2]
+
+	isNew, delegator, err := d.getOrCreateDelegatorData(delegatorAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew || len(delegator.ActiveFund) == 0 {
+		d.eei.AddReturnMessage("address has no active funds")
+		return vmcommon.UserError
+	}
+
+	activeFund, err := d.getFund(delegator.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if activeFund.Value.Cmp(valueToMove) < 0 {
+		d.eei.AddReturnMessage("invalid value to move")
+		return vmcommon.UserError
+	}
+
+	delegationManagement, err := getDelegationManagement(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+	if err != nil {
+		d.eei.AddReturnMessage("error getting minimum delegation amount " + err.Error())
+		return vmcommon.UserError
+	}
+	remainedFund := big.NewInt(0).Sub(activeFund.Value, valueToMove)
+	if remainedFund.Cmp(zero) > 0 && remainedFund.Cmp(delegationManagement.MinDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("invalid value to move - need to move all - do not leave dust behind")
+		return vmcommon.UserError
+	}
+	err = d.checkOwnerCanUnDelegate(delegatorAddress, activeFund, valueToMove)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.computeAndUpdateRewards(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	vmOutput, err := d.executeOnValidatorSC(args.RecipientAddr, "moveStakeTokens", [][]byte{destination, valueToMove.Bytes()}, big.NewInt(0))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	activeFund.Value.Sub(activeFund.Value, valueToMove)
+	err = d.saveFund(delegator.ActiveFund, activeFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if activeFund.Value.Cmp(zero) == 0 {
+		delegator.ActiveFund = nil
+	}
+
+	globalFund.TotalActive.Sub(globalFund.TotalActive, valueToMove)
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegatorData(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.deleteDelegatorIfNeeded(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// moveInDelegation adds to the delegator's active fund the stake that was already moved inside the validator system
+// smart contract by the source delegation contract. Can be called only by the delegation manager.
+func (d *delegation) moveInDelegation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	delegatorAddress, valueToMove, returnCode := d.checkMoveDelegationInput(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	globalFund.TotalActive.Add(globalFund.TotalActive, valueToMove)
+	withDelegationCap := dConfig.MaxDelegationCap.Cmp(zero) != 0
+	if withDelegationCap && globalFund.TotalActive.Cmp(dConfig.MaxDelegationCap) > 0 {
+		d.eei.AddReturnMessage("total delegation cap reached")
+		return vmcommon.UserError
+	}
+
+	isNew, delegator, err := d.getOrCreateDelegatorData(delegatorAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+		delegator.UnClaimedRewards = big.NewInt(0)
+		dStatus.NumUsers++
+	} else {
+		err = d.computeAndUpdateRewards(delegatorAddress, delegator)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	if len(delegator.ActiveFund) == 0 {
+		var fundKey []byte
+		fundKey, err = d.createAndSaveNextKeyFund(delegatorAddress, valueToMove, active)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+		delegator.ActiveFund = fundKey
+	} else {
+		err = d.addValueToFund(delegator.ActiveFund, valueToMove)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	stakeArgs := d.makeStakeArgsIfAutomaticActivation(dConfig, dStatus, globalFund)
+	if len(stakeArgs) > 0 {
+		vmOutput, errExec := d.executeOnValidatorSC(args.RecipientAddr, "stake", stakeArgs, big.NewInt(0))
+		if errExec != nil {
+			d.eei.AddReturnMessage(errExec.Error())
+			return vmcommon.UserError
+		}
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			return vmOutput.ReturnCode
+		}
+
+		err = d.updateDelegationStatusAfterStake(dStatus, vmOutput.ReturnData, stakeArgs)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err = d.saveDelegationStatus(dStatus)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveDelegatorData(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
 func (d *delegation) addNewUnStakedFund(
 	delegatorAddress []byte,
 	delegator *DelegatorData,
@@ -2339,6 +2581,11 @@ func (d *delegation) createAndSaveNextKeyFund(address []byte, value *big.Int, fu
 }
 
 func (d *delegation) saveFund(key []byte, dFund *Fund) error {
+	err := d.saveActiveFundCheckpoint(key, dFund)
+	if err != nil {
+		return err
+	}
+
 	if dFund.Value.Cmp(zero) == 0 {
 		d.eei.SetStorage(key, nil)
 		return nil
@@ -2353,6 +2600,23 @@ func (d *delegation) saveFund(key []byte, dFund *Fund) error {
 	return nil
 }
 
+func (d *delegation) saveActiveFundCheckpoint(key []byte, dFund *Fund) error {
+	if !d.stakeCheckpoints.IsSet() || dFund.Type != active {
+		return nil
+	}
+
+	oldValue := big.NewInt(0)
+	if len(d.eei.GetStorage(key)) > 0 {
+		oldFund, err := d.getFund(key)
+		if err != nil {
+			return err
+		}
+		oldValue = oldFund.Value
+	}
+
+	return saveStakeCheckpoint(d.eei, d.marshalizer, d.gasCost, d.governanceSCAddr, dFund.Address, oldValue, dFund.Value)
+}
+
 func (d *delegation) createNextKeyFund(address []byte, value *big.Int, fundType uint32) ([]byte, *Fund) {
 	nextKey := big.NewInt(1)
 	lastKey := d.eei.GetStorage([]byte(lastFundKey))
@@ -2461,6 +2725,9 @@ func (d *delegation) EpochConfirmed(epoch uint32) {
 
 	d.stakingV2Enabled.Toggle(epoch > d.stakingV2EnableEpoch)
 	log.Debug("stakingV2", "enabled", d.stakingV2Enabled.IsSet())
+
+	d.stakeCheckpoints.Toggle(epoch >= d.stakeCheckpointsEpoch)
+	log.Debug("delegation stake checkpoints", "enabled", d.stakeCheckpoints.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
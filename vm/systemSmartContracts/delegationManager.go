@@ -3,6 +3,7 @@ package systemSmartContracts
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sync"
@@ -18,6 +19,7 @@ import (
 
 const delegationManagementKey = "delegationManagement"
 const delegationContractsList = "delegationContracts"
+const lastMoveDelegationPrefix = "lastMoveDelegation"
 
 var nextAddressAdd = big.NewInt(1 << 24)
 
@@ -36,6 +38,10 @@ type delegationManager struct {
 	minFee                   uint64
 	maxFee                   uint64
 	mutExecution             sync.RWMutex
+
+	flagMoveDelegation           atomic.Flag
+	moveDelegationEnableEpoch    uint32
+	moveDelegationCooldownEpochs uint32
 }
 
 // ArgsNewDelegationManager defines the arguments to create the delegation manager system smart contract
@@ -100,6 +106,9 @@ func NewDelegationManagerSystemSC(args ArgsNewDelegationManager) (*delegationMan
 		minDelegationAmount:      minDelegationAmount,
 		minFee:                   args.DelegationSCConfig.MinServiceFee,
 		maxFee:                   args.DelegationSCConfig.MaxServiceFee,
+
+		moveDelegationEnableEpoch:    args.DelegationMgrSCConfig.MoveDelegationEnableEpoch,
+		moveDelegationCooldownEpochs: args.DelegationMgrSCConfig.MoveDelegationCooldownInEpochs,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(d)
@@ -136,6 +145,8 @@ func (d *delegationManager) Execute(args *vmcommon.ContractCallInput) vmcommon.R
 		return d.changeMinDeposit(args)
 	case "changeMinDelegationAmount":
 		return d.changeMinDelegationAmount(args)
+	case "moveDelegation":
+		return d.moveDelegation(args)
 	}
 
 	d.eei.AddReturnMessage("invalid function to call")
@@ -354,6 +365,103 @@ func (d *delegationManager) getContractConfig(args *vmcommon.ContractCallInput)
 	return vmcommon.Ok
 }
 
+// moveDelegation moves active delegated stake of the caller from one delegation contract to another one, without
+// passing through the unbonding period. Expected arguments: source contract, destination contract, value to move.
+func (d *delegationManager) moveDelegation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagMoveDelegation.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationMgrOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	source, destination := args.Arguments[0], args.Arguments[1]
+	if bytes.Equal(source, destination) {
+		d.eei.AddReturnMessage("source and destination must be different")
+		return vmcommon.UserError
+	}
+
+	contractList, err := d.getDelegationContractList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !isDelegationContractInList(contractList, source) || !isDelegationContractInList(contractList, destination) {
+		d.eei.AddReturnMessage("source and destination must be delegation contracts")
+		return vmcommon.UserError
+	}
+
+	delegationManagement, err := d.getDelegationManagementData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	valueToMove := big.NewInt(0).SetBytes(args.Arguments[2])
+	if valueToMove.Cmp(delegationManagement.MinDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("value to move is less than the minimum delegation amount")
+		return vmcommon.UserError
+	}
+
+	currentEpoch := d.eei.BlockChainHook().CurrentEpoch()
+	lastMoveKey := append([]byte(lastMoveDelegationPrefix), args.CallerAddr...)
+	lastMoveData := d.eei.GetStorage(lastMoveKey)
+	if len(lastMoveData) > 0 {
+		lastMoveEpoch := uint32(big.NewInt(0).SetBytes(lastMoveData).Uint64())
+		if currentEpoch < lastMoveEpoch+d.moveDelegationCooldownEpochs {
+			d.eei.AddReturnMessage("delegation was moved too recently, cooldown period not passed")
+			return vmcommon.UserError
+		}
+	}
+
+	txData := "moveOutDelegation@" + hex.EncodeToString(args.CallerAddr) + "@" +
+		hex.EncodeToString(valueToMove.Bytes()) + "@" + hex.EncodeToString(destination)
+	returnCode := d.executeOnDelegationSC(source, txData)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	txData = "moveInDelegation@" + hex.EncodeToString(args.CallerAddr) + "@" + hex.EncodeToString(valueToMove.Bytes())
+	returnCode = d.executeOnDelegationSC(destination, txData)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	d.eei.SetStorage(lastMoveKey, big.NewInt(0).SetUint64(uint64(currentEpoch)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) executeOnDelegationSC(address []byte, txData string) vmcommon.ReturnCode {
+	vmOutput, err := d.eei.ExecuteOnDestContext(address, d.delegationMgrSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmOutput.ReturnCode
+}
+
+func isDelegationContractInList(list *DelegationContractList, address []byte) bool {
+	for _, contractAddress := range list.Addresses {
+		if bytes.Equal(contractAddress, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func createNewAddress(lastAddress []byte) []byte {
 	i := 0
 	for ; i < len(lastAddress) && lastAddress[i] == 0; i++ {
@@ -430,6 +538,9 @@ func (d *delegationManager) SetNewGasCost(gasCost vm.GasCost) {
 func (d *delegationManager) EpochConfirmed(epoch uint32) {
 	d.delegationMgrEnabled.Toggle(epoch >= d.enableDelegationMgrEpoch)
 	log.Debug("delegationManager", "enabled", d.delegationMgrEnabled.IsSet())
+
+	d.flagMoveDelegation.Toggle(epoch >= d.moveDelegationEnableEpoch)
+	log.Debug("delegationManager: move delegation", "enabled", d.flagMoveDelegation.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
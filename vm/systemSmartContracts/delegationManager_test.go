@@ -630,6 +630,134 @@ func TestDelegationManager_ChangeMinDelegationShouldWork(t *testing.T) {
 	assert.Equal(t, newMinDelegationAmount, recovered.MinDelegationAmount)
 }
 
+func createDelegationManagerForMoveDelegation(
+	blockChainHook *mock.BlockChainHookStub,
+	executeCalled func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode,
+) (*delegationManager, *vmContext) {
+	args := createMockArgumentsForDelegationManager()
+	args.DelegationMgrSCConfig.MoveDelegationCooldownInEpochs = 2
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			return &mock.SystemSCStub{ExecuteCalled: executeCalled}, nil
+		},
+	})
+	args.Eei = eei
+
+	dm, _ := NewDelegationManagerSystemSC(args)
+	_ = dm.saveDelegationContractList(&DelegationContractList{Addresses: [][]byte{[]byte("source"), []byte("destination")}})
+	_ = dm.saveDelegationManagementData(&DelegationManagement{
+		MinDelegationAmount: big.NewInt(10),
+	})
+
+	return dm, eei
+}
+
+func TestDelegationManagerSystemSC_MoveDelegationUserErrors(t *testing.T) {
+	t.Parallel()
+
+	dm, eei := createDelegationManagerForMoveDelegation(&mock.BlockChainHookStub{}, nil)
+	vmInput := getDefaultVmInputForDelegationManager("moveDelegation", [][]byte{[]byte("source"), []byte("destination")})
+
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "wrong number of arguments"))
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{[]byte("source"), []byte("destination"), {10}}
+	vmInput.CallValue = big.NewInt(10)
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrCallValueMustBeZero.Error()))
+
+	eei.returnMessage = ""
+	vmInput.CallValue = big.NewInt(0)
+	vmInput.Arguments = [][]byte{[]byte("source"), []byte("source"), {10}}
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "source and destination must be different"))
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{[]byte("source"), []byte("other"), {10}}
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "source and destination must be delegation contracts"))
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{[]byte("source"), []byte("destination"), {9}}
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "value to move is less than the minimum delegation amount"))
+}
+
+func TestDelegationManagerSystemSC_MoveDelegationNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dm, eei := createDelegationManagerForMoveDelegation(&mock.BlockChainHookStub{}, nil)
+	dm.flagMoveDelegation.Unset()
+	vmInput := getDefaultVmInputForDelegationManager("moveDelegation", [][]byte{[]byte("source"), []byte("destination"), {10}})
+
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid function to call"))
+}
+
+func TestDelegationManagerSystemSC_MoveDelegationShouldWorkAndRespectCooldown(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+	calledFunctions := make([]string, 0)
+	executeCalled := func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+		assert.Equal(t, vm.DelegationManagerSCAddress, args.CallerAddr)
+		assert.Equal(t, []byte("addr"), args.Arguments[0])
+		assert.Equal(t, []byte{10}, args.Arguments[1])
+		calledFunctions = append(calledFunctions, string(args.RecipientAddr)+":"+args.Function)
+		return vmcommon.Ok
+	}
+	dm, eei := createDelegationManagerForMoveDelegation(blockChainHook, executeCalled)
+	vmInput := getDefaultVmInputForDelegationManager("moveDelegation", [][]byte{[]byte("source"), []byte("destination"), {10}})
+
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, []string{"source:moveOutDelegation", "destination:moveInDelegation"}, calledFunctions)
+
+	epoch = 6
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "cooldown period not passed"))
+
+	epoch = 7
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 4, len(calledFunctions))
+}
+
+func TestDelegationManagerSystemSC_MoveDelegationSourceFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	executeCalled := func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+		assert.Equal(t, "moveOutDelegation", args.Function)
+		return vmcommon.UserError
+	}
+	dm, eei := createDelegationManagerForMoveDelegation(&mock.BlockChainHookStub{}, executeCalled)
+	vmInput := getDefaultVmInputForDelegationManager("moveDelegation", [][]byte{[]byte("source"), []byte("destination"), {10}})
+
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, 0, len(eei.GetStorage(append([]byte(lastMoveDelegationPrefix), vmInput.CallerAddr...))))
+}
+
 func TestCreateNewAddress_NextAddressShouldWork(t *testing.T) {
 	t.Parallel()
 
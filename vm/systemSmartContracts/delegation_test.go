@@ -38,6 +38,7 @@ func createMockArgumentsForDelegation() ArgsNewDelegation {
 		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
 		StakingSCAddress:       vm.StakingSCAddress,
 		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		GasCost:                vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{ESDTIssue: 10}},
 		Marshalizer:            &mock.MarshalizerMock{},
 		EpochNotifier:          &mock.EpochNotifierStub{},
@@ -164,6 +165,18 @@ func TestNewDelegationSystemSC_InvalidDelegationMgrSCAddrShouldErr(t *testing.T)
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestNewDelegationSystemSC_InvalidGovernanceSCAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("%w for governance sc address", vm.ErrInvalidAddress)
+	args := createMockArgumentsForDelegation()
+	args.GovernanceSCAddress = []byte{}
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNewDelegationSystemSC_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -2052,6 +2065,149 @@ func TestDelegationSystemSC_ExecuteUnDelegateAllFundsAsOwner(t *testing.T) {
 	assert.Equal(t, vmcommon.Ok, output)
 }
 
+func TestDelegationSystemSC_ExecuteMoveDelegationNotFromDelegationManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+
+	vmInput := getDefaultVmInputForFunc("moveOutDelegation", [][]byte{[]byte("delegator"), {80}, []byte("destination")})
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only delegation manager can call this function"))
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("moveInDelegation", [][]byte{[]byte("delegator"), {80}})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only delegation manager can call this function"))
+}
+
+func TestDelegationSystemSC_ExecuteMoveOutDelegation(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator")
+	destination := []byte("destination")
+	fundKey := append([]byte(fundKeyPrefix), []byte{1}...)
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	vmInput := getDefaultVmInputForFunc("moveOutDelegation", [][]byte{delegator, {95}, destination})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+	d, _ := NewDelegationSystemSC(args)
+
+	eei.SetStorageForAddress(vmInput.RecipientAddr, []byte(core.DelegationSystemSCKey), []byte("delegation"))
+	eei.SetStorageForAddress(destination, []byte(core.DelegationSystemSCKey), []byte("delegation"))
+	marshaledData, _ := args.Marshalizer.Marshal(&ValidatorDataV2{
+		RewardAddress:   destination,
+		TotalStakeValue: big.NewInt(0),
+	})
+	eei.SetStorageForAddress(vm.ValidatorSCAddress, destination, marshaledData)
+
+	_ = d.saveDelegatorData(delegator, &DelegatorData{
+		ActiveFund:            fundKey,
+		UnStakedFunds:         [][]byte{},
+		UnClaimedRewards:      big.NewInt(0),
+		TotalCumulatedRewards: big.NewInt(0),
+	})
+	_ = d.saveFund(fundKey, &Fund{
+		Value: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(100),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "do not leave dust behind"))
+
+	vmInput.Arguments[1] = []byte{80}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	dFund, _ := d.getFund(fundKey)
+	assert.Equal(t, big.NewInt(20), dFund.Value)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(20), globalFund.TotalActive)
+	assert.Equal(t, big.NewInt(0), globalFund.TotalUnStaked)
+
+	destinationData := &ValidatorDataV2{}
+	_ = args.Marshalizer.Unmarshal(destinationData, eei.GetStorageFromAddress(vm.ValidatorSCAddress, destination))
+	assert.Equal(t, big.NewInt(80), destinationData.TotalStakeValue)
+}
+
+func TestDelegationSystemSC_ExecuteMoveInDelegation(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator")
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	vmInput := getDefaultVmInputForFunc("moveInDelegation", [][]byte{delegator, {80}})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+	d, _ := NewDelegationSystemSC(args)
+
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(150),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive: big.NewInt(100),
+	})
+
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "total delegation cap reached"))
+
+	vmInput.Arguments[1] = []byte{50}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	fundKey := append([]byte(fundKeyPrefix), []byte{1}...)
+	dFund, _ := d.getFund(fundKey)
+	assert.Equal(t, big.NewInt(50), dFund.Value)
+	assert.Equal(t, delegator, dFund.Address)
+	assert.Equal(t, active, dFund.Type)
+
+	dGlobalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(150), dGlobalFund.TotalActive)
+
+	dStatus, _ := d.getDelegationStatus()
+	assert.Equal(t, uint64(1), dStatus.NumUsers)
+
+	_, dData, _ := d.getOrCreateDelegatorData(delegator)
+	assert.Equal(t, fundKey, dData.ActiveFund)
+}
+
 func TestDelegationSystemSC_ExecuteUnDelegateMultipleTimesSameAndDiffEpochAndWithdraw(t *testing.T) {
 	t.Parallel()
 
@@ -4246,3 +4402,43 @@ func TestDelegation_GetDelegationManagementShouldWork(t *testing.T) {
 	assert.Equal(t, minDeposit, delegationManagement.MinDeposit)
 	assert.Equal(t, minDelegationAmount, delegationManagement.MinDelegationAmount)
 }
+
+func TestDelegationSystemSC_SaveFundShouldSaveStakeCheckpointsForActiveFundsOnly(t *testing.T) {
+	t.Parallel()
+
+	currentNonce := uint64(5)
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{
+			CurrentNonceCalled: func() uint64 {
+				return currentNonce
+			},
+		},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	args.GovernanceSCAddress = governanceSCAddress
+	args.StakeCheckpointsEnableEpoch = 1
+	d, _ := NewDelegationSystemSC(args)
+	delegator := []byte("delegator")
+	setOpenProposalSnapshots(eei, &OpenProposalSnapshot{SnapshotNonce: 1, EndVoteNonce: 30})
+
+	_ = d.saveFund([]byte("fund1"), &Fund{Value: big.NewInt(100), Address: delegator, Type: active})
+	assert.Equal(t, 0, len(eei.GetStorage(stakeCheckpointsKey(delegator))))
+
+	d.EpochConfirmed(1)
+	currentNonce = 10
+	_ = d.saveFund([]byte("fund1"), &Fund{Value: big.NewInt(50), Address: delegator, Type: active})
+	_ = d.saveFund([]byte("fund2"), &Fund{Value: big.NewInt(50), Address: delegator, Type: unStaked})
+	currentNonce = 20
+	_ = d.saveFund([]byte("fund1"), &Fund{Value: big.NewInt(0), Address: delegator, Type: active})
+
+	checkpoints, _ := unmarshalStakeCheckpoints(args.Marshalizer, eei.GetStorage(stakeCheckpointsKey(delegator)))
+	require.Equal(t, []*StakeCheckpoint{
+		{Nonce: 0, Value: big.NewInt(100)},
+		{Nonce: 10, Value: big.NewInt(50)},
+		{Nonce: 20, Value: big.NewInt(0)},
+	}, checkpoints.Checkpoints)
+}
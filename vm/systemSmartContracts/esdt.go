@@ -39,6 +39,16 @@ const canChangeOwner = "canChangeOwner"
 const canAddSpecialRoles = "canAddSpecialRoles"
 const canTransferNFTCreateRole = "canTransferNFTCreateRole"
 const upgradable = "canUpgrade"
+const website = "website"
+const description = "description"
+const logoHash = "logoHash"
+const socialLinks = "socialLinks"
+const socialLinksSeparator = ","
+const maxLengthForWebsite = 256
+const maxLengthForDescription = 512
+const maxLengthForLogoHash = 64
+const maxNumberOfSocialLinks = 10
+const maxLengthForSocialLink = 256
 
 const conversionBase = 10
 
@@ -55,6 +65,9 @@ type esdt struct {
 	flagEnabled            atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
+
+	tokenMetadataEnableEpoch uint32
+	flagTokenMetadata        atomic.Flag
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
@@ -109,6 +122,8 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
+
+		tokenMetadataEnableEpoch: args.ESDTSCConfig.TokenMetadataEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(e)
 
@@ -184,6 +199,8 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllAddressesAndRoles(args)
 	case "getContractConfig":
 		return e.getContractConfig(args)
+	case "setTokenMetadata":
+		return e.setTokenMetadata(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -900,9 +917,95 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	e.eei.Finish([]byte("NFTCreateStopped-" + getStringFromBool(esdtToken.NFTCreateStopped)))
 	e.eei.Finish([]byte(fmt.Sprintf("NumWiped-%d", esdtToken.NumWiped)))
 
+	if e.flagTokenMetadata.IsSet() {
+		e.eei.Finish([]byte("Website-" + string(esdtToken.Website)))
+		e.eei.Finish([]byte("Description-" + string(esdtToken.Description)))
+		e.eei.Finish([]byte("LogoHash-" + hex.EncodeToString(esdtToken.LogoHash)))
+		e.eei.Finish([]byte("SocialLinks-" + string(bytes.Join(esdtToken.SocialLinks, []byte(socialLinksSeparator)))))
+	}
+
+	return vmcommon.Ok
+}
+
+// setTokenMetadata sets the optional descriptive fields of a token. Expected arguments: token identifier followed by
+// pairs of metadata name and value. An empty value removes the existing one.
+func (e *esdt) setTokenMetadata(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagTokenMetadata.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 3 || len(args.Arguments)%2 == 0 {
+		e.eei.AddReturnMessage("invalid number of arguments, expected token identifier followed by name@value pairs")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	storedBytes := 0
+	for i := 1; i < len(args.Arguments); i += 2 {
+		err := setTokenMetadataField(token, string(args.Arguments[i]), args.Arguments[i+1])
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+		storedBytes += len(args.Arguments[i+1])
+	}
+
+	err := e.eei.UseGas(e.gasCost.BaseOperationCost.StorePerByte * uint64(storedBytes))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	err = e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
+func setTokenMetadataField(token *ESDTData, name string, value []byte) error {
+	switch name {
+	case website:
+		if len(value) > maxLengthForWebsite {
+			return fmt.Errorf("%w for %s", vm.ErrInvalidTokenMetadata, name)
+		}
+		token.Website = value
+	case description:
+		if len(value) > maxLengthForDescription {
+			return fmt.Errorf("%w for %s", vm.ErrInvalidTokenMetadata, name)
+		}
+		token.Description = value
+	case logoHash:
+		if len(value) > maxLengthForLogoHash {
+			return fmt.Errorf("%w for %s", vm.ErrInvalidTokenMetadata, name)
+		}
+		token.LogoHash = value
+	case socialLinks:
+		links := make([][]byte, 0)
+		if len(value) > 0 {
+			links = bytes.Split(value, []byte(socialLinksSeparator))
+		}
+		if len(links) > maxNumberOfSocialLinks {
+			return fmt.Errorf("%w, too many %s", vm.ErrInvalidTokenMetadata, name)
+		}
+		for _, link := range links {
+			if len(link) == 0 || len(link) > maxLengthForSocialLink {
+				return fmt.Errorf("%w for %s", vm.ErrInvalidTokenMetadata, name)
+			}
+		}
+		token.SocialLinks = links
+	default:
+		return fmt.Errorf("%w %s", vm.ErrInvalidArgument, name)
+	}
+
+	return nil
+}
+
 func (e *esdt) getSpecialRoles(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		e.eei.AddReturnMessage("callValue must be 0")
@@ -1493,6 +1596,9 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagTokenMetadata.Toggle(epoch >= e.tokenMetadataEnableEpoch)
+	log.Debug("esdt contract: token metadata", "enabled", e.flagTokenMetadata.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
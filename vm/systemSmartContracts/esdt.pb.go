@@ -49,6 +49,10 @@ type ESDTData struct {
 	CanTransferNFTCreateRole bool          `protobuf:"varint,18,opt,name=CanTransferNFTCreateRole,proto3" json:"CanTransferNFTCreateRole"`
 	SpecialRoles             []*ESDTRoles  `protobuf:"bytes,19,rep,name=SpecialRoles,proto3" json:"SpecialRoles"`
 	NumWiped                 uint32        `protobuf:"varint,20,opt,name=NumWiped,proto3" json:"NumWiped"`
+	Website                  []byte        `protobuf:"bytes,21,opt,name=Website,proto3" json:"Website"`
+	Description              []byte        `protobuf:"bytes,22,opt,name=Description,proto3" json:"Description"`
+	LogoHash                 []byte        `protobuf:"bytes,23,opt,name=LogoHash,proto3" json:"LogoHash"`
+	SocialLinks              [][]byte      `protobuf:"bytes,24,rep,name=SocialLinks,proto3" json:"SocialLinks"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -219,6 +223,34 @@ func (m *ESDTData) GetNumWiped() uint32 {
 	return 0
 }
 
+func (m *ESDTData) GetWebsite() []byte {
+	if m != nil {
+		return m.Website
+	}
+	return nil
+}
+
+func (m *ESDTData) GetDescription() []byte {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *ESDTData) GetLogoHash() []byte {
+	if m != nil {
+		return m.LogoHash
+	}
+	return nil
+}
+
+func (m *ESDTData) GetSocialLinks() [][]byte {
+	if m != nil {
+		return m.SocialLinks
+	}
+	return nil
+}
+
 type ESDTRoles struct {
 	Address []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
 	Roles   [][]byte `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles"`
@@ -338,57 +370,61 @@ func init() {
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xf3, 0x44,
-	0x14, 0x8e, 0x7b, 0x4d, 0x26, 0x49, 0x1b, 0x86, 0x0a, 0x8d, 0x10, 0xb2, 0xa3, 0x4a, 0x48, 0x91,
-	0x50, 0x13, 0x71, 0x59, 0xc1, 0x86, 0xda, 0x6d, 0xa4, 0x48, 0x34, 0xa0, 0x49, 0x80, 0x8a, 0xdd,
-	0x24, 0x9e, 0x3a, 0x56, 0xe3, 0x71, 0xe4, 0x19, 0x53, 0xca, 0x0a, 0xf1, 0x04, 0x3c, 0x46, 0xc5,
-	0x93, 0xb0, 0xec, 0x8e, 0xae, 0x0c, 0x75, 0x37, 0xbf, 0xbc, 0xea, 0x23, 0xfc, 0x9a, 0xf1, 0xef,
-	0x4b, 0x2e, 0xdd, 0xfc, 0xea, 0xca, 0xdf, 0xf9, 0xce, 0xe7, 0xcf, 0x73, 0xce, 0x1c, 0x1f, 0x00,
-	0x28, 0xb7, 0x45, 0x77, 0x11, 0xf8, 0xc2, 0x87, 0xbb, 0xea, 0xf1, 0xf1, 0x89, 0xe3, 0x8a, 0x59,
-	0x38, 0xe9, 0x4e, 0x7d, 0xaf, 0xe7, 0xf8, 0x8e, 0xdf, 0x53, 0xf4, 0x24, 0xbc, 0x52, 0x91, 0x0a,
-	0x14, 0x4a, 0xdf, 0x3a, 0xbe, 0xab, 0x81, 0xea, 0xf9, 0xe8, 0x6c, 0x7c, 0x46, 0x04, 0x81, 0x5f,
-	0x81, 0xc6, 0xf7, 0x37, 0x8c, 0x06, 0xa7, 0xb6, 0x1d, 0x50, 0xce, 0x91, 0xd6, 0xd6, 0x3a, 0x0d,
-	0xb3, 0x95, 0x44, 0xc6, 0x12, 0x8f, 0x97, 0x22, 0xf8, 0x19, 0xa8, 0x8d, 0xfd, 0x6b, 0xca, 0x86,
-	0xc4, 0xa3, 0x68, 0x4b, 0xbd, 0xd2, 0x4c, 0x22, 0xa3, 0x20, 0x71, 0x01, 0x61, 0x17, 0x80, 0xb1,
-	0x3b, 0xbd, 0xa6, 0x81, 0x52, 0x6f, 0x2b, 0xf5, 0x41, 0x12, 0x19, 0x25, 0x16, 0x97, 0x70, 0x6e,
-	0x3e, 0xbe, 0x5d, 0x50, 0xb4, 0xb3, 0x62, 0x2e, 0x49, 0x5c, 0x40, 0xd8, 0x01, 0xd5, 0x0b, 0x97,
-	0x09, 0x32, 0x99, 0x53, 0xb4, 0xdb, 0xd6, 0x3a, 0x55, 0xb3, 0x91, 0x44, 0x46, 0xce, 0xe1, 0x1c,
-	0x49, 0xa5, 0x19, 0x06, 0x4c, 0x29, 0xf7, 0x0a, 0x65, 0xc6, 0xe1, 0x1c, 0x49, 0xa5, 0x45, 0xd8,
-	0x0f, 0x24, 0xe4, 0x14, 0xed, 0x17, 0xca, 0x8c, 0xc3, 0x39, 0x92, 0x47, 0xb5, 0x08, 0xeb, 0x07,
-	0x94, 0xfe, 0x4e, 0x51, 0x55, 0x49, 0xd5, 0x51, 0x73, 0x12, 0x17, 0x10, 0x7e, 0x0a, 0xf6, 0x2d,
-	0xc2, 0x7e, 0x76, 0x17, 0x14, 0xd5, 0x94, 0xb4, 0x9e, 0x44, 0x46, 0x46, 0xe1, 0x0c, 0xc8, 0x76,
-	0xfd, 0xb8, 0x70, 0x02, 0x62, 0xab, 0x93, 0x02, 0xa5, 0x54, 0xed, 0xb2, 0x08, 0x4b, 0x13, 0x14,
-	0x97, 0x14, 0xf0, 0x6b, 0x70, 0x60, 0x11, 0x66, 0xcd, 0x08, 0x73, 0xa8, 0xba, 0x24, 0x54, 0x57,
-	0xef, 0xc0, 0x24, 0x32, 0x56, 0x32, 0x78, 0x25, 0x96, 0x95, 0x0e, 0xb8, 0x2a, 0xc5, 0x46, 0x8d,
-	0xa2, 0xd2, 0x8c, 0xc3, 0x39, 0x82, 0xbf, 0x82, 0xba, 0xec, 0x24, 0xb5, 0x7f, 0x22, 0xf3, 0x90,
-	0xa2, 0xa6, 0xba, 0x96, 0x71, 0x12, 0x19, 0x65, 0xfa, 0xef, 0xff, 0x8c, 0x53, 0x8f, 0x88, 0x59,
-	0x6f, 0xe2, 0x3a, 0xdd, 0x01, 0x13, 0xdf, 0x94, 0x06, 0xf3, 0x7c, 0x1e, 0xf8, 0xcc, 0x1e, 0x52,
-	0x71, 0xe3, 0x07, 0xd7, 0x3d, 0xaa, 0xa2, 0x13, 0xc7, 0xef, 0xd9, 0x44, 0x90, 0xae, 0xe9, 0x3a,
-	0x03, 0x26, 0x2c, 0xc2, 0x05, 0x0d, 0x70, 0xd9, 0x11, 0x72, 0x00, 0xe4, 0xbd, 0x88, 0xf4, 0xb3,
-	0x07, 0xea, 0xb3, 0x23, 0xd9, 0x8d, 0x82, 0x7d, 0x9d, 0xaf, 0x96, 0x0c, 0xe1, 0xe7, 0xa0, 0x3e,
-	0x0c, 0xbd, 0x33, 0x3a, 0x75, 0x3d, 0x32, 0xe7, 0xe8, 0xb0, 0xad, 0x75, 0x9a, 0xe6, 0xa1, 0x2c,
-	0xb6, 0x44, 0xe3, 0x72, 0x00, 0xfb, 0x00, 0x5a, 0x84, 0x9d, 0xda, 0xf6, 0x68, 0x41, 0xa7, 0x2e,
-	0x99, 0x63, 0x7f, 0x4e, 0x39, 0x6a, 0xa9, 0x9e, 0x7e, 0x94, 0x44, 0xc6, 0x86, 0x2c, 0xde, 0xc0,
-	0xc1, 0x6f, 0x41, 0x6b, 0xd8, 0x1f, 0x5b, 0x01, 0x25, 0x82, 0x8e, 0x84, 0xbf, 0x58, 0x50, 0x1b,
-	0x7d, 0xa0, 0x5c, 0x8e, 0x92, 0xc8, 0x58, 0xcb, 0xe1, 0x35, 0x06, 0x5e, 0x02, 0x64, 0x11, 0x36,
-	0x0e, 0x08, 0xe3, 0x57, 0x34, 0xc8, 0xd3, 0xd2, 0x1e, 0x41, 0xe5, 0xf4, 0x49, 0x12, 0x19, 0x2f,
-	0x6a, 0xf0, 0x8b, 0x19, 0xd8, 0x07, 0x8d, 0xa5, 0xea, 0x3e, 0x6c, 0x6f, 0x77, 0xea, 0x5f, 0xb4,
-	0xd2, 0xb5, 0xd2, 0x95, 0x2b, 0x45, 0xf1, 0xe9, 0xf6, 0x58, 0xaa, 0x74, 0x29, 0x92, 0x53, 0x37,
-	0x0c, 0x3d, 0x39, 0xec, 0x36, 0x3a, 0x52, 0xbd, 0x55, 0x53, 0x97, 0x71, 0x38, 0x47, 0xc7, 0x23,
-	0x50, 0xcb, 0x6d, 0xe5, 0xff, 0xb3, 0xbc, 0xa5, 0xd4, 0xff, 0x93, 0x2d, 0xa8, 0x0c, 0x40, 0x03,
-	0xec, 0xa6, 0xc7, 0xdb, 0x6a, 0x6f, 0x77, 0x1a, 0x66, 0x2d, 0x89, 0x8c, 0x94, 0xc0, 0xe9, 0xe3,
-	0xf8, 0xdf, 0x2d, 0x00, 0xa4, 0xab, 0xe5, 0xb3, 0x2b, 0xd7, 0x79, 0xcf, 0x0d, 0xf8, 0xa7, 0x06,
-	0x0e, 0x4d, 0xc2, 0xe9, 0x80, 0xf3, 0xd0, 0x65, 0x8e, 0xe5, 0x73, 0xf1, 0x6e, 0x11, 0x5e, 0x26,
-	0x91, 0xb1, 0x9a, 0x7a, 0x9d, 0x11, 0x5d, 0x75, 0x95, 0x43, 0x77, 0xe1, 0xb2, 0x7c, 0xd3, 0x7e,
-	0x47, 0x99, 0x23, 0x66, 0x6a, 0xc3, 0x36, 0xd3, 0xa1, 0x5b, 0xcf, 0xe2, 0x0d, 0x9c, 0xf2, 0x21,
-	0xbf, 0xad, 0xfa, 0xec, 0x94, 0x7c, 0xd6, 0xb2, 0x78, 0x03, 0x67, 0x0e, 0xef, 0x1f, 0xf5, 0xca,
-	0xc3, 0xa3, 0x5e, 0x79, 0x7e, 0xd4, 0xb5, 0x3f, 0x62, 0x5d, 0xbb, 0x8b, 0x75, 0xed, 0x9f, 0x58,
-	0xd7, 0xee, 0x63, 0x5d, 0x7b, 0x88, 0x75, 0xed, 0xff, 0x58, 0xd7, 0xde, 0xc4, 0x7a, 0xe5, 0x39,
-	0xd6, 0xb5, 0xbf, 0x9e, 0xf4, 0xca, 0xfd, 0x93, 0x5e, 0x79, 0x78, 0xd2, 0x2b, 0xbf, 0x1c, 0xf1,
-	0x5b, 0x2e, 0xa8, 0x37, 0xf2, 0x48, 0x20, 0x2c, 0x9f, 0x89, 0x80, 0x4c, 0x05, 0x9f, 0xec, 0xa9,
-	0xc9, 0xfa, 0xf2, 0x6d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xbf, 0xbc, 0xec, 0xf4, 0x06, 0x00,
-	0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x75, 0x27, 0x93, 0x99, 0xa4, 0xec, 0x3c, 0x28, 0xc2, 0x50, 0x42, 0xa8, 0xdb, 0x8a, 0x84,
+	0x64, 0x09, 0x8d, 0x2d, 0x1e, 0x2b, 0xd8, 0x10, 0x77, 0x26, 0x22, 0x52, 0xc6, 0xa0, 0xb2, 0x61,
+	0x46, 0xec, 0xca, 0xee, 0x9b, 0x76, 0x2b, 0x76, 0x95, 0xd5, 0x55, 0x66, 0x18, 0x56, 0x88, 0x2f,
+	0xe0, 0x33, 0x10, 0x5f, 0xc2, 0x32, 0x3b, 0xb2, 0xea, 0x21, 0xce, 0x06, 0xf5, 0x6a, 0x3e, 0x01,
+	0xd5, 0xed, 0xf4, 0xc3, 0x4e, 0x66, 0x33, 0xca, 0xaa, 0xcf, 0x3d, 0xf7, 0xd4, 0xa9, 0xba, 0xb7,
+	0x1e, 0x4d, 0x08, 0xe8, 0xc0, 0xb4, 0x67, 0xb1, 0x32, 0x8a, 0x6e, 0xe0, 0xe7, 0xa3, 0x27, 0x61,
+	0x64, 0xc6, 0xf3, 0x61, 0x7b, 0xa4, 0xa6, 0x9d, 0x50, 0x85, 0xaa, 0x83, 0xf4, 0x70, 0x7e, 0x86,
+	0x11, 0x06, 0x88, 0xb2, 0x51, 0x07, 0xaf, 0x09, 0xd9, 0x7c, 0xda, 0x3f, 0x1a, 0x1c, 0x09, 0x23,
+	0xe8, 0x97, 0xa4, 0xf1, 0xdd, 0x4b, 0x09, 0xf1, 0x61, 0x10, 0xc4, 0xa0, 0x35, 0x73, 0x9a, 0x4e,
+	0xab, 0xd1, 0xdd, 0x4b, 0x13, 0x6f, 0x89, 0xe7, 0x4b, 0x11, 0xfd, 0x94, 0x6c, 0x0d, 0xd4, 0x39,
+	0xc8, 0x9e, 0x98, 0x02, 0x5b, 0xc3, 0x21, 0xdb, 0x69, 0xe2, 0x95, 0x24, 0x2f, 0x21, 0x6d, 0x13,
+	0x32, 0x88, 0x46, 0xe7, 0x10, 0xa3, 0x7a, 0x1d, 0xd5, 0x3b, 0x69, 0xe2, 0x55, 0x58, 0x5e, 0xc1,
+	0x85, 0xf9, 0xe0, 0xd5, 0x0c, 0xd8, 0x83, 0x15, 0x73, 0x4b, 0xf2, 0x12, 0xd2, 0x16, 0xd9, 0x7c,
+	0x16, 0x49, 0x23, 0x86, 0x13, 0x60, 0x1b, 0x4d, 0xa7, 0xb5, 0xd9, 0x6d, 0xa4, 0x89, 0x57, 0x70,
+	0xbc, 0x40, 0x56, 0xd9, 0x9d, 0xc7, 0x12, 0x95, 0x0f, 0x4b, 0x65, 0xce, 0xf1, 0x02, 0x59, 0xa5,
+	0x2f, 0xe4, 0xf7, 0x62, 0xae, 0x81, 0x3d, 0x2a, 0x95, 0x39, 0xc7, 0x0b, 0x64, 0x97, 0xea, 0x0b,
+	0x79, 0x1c, 0x03, 0xfc, 0x0a, 0x6c, 0x13, 0xa5, 0xb8, 0xd4, 0x82, 0xe4, 0x25, 0xa4, 0x9f, 0x90,
+	0x47, 0xbe, 0x90, 0xcf, 0xa3, 0x19, 0xb0, 0x2d, 0x94, 0xd6, 0xd3, 0xc4, 0xcb, 0x29, 0x9e, 0x03,
+	0xdb, 0xae, 0x1f, 0x66, 0x61, 0x2c, 0x02, 0x5c, 0x29, 0x41, 0x25, 0xb6, 0xcb, 0x17, 0x32, 0x4b,
+	0x00, 0xaf, 0x28, 0xe8, 0x57, 0x64, 0xc7, 0x17, 0xd2, 0x1f, 0x0b, 0x19, 0x02, 0x6e, 0x12, 0xab,
+	0xe3, 0x18, 0x9a, 0x26, 0xde, 0x4a, 0x86, 0xaf, 0xc4, 0xb6, 0xd2, 0x13, 0x8d, 0xa5, 0x04, 0xac,
+	0x51, 0x56, 0x9a, 0x73, 0xbc, 0x40, 0xf4, 0x67, 0x52, 0xb7, 0x9d, 0x84, 0xe0, 0x47, 0x31, 0x99,
+	0x03, 0xdb, 0xc6, 0x6d, 0x19, 0xa4, 0x89, 0x57, 0xa5, 0xff, 0x7a, 0xed, 0x1d, 0x4e, 0x85, 0x19,
+	0x77, 0x86, 0x51, 0xd8, 0x3e, 0x91, 0xe6, 0xeb, 0xca, 0xc1, 0x7c, 0x3a, 0x89, 0x95, 0x0c, 0x7a,
+	0x60, 0x5e, 0xaa, 0xf8, 0xbc, 0x03, 0x18, 0x3d, 0x09, 0x55, 0x27, 0x10, 0x46, 0xb4, 0xbb, 0x51,
+	0x78, 0x22, 0x8d, 0x2f, 0xb4, 0x81, 0x98, 0x57, 0x1d, 0xa9, 0x26, 0xc4, 0xee, 0x8b, 0xc9, 0xa6,
+	0xdd, 0xc1, 0x69, 0xfb, 0xb6, 0x1b, 0x25, 0x7b, 0x3f, 0xb3, 0x56, 0x0c, 0xe9, 0x67, 0xa4, 0xde,
+	0x9b, 0x4f, 0x8f, 0x60, 0x14, 0x4d, 0xc5, 0x44, 0xb3, 0xdd, 0xa6, 0xd3, 0xda, 0xee, 0xee, 0xda,
+	0x62, 0x2b, 0x34, 0xaf, 0x06, 0xf4, 0x98, 0x50, 0x5f, 0xc8, 0xc3, 0x20, 0xe8, 0xcf, 0x60, 0x14,
+	0x89, 0x09, 0x57, 0x13, 0xd0, 0x6c, 0x0f, 0x7b, 0xfa, 0x38, 0x4d, 0xbc, 0x3b, 0xb2, 0xfc, 0x0e,
+	0x8e, 0x7e, 0x43, 0xf6, 0x7a, 0xc7, 0x03, 0x3f, 0x06, 0x61, 0xa0, 0x6f, 0xd4, 0x6c, 0x06, 0x01,
+	0x7b, 0x0f, 0x5d, 0xf6, 0xd3, 0xc4, 0xbb, 0x95, 0xe3, 0xb7, 0x18, 0xfa, 0x82, 0x30, 0x5f, 0xc8,
+	0x41, 0x2c, 0xa4, 0x3e, 0x83, 0xb8, 0x48, 0x5b, 0x7b, 0x46, 0xd1, 0xe9, 0xe3, 0x34, 0xf1, 0xde,
+	0xaa, 0xe1, 0x6f, 0xcd, 0xd0, 0x63, 0xd2, 0x58, 0xaa, 0xee, 0xfd, 0xe6, 0x7a, 0xab, 0xfe, 0xf9,
+	0x5e, 0xf6, 0xac, 0xb4, 0xed, 0x93, 0x82, 0x7c, 0xf6, 0x7a, 0x2c, 0x55, 0xba, 0x14, 0xd9, 0x53,
+	0xd7, 0x9b, 0x4f, 0xed, 0x61, 0x0f, 0xd8, 0x3e, 0xf6, 0x16, 0x4f, 0x5d, 0xce, 0xf1, 0x02, 0xd9,
+	0x2b, 0xf3, 0x1c, 0x86, 0x3a, 0x32, 0xc0, 0x3e, 0xc0, 0xad, 0xc7, 0x2b, 0x73, 0x43, 0xf1, 0x1c,
+	0xd8, 0xfd, 0x3a, 0x02, 0x3d, 0x8a, 0xa3, 0x99, 0x89, 0x94, 0x64, 0x8f, 0x51, 0x8a, 0xfb, 0x55,
+	0xa1, 0x79, 0x35, 0xb0, 0x6b, 0x38, 0x55, 0xa1, 0xfa, 0x56, 0xe8, 0x31, 0xfb, 0x10, 0xf5, 0xb8,
+	0x86, 0x9c, 0xe3, 0x05, 0xb2, 0xe6, 0x7d, 0x65, 0x17, 0x7f, 0x1a, 0xc9, 0x73, 0xcd, 0x58, 0x73,
+	0x3d, 0x37, 0xaf, 0xd0, 0xbc, 0x1a, 0x1c, 0xf4, 0xc9, 0x56, 0xd1, 0x0d, 0x5b, 0xc3, 0xf2, 0xe3,
+	0x8a, 0x35, 0xdc, 0x50, 0x3c, 0x07, 0xd4, 0x23, 0x1b, 0x59, 0x57, 0xd7, 0x70, 0x82, 0xad, 0x34,
+	0xf1, 0x32, 0x82, 0x67, 0x9f, 0x83, 0x7f, 0xd6, 0x08, 0xb1, 0xae, 0xbe, 0x92, 0x67, 0x51, 0xf8,
+	0x8e, 0x0f, 0xf7, 0xef, 0x0e, 0xd9, 0xed, 0x0a, 0x0d, 0x27, 0x5a, 0xcf, 0x23, 0x19, 0xfa, 0x4a,
+	0x9b, 0x9b, 0xf7, 0xfb, 0x45, 0x9a, 0x78, 0xab, 0xa9, 0xfb, 0xb9, 0x59, 0xab, 0xae, 0xf6, 0xae,
+	0x3c, 0x8b, 0x64, 0xf1, 0x83, 0x38, 0x05, 0x19, 0x9a, 0x31, 0xfe, 0x18, 0xb6, 0xb3, 0xbb, 0x72,
+	0x3b, 0xcb, 0xef, 0xe0, 0xd0, 0x47, 0xfc, 0xb2, 0xea, 0xf3, 0xa0, 0xe2, 0x73, 0x2b, 0xcb, 0xef,
+	0xe0, 0xba, 0xbd, 0x8b, 0x2b, 0xb7, 0x76, 0x79, 0xe5, 0xd6, 0xde, 0x5c, 0xb9, 0xce, 0x6f, 0x0b,
+	0xd7, 0xf9, 0x73, 0xe1, 0x3a, 0x7f, 0x2f, 0x5c, 0xe7, 0x62, 0xe1, 0x3a, 0x97, 0x0b, 0xd7, 0xf9,
+	0x77, 0xe1, 0x3a, 0xff, 0x2d, 0xdc, 0xda, 0x9b, 0x85, 0xeb, 0xfc, 0x71, 0xed, 0xd6, 0x2e, 0xae,
+	0xdd, 0xda, 0xe5, 0xb5, 0x5b, 0xfb, 0x69, 0x5f, 0xbf, 0xd2, 0x06, 0xa6, 0xfd, 0xa9, 0x88, 0x8d,
+	0xaf, 0xa4, 0x89, 0xc5, 0xc8, 0xe8, 0xe1, 0x43, 0xbc, 0x10, 0x5f, 0xfc, 0x1f, 0x00, 0x00, 0xff,
+	0xff, 0xa5, 0x27, 0x98, 0x1a, 0xab, 0x07, 0x00, 0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -481,6 +517,23 @@ func (this *ESDTData) Equal(that interface{}) bool {
 	if this.NumWiped != that1.NumWiped {
 		return false
 	}
+	if !bytes.Equal(this.Website, that1.Website) {
+		return false
+	}
+	if !bytes.Equal(this.Description, that1.Description) {
+		return false
+	}
+	if !bytes.Equal(this.LogoHash, that1.LogoHash) {
+		return false
+	}
+	if len(this.SocialLinks) != len(that1.SocialLinks) {
+		return false
+	}
+	for i := range this.SocialLinks {
+		if !bytes.Equal(this.SocialLinks[i], that1.SocialLinks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ESDTRoles) Equal(that interface{}) bool {
@@ -555,7 +608,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 24)
+	s := make([]string, 0, 28)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -579,6 +632,10 @@ func (this *ESDTData) GoString() string {
 		s = append(s, "SpecialRoles: "+fmt.Sprintf("%#v", this.SpecialRoles)+",\n")
 	}
 	s = append(s, "NumWiped: "+fmt.Sprintf("%#v", this.NumWiped)+",\n")
+	s = append(s, "Website: "+fmt.Sprintf("%#v", this.Website)+",\n")
+	s = append(s, "Description: "+fmt.Sprintf("%#v", this.Description)+",\n")
+	s = append(s, "LogoHash: "+fmt.Sprintf("%#v", this.LogoHash)+",\n")
+	s = append(s, "SocialLinks: "+fmt.Sprintf("%#v", this.SocialLinks)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -634,6 +691,44 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SocialLinks) > 0 {
+		for iNdEx := len(m.SocialLinks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SocialLinks[iNdEx])
+			copy(dAtA[i:], m.SocialLinks[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.SocialLinks[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.LogoHash) > 0 {
+		i -= len(m.LogoHash)
+		copy(dAtA[i:], m.LogoHash)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.LogoHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.NumWiped != 0 {
 		i = encodeVarintEsdt(dAtA, i, uint64(m.NumWiped))
 		i--
@@ -1009,6 +1104,24 @@ func (m *ESDTData) Size() (n int) {
 	if m.NumWiped != 0 {
 		n += 2 + sovEsdt(uint64(m.NumWiped))
 	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 2 + l + sovEsdt(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovEsdt(uint64(l))
+	}
+	l = len(m.LogoHash)
+	if l > 0 {
+		n += 2 + l + sovEsdt(uint64(l))
+	}
+	if len(m.SocialLinks) > 0 {
+		for _, b := range m.SocialLinks {
+			l = len(b)
+			n += 2 + l + sovEsdt(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1091,6 +1204,10 @@ func (this *ESDTData) String() string {
 		`CanTransferNFTCreateRole:` + fmt.Sprintf("%v", this.CanTransferNFTCreateRole) + `,`,
 		`SpecialRoles:` + repeatedStringForSpecialRoles + `,`,
 		`NumWiped:` + fmt.Sprintf("%v", this.NumWiped) + `,`,
+		`Website:` + fmt.Sprintf("%v", this.Website) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`LogoHash:` + fmt.Sprintf("%v", this.LogoHash) + `,`,
+		`SocialLinks:` + fmt.Sprintf("%v", this.SocialLinks) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1660,6 +1777,140 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = append(m.Website[:0], dAtA[iNdEx:postIndex]...)
+			if m.Website == nil {
+				m.Website = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = append(m.Description[:0], dAtA[iNdEx:postIndex]...)
+			if m.Description == nil {
+				m.Description = []byte{}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoHash = append(m.LogoHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LogoHash == nil {
+				m.LogoHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialLinks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialLinks = append(m.SocialLinks, make([]byte, postIndex-iNdEx))
+			copy(m.SocialLinks[len(m.SocialLinks)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -25,7 +25,8 @@ func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
 		Eei:     &mock.SystemEIStub{},
 		GasCost: vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{ESDTIssue: 10}},
 		ESDTSCConfig: config.ESDTSystemSCConfig{
-			BaseIssuingCost: "1000",
+			BaseIssuingCost:          "1000",
+			TokenMetadataEnableEpoch: 10,
 		},
 		ESDTSCAddress:          []byte("address"),
 		Marshalizer:            &mock.MarshalizerMock{},
@@ -2581,6 +2582,122 @@ func TestEsdt_ExecuteEsdtControlChangesSavesTokenWithUpgradedProperties(t *testi
 	assert.Equal(t, []byte("NumWiped-37"), eei.output[17])
 }
 
+func createESDTWithTokenForMetadata(t *testing.T, tokenName []byte) (*esdt, *vmContext) {
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		TokenName:    tokenName,
+		TokenType:    []byte(core.FungibleESDT),
+		OwnerAddress: []byte("owner"),
+		BurntValue:   big.NewInt(0),
+		MintedValue:  big.NewInt(0),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, err := NewESDTSmartContract(args)
+	require.Nil(t, err)
+	e.EpochConfirmed(10)
+
+	return e, eei
+}
+
+func TestEsdt_SetTokenMetadataNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, eei := createESDTWithTokenForMetadata(t, []byte("esdtToken"))
+	e.EpochConfirmed(9)
+
+	vmInput := getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte(website), []byte("elrond.com")})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid method to call"))
+}
+
+func TestEsdt_SetTokenMetadataInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, eei := createESDTWithTokenForMetadata(t, []byte("esdtToken"))
+
+	vmInput := getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte(website)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte("unknown"), []byte("value")})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte(description), make([]byte, maxLengthForDescription+1)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidTokenMetadata.Error()))
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte(socialLinks), []byte("a,,b")})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidTokenMetadata.Error()))
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("setTokenMetadata", [][]byte{[]byte("esdtToken"), []byte(website), []byte("elrond.com")})
+	vmInput.CallerAddr = []byte("not owner")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by owner only"))
+}
+
+func TestEsdt_SetTokenMetadataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithTokenForMetadata(t, tokenName)
+	logo := []byte{0xaa, 0xbb}
+
+	vmInput := getDefaultVmInputForFunc("setTokenMetadata", [][]byte{tokenName,
+		[]byte(website), []byte("https://elrond.com"),
+		[]byte(description), []byte("a token"),
+		[]byte(logoHash), logo,
+		[]byte(socialLinks), []byte("https://twitter.com/elrond,https://t.me/elrond"),
+	})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, []byte("https://elrond.com"), token.Website)
+	assert.Equal(t, []byte("a token"), token.Description)
+	assert.Equal(t, logo, token.LogoHash)
+	assert.Equal(t, [][]byte{[]byte("https://twitter.com/elrond"), []byte("https://t.me/elrond")}, token.SocialLinks)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getTokenProperties", [][]byte{tokenName})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 22, len(eei.output))
+	assert.Equal(t, []byte("Website-https://elrond.com"), eei.output[18])
+	assert.Equal(t, []byte("Description-a token"), eei.output[19])
+	assert.Equal(t, []byte("LogoHash-aabb"), eei.output[20])
+	assert.Equal(t, []byte("SocialLinks-https://twitter.com/elrond,https://t.me/elrond"), eei.output[21])
+
+	vmInput = getDefaultVmInputForFunc("setTokenMetadata", [][]byte{tokenName, []byte(website), []byte("")})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ = e.getExistingToken(tokenName)
+	assert.Equal(t, 0, len(token.Website))
+	assert.Equal(t, []byte("a token"), token.Description)
+}
+
 func TestEsdt_GetSpecialRolesValueNotZeroShouldErr(t *testing.T) {
 	t.Parallel()
 
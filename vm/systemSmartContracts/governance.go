@@ -22,6 +22,7 @@ const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
+const delegatedVotePrefix = "delegatedVote"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
@@ -53,6 +54,12 @@ type governanceContract struct {
 	enabledEpoch        uint32
 	flagEnabled         atomic.Flag
 	mutExecution        sync.RWMutex
+
+	minQuorumStake                 *big.Int
+	minPassThresholdStake          *big.Int
+	minVetoThresholdStake          *big.Int
+	stakeWeightedVotingEnableEpoch uint32
+	flagStakeWeightedVoting        atomic.Flag
 }
 
 // NewGovernanceContract creates a new governance smart contract
@@ -74,6 +81,18 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if !okConvert || baseProposalCost.Cmp(big.NewInt(0)) < 0 {
 		return nil, vm.ErrInvalidBaseIssuingCost
 	}
+	minQuorumStake, err := stakeThresholdFromConfig(args.GovernanceConfig.MinQuorumStake)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MinQuorumStake", err)
+	}
+	minPassThresholdStake, err := stakeThresholdFromConfig(args.GovernanceConfig.MinPassThresholdStake)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MinPassThresholdStake", err)
+	}
+	minVetoThresholdStake, err := stakeThresholdFromConfig(args.GovernanceConfig.MinVetoThresholdStake)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MinVetoThresholdStake", err)
+	}
 
 	g := &governanceContract{
 		eei:                 args.Eei,
@@ -87,12 +106,30 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		hasher:              args.Hasher,
 		governanceConfig:    args.GovernanceConfig,
 		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+
+		minQuorumStake:                 minQuorumStake,
+		minPassThresholdStake:          minPassThresholdStake,
+		minVetoThresholdStake:          minVetoThresholdStake,
+		stakeWeightedVotingEnableEpoch: args.GovernanceConfig.StakeWeightedVotingEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
 	return g, nil
 }
 
+func stakeThresholdFromConfig(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	threshold, okConvert := big.NewInt(0).SetString(value, conversionBase)
+	if !okConvert || threshold.Cmp(zero) < 0 {
+		return nil, vm.ErrInvalidStakeThreshold
+	}
+
+	return threshold, nil
+}
+
 // Execute calls one of the functions from the governance smart contract and runs the code according to the input
 func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	g.mutExecution.RLock()
@@ -125,6 +162,8 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.revokeVotePower(args)
 	case "changeConfig":
 		return g.changeConfig(args)
+	case "changeStakeConfig":
+		return g.changeStakeConfig(args)
 	case "closeProposal":
 		return g.closeProposal(args)
 	}
@@ -140,6 +179,10 @@ func (g *governanceContract) init(args *vmcommon.ContractCallInput) vmcommon.Ret
 		MinPassThreshold: g.governanceConfig.MinPassThreshold,
 		MinVetoThreshold: g.governanceConfig.MinVetoThreshold,
 		ProposalFee:      g.baseProposalCost,
+
+		MinQuorumStake:        g.minQuorumStake,
+		MinPassThresholdStake: g.minPassThresholdStake,
+		MinVetoThresholdStake: g.minVetoThresholdStake,
 	}
 	marshaledData, err := g.marshalizer.Marshal(scConfig)
 	log.LogIfError(err, "function", "governanceContract.init")
@@ -207,6 +250,60 @@ func (g *governanceContract) changeConfig(args *vmcommon.ContractCallInput) vmco
 	return vmcommon.Ok
 }
 
+func (g *governanceContract) changeStakeConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagStakeWeightedVoting.IsSet() {
+		g.eei.AddReturnMessage("stake weighted voting is not enabled")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(g.ownerAddress, args.CallerAddr) {
+		g.eei.AddReturnMessage("changeStakeConfig can be called only by owner")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("changeStakeConfig can be called only without callValue")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		g.eei.AddReturnMessage("changeStakeConfig needs 3 arguments")
+		return vmcommon.UserError
+	}
+
+	minQuorumStake, okConvert := big.NewInt(0).SetString(string(args.Arguments[0]), conversionBase)
+	if !okConvert || minQuorumStake.Cmp(zero) < 0 {
+		g.eei.AddReturnMessage("changeStakeConfig first argument is incorrectly formatted")
+		return vmcommon.UserError
+	}
+	minPassStake, okConvert := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+	if !okConvert || minPassStake.Cmp(zero) < 0 {
+		g.eei.AddReturnMessage("changeStakeConfig second argument is incorrectly formatted")
+		return vmcommon.UserError
+	}
+	minVetoStake, okConvert := big.NewInt(0).SetString(string(args.Arguments[2]), conversionBase)
+	if !okConvert || minVetoStake.Cmp(zero) < 0 {
+		g.eei.AddReturnMessage("changeStakeConfig third argument is incorrectly formatted")
+		return vmcommon.UserError
+	}
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage("changeStakeConfig error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	scConfig.MinQuorumStake = minQuorumStake
+	scConfig.MinPassThresholdStake = minPassStake
+	scConfig.MinVetoThresholdStake = minVetoStake
+
+	marshaledData, err := g.marshalizer.Marshal(scConfig)
+	if err != nil {
+		g.eei.AddReturnMessage("changeStakeConfig error " + err.Error())
+		return vmcommon.UserError
+	}
+	g.eei.SetStorage([]byte(governanceConfigKey), marshaledData)
+
+	return vmcommon.Ok
+}
+
 func (g *governanceContract) getConfig() (*GovernanceConfig, error) {
 	marshaledData := g.eei.GetStorage([]byte(governanceConfigKey))
 	scConfig := &GovernanceConfig{}
@@ -215,6 +312,17 @@ func (g *governanceContract) getConfig() (*GovernanceConfig, error) {
 		return nil, err
 	}
 
+	// configs saved before stake weighted voting existed do not hold the stake thresholds
+	if scConfig.MinQuorumStake == nil {
+		scConfig.MinQuorumStake = big.NewInt(0).Set(g.minQuorumStake)
+	}
+	if scConfig.MinPassThresholdStake == nil {
+		scConfig.MinPassThresholdStake = big.NewInt(0).Set(g.minPassThresholdStake)
+	}
+	if scConfig.MinVetoThresholdStake == nil {
+		scConfig.MinVetoThresholdStake = big.NewInt(0).Set(g.minVetoThresholdStake)
+	}
+
 	return scConfig, nil
 }
 
@@ -275,6 +383,11 @@ func (g *governanceContract) whiteListProposal(args *vmcommon.ContractCallInput)
 		TopReference:   key,
 		Voters:         make([][]byte, 0),
 	}
+	err = g.setVotingMode(generalProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("set voting mode error " + err.Error())
+		return vmcommon.UserError
+	}
 
 	marshaledData, err := g.marshalizer.Marshal(whiteListAcc)
 	if err != nil {
@@ -292,6 +405,57 @@ func (g *governanceContract) whiteListProposal(args *vmcommon.ContractCallInput)
 	return vmcommon.Ok
 }
 
+// setVotingMode marks the proposals created after the stake weighted voting activation, so that the proposals
+// opened before it keep on being counted by number of nodes until they are closed. The stake weighted proposals
+// record the current nonce, the voting power being the stake held at the start of that block
+func (g *governanceContract) setVotingMode(generalProposal *GeneralProposal) error {
+	if !g.flagStakeWeightedVoting.IsSet() {
+		return nil
+	}
+
+	generalProposal.StakeWeighted = true
+	generalProposal.SnapshotNonce = g.eei.BlockChainHook().CurrentNonce()
+	generalProposal.YesStake = big.NewInt(0)
+	generalProposal.NoStake = big.NewInt(0)
+	generalProposal.VetoStake = big.NewInt(0)
+	generalProposal.DontCareStake = big.NewInt(0)
+	if generalProposal.MinQuorumStake == nil {
+		generalProposal.MinQuorumStake = big.NewInt(0)
+	}
+
+	return g.saveOpenProposalSnapshot(generalProposal)
+}
+
+// saveOpenProposalSnapshot records the snapshot nonce of the proposal until its voting ends, so that the stake
+// checkpoints no open proposal can ask for are pruned by the validator and delegation system smart contracts
+func (g *governanceContract) saveOpenProposalSnapshot(generalProposal *GeneralProposal) error {
+	snapshots, err := unmarshalOpenProposalSnapshots(g.marshalizer, g.eei.GetStorage([]byte(openProposalSnapshotsKey)))
+	if err != nil {
+		return err
+	}
+
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	openSnapshots := make([]*OpenProposalSnapshot, 0, len(snapshots.Snapshots)+1)
+	for _, snapshot := range snapshots.Snapshots {
+		if snapshot.EndVoteNonce < currentNonce {
+			continue
+		}
+		openSnapshots = append(openSnapshots, snapshot)
+	}
+	snapshots.Snapshots = append(openSnapshots, &OpenProposalSnapshot{
+		SnapshotNonce: generalProposal.SnapshotNonce,
+		EndVoteNonce:  generalProposal.EndVoteNonce,
+	})
+
+	marshaledData, err := g.marshalizer.Marshal(snapshots)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(openProposalSnapshotsKey), marshaledData)
+	return nil
+}
+
 func (g *governanceContract) saveGeneralProposal(reference []byte, generalProposal *GeneralProposal) error {
 	marshaledData, err := g.marshalizer.Marshal(generalProposal)
 	if err != nil {
@@ -496,6 +660,13 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		TopReference:   key,
 		Voters:         make([][]byte, 0),
 	}
+	err = g.setVotingMode(generalProposal)
+	if err != nil {
+		log.Warn("hardFork proposal set voting mode", "error", err)
+		g.eei.AddReturnMessage("setVotingMode" + err.Error())
+		return vmcommon.UserError
+	}
+
 	marshaledData, err = g.marshalizer.Marshal(hardForkProposal)
 	if err != nil {
 		log.Warn("hardFork proposal marshal", "err", err)
@@ -524,8 +695,12 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		g.eei.AddReturnMessage("not enough gas")
 		return vmcommon.OutOfGas
 	}
-	if len(args.Arguments) != 3 {
-		g.eei.AddReturnMessage("invalid number of arguments, expected 3")
+	maxNumArguments := 3
+	if g.flagStakeWeightedVoting.IsSet() {
+		maxNumArguments = 4
+	}
+	if len(args.Arguments) < 3 || len(args.Arguments) > maxNumArguments {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments, expected between 3 and %d", maxNumArguments))
 		return vmcommon.FunctionWrongSignature
 	}
 	if !g.isWhiteListed(args.CallerAddr) {
@@ -560,6 +735,21 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		Voted:          false,
 		Voters:         make([][]byte, 0),
 	}
+	if len(args.Arguments) == 4 {
+		minQuorumStake, okConvert := big.NewInt(0).SetString(string(args.Arguments[3]), conversionBase)
+		if !okConvert || minQuorumStake.Cmp(zero) < 0 {
+			g.eei.AddReturnMessage("invalid min quorum stake")
+			return vmcommon.UserError
+		}
+		generalProposal.MinQuorumStake = minQuorumStake
+	}
+	err = g.setVotingMode(generalProposal)
+	if err != nil {
+		log.Warn("setVotingMode", "err", err)
+		g.eei.AddReturnMessage("setVotingMode" + err.Error())
+		return vmcommon.UserError
+	}
+
 	err = g.saveGeneralProposal(gitHubCommit, generalProposal)
 	if err != nil {
 		log.Warn("saveGeneralProposal", "err", err)
@@ -605,6 +795,15 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
+	generalProposal, err := g.getGeneralProposal(proposalToVote)
+	if err != nil {
+		g.eei.AddReturnMessage("getGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+	if generalProposal.StakeWeighted {
+		return g.voteWithStake(args, generalProposal, voteString)
+	}
+
 	voterAddress := args.CallerAddr
 	validatorAddress := args.CallerAddr
 	if len(args.Arguments) == 3 {
@@ -646,6 +845,341 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+func (g *governanceContract) voteWithStake(
+	args *vmcommon.ContractCallInput,
+	generalProposal *GeneralProposal,
+	vote string,
+) vmcommon.ReturnCode {
+	proposal := args.Arguments[0]
+	err := g.checkVotingPeriod(generalProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("voteWithStake " + err.Error())
+		return vmcommon.UserError
+	}
+
+	switch {
+	case len(args.Arguments) == 2:
+		err = g.voteAsStaker(proposal, generalProposal, args.CallerAddr, vote)
+	case !g.isDelegationContract(args.Arguments[2]):
+		g.eei.AddReturnMessage("argument number 3 should be a delegation contract address")
+		return vmcommon.UserError
+	case g.isDelegationContractOwner(args.Arguments[2], args.CallerAddr):
+		err = g.voteAsDelegationProvider(proposal, generalProposal, args.Arguments[2], vote)
+	default:
+		err = g.voteAsDelegator(proposal, generalProposal, args.CallerAddr, args.Arguments[2], vote)
+	}
+	if err != nil {
+		g.eei.AddReturnMessage("voteWithStake " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = g.saveGeneralProposal(proposal, generalProposal)
+	if err != nil {
+		log.Warn("saveGeneralProposal", "err", err)
+		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) checkVotingPeriod(generalProposal *GeneralProposal) error {
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	if currentNonce < generalProposal.StartVoteNonce {
+		return vm.ErrVotedForAProposalThatNotBeginsYet
+	}
+	if currentNonce > generalProposal.EndVoteNonce {
+		return vm.ErrVotedForAnExpiredProposal
+	}
+
+	return nil
+}
+
+// voteAsStaker votes with the stake the voter holds directly in the validator system smart contract
+func (g *governanceContract) voteAsStaker(
+	proposal []byte,
+	generalProposal *GeneralProposal,
+	voter []byte,
+	vote string,
+) error {
+	votePower, err := g.stakedValueAtSnapshot(voter, generalProposal)
+	if err != nil {
+		return err
+	}
+	if votePower.Cmp(zero) <= 0 {
+		return vm.ErrNoVotingPower
+	}
+
+	_, err = g.updateStakeVote(proposal, generalProposal, voter, vote, votePower)
+	return err
+}
+
+// voteAsDelegator votes with the active stake the voter holds in the delegation contract. The vote overrides the
+// vote cast by the delegation contract owner for the voter's stake
+func (g *governanceContract) voteAsDelegator(
+	proposal []byte,
+	generalProposal *GeneralProposal,
+	voter []byte,
+	delegationAddress []byte,
+	vote string,
+) error {
+	votePower, err := g.delegatedValueAtSnapshot(delegationAddress, voter, generalProposal)
+	if err != nil {
+		return err
+	}
+	if votePower.Cmp(zero) <= 0 {
+		return vm.ErrNoVotingPower
+	}
+
+	voterKey := append(append([]byte{}, voter...), delegationAddress...)
+	oldVotePower, err := g.updateStakeVote(proposal, generalProposal, voterKey, vote, votePower)
+	if err != nil {
+		return err
+	}
+
+	delegatedVoteData, err := g.getOrCreateDelegatedVoteData(proposal, delegationAddress)
+	if err != nil {
+		return err
+	}
+	delegatedVoteData.OverriddenStake.Add(delegatedVoteData.OverriddenStake, votePower)
+	delegatedVoteData.OverriddenStake.Sub(delegatedVoteData.OverriddenStake, oldVotePower)
+
+	if len(delegatedVoteData.ProviderVote) > 0 {
+		providerPower, errCompute := g.computeProviderVotePower(delegationAddress, delegatedVoteData, generalProposal)
+		if errCompute != nil {
+			return errCompute
+		}
+		g.addStakeToProposal(generalProposal, delegatedVoteData.ProviderVote, big.NewInt(0).Neg(delegatedVoteData.ProviderPower))
+		g.addStakeToProposal(generalProposal, delegatedVoteData.ProviderVote, providerPower)
+		delegatedVoteData.ProviderPower = providerPower
+	}
+
+	return g.saveDelegatedVoteData(proposal, delegationAddress, delegatedVoteData)
+}
+
+// voteAsDelegationProvider votes, in the name of the delegation contract, with the stake of all the delegators that
+// did not vote on their own
+func (g *governanceContract) voteAsDelegationProvider(
+	proposal []byte,
+	generalProposal *GeneralProposal,
+	delegationAddress []byte,
+	vote string,
+) error {
+	delegatedVoteData, err := g.getOrCreateDelegatedVoteData(proposal, delegationAddress)
+	if err != nil {
+		return err
+	}
+
+	votePower, err := g.computeProviderVotePower(delegationAddress, delegatedVoteData, generalProposal)
+	if err != nil {
+		return err
+	}
+	if votePower.Cmp(zero) <= 0 {
+		return vm.ErrNoVotingPower
+	}
+
+	if len(delegatedVoteData.ProviderVote) > 0 {
+		g.addStakeToProposal(generalProposal, delegatedVoteData.ProviderVote, big.NewInt(0).Neg(delegatedVoteData.ProviderPower))
+	} else {
+		generalProposal.Voters = append(generalProposal.Voters, delegatedVoteKey(delegationAddress))
+	}
+	g.addStakeToProposal(generalProposal, vote, votePower)
+
+	delegatedVoteData.ProviderVote = vote
+	delegatedVoteData.ProviderPower = votePower
+
+	return g.saveDelegatedVoteData(proposal, delegationAddress, delegatedVoteData)
+}
+
+func (g *governanceContract) computeProviderVotePower(
+	delegationAddress []byte,
+	delegatedVoteData *DelegatedVoteData,
+	generalProposal *GeneralProposal,
+) (*big.Int, error) {
+	totalStake, err := g.stakedValueAtSnapshot(delegationAddress, generalProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	votePower := big.NewInt(0).Sub(totalStake, delegatedVoteData.OverriddenStake)
+	if votePower.Cmp(zero) < 0 {
+		return big.NewInt(0), nil
+	}
+
+	return votePower, nil
+}
+
+// updateStakeVote records the new vote of the voter and moves its voting power inside the proposal accordingly.
+// It returns the voting power used by the previous vote
+func (g *governanceContract) updateStakeVote(
+	proposal []byte,
+	generalProposal *GeneralProposal,
+	voterKey []byte,
+	vote string,
+	votePower *big.Int,
+) (*big.Int, error) {
+	voteData, err := g.getOrCreateVoteData(proposal, voterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	oldVotePower := big.NewInt(0)
+	if voteData.VotePower != nil {
+		oldVotePower.Set(voteData.VotePower)
+	}
+
+	if len(voteData.VoteValue) > 0 {
+		g.addStakeToProposal(generalProposal, voteData.VoteValue, big.NewInt(0).Neg(oldVotePower))
+	} else {
+		generalProposal.Voters = append(generalProposal.Voters, voterKey)
+	}
+	g.addStakeToProposal(generalProposal, vote, votePower)
+
+	voteData.VoteValue = vote
+	voteData.VotePower = votePower
+	err = g.saveVoteValue(proposal, voterKey, voteData)
+	if err != nil {
+		return nil, err
+	}
+
+	return oldVotePower, nil
+}
+
+func (g *governanceContract) addStakeToProposal(generalProposal *GeneralProposal, voteValue string, value *big.Int) {
+	switch voteValue {
+	case "yes":
+		generalProposal.YesStake = big.NewInt(0).Add(valueOrZero(generalProposal.YesStake), value)
+	case "no":
+		generalProposal.NoStake = big.NewInt(0).Add(valueOrZero(generalProposal.NoStake), value)
+	case "veto":
+		generalProposal.VetoStake = big.NewInt(0).Add(valueOrZero(generalProposal.VetoStake), value)
+	case "dontCare":
+		generalProposal.DontCareStake = big.NewInt(0).Add(valueOrZero(generalProposal.DontCareStake), value)
+	}
+}
+
+func delegatedVoteKey(delegationAddress []byte) []byte {
+	return append([]byte(delegatedVotePrefix), delegationAddress...)
+}
+
+func (g *governanceContract) getOrCreateDelegatedVoteData(proposal []byte, delegationAddress []byte) (*DelegatedVoteData, error) {
+	delegatedVoteData := &DelegatedVoteData{}
+	key := append(append([]byte{}, proposal...), delegatedVoteKey(delegationAddress)...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) > 0 {
+		err := g.marshalizer.Unmarshal(delegatedVoteData, marshaledData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	delegatedVoteData.ProviderPower = valueOrZero(delegatedVoteData.ProviderPower)
+	delegatedVoteData.OverriddenStake = valueOrZero(delegatedVoteData.OverriddenStake)
+
+	return delegatedVoteData, nil
+}
+
+func (g *governanceContract) saveDelegatedVoteData(proposal []byte, delegationAddress []byte, delegatedVoteData *DelegatedVoteData) error {
+	marshaledData, err := g.marshalizer.Marshal(delegatedVoteData)
+	if err != nil {
+		return err
+	}
+
+	key := append(append([]byte{}, proposal...), delegatedVoteKey(delegationAddress)...)
+	g.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+func (g *governanceContract) isDelegationContract(address []byte) bool {
+	marshaledData := g.eei.GetStorageFromAddress(address, []byte(delegationConfigKey))
+	return len(marshaledData) > 0
+}
+
+func (g *governanceContract) isDelegationContractOwner(delegationAddress []byte, address []byte) bool {
+	ownerAddress := g.eei.GetStorageFromAddress(delegationAddress, []byte(ownerKey))
+	return bytes.Equal(ownerAddress, address)
+}
+
+// stakedValueAtSnapshot returns the stake the address held in the validator system smart contract when the proposal
+// was created, so that the same stake can not vote again after being moved
+func (g *governanceContract) stakedValueAtSnapshot(address []byte, generalProposal *GeneralProposal) (*big.Int, error) {
+	currentValue, err := g.stakedValue(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakeAtNonce(g.eei, g.marshalizer, g.validatorSCAddress, address, generalProposal.SnapshotNonce, currentValue)
+}
+
+// delegatedValueAtSnapshot returns the active fund the delegator held in the delegation contract when the proposal
+// was created
+func (g *governanceContract) delegatedValueAtSnapshot(
+	delegationAddress []byte,
+	delegator []byte,
+	generalProposal *GeneralProposal,
+) (*big.Int, error) {
+	currentValue, err := g.delegatedValue(delegationAddress, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakeAtNonce(g.eei, g.marshalizer, delegationAddress, delegator, generalProposal.SnapshotNonce, currentValue)
+}
+
+// stakedValue returns the active stake the address has in the validator system smart contract
+func (g *governanceContract) stakedValue(address []byte) (*big.Int, error) {
+	marshaledData := g.eei.GetStorageFromAddress(g.validatorSCAddress, address)
+	if len(marshaledData) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	validatorData := &ValidatorDataV2{}
+	err := g.marshalizer.Unmarshal(validatorData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return valueOrZero(validatorData.TotalStakeValue), nil
+}
+
+// delegatedValue returns the active fund the delegator has in the provided delegation contract
+func (g *governanceContract) delegatedValue(delegationAddress []byte, delegator []byte) (*big.Int, error) {
+	marshaledData := g.eei.GetStorageFromAddress(delegationAddress, delegator)
+	if len(marshaledData) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	delegatorData := &DelegatorData{}
+	err := g.marshalizer.Unmarshal(delegatorData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if len(delegatorData.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	marshaledData = g.eei.GetStorageFromAddress(delegationAddress, delegatorData.ActiveFund)
+	if len(marshaledData) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	fund := &Fund{}
+	err = g.marshalizer.Unmarshal(fund, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return valueOrZero(fund.Value), nil
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
 func (g *governanceContract) isValidVoteString(vote string) bool {
 	switch vote {
 	case "yes":
@@ -889,6 +1423,10 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	if err != nil {
 		return err
 	}
+	if proposal.StakeWeighted {
+		g.computeEndResultsWithStake(proposal, baseConfig)
+		return nil
+	}
 	totalVotes := proposal.Yes + proposal.No + proposal.DontCare + proposal.Veto
 	if totalVotes < baseConfig.MinQuorum {
 		proposal.Voted = false
@@ -908,10 +1446,37 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	return nil
 }
 
+func (g *governanceContract) computeEndResultsWithStake(proposal *GeneralProposal, baseConfig *GovernanceConfig) {
+	minQuorumStake := baseConfig.MinQuorumStake
+	if proposal.MinQuorumStake != nil && proposal.MinQuorumStake.Cmp(zero) > 0 {
+		minQuorumStake = proposal.MinQuorumStake
+	}
+
+	totalStake := big.NewInt(0).Add(valueOrZero(proposal.YesStake), valueOrZero(proposal.NoStake))
+	totalStake.Add(totalStake, valueOrZero(proposal.VetoStake))
+	totalStake.Add(totalStake, valueOrZero(proposal.DontCareStake))
+	if totalStake.Cmp(minQuorumStake) < 0 {
+		proposal.Voted = false
+		return
+	}
+
+	if valueOrZero(proposal.VetoStake).Cmp(baseConfig.MinVetoThresholdStake) > 0 {
+		proposal.Voted = false
+		return
+	}
+
+	if valueOrZero(proposal.YesStake).Cmp(baseConfig.MinPassThresholdStake) > 0 {
+		proposal.Voted = true
+	}
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagStakeWeightedVoting.Toggle(epoch >= g.stakeWeightedVotingEnableEpoch)
+	log.Debug("governance contract: stake weighted voting", "enabled", g.flagStakeWeightedVoting.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
@@ -29,18 +29,25 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GeneralProposal struct {
-	IssuerAddress  []byte   `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
-	GitHubCommit   []byte   `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
-	StartVoteNonce uint64   `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
-	EndVoteNonce   uint64   `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
-	Yes            int32    `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
-	No             int32    `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
-	Veto           int32    `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
-	DontCare       int32    `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
-	Voted          bool     `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
-	Voters         [][]byte `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
-	TopReference   []byte   `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
-	Closed         bool     `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	IssuerAddress  []byte        `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
+	GitHubCommit   []byte        `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
+	StartVoteNonce uint64        `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
+	EndVoteNonce   uint64        `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
+	Yes            int32         `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
+	No             int32         `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
+	Veto           int32         `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
+	DontCare       int32         `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
+	Voted          bool          `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
+	Voters         [][]byte      `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
+	TopReference   []byte        `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
+	Closed         bool          `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	StakeWeighted  bool          `protobuf:"varint,13,opt,name=StakeWeighted,proto3" json:"StakeWeighted"`
+	YesStake       *math_big.Int `protobuf:"bytes,14,opt,name=YesStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"YesStake"`
+	NoStake        *math_big.Int `protobuf:"bytes,15,opt,name=NoStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"NoStake"`
+	VetoStake      *math_big.Int `protobuf:"bytes,16,opt,name=VetoStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"VetoStake"`
+	DontCareStake  *math_big.Int `protobuf:"bytes,17,opt,name=DontCareStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DontCareStake"`
+	MinQuorumStake *math_big.Int `protobuf:"bytes,18,opt,name=MinQuorumStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MinQuorumStake"`
+	SnapshotNonce  uint64        `protobuf:"varint,19,opt,name=SnapshotNonce,proto3" json:"SnapshotNonce"`
 }
 
 func (m *GeneralProposal) Reset()      { *m = GeneralProposal{} }
@@ -155,6 +162,55 @@ func (m *GeneralProposal) GetClosed() bool {
 	return false
 }
 
+func (m *GeneralProposal) GetStakeWeighted() bool {
+	if m != nil {
+		return m.StakeWeighted
+	}
+	return false
+}
+
+func (m *GeneralProposal) GetYesStake() *math_big.Int {
+	if m != nil {
+		return m.YesStake
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetNoStake() *math_big.Int {
+	if m != nil {
+		return m.NoStake
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetVetoStake() *math_big.Int {
+	if m != nil {
+		return m.VetoStake
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetDontCareStake() *math_big.Int {
+	if m != nil {
+		return m.DontCareStake
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetMinQuorumStake() *math_big.Int {
+	if m != nil {
+		return m.MinQuorumStake
+	}
+	return nil
+}
+
+func (m *GeneralProposal) GetSnapshotNonce() uint64 {
+	if m != nil {
+		return m.SnapshotNonce
+	}
+	return 0
+}
+
 type WhiteListProposal struct {
 	WhiteListAddress []byte `protobuf:"bytes,1,opt,name=WhiteListAddress,proto3" json:"WhiteListAddress"`
 	ProposalStatus   []byte `protobuf:"bytes,2,opt,name=ProposalStatus,proto3" json:"ProposalStatus"`
@@ -258,11 +314,14 @@ func (m *HardForkProposal) GetProposalStatus() []byte {
 }
 
 type GovernanceConfig struct {
-	NumNodes         int64         `protobuf:"varint,1,opt,name=NumNodes,proto3" json:"NumNodes"`
-	MinQuorum        int32         `protobuf:"varint,2,opt,name=MinQuorum,proto3" json:"MinQuorum"`
-	MinPassThreshold int32         `protobuf:"varint,3,opt,name=MinPassThreshold,proto3" json:"MinPassThreshold"`
-	MinVetoThreshold int32         `protobuf:"varint,4,opt,name=MinVetoThreshold,proto3" json:"MinVetoThreshold"`
-	ProposalFee      *math_big.Int `protobuf:"bytes,5,opt,name=ProposalFee,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ProposalFee"`
+	NumNodes              int64         `protobuf:"varint,1,opt,name=NumNodes,proto3" json:"NumNodes"`
+	MinQuorum             int32         `protobuf:"varint,2,opt,name=MinQuorum,proto3" json:"MinQuorum"`
+	MinPassThreshold      int32         `protobuf:"varint,3,opt,name=MinPassThreshold,proto3" json:"MinPassThreshold"`
+	MinVetoThreshold      int32         `protobuf:"varint,4,opt,name=MinVetoThreshold,proto3" json:"MinVetoThreshold"`
+	ProposalFee           *math_big.Int `protobuf:"bytes,5,opt,name=ProposalFee,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ProposalFee"`
+	MinQuorumStake        *math_big.Int `protobuf:"bytes,6,opt,name=MinQuorumStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MinQuorumStake"`
+	MinPassThresholdStake *math_big.Int `protobuf:"bytes,7,opt,name=MinPassThresholdStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MinPassThresholdStake"`
+	MinVetoThresholdStake *math_big.Int `protobuf:"bytes,8,opt,name=MinVetoThresholdStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MinVetoThresholdStake"`
 }
 
 func (m *GovernanceConfig) Reset()      { *m = GovernanceConfig{} }
@@ -328,6 +387,27 @@ func (m *GovernanceConfig) GetProposalFee() *math_big.Int {
 	return nil
 }
 
+func (m *GovernanceConfig) GetMinQuorumStake() *math_big.Int {
+	if m != nil {
+		return m.MinQuorumStake
+	}
+	return nil
+}
+
+func (m *GovernanceConfig) GetMinPassThresholdStake() *math_big.Int {
+	if m != nil {
+		return m.MinPassThresholdStake
+	}
+	return nil
+}
+
+func (m *GovernanceConfig) GetMinVetoThresholdStake() *math_big.Int {
+	if m != nil {
+		return m.MinVetoThresholdStake
+	}
+	return nil
+}
+
 type VoterData struct {
 	Address  []byte `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
 	NumNodes int32  `protobuf:"varint,2,opt,name=NumNodes,proto3" json:"NumNodes"`
@@ -423,8 +503,9 @@ func (m *ValidatorData) GetNumNodes() int32 {
 }
 
 type VoteData struct {
-	NumVotes  int32  `protobuf:"varint,1,opt,name=NumVotes,proto3" json:"VoteData"`
-	VoteValue string `protobuf:"bytes,2,opt,name=VoteValue,proto3" json:"VoteValue"`
+	NumVotes  int32         `protobuf:"varint,1,opt,name=NumVotes,proto3" json:"VoteData"`
+	VoteValue string        `protobuf:"bytes,2,opt,name=VoteValue,proto3" json:"VoteValue"`
+	VotePower *math_big.Int `protobuf:"bytes,3,opt,name=VotePower,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"VotePower"`
 }
 
 func (m *VoteData) Reset()      { *m = VoteData{} }
@@ -469,6 +550,240 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+func (m *VoteData) GetVotePower() *math_big.Int {
+	if m != nil {
+		return m.VotePower
+	}
+	return nil
+}
+
+type DelegatedVoteData struct {
+	ProviderVote    string        `protobuf:"bytes,1,opt,name=ProviderVote,proto3" json:"ProviderVote"`
+	ProviderPower   *math_big.Int `protobuf:"bytes,2,opt,name=ProviderPower,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ProviderPower"`
+	OverriddenStake *math_big.Int `protobuf:"bytes,3,opt,name=OverriddenStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"OverriddenStake"`
+}
+
+func (m *DelegatedVoteData) Reset()      { *m = DelegatedVoteData{} }
+func (*DelegatedVoteData) ProtoMessage() {}
+func (*DelegatedVoteData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *DelegatedVoteData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatedVoteData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatedVoteData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedVoteData.Merge(m, src)
+}
+func (m *DelegatedVoteData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatedVoteData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedVoteData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedVoteData proto.InternalMessageInfo
+
+func (m *DelegatedVoteData) GetProviderVote() string {
+	if m != nil {
+		return m.ProviderVote
+	}
+	return ""
+}
+
+func (m *DelegatedVoteData) GetProviderPower() *math_big.Int {
+	if m != nil {
+		return m.ProviderPower
+	}
+	return nil
+}
+
+func (m *DelegatedVoteData) GetOverriddenStake() *math_big.Int {
+	if m != nil {
+		return m.OverriddenStake
+	}
+	return nil
+}
+
+type StakeCheckpoint struct {
+	Nonce uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce"`
+	Value *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Value"`
+}
+
+func (m *StakeCheckpoint) Reset()      { *m = StakeCheckpoint{} }
+func (*StakeCheckpoint) ProtoMessage() {}
+func (*StakeCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{8}
+}
+func (m *StakeCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StakeCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCheckpoint.Merge(m, src)
+}
+func (m *StakeCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeCheckpoint proto.InternalMessageInfo
+
+func (m *StakeCheckpoint) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *StakeCheckpoint) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type StakeCheckpoints struct {
+	Checkpoints []*StakeCheckpoint `protobuf:"bytes,1,rep,name=Checkpoints,proto3" json:"Checkpoints"`
+}
+
+func (m *StakeCheckpoints) Reset()      { *m = StakeCheckpoints{} }
+func (*StakeCheckpoints) ProtoMessage() {}
+func (*StakeCheckpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{9}
+}
+func (m *StakeCheckpoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeCheckpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StakeCheckpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCheckpoints.Merge(m, src)
+}
+func (m *StakeCheckpoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeCheckpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCheckpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeCheckpoints proto.InternalMessageInfo
+
+func (m *StakeCheckpoints) GetCheckpoints() []*StakeCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type OpenProposalSnapshot struct {
+	SnapshotNonce uint64 `protobuf:"varint,1,opt,name=SnapshotNonce,proto3" json:"SnapshotNonce"`
+	EndVoteNonce  uint64 `protobuf:"varint,2,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
+}
+
+func (m *OpenProposalSnapshot) Reset()      { *m = OpenProposalSnapshot{} }
+func (*OpenProposalSnapshot) ProtoMessage() {}
+func (*OpenProposalSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{10}
+}
+func (m *OpenProposalSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenProposalSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OpenProposalSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenProposalSnapshot.Merge(m, src)
+}
+func (m *OpenProposalSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenProposalSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenProposalSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenProposalSnapshot proto.InternalMessageInfo
+
+func (m *OpenProposalSnapshot) GetSnapshotNonce() uint64 {
+	if m != nil {
+		return m.SnapshotNonce
+	}
+	return 0
+}
+
+func (m *OpenProposalSnapshot) GetEndVoteNonce() uint64 {
+	if m != nil {
+		return m.EndVoteNonce
+	}
+	return 0
+}
+
+type OpenProposalSnapshots struct {
+	Snapshots []*OpenProposalSnapshot `protobuf:"bytes,1,rep,name=Snapshots,proto3" json:"Snapshots"`
+}
+
+func (m *OpenProposalSnapshots) Reset()      { *m = OpenProposalSnapshots{} }
+func (*OpenProposalSnapshots) ProtoMessage() {}
+func (*OpenProposalSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{11}
+}
+func (m *OpenProposalSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenProposalSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OpenProposalSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenProposalSnapshots.Merge(m, src)
+}
+func (m *OpenProposalSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenProposalSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenProposalSnapshots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenProposalSnapshots proto.InternalMessageInfo
+
+func (m *OpenProposalSnapshots) GetSnapshots() []*OpenProposalSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +792,95 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*DelegatedVoteData)(nil), "proto.DelegatedVoteData")
+	proto.RegisterType((*StakeCheckpoint)(nil), "proto.StakeCheckpoint")
+	proto.RegisterType((*StakeCheckpoints)(nil), "proto.StakeCheckpoints")
+	proto.RegisterType((*OpenProposalSnapshot)(nil), "proto.OpenProposalSnapshot")
+	proto.RegisterType((*OpenProposalSnapshots)(nil), "proto.OpenProposalSnapshots")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
-	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	// 1245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6b, 0xe3, 0x46,
+	0x14, 0xb7, 0xec, 0xd8, 0xb1, 0x27, 0x76, 0xec, 0xcc, 0xfe, 0x41, 0xfd, 0x83, 0x14, 0x0c, 0x05,
+	0x43, 0xd9, 0x04, 0xda, 0x85, 0x42, 0x4b, 0x61, 0x57, 0xde, 0xdd, 0x24, 0xd0, 0xf5, 0x66, 0x27,
+	0xc1, 0xe9, 0x96, 0xb6, 0xa0, 0x58, 0x13, 0x5b, 0x8d, 0xad, 0x31, 0xa3, 0x71, 0x42, 0x69, 0xa1,
+	0xa5, 0xd0, 0x7b, 0x0b, 0x7b, 0xe8, 0x47, 0x28, 0xfd, 0x24, 0xa5, 0xf4, 0x10, 0x28, 0x85, 0x9c,
+	0xd4, 0xc6, 0xb9, 0x14, 0x9d, 0xf6, 0x23, 0x94, 0x99, 0x91, 0x64, 0x8d, 0xec, 0x43, 0x0a, 0xa6,
+	0x17, 0xeb, 0xbd, 0xdf, 0x9b, 0x79, 0xef, 0xf7, 0x66, 0xe6, 0xcd, 0x1b, 0x83, 0x46, 0x9f, 0x9c,
+	0x61, 0xea, 0xd9, 0x5e, 0x0f, 0x6f, 0x8d, 0x29, 0x61, 0x04, 0x16, 0xc5, 0xe7, 0xf5, 0x7b, 0x7d,
+	0x97, 0x0d, 0x26, 0xc7, 0x5b, 0x3d, 0x32, 0xda, 0xee, 0x93, 0x3e, 0xd9, 0x16, 0xf0, 0xf1, 0xe4,
+	0x44, 0x68, 0x42, 0x11, 0x92, 0x9c, 0xd5, 0xfc, 0xad, 0x02, 0xea, 0x3b, 0xd8, 0xc3, 0xd4, 0x1e,
+	0xee, 0x53, 0x32, 0x26, 0xbe, 0x3d, 0x84, 0xef, 0x81, 0xda, 0x9e, 0xef, 0x4f, 0x30, 0x7d, 0xe8,
+	0x38, 0x14, 0xfb, 0xbe, 0xae, 0x6d, 0x6a, 0xad, 0xaa, 0xb5, 0x11, 0x06, 0xa6, 0x6a, 0x40, 0xaa,
+	0x0a, 0xef, 0x83, 0xea, 0x8e, 0xcb, 0x76, 0x27, 0xc7, 0x6d, 0x32, 0x1a, 0xb9, 0x4c, 0xcf, 0x8b,
+	0x79, 0x8d, 0x30, 0x30, 0x15, 0x1c, 0x29, 0x1a, 0x7c, 0x1f, 0xac, 0x1f, 0x30, 0x9b, 0xb2, 0x2e,
+	0x61, 0xb8, 0x43, 0xbc, 0x1e, 0xd6, 0x0b, 0x9b, 0x5a, 0x6b, 0xc5, 0x82, 0x61, 0x60, 0x66, 0x2c,
+	0x28, 0xa3, 0xf3, 0x88, 0x8f, 0x3d, 0x67, 0x36, 0x73, 0x45, 0xcc, 0x14, 0x11, 0xd3, 0x38, 0x52,
+	0x34, 0xf8, 0x1a, 0x28, 0xbc, 0xc0, 0xbe, 0x5e, 0xdc, 0xd4, 0x5a, 0x45, 0x6b, 0x35, 0x0c, 0x4c,
+	0xae, 0x22, 0xfe, 0x03, 0xef, 0x82, 0x7c, 0x87, 0xe8, 0x25, 0x61, 0x29, 0x85, 0x81, 0x99, 0xef,
+	0x10, 0x94, 0xef, 0x10, 0xf8, 0x26, 0x58, 0xe9, 0x62, 0x46, 0xf4, 0x55, 0x61, 0x29, 0x87, 0x81,
+	0x29, 0x74, 0x24, 0x7e, 0x61, 0x0b, 0x94, 0x1f, 0x11, 0x8f, 0xb5, 0x6d, 0x8a, 0xf5, 0xb2, 0x18,
+	0x51, 0x0d, 0x03, 0x33, 0xc1, 0x50, 0x22, 0x41, 0x13, 0x14, 0x39, 0x0f, 0x47, 0xaf, 0x6c, 0x6a,
+	0xad, 0xb2, 0x55, 0x09, 0x03, 0x53, 0x02, 0x48, 0x7e, 0x60, 0x13, 0x94, 0xb8, 0x40, 0x7d, 0x1d,
+	0x6c, 0x16, 0x5a, 0x55, 0x0b, 0x84, 0x81, 0x19, 0x21, 0x28, 0xfa, 0xf2, 0xac, 0x0f, 0xc9, 0x18,
+	0xe1, 0x13, 0x4c, 0x31, 0xcf, 0x7a, 0x6d, 0xb6, 0xce, 0x69, 0x1c, 0x29, 0x1a, 0xf7, 0xdc, 0x1e,
+	0x12, 0x1f, 0x3b, 0x7a, 0x55, 0xc4, 0x16, 0x9e, 0x25, 0x82, 0xa2, 0x2f, 0xdf, 0xfa, 0x03, 0x66,
+	0x9f, 0xe2, 0x23, 0xec, 0xf6, 0x07, 0x9c, 0x66, 0x4d, 0x0c, 0x15, 0x5b, 0xaf, 0x18, 0x90, 0xaa,
+	0xc2, 0x11, 0x28, 0xbf, 0xc0, 0xbe, 0xc0, 0xf4, 0x75, 0x41, 0xe7, 0x39, 0x5f, 0x81, 0x18, 0xfb,
+	0xe5, 0x2f, 0xf3, 0xe1, 0xc8, 0x66, 0x83, 0xed, 0x63, 0xb7, 0xbf, 0xb5, 0xe7, 0xb1, 0x0f, 0x52,
+	0xa7, 0xf4, 0xf1, 0x90, 0x12, 0xcf, 0xe9, 0x60, 0x76, 0x4e, 0xe8, 0xe9, 0x36, 0x16, 0xda, 0xbd,
+	0x3e, 0xd9, 0x76, 0x6c, 0x66, 0x6f, 0x59, 0x6e, 0x7f, 0x8f, 0x2f, 0x9d, 0xcf, 0x30, 0x45, 0x89,
+	0x3b, 0xf8, 0x05, 0x58, 0xed, 0x10, 0x19, 0xad, 0x2e, 0xa2, 0xed, 0x87, 0x81, 0x19, 0x43, 0xcb,
+	0x09, 0x16, 0x7b, 0x83, 0x63, 0x50, 0xe1, 0x9b, 0x2c, 0xa3, 0x35, 0x44, 0x34, 0x14, 0x06, 0xe6,
+	0x0c, 0x5c, 0x4e, 0xbc, 0x99, 0x3f, 0xf8, 0x35, 0xa8, 0xc5, 0x07, 0x46, 0x46, 0xdd, 0x10, 0x51,
+	0xbb, 0x7c, 0x17, 0x14, 0xc3, 0x72, 0x22, 0xab, 0x3e, 0xe1, 0x37, 0x60, 0xfd, 0xa9, 0xeb, 0x3d,
+	0x9f, 0x10, 0x3a, 0x19, 0xc9, 0xf0, 0x50, 0x84, 0x3f, 0xe2, 0xf5, 0xa8, 0x5a, 0x96, 0x13, 0x3f,
+	0xe3, 0x54, 0x1c, 0x42, 0xcf, 0x1e, 0xfb, 0x03, 0xc2, 0x64, 0x55, 0xdf, 0x12, 0x55, 0x2d, 0x0f,
+	0x61, 0xda, 0x80, 0x54, 0xb5, 0xf9, 0xa3, 0x06, 0x36, 0x8e, 0x06, 0x2e, 0xc3, 0x1f, 0xb9, 0x3e,
+	0x4b, 0xae, 0xb3, 0x07, 0xa0, 0x91, 0x80, 0xea, 0x8d, 0x76, 0x3b, 0x0c, 0xcc, 0x39, 0x1b, 0x9a,
+	0x43, 0xf8, 0x0d, 0x15, 0x7b, 0x3b, 0x60, 0x36, 0x9b, 0xf8, 0xd1, 0xcd, 0x26, 0x6e, 0x28, 0xd5,
+	0x82, 0x32, 0x7a, 0xf3, 0x4f, 0x0d, 0x34, 0x76, 0x6d, 0xea, 0x3c, 0x21, 0xf4, 0x34, 0xa1, 0xf4,
+	0x21, 0xa8, 0x3f, 0x1e, 0x93, 0xde, 0xe0, 0x90, 0xc4, 0x26, 0xc1, 0xa8, 0x66, 0xdd, 0x0a, 0x03,
+	0x33, 0x6b, 0x42, 0x59, 0x00, 0x3e, 0x01, 0xb0, 0x83, 0xcf, 0x0f, 0xc8, 0x09, 0x3b, 0xb7, 0x29,
+	0xee, 0x62, 0xea, 0xbb, 0xc4, 0x8b, 0x38, 0xdd, 0x0d, 0x03, 0x73, 0x81, 0x15, 0x2d, 0xc0, 0x16,
+	0xe4, 0x55, 0xb8, 0x71, 0x5e, 0x2f, 0x4b, 0xa0, 0xb1, 0x93, 0xf4, 0xa0, 0x36, 0xf1, 0x4e, 0xdc,
+	0x3e, 0xbf, 0x07, 0x3b, 0x93, 0x51, 0x87, 0x38, 0x58, 0x2e, 0x71, 0x41, 0xde, 0x83, 0x31, 0x86,
+	0x12, 0x09, 0xbe, 0x0d, 0x2a, 0xc9, 0xae, 0x0b, 0xe6, 0x45, 0xab, 0xc6, 0x8b, 0x2a, 0x01, 0xd1,
+	0x4c, 0xe4, 0x3b, 0xf8, 0xd4, 0xf5, 0xf6, 0x6d, 0xdf, 0x3f, 0x1c, 0x50, 0xec, 0x0f, 0xc8, 0xd0,
+	0x11, 0x4c, 0x8b, 0x72, 0x07, 0xb3, 0x36, 0x34, 0x87, 0x44, 0x1e, 0x78, 0x85, 0xcd, 0x3c, 0xac,
+	0x28, 0x1e, 0x14, 0x1b, 0x9a, 0x43, 0xe0, 0x19, 0x58, 0x8b, 0x57, 0xe0, 0x09, 0xc6, 0xa2, 0x77,
+	0x54, 0xad, 0xc3, 0x30, 0x30, 0xd3, 0xf0, 0x72, 0xea, 0x21, 0xed, 0x71, 0x41, 0x35, 0x96, 0xfe,
+	0xdf, 0x6a, 0x7c, 0xa9, 0x81, 0x3b, 0xd9, 0xf5, 0x94, 0x44, 0x56, 0x05, 0x91, 0xcf, 0xc3, 0xc0,
+	0x5c, 0x3c, 0x60, 0x39, 0x7c, 0x16, 0xfb, 0x8e, 0x69, 0x29, 0x9b, 0x24, 0x69, 0x95, 0x15, 0x5a,
+	0xf3, 0x03, 0x96, 0x47, 0x6b, 0xde, 0x77, 0xf3, 0x53, 0x50, 0x11, 0x4d, 0xfa, 0x91, 0xcd, 0x6c,
+	0xf8, 0x16, 0x58, 0x55, 0x2f, 0x9c, 0x35, 0xde, 0xa5, 0x22, 0x08, 0xc5, 0x82, 0x52, 0x35, 0xf9,
+	0xd9, 0xeb, 0x61, 0xbe, 0x6a, 0x9a, 0x5f, 0x81, 0x5a, 0xd7, 0x1e, 0xba, 0x8e, 0xcd, 0x88, 0x8c,
+	0xf0, 0x00, 0x80, 0x47, 0x78, 0x88, 0xfb, 0x1c, 0xe0, 0x41, 0x0a, 0xad, 0xb5, 0x77, 0x1a, 0xf2,
+	0x69, 0xb7, 0x95, 0xf0, 0xb0, 0xd6, 0xc3, 0xc0, 0x4c, 0x8d, 0x43, 0x29, 0xf9, 0x3f, 0x04, 0xff,
+	0x43, 0x03, 0x65, 0xee, 0x53, 0x04, 0x96, 0xd3, 0xb8, 0x2a, 0x73, 0x8b, 0xa6, 0xc5, 0x76, 0x94,
+	0x58, 0x79, 0xa5, 0x73, 0xa1, 0x6b, 0x0f, 0x27, 0x58, 0x44, 0xa8, 0xc8, 0x4a, 0x4f, 0x40, 0x34,
+	0x13, 0x45, 0xaf, 0x25, 0x0c, 0xef, 0x93, 0x73, 0x4c, 0xf5, 0x42, 0xaa, 0xd7, 0xc6, 0xe0, 0xb2,
+	0x7a, 0x6d, 0xec, 0xaf, 0xf9, 0x7b, 0x1e, 0x6c, 0x44, 0xcb, 0x81, 0x9d, 0x24, 0xbd, 0xfb, 0xa0,
+	0xba, 0x4f, 0xc9, 0x99, 0xeb, 0x60, 0xca, 0x31, 0x91, 0x62, 0x45, 0xbe, 0xb0, 0xd2, 0x38, 0x52,
+	0x34, 0xde, 0xb7, 0x63, 0x5d, 0x66, 0x90, 0x9f, 0xf5, 0x6d, 0xc5, 0xb0, 0xa4, 0xbe, 0xad, 0xf8,
+	0x84, 0xdf, 0x69, 0xa0, 0xfe, 0xec, 0x0c, 0x53, 0xea, 0x3a, 0x0e, 0xf6, 0x64, 0x2d, 0xc8, 0x25,
+	0xfc, 0x98, 0x77, 0x95, 0x8c, 0x69, 0x39, 0x14, 0xb2, 0x5e, 0x9b, 0x3f, 0x69, 0xa0, 0x2e, 0xa4,
+	0xf6, 0x00, 0xf7, 0x4e, 0xc7, 0xc4, 0xf5, 0x18, 0x7f, 0xf3, 0xca, 0x3e, 0xae, 0x89, 0x3e, 0x2e,
+	0xde, 0xbc, 0x02, 0x40, 0xf2, 0x03, 0x1d, 0x50, 0x9c, 0x1d, 0x8f, 0xaa, 0xd5, 0xe1, 0x03, 0x04,
+	0xb0, 0x1c, 0x92, 0xd2, 0x57, 0xf3, 0x33, 0xd0, 0xc8, 0x30, 0xf3, 0xe1, 0x1e, 0x58, 0x4b, 0xa9,
+	0x51, 0x01, 0xdd, 0x8d, 0x0a, 0x28, 0x33, 0xda, 0xaa, 0xf3, 0xdb, 0x3e, 0x35, 0x1c, 0xa5, 0x95,
+	0xe6, 0xf7, 0x1a, 0xb8, 0xfd, 0x6c, 0x8c, 0xbd, 0xa4, 0x4f, 0x46, 0x4f, 0x93, 0xf9, 0xe7, 0x8c,
+	0x76, 0xb3, 0xe7, 0xcc, 0xdc, 0x9f, 0x9b, 0xfc, 0x4d, 0xfe, 0xdc, 0x34, 0x6d, 0x70, 0x67, 0x11,
+	0x0d, 0x1f, 0xee, 0x82, 0x4a, 0xa2, 0x44, 0x99, 0xbe, 0x11, 0x65, 0xba, 0x68, 0x82, 0xac, 0xd2,
+	0x64, 0x06, 0x9a, 0x89, 0x56, 0xe7, 0xe2, 0xca, 0xc8, 0x5d, 0x5e, 0x19, 0xb9, 0x57, 0x57, 0x86,
+	0xf6, 0xed, 0xd4, 0xd0, 0x7e, 0x9e, 0x1a, 0xda, 0xaf, 0x53, 0x43, 0xbb, 0x98, 0x1a, 0xda, 0xe5,
+	0xd4, 0xd0, 0xfe, 0x9e, 0x1a, 0xda, 0x3f, 0x53, 0x23, 0xf7, 0x6a, 0x6a, 0x68, 0x3f, 0x5c, 0x1b,
+	0xb9, 0x8b, 0x6b, 0x23, 0x77, 0x79, 0x6d, 0xe4, 0x3e, 0xb9, 0xed, 0x7f, 0xe9, 0x33, 0x3c, 0x3a,
+	0x18, 0xd9, 0x94, 0xb5, 0x89, 0xc7, 0xa8, 0xdd, 0x63, 0xfe, 0x71, 0x49, 0xb0, 0x78, 0xf7, 0xdf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x55, 0xcc, 0x66, 0xd5, 0x0e, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -598,6 +943,42 @@ func (this *GeneralProposal) Equal(that interface{}) bool {
 	if this.Closed != that1.Closed {
 		return false
 	}
+	if this.StakeWeighted != that1.StakeWeighted {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.YesStake, that1.YesStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.NoStake, that1.NoStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.VetoStake, that1.VetoStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DontCareStake, that1.DontCareStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MinQuorumStake, that1.MinQuorumStake) {
+			return false
+		}
+	}
+	if this.SnapshotNonce != that1.SnapshotNonce {
+		return false
+	}
 	return true
 }
 func (this *WhiteListProposal) Equal(that interface{}) bool {
@@ -694,6 +1075,24 @@ func (this *GovernanceConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MinQuorumStake, that1.MinQuorumStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MinPassThresholdStake, that1.MinPassThresholdStake) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MinVetoThresholdStake, that1.MinVetoThresholdStake) {
+			return false
+		}
+	}
 	return true
 }
 func (this *VoterData) Equal(that interface{}) bool {
@@ -780,26 +1179,190 @@ func (this *VoteData) Equal(that interface{}) bool {
 	if this.VoteValue != that1.VoteValue {
 		return false
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.VotePower, that1.VotePower) {
+			return false
+		}
+	}
 	return true
 }
-func (this *GeneralProposal) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DelegatedVoteData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&systemSmartContracts.GeneralProposal{")
-	s = append(s, "IssuerAddress: "+fmt.Sprintf("%#v", this.IssuerAddress)+",\n")
-	s = append(s, "GitHubCommit: "+fmt.Sprintf("%#v", this.GitHubCommit)+",\n")
-	s = append(s, "StartVoteNonce: "+fmt.Sprintf("%#v", this.StartVoteNonce)+",\n")
-	s = append(s, "EndVoteNonce: "+fmt.Sprintf("%#v", this.EndVoteNonce)+",\n")
-	s = append(s, "Yes: "+fmt.Sprintf("%#v", this.Yes)+",\n")
-	s = append(s, "No: "+fmt.Sprintf("%#v", this.No)+",\n")
-	s = append(s, "Veto: "+fmt.Sprintf("%#v", this.Veto)+",\n")
-	s = append(s, "DontCare: "+fmt.Sprintf("%#v", this.DontCare)+",\n")
-	s = append(s, "Voted: "+fmt.Sprintf("%#v", this.Voted)+",\n")
-	s = append(s, "Voters: "+fmt.Sprintf("%#v", this.Voters)+",\n")
+
+	that1, ok := that.(*DelegatedVoteData)
+	if !ok {
+		that2, ok := that.(DelegatedVoteData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProviderVote != that1.ProviderVote {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.ProviderPower, that1.ProviderPower) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.OverriddenStake, that1.OverriddenStake) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StakeCheckpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakeCheckpoint)
+	if !ok {
+		that2, ok := that.(StakeCheckpoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StakeCheckpoints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakeCheckpoints)
+	if !ok {
+		that2, ok := that.(StakeCheckpoints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Checkpoints) != len(that1.Checkpoints) {
+		return false
+	}
+	for i := range this.Checkpoints {
+		if !this.Checkpoints[i].Equal(that1.Checkpoints[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OpenProposalSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OpenProposalSnapshot)
+	if !ok {
+		that2, ok := that.(OpenProposalSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SnapshotNonce != that1.SnapshotNonce {
+		return false
+	}
+	if this.EndVoteNonce != that1.EndVoteNonce {
+		return false
+	}
+	return true
+}
+func (this *OpenProposalSnapshots) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OpenProposalSnapshots)
+	if !ok {
+		that2, ok := that.(OpenProposalSnapshots)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return false
+	}
+	for i := range this.Snapshots {
+		if !this.Snapshots[i].Equal(that1.Snapshots[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GeneralProposal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 23)
+	s = append(s, "&systemSmartContracts.GeneralProposal{")
+	s = append(s, "IssuerAddress: "+fmt.Sprintf("%#v", this.IssuerAddress)+",\n")
+	s = append(s, "GitHubCommit: "+fmt.Sprintf("%#v", this.GitHubCommit)+",\n")
+	s = append(s, "StartVoteNonce: "+fmt.Sprintf("%#v", this.StartVoteNonce)+",\n")
+	s = append(s, "EndVoteNonce: "+fmt.Sprintf("%#v", this.EndVoteNonce)+",\n")
+	s = append(s, "Yes: "+fmt.Sprintf("%#v", this.Yes)+",\n")
+	s = append(s, "No: "+fmt.Sprintf("%#v", this.No)+",\n")
+	s = append(s, "Veto: "+fmt.Sprintf("%#v", this.Veto)+",\n")
+	s = append(s, "DontCare: "+fmt.Sprintf("%#v", this.DontCare)+",\n")
+	s = append(s, "Voted: "+fmt.Sprintf("%#v", this.Voted)+",\n")
+	s = append(s, "Voters: "+fmt.Sprintf("%#v", this.Voters)+",\n")
 	s = append(s, "TopReference: "+fmt.Sprintf("%#v", this.TopReference)+",\n")
 	s = append(s, "Closed: "+fmt.Sprintf("%#v", this.Closed)+",\n")
+	s = append(s, "StakeWeighted: "+fmt.Sprintf("%#v", this.StakeWeighted)+",\n")
+	s = append(s, "YesStake: "+fmt.Sprintf("%#v", this.YesStake)+",\n")
+	s = append(s, "NoStake: "+fmt.Sprintf("%#v", this.NoStake)+",\n")
+	s = append(s, "VetoStake: "+fmt.Sprintf("%#v", this.VetoStake)+",\n")
+	s = append(s, "DontCareStake: "+fmt.Sprintf("%#v", this.DontCareStake)+",\n")
+	s = append(s, "MinQuorumStake: "+fmt.Sprintf("%#v", this.MinQuorumStake)+",\n")
+	s = append(s, "SnapshotNonce: "+fmt.Sprintf("%#v", this.SnapshotNonce)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -830,13 +1393,16 @@ func (this *GovernanceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 12)
 	s = append(s, "&systemSmartContracts.GovernanceConfig{")
 	s = append(s, "NumNodes: "+fmt.Sprintf("%#v", this.NumNodes)+",\n")
 	s = append(s, "MinQuorum: "+fmt.Sprintf("%#v", this.MinQuorum)+",\n")
 	s = append(s, "MinPassThreshold: "+fmt.Sprintf("%#v", this.MinPassThreshold)+",\n")
 	s = append(s, "MinVetoThreshold: "+fmt.Sprintf("%#v", this.MinVetoThreshold)+",\n")
 	s = append(s, "ProposalFee: "+fmt.Sprintf("%#v", this.ProposalFee)+",\n")
+	s = append(s, "MinQuorumStake: "+fmt.Sprintf("%#v", this.MinQuorumStake)+",\n")
+	s = append(s, "MinPassThresholdStake: "+fmt.Sprintf("%#v", this.MinPassThresholdStake)+",\n")
+	s = append(s, "MinVetoThresholdStake: "+fmt.Sprintf("%#v", this.MinVetoThresholdStake)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -868,10 +1434,69 @@ func (this *VoteData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&systemSmartContracts.VoteData{")
 	s = append(s, "NumVotes: "+fmt.Sprintf("%#v", this.NumVotes)+",\n")
 	s = append(s, "VoteValue: "+fmt.Sprintf("%#v", this.VoteValue)+",\n")
+	s = append(s, "VotePower: "+fmt.Sprintf("%#v", this.VotePower)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegatedVoteData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&systemSmartContracts.DelegatedVoteData{")
+	s = append(s, "ProviderVote: "+fmt.Sprintf("%#v", this.ProviderVote)+",\n")
+	s = append(s, "ProviderPower: "+fmt.Sprintf("%#v", this.ProviderPower)+",\n")
+	s = append(s, "OverriddenStake: "+fmt.Sprintf("%#v", this.OverriddenStake)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StakeCheckpoint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.StakeCheckpoint{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StakeCheckpoints) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.StakeCheckpoints{")
+	if this.Checkpoints != nil {
+		s = append(s, "Checkpoints: "+fmt.Sprintf("%#v", this.Checkpoints)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OpenProposalSnapshot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.OpenProposalSnapshot{")
+	s = append(s, "SnapshotNonce: "+fmt.Sprintf("%#v", this.SnapshotNonce)+",\n")
+	s = append(s, "EndVoteNonce: "+fmt.Sprintf("%#v", this.EndVoteNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OpenProposalSnapshots) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.OpenProposalSnapshots{")
+	if this.Snapshots != nil {
+		s = append(s, "Snapshots: "+fmt.Sprintf("%#v", this.Snapshots)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -903,6 +1528,84 @@ func (m *GeneralProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SnapshotNonce != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.SnapshotNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MinQuorumStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MinQuorumStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.DontCareStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DontCareStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.VetoStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.VetoStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.NoStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.NoStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.YesStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.YesStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.StakeWeighted {
+		i--
+		if m.StakeWeighted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Closed {
 		i--
 		if m.Closed {
@@ -1085,6 +1788,39 @@ func (m *GovernanceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MinVetoThresholdStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MinVetoThresholdStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MinPassThresholdStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MinPassThresholdStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MinQuorumStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MinQuorumStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.ProposalFee)
@@ -1216,11 +1952,22 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.VoteValue) > 0 {
-		i -= len(m.VoteValue)
-		copy(dAtA[i:], m.VoteValue)
-		i = encodeVarintGovernance(dAtA, i, uint64(len(m.VoteValue)))
-		i--
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.VotePower)
+		i -= size
+		if _, err := __caster.MarshalTo(m.VotePower, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VoteValue) > 0 {
+		i -= len(m.VoteValue)
+		copy(dAtA[i:], m.VoteValue)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.VoteValue)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.NumVotes != 0 {
@@ -1231,6 +1978,204 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatedVoteData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedVoteData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.OverriddenStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.OverriddenStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.ProviderPower)
+		i -= size
+		if _, err := __caster.MarshalTo(m.ProviderPower, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderVote) > 0 {
+		i -= len(m.ProviderVote)
+		copy(dAtA[i:], m.ProviderVote)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.ProviderVote)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Nonce != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeCheckpoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeCheckpoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeCheckpoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenProposalSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenProposalSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenProposalSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndVoteNonce != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.EndVoteNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SnapshotNonce != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.SnapshotNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenProposalSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenProposalSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenProposalSnapshots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1290,6 +2235,37 @@ func (m *GeneralProposal) Size() (n int) {
 	if m.Closed {
 		n += 2
 	}
+	if m.StakeWeighted {
+		n += 2
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.YesStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.NoStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.VetoStake)
+		n += 2 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.DontCareStake)
+		n += 2 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MinQuorumStake)
+		n += 2 + l + sovGovernance(uint64(l))
+	}
+	if m.SnapshotNonce != 0 {
+		n += 2 + sovGovernance(uint64(m.SnapshotNonce))
+	}
 	return n
 }
 
@@ -1353,6 +2329,21 @@ func (m *GovernanceConfig) Size() (n int) {
 		l = __caster.Size(m.ProposalFee)
 		n += 1 + l + sovGovernance(uint64(l))
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MinQuorumStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MinPassThresholdStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MinVetoThresholdStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
 	return n
 }
 
@@ -1403,39 +2394,136 @@ func (m *VoteData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGovernance(uint64(l))
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.VotePower)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
 	return n
 }
 
-func sovGovernance(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGovernance(x uint64) (n int) {
-	return sovGovernance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *GeneralProposal) String() string {
-	if this == nil {
-		return "nil"
+func (m *DelegatedVoteData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GeneralProposal{`,
-		`IssuerAddress:` + fmt.Sprintf("%v", this.IssuerAddress) + `,`,
-		`GitHubCommit:` + fmt.Sprintf("%v", this.GitHubCommit) + `,`,
-		`StartVoteNonce:` + fmt.Sprintf("%v", this.StartVoteNonce) + `,`,
-		`EndVoteNonce:` + fmt.Sprintf("%v", this.EndVoteNonce) + `,`,
-		`Yes:` + fmt.Sprintf("%v", this.Yes) + `,`,
-		`No:` + fmt.Sprintf("%v", this.No) + `,`,
-		`Veto:` + fmt.Sprintf("%v", this.Veto) + `,`,
-		`DontCare:` + fmt.Sprintf("%v", this.DontCare) + `,`,
-		`Voted:` + fmt.Sprintf("%v", this.Voted) + `,`,
-		`Voters:` + fmt.Sprintf("%v", this.Voters) + `,`,
-		`TopReference:` + fmt.Sprintf("%v", this.TopReference) + `,`,
-		`Closed:` + fmt.Sprintf("%v", this.Closed) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.ProviderVote)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.ProviderPower)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.OverriddenStake)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
 }
-func (this *WhiteListProposal) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StakeCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGovernance(uint64(m.Nonce))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func (m *StakeCheckpoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpenProposalSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotNonce != 0 {
+		n += 1 + sovGovernance(uint64(m.SnapshotNonce))
+	}
+	if m.EndVoteNonce != 0 {
+		n += 1 + sovGovernance(uint64(m.EndVoteNonce))
+	}
+	return n
+}
+
+func (m *OpenProposalSnapshots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGovernance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovernance(x uint64) (n int) {
+	return sovGovernance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GeneralProposal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneralProposal{`,
+		`IssuerAddress:` + fmt.Sprintf("%v", this.IssuerAddress) + `,`,
+		`GitHubCommit:` + fmt.Sprintf("%v", this.GitHubCommit) + `,`,
+		`StartVoteNonce:` + fmt.Sprintf("%v", this.StartVoteNonce) + `,`,
+		`EndVoteNonce:` + fmt.Sprintf("%v", this.EndVoteNonce) + `,`,
+		`Yes:` + fmt.Sprintf("%v", this.Yes) + `,`,
+		`No:` + fmt.Sprintf("%v", this.No) + `,`,
+		`Veto:` + fmt.Sprintf("%v", this.Veto) + `,`,
+		`DontCare:` + fmt.Sprintf("%v", this.DontCare) + `,`,
+		`Voted:` + fmt.Sprintf("%v", this.Voted) + `,`,
+		`Voters:` + fmt.Sprintf("%v", this.Voters) + `,`,
+		`TopReference:` + fmt.Sprintf("%v", this.TopReference) + `,`,
+		`Closed:` + fmt.Sprintf("%v", this.Closed) + `,`,
+		`StakeWeighted:` + fmt.Sprintf("%v", this.StakeWeighted) + `,`,
+		`YesStake:` + fmt.Sprintf("%v", this.YesStake) + `,`,
+		`NoStake:` + fmt.Sprintf("%v", this.NoStake) + `,`,
+		`VetoStake:` + fmt.Sprintf("%v", this.VetoStake) + `,`,
+		`DontCareStake:` + fmt.Sprintf("%v", this.DontCareStake) + `,`,
+		`MinQuorumStake:` + fmt.Sprintf("%v", this.MinQuorumStake) + `,`,
+		`SnapshotNonce:` + fmt.Sprintf("%v", this.SnapshotNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WhiteListProposal) String() string {
+	if this == nil {
+		return "nil"
 	}
 	s := strings.Join([]string{`&WhiteListProposal{`,
 		`WhiteListAddress:` + fmt.Sprintf("%v", this.WhiteListAddress) + `,`,
@@ -1466,6 +2554,9 @@ func (this *GovernanceConfig) String() string {
 		`MinPassThreshold:` + fmt.Sprintf("%v", this.MinPassThreshold) + `,`,
 		`MinVetoThreshold:` + fmt.Sprintf("%v", this.MinVetoThreshold) + `,`,
 		`ProposalFee:` + fmt.Sprintf("%v", this.ProposalFee) + `,`,
+		`MinQuorumStake:` + fmt.Sprintf("%v", this.MinQuorumStake) + `,`,
+		`MinPassThresholdStake:` + fmt.Sprintf("%v", this.MinPassThresholdStake) + `,`,
+		`MinVetoThresholdStake:` + fmt.Sprintf("%v", this.MinVetoThresholdStake) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1504,6 +2595,71 @@ func (this *VoteData) String() string {
 	s := strings.Join([]string{`&VoteData{`,
 		`NumVotes:` + fmt.Sprintf("%v", this.NumVotes) + `,`,
 		`VoteValue:` + fmt.Sprintf("%v", this.VoteValue) + `,`,
+		`VotePower:` + fmt.Sprintf("%v", this.VotePower) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegatedVoteData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegatedVoteData{`,
+		`ProviderVote:` + fmt.Sprintf("%v", this.ProviderVote) + `,`,
+		`ProviderPower:` + fmt.Sprintf("%v", this.ProviderPower) + `,`,
+		`OverriddenStake:` + fmt.Sprintf("%v", this.OverriddenStake) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StakeCheckpoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StakeCheckpoint{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StakeCheckpoints) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCheckpoints := "[]*StakeCheckpoint{"
+	for _, f := range this.Checkpoints {
+		repeatedStringForCheckpoints += strings.Replace(f.String(), "StakeCheckpoint", "StakeCheckpoint", 1) + ","
+	}
+	repeatedStringForCheckpoints += "}"
+	s := strings.Join([]string{`&StakeCheckpoints{`,
+		`Checkpoints:` + repeatedStringForCheckpoints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenProposalSnapshot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OpenProposalSnapshot{`,
+		`SnapshotNonce:` + fmt.Sprintf("%v", this.SnapshotNonce) + `,`,
+		`EndVoteNonce:` + fmt.Sprintf("%v", this.EndVoteNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenProposalSnapshots) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSnapshots := "[]*OpenProposalSnapshot{"
+	for _, f := range this.Snapshots {
+		repeatedStringForSnapshots += strings.Replace(f.String(), "OpenProposalSnapshot", "OpenProposalSnapshot", 1) + ","
+	}
+	repeatedStringForSnapshots += "}"
+	s := strings.Join([]string{`&OpenProposalSnapshots{`,
+		`Snapshots:` + repeatedStringForSnapshots + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1833,64 +2989,1066 @@ func (m *GeneralProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Closed = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGovernance(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeighted", wireType)
 			}
-			if skippy < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeighted = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGovernance
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGovernance
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhiteListProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGovernance
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.YesStake = tmp
+				}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.NoStake = tmp
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhiteListProposal: wiretype end group for non-group")
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.VetoStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DontCareStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DontCareStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorumStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MinQuorumStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotNonce", wireType)
+			}
+			m.SnapshotNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhiteListProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhiteListProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhiteListProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhiteListAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhiteListAddress = append(m.WhiteListAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.WhiteListAddress == nil {
+				m.WhiteListAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalStatus = append(m.ProposalStatus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalStatus == nil {
+				m.ProposalStatus = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardForkProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardForkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochToHardFork", wireType)
+			}
+			m.EpochToHardFork = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochToHardFork |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSoftwareVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSoftwareVersion = append(m.NewSoftwareVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewSoftwareVersion == nil {
+				m.NewSoftwareVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalStatus = append(m.ProposalStatus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalStatus == nil {
+				m.ProposalStatus = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorum", wireType)
+			}
+			m.MinQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQuorum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPassThreshold", wireType)
+			}
+			m.MinPassThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPassThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVetoThreshold", wireType)
+			}
+			m.MinVetoThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVetoThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.ProposalFee = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorumStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MinQuorumStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPassThresholdStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MinPassThresholdStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVetoThresholdStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MinVetoThresholdStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, &VoterData{})
+			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhiteListProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
+			}
+			m.NumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhiteListAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteValue", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -1900,29 +4058,27 @@ func (m *WhiteListProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGovernance
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGovernance
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhiteListAddress = append(m.WhiteListAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.WhiteListAddress == nil {
-				m.WhiteListAddress = []byte{}
-			}
+			m.VoteValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePower", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1949,9 +4105,13 @@ func (m *WhiteListProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalStatus = append(m.ProposalStatus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalStatus == nil {
-				m.ProposalStatus = []byte{}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.VotePower = tmp
+				}
 			}
 			iNdEx = postIndex
 		default:
@@ -1978,7 +4138,7 @@ func (m *WhiteListProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
+func (m *DelegatedVoteData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2001,17 +4161,17 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HardForkProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatedVoteData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HardForkProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatedVoteData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochToHardFork", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderVote", wireType)
 			}
-			m.EpochToHardFork = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2021,14 +4181,27 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochToHardFork |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderVote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSoftwareVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2055,14 +4228,18 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewSoftwareVersion = append(m.NewSoftwareVersion[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewSoftwareVersion == nil {
-				m.NewSoftwareVersion = []byte{}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.ProviderPower = tmp
+				}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverriddenStake", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,9 +4266,13 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalStatus = append(m.ProposalStatus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalStatus == nil {
-				m.ProposalStatus = []byte{}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.OverriddenStake = tmp
+				}
 			}
 			iNdEx = postIndex
 		default:
@@ -2118,7 +4299,7 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
+func (m *StakeCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2141,17 +4322,17 @@ func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernanceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernanceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.NumNodes = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2161,71 +4342,14 @@ func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumNodes |= int64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorum", wireType)
-			}
-			m.MinQuorum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGovernance
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinQuorum |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPassThreshold", wireType)
-			}
-			m.MinPassThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGovernance
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinPassThreshold |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVetoThreshold", wireType)
-			}
-			m.MinVetoThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGovernance
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinVetoThreshold |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2257,7 +4381,7 @@ func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
 				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				} else {
-					m.ProposalFee = tmp
+					m.Value = tmp
 				}
 			}
 			iNdEx = postIndex
@@ -2285,7 +4409,7 @@ func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterData) Unmarshal(dAtA []byte) error {
+func (m *StakeCheckpoints) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,17 +4432,17 @@ func (m *VoterData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterData: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakeCheckpoints: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakeCheckpoints: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2328,45 +4452,26 @@ func (m *VoterData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGovernance
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGovernance
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Checkpoints = append(m.Checkpoints, &StakeCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
-			}
-			m.NumNodes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGovernance
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumNodes |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovernance(dAtA[iNdEx:])
@@ -2391,7 +4496,7 @@ func (m *VoterData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorData) Unmarshal(dAtA []byte) error {
+func (m *OpenProposalSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2414,17 +4519,17 @@ func (m *ValidatorData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorData: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenProposalSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenProposalSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotNonce", wireType)
 			}
-			var msglen int
+			m.SnapshotNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2434,31 +4539,16 @@ func (m *ValidatorData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SnapshotNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGovernance
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGovernance
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegators = append(m.Delegators, &VoterData{})
-			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVoteNonce", wireType)
 			}
-			m.NumNodes = 0
+			m.EndVoteNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2468,7 +4558,7 @@ func (m *ValidatorData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumNodes |= int32(b&0x7F) << shift
+				m.EndVoteNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2497,7 +4587,7 @@ func (m *ValidatorData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteData) Unmarshal(dAtA []byte) error {
+func (m *OpenProposalSnapshots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,36 +4610,17 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteData: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenProposalSnapshots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenProposalSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
-			}
-			m.NumVotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGovernance
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumVotes |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGovernance
@@ -2559,23 +4630,25 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGovernance
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGovernance
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteValue = string(dAtA[iNdEx:postIndex])
+			m.Snapshots = append(m.Snapshots, &OpenProposalSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
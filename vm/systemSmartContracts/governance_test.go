@@ -3,6 +3,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -28,6 +29,8 @@ func createMockGovernanceArgs() ArgsNewGovernanceContract {
 			MinQuorum:        2,
 			MinVetoThreshold: 2,
 			ProposalCost:     "100",
+
+			StakeWeightedVotingEnableEpoch: 10,
 		},
 		ESDTSCAddress:       nil,
 		Marshalizer:         &mock.MarshalizerMock{},
@@ -665,3 +668,337 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func TestNewGovernanceContract_InvalidStakeThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.GovernanceConfig.MinQuorumStake = "-1"
+
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.True(t, errors.Is(err, vm.ErrInvalidStakeThreshold))
+}
+
+func TestGovernanceContract_ExecuteChangeStakeConfigNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	gsc, _ := NewGovernanceContract(args)
+	gsc.EpochConfirmed(1)
+
+	callInput := createVMInput(big.NewInt(0), "changeStakeConfig", gsc.ownerAddress, []byte("addr2"))
+	callInput.Arguments = [][]byte{[]byte("1"), []byte("1"), []byte("1")}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_ExecuteChangeStakeConfigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := []byte("address")
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			if bytes.Equal(key, []byte(governanceConfigKey)) {
+				configBytes, _ := json.Marshal(&GovernanceConfig{})
+				return configBytes
+			}
+
+			return nil
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			if bytes.Equal(key, []byte(governanceConfigKey)) {
+				scConfig := &GovernanceConfig{}
+				_ = json.Unmarshal(value, scConfig)
+				require.Equal(t, big.NewInt(1000), scConfig.MinQuorumStake)
+				require.Equal(t, big.NewInt(500), scConfig.MinPassThresholdStake)
+				require.Equal(t, big.NewInt(100), scConfig.MinVetoThresholdStake)
+			}
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+	gsc.EpochConfirmed(10)
+	gsc.ownerAddress = callerAddress
+
+	callInput := createVMInput(big.NewInt(0), "changeStakeConfig", callerAddress, []byte("addr2"))
+	callInput.Arguments = [][]byte{[]byte("1000"), []byte("500"), []byte("100")}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestGovernanceContract_GetConfigFillsMissingStakeThresholds(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.GovernanceConfig.MinQuorumStake = "1000"
+	args.GovernanceConfig.MinPassThresholdStake = "500"
+	args.GovernanceConfig.MinVetoThresholdStake = "100"
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			configBytes, _ := json.Marshal(&GovernanceConfig{MinQuorum: 2})
+			return configBytes
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	scConfig, err := gsc.getConfig()
+	require.Nil(t, err)
+	require.Equal(t, int32(2), scConfig.MinQuorum)
+	require.Equal(t, big.NewInt(1000), scConfig.MinQuorumStake)
+	require.Equal(t, big.NewInt(500), scConfig.MinPassThresholdStake)
+	require.Equal(t, big.NewInt(100), scConfig.MinVetoThresholdStake)
+}
+
+// Test Scenario
+// A stake weighted proposal is voted by a direct staker, by a delegation contract owner and by one of the delegators
+// 1. Init governance smart contract and white list an address at genesis
+// 2. Enable stake weighted voting and open a proposal with its own min quorum stake
+// 3. Vote yes with a direct staker (400) and no with the delegation contract owner (1000 total delegated stake)
+// 4. One delegator (300) overrides the owner's vote with yes
+// 5. Close the proposal: yes has 700 and no has 700, the proposal passes as yes is over the pass threshold
+func TestGovernanceContract_StakeWeightedVoting(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		atArgParser,
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	args.ValidatorSCAddress = []byte("validatorSC")
+	args.GovernanceConfig.MinQuorumStake = "100000"
+	args.GovernanceConfig.MinPassThresholdStake = "650"
+	args.GovernanceConfig.MinVetoThresholdStake = "1000"
+
+	stakerAddress := []byte("stakerAddress")
+	delegationAddress := []byte("delegationSC1")
+	delegationOwner := []byte("delegOwnerAdr")
+	delegatorAddress := []byte("delegatorAddr")
+
+	validatorDataBytes, _ := json.Marshal(&ValidatorDataV2{TotalStakeValue: big.NewInt(400)})
+	eei.SetStorageForAddress(args.ValidatorSCAddress, stakerAddress, validatorDataBytes)
+	validatorDataBytes, _ = json.Marshal(&ValidatorDataV2{TotalStakeValue: big.NewInt(1000)})
+	eei.SetStorageForAddress(args.ValidatorSCAddress, delegationAddress, validatorDataBytes)
+
+	delegationConfigBytes, _ := json.Marshal(&DelegationConfig{})
+	eei.SetStorageForAddress(delegationAddress, []byte(delegationConfigKey), delegationConfigBytes)
+	eei.SetStorageForAddress(delegationAddress, []byte(ownerKey), delegationOwner)
+	fundKey := []byte("fund1")
+	delegatorDataBytes, _ := json.Marshal(&DelegatorData{ActiveFund: fundKey})
+	eei.SetStorageForAddress(delegationAddress, delegatorAddress, delegatorDataBytes)
+	fundBytes, _ := json.Marshal(&Fund{Value: big.NewInt(300)})
+	eei.SetStorageForAddress(delegationAddress, fundKey, fundBytes)
+
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	recipientAddr := []byte("recipientAddress")
+	genesisWLAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+
+	gsc.EpochConfirmed(10)
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	callInput := createVMInput(big.NewInt(100), "proposal", genesisWLAddr, recipientAddr)
+	callInput.Arguments = [][]byte{
+		gitHubCommit,
+		[]byte(fmt.Sprintf("%d", startNonce)),
+		[]byte(fmt.Sprintf("%d", stopNonce)),
+		[]byte("1400"),
+	}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, stakerAddress, gitHubCommit, recipientAddr, "yes")
+	voteProposalForDelegation(t, gsc, delegationOwner, delegationAddress, gitHubCommit, recipientAddr, "no")
+	voteProposalForDelegation(t, gsc, delegatorAddress, delegationAddress, gitHubCommit, recipientAddr, "yes")
+
+	generalProposal, _ := gsc.getGeneralProposal(gitHubCommit)
+	require.True(t, generalProposal.StakeWeighted)
+	require.Equal(t, big.NewInt(700), generalProposal.YesStake)
+	require.Equal(t, big.NewInt(700), generalProposal.NoStake)
+	require.Equal(t, big.NewInt(1400), generalProposal.MinQuorumStake)
+
+	// an address without stake can not vote
+	callInput = createVMInput(big.NewInt(0), "vote", []byte("nobody"), recipientAddr)
+	callInput.Arguments = [][]byte{gitHubCommit, []byte("yes")}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, genesisWLAddr, gitHubCommit, recipientAddr)
+
+	generalProposal, _ = gsc.getGeneralProposal(gitHubCommit)
+	require.True(t, generalProposal.Closed)
+	require.True(t, generalProposal.Voted)
+}
+
+func voteProposalForDelegation(t *testing.T, g *governanceContract, voterAddr, delegationAddr, propAddr, recipientAddr []byte, vote string) {
+	callInput := createVMInput(big.NewInt(0), "vote", voterAddr, recipientAddr)
+	callInput.Arguments = [][]byte{
+		propAddr,
+		[]byte(vote),
+		delegationAddr,
+	}
+	retCode := g.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestGovernanceContract_StakeWeightedVotingShouldUseTheStakeAtTheSnapshotNonce(t *testing.T) {
+	t.Parallel()
+
+	currentNonce := uint64(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return currentNonce
+		},
+	}
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		atArgParser,
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	args := createMockGovernanceArgs()
+	args.ValidatorSCAddress = []byte("validatorSC")
+	args.Eei = eei
+	marshalizer := args.Marshalizer
+
+	firstStaker := []byte("firstStaker")
+	secondStaker := []byte("secondStaker")
+	delegationAddress := []byte("delegationSC1")
+	firstDelegator := []byte("delegatorAdr1")
+	secondDelegator := []byte("delegatorAdr2")
+
+	setStake := func(address []byte, oldValue int64, newValue int64) {
+		eei.SetSCAddress(args.ValidatorSCAddress)
+		require.Nil(t, saveStakeCheckpoint(eei, marshalizer, args.GasCost, args.GovernanceSCAddress, address, big.NewInt(oldValue), big.NewInt(newValue)))
+		validatorDataBytes, _ := json.Marshal(&ValidatorDataV2{TotalStakeValue: big.NewInt(newValue)})
+		eei.SetStorage(address, validatorDataBytes)
+	}
+	setDelegatedFund := func(delegator []byte, oldValue int64, newValue int64) {
+		eei.SetSCAddress(delegationAddress)
+		require.Nil(t, saveStakeCheckpoint(eei, marshalizer, args.GasCost, args.GovernanceSCAddress, delegator, big.NewInt(oldValue), big.NewInt(newValue)))
+		fundKey := append([]byte("fund"), delegator...)
+		delegatorDataBytes, _ := json.Marshal(&DelegatorData{ActiveFund: fundKey})
+		eei.SetStorage(delegator, delegatorDataBytes)
+		fundBytes, _ := json.Marshal(&Fund{Value: big.NewInt(newValue)})
+		eei.SetStorage(fundKey, fundBytes)
+	}
+
+	delegationConfigBytes, _ := json.Marshal(&DelegationConfig{})
+	eei.SetStorageForAddress(delegationAddress, []byte(delegationConfigKey), delegationConfigBytes)
+	eei.SetStorageForAddress(delegationAddress, []byte(ownerKey), []byte("delegOwnerAdr"))
+	currentNonce = 5
+	setStake(firstStaker, 0, 400)
+	setDelegatedFund(firstDelegator, 0, 300)
+
+	currentNonce = 0
+	eei.SetSCAddress([]byte("addr"))
+	gsc, _ := NewGovernanceContract(args)
+	recipientAddr := []byte("recipientAddress")
+	genesisWLAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+	gsc.EpochConfirmed(10)
+
+	currentNonce = 10
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, gitHubCommit, 100, 1000)
+	generalProposal, _ := gsc.getGeneralProposal(gitHubCommit)
+	require.Equal(t, uint64(10), generalProposal.SnapshotNonce)
+
+	// the stake is moved after the proposal was created, in the same block and in a later one
+	setStake(firstStaker, 400, 0)
+	setStake(secondStaker, 0, 400)
+	currentNonce = 50
+	setDelegatedFund(firstDelegator, 300, 0)
+	setDelegatedFund(secondDelegator, 0, 300)
+
+	eei.SetSCAddress([]byte("addr"))
+	currentNonce = 101
+	voteProposal(t, gsc, firstStaker, gitHubCommit, recipientAddr, "yes")
+	voteProposalForDelegation(t, gsc, firstDelegator, delegationAddress, gitHubCommit, recipientAddr, "yes")
+
+	for _, voter := range [][]byte{secondStaker, secondDelegator} {
+		callInput := createVMInput(big.NewInt(0), "vote", voter, recipientAddr)
+		callInput.Arguments = [][]byte{gitHubCommit, []byte("no")}
+		if bytes.Equal(voter, secondDelegator) {
+			callInput.Arguments = append(callInput.Arguments, delegationAddress)
+		}
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.True(t, strings.Contains(eei.returnMessage, vm.ErrNoVotingPower.Error()))
+	}
+
+	generalProposal, _ = gsc.getGeneralProposal(gitHubCommit)
+	require.Equal(t, big.NewInt(700), generalProposal.YesStake)
+	require.Equal(t, big.NewInt(0), generalProposal.NoStake)
+}
+
+func TestGovernanceContract_StakeWeightedProposalsShouldRecordTheOpenSnapshots(t *testing.T) {
+	t.Parallel()
+
+	currentNonce := uint64(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return currentNonce
+		},
+	}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	eei.SetSCAddress([]byte("addr"))
+	gsc, _ := NewGovernanceContract(args)
+	recipientAddr := []byte("recipientAddress")
+	genesisWLAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+	gsc.EpochConfirmed(10)
+
+	currentNonce = 10
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, []byte("0123456789012345678901234567890123456789"), 10, 20)
+	currentNonce = 15
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, []byte("1123456789012345678901234567890123456789"), 100, 1000)
+	currentNonce = 21
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, []byte("2123456789012345678901234567890123456789"), 21, 30)
+
+	snapshots, err := unmarshalOpenProposalSnapshots(args.Marshalizer, eei.GetStorage([]byte(openProposalSnapshotsKey)))
+	require.Nil(t, err)
+	require.Equal(t, []*OpenProposalSnapshot{
+		{SnapshotNonce: 15, EndVoteNonce: 1000},
+		{SnapshotNonce: 21, EndVoteNonce: 30},
+	}, snapshots.Snapshots)
+
+	oldestSnapshotNonce, err := oldestOpenSnapshotNonce(eei, args.Marshalizer, eei.scAddress)
+	require.Nil(t, err)
+	require.Equal(t, uint64(15), oldestSnapshotNonce)
+}
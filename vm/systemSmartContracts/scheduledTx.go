@@ -0,0 +1,575 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. scheduledTx.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const scheduledTxQueueKey = "scheduledTxQueue"
+const scheduledTxPrefix = "scheduledTx"
+const pendingPerSenderPrefix = "pendingPerSender"
+
+var metachainShardIdentifier = []byte{255}
+
+type scheduledTxSC struct {
+	eei                    vm.SystemEI
+	scheduledTxSCAddress   []byte
+	endOfEpochAddress      []byte
+	gasCost                vm.GasCost
+	marshalizer            marshal.Marshalizer
+	enabledEpoch           uint32
+	flagEnabled            atomic.Flag
+	maxPendingTxs          uint32
+	maxPendingTxsPerSender uint32
+	maxRoundsInFuture      uint64
+	maxExecutionsPerCall   uint32
+	depositPerTx           *big.Int
+	mutExecution           sync.RWMutex
+}
+
+// ArgsNewScheduledTxSC defines the arguments to create the scheduled transactions system smart contract
+type ArgsNewScheduledTxSC struct {
+	ScheduledTxSCConfig  config.ScheduledTxSystemSCConfig
+	Eei                  vm.SystemEI
+	ScheduledTxSCAddress []byte
+	EndOfEpochAddress    []byte
+	GasCost              vm.GasCost
+	Marshalizer          marshal.Marshalizer
+	EpochNotifier        vm.EpochNotifier
+}
+
+// NewScheduledTxSystemSC creates a new scheduled transactions system SC. The contract escrows the value, the fee for
+// the gas limit and a deposit of a transaction until its target round. Due transactions are released by the metachain
+// block processing, in ascending (target round, id) order, as smart contract results.
+func NewScheduledTxSystemSC(args ArgsNewScheduledTxSC) (*scheduledTxSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.ScheduledTxSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for scheduled tx sc address", vm.ErrInvalidAddress)
+	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, fmt.Errorf("%w for end of epoch address", vm.ErrInvalidAddress)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+	if args.ScheduledTxSCConfig.MaxPendingTxs == 0 {
+		return nil, vm.ErrInvalidMaxPendingScheduledTxs
+	}
+	if args.ScheduledTxSCConfig.MaxPendingTxsPerSender == 0 {
+		return nil, vm.ErrInvalidMaxPendingScheduledTxsPerSender
+	}
+	if args.ScheduledTxSCConfig.MaxRoundsInFuture == 0 {
+		return nil, vm.ErrInvalidMaxRoundsInFuture
+	}
+	if args.ScheduledTxSCConfig.MaxExecutionsPerCall == 0 {
+		return nil, vm.ErrInvalidMaxExecutionsPerCall
+	}
+	depositPerTx, okConvert := big.NewInt(0).SetString(args.ScheduledTxSCConfig.DepositPerTx, conversionBase)
+	if !okConvert || depositPerTx.Cmp(zero) < 0 {
+		return nil, vm.ErrInvalidScheduledTxDeposit
+	}
+
+	s := &scheduledTxSC{
+		eei:                    args.Eei,
+		scheduledTxSCAddress:   args.ScheduledTxSCAddress,
+		endOfEpochAddress:      args.EndOfEpochAddress,
+		gasCost:                args.GasCost,
+		marshalizer:            args.Marshalizer,
+		enabledEpoch:           args.ScheduledTxSCConfig.EnabledEpoch,
+		maxPendingTxs:          args.ScheduledTxSCConfig.MaxPendingTxs,
+		maxPendingTxsPerSender: args.ScheduledTxSCConfig.MaxPendingTxsPerSender,
+		maxRoundsInFuture:      args.ScheduledTxSCConfig.MaxRoundsInFuture,
+		maxExecutionsPerCall:   args.ScheduledTxSCConfig.MaxExecutionsPerCall,
+		depositPerTx:           depositPerTx,
+	}
+
+	args.EpochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// Execute calls one of the functions from the scheduled transactions contract and runs the code according to the input
+func (s *scheduledTxSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	err := CheckIfNil(args)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return s.init(args)
+	}
+
+	if !s.flagEnabled.IsSet() {
+		s.eei.AddReturnMessage("scheduled transactions contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "schedule":
+		return s.schedule(args)
+	case "cancel":
+		return s.cancel(args)
+	case "executeDue":
+		return s.executeDue(args)
+	case "getScheduledTx":
+		return s.getScheduledTx(args)
+	case "getNumPending":
+		return s.getNumPending(args)
+	}
+
+	s.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.UserError
+}
+
+func (s *scheduledTxSC) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// schedule escrows the call value and registers a transaction to be released at the target round
+// arguments: destination@targetRound@value@gasLimit[@callData]
+// the call value must be the value, plus the fee for the gas limit at the gas price of the scheduling transaction,
+// plus the deposit returned to the sender when the transaction is executed or cancelled
+func (s *scheduledTxSC) schedule(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 4 && len(args.Arguments) != 5 {
+		s.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledTxOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	destination := args.Arguments[0]
+	if len(destination) != len(args.CallerAddr) {
+		s.eei.AddReturnMessage("invalid destination address")
+		return vmcommon.UserError
+	}
+	if core.IsSmartContractOnMetachain(metachainShardIdentifier, destination) {
+		s.eei.AddReturnMessage("cannot schedule a transaction to a metachain smart contract")
+		return vmcommon.UserError
+	}
+
+	currentRound := s.eei.BlockChainHook().CurrentRound()
+	targetRound := big.NewInt(0).SetBytes(args.Arguments[1]).Uint64()
+	if targetRound <= currentRound {
+		s.eei.AddReturnMessage("target round must be in the future")
+		return vmcommon.UserError
+	}
+	if targetRound-currentRound > s.maxRoundsInFuture {
+		s.eei.AddReturnMessage("target round is too far in the future")
+		return vmcommon.UserError
+	}
+
+	// the gas limit is bounded by the gas of the scheduling transaction so that it fits in a transaction at execution
+	gasLimit := big.NewInt(0).SetBytes(args.Arguments[3]).Uint64()
+	if gasLimit > args.GasProvided {
+		s.eei.AddReturnMessage("gas limit can not exceed the gas provided for scheduling")
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[2])
+	executionFee := core.SafeMul(gasLimit, args.GasPrice)
+	expectedCallValue := big.NewInt(0).Add(value, executionFee)
+	expectedCallValue.Add(expectedCallValue, s.depositPerTx)
+	if args.CallValue.Cmp(expectedCallValue) != 0 {
+		s.eei.AddReturnMessage("invalid call value, needs exactly " + expectedCallValue.String())
+		return vmcommon.UserError
+	}
+
+	var callData []byte
+	if len(args.Arguments) == 5 {
+		callData = args.Arguments[4]
+	}
+
+	queue, err := s.getQueue()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if uint32(len(queue.Entries)) >= s.maxPendingTxs {
+		s.eei.AddReturnMessage("too many pending scheduled transactions")
+		return vmcommon.UserError
+	}
+	numPendingForSender := s.getNumPendingForSender(args.CallerAddr)
+	if numPendingForSender >= uint64(s.maxPendingTxsPerSender) {
+		s.eei.AddReturnMessage("too many pending scheduled transactions for sender")
+		return vmcommon.UserError
+	}
+
+	scheduledTx := &ScheduledTx{
+		ID:           queue.LastID + 1,
+		Sender:       args.CallerAddr,
+		Destination:  destination,
+		Value:        value,
+		ExecutionFee: executionFee,
+		GasLimit:     gasLimit,
+		GasPrice:     args.GasPrice,
+		TargetRound:  targetRound,
+		CallData:     callData,
+		Deposit:      big.NewInt(0).Set(s.depositPerTx),
+	}
+
+	err = s.saveScheduledTx(scheduledTx)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	queue.LastID = scheduledTx.ID
+	insertInQueue(queue, &ScheduledTxQueueEntry{ID: scheduledTx.ID, TargetRound: targetRound})
+	err = s.saveQueue(queue)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.setNumPendingForSender(args.CallerAddr, numPendingForSender+1)
+	s.eei.Finish(big.NewInt(0).SetUint64(scheduledTx.ID).Bytes())
+
+	return vmcommon.Ok
+}
+
+// cancel removes a pending scheduled transaction and returns the escrowed funds to its sender
+// arguments: id
+func (s *scheduledTxSC) cancel(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledTxOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	id := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	scheduledTx, err := s.getScheduledTxByID(id)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(scheduledTx.Sender, args.CallerAddr) {
+		s.eei.AddReturnMessage("only the sender can cancel a scheduled transaction")
+		return vmcommon.UserError
+	}
+
+	queue, err := s.getQueue()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	removeFromQueue(queue, id)
+	err = s.saveQueue(queue)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.removeScheduledTx(scheduledTx)
+
+	refund := big.NewInt(0).Add(scheduledTx.Value, scheduledTx.ExecutionFee)
+	refund.Add(refund, valueOrZero(scheduledTx.Deposit))
+	err = s.eei.Transfer(scheduledTx.Sender, s.scheduledTxSCAddress, refund, nil, 0)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// executeDue releases the scheduled transactions which reached their target round, in queue order. It can only be
+// called by the protocol, at the start of each metachain block: the value is transferred to the destination, the
+// deposit is returned to the sender and the execution fees are paid to the caller, to be accounted as block fees.
+// Each released transaction is returned so that the caller can create its smart contract results
+func (s *scheduledTxSC) executeDue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.endOfEpochAddress) {
+		s.eei.AddReturnMessage("executeDue can only be called by the protocol")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		s.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	queue, err := s.getQueue()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	currentRound := s.eei.BlockChainHook().CurrentRound()
+	totalFee := big.NewInt(0)
+	numExecuted := uint32(0)
+	for numExecuted < s.maxExecutionsPerCall && len(queue.Entries) > 0 {
+		entry := queue.Entries[0]
+		if entry.TargetRound > currentRound {
+			break
+		}
+
+		scheduledTx, errGet := s.getScheduledTxByID(entry.ID)
+		if errGet != nil {
+			s.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+
+		marshaledTx, errMarshal := s.marshalizer.Marshal(scheduledTx)
+		if errMarshal != nil {
+			s.eei.AddReturnMessage(errMarshal.Error())
+			return vmcommon.UserError
+		}
+
+		err = s.eei.Transfer(scheduledTx.Destination, s.scheduledTxSCAddress, scheduledTx.Value, scheduledTx.CallData, scheduledTx.GasLimit)
+		if err != nil {
+			s.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+		deposit := valueOrZero(scheduledTx.Deposit)
+		if deposit.Cmp(zero) > 0 {
+			err = s.eei.Transfer(scheduledTx.Sender, s.scheduledTxSCAddress, deposit, nil, 0)
+			if err != nil {
+				s.eei.AddReturnMessage(err.Error())
+				return vmcommon.UserError
+			}
+		}
+
+		totalFee.Add(totalFee, scheduledTx.ExecutionFee)
+		s.removeScheduledTx(scheduledTx)
+		s.eei.Finish(marshaledTx)
+		queue.Entries = queue.Entries[1:]
+		numExecuted++
+	}
+
+	if numExecuted == 0 {
+		return vmcommon.Ok
+	}
+
+	err = s.saveQueue(queue)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	if totalFee.Cmp(zero) > 0 {
+		err = s.eei.Transfer(args.CallerAddr, s.scheduledTxSCAddress, totalFee, nil, 0)
+		if err != nil {
+			s.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+func (s *scheduledTxSC) getScheduledTx(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.ScheduledTxOps)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	scheduledTx, err := s.getScheduledTxByID(big.NewInt(0).SetBytes(args.Arguments[0]).Uint64())
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(scheduledTx.Sender)
+	s.eei.Finish(scheduledTx.Destination)
+	s.eei.Finish(scheduledTx.Value.Bytes())
+	s.eei.Finish(scheduledTx.ExecutionFee.Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(scheduledTx.GasLimit).Bytes())
+	s.eei.Finish(big.NewInt(0).SetUint64(scheduledTx.TargetRound).Bytes())
+	s.eei.Finish(scheduledTx.CallData)
+	s.eei.Finish(big.NewInt(0).SetUint64(scheduledTx.GasPrice).Bytes())
+	s.eei.Finish(valueOrZero(scheduledTx.Deposit).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (s *scheduledTxSC) getNumPending(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		s.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	queue, err := s.getQueue()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(big.NewInt(int64(len(queue.Entries))).Bytes())
+
+	return vmcommon.Ok
+}
+
+func insertInQueue(queue *ScheduledTxQueue, entry *ScheduledTxQueueEntry) {
+	index := sort.Search(len(queue.Entries), func(i int) bool {
+		return queue.Entries[i].TargetRound > entry.TargetRound
+	})
+
+	queue.Entries = append(queue.Entries, nil)
+	copy(queue.Entries[index+1:], queue.Entries[index:])
+	queue.Entries[index] = entry
+}
+
+func removeFromQueue(queue *ScheduledTxQueue, id uint64) {
+	for i, entry := range queue.Entries {
+		if entry.ID == id {
+			queue.Entries = append(queue.Entries[:i], queue.Entries[i+1:]...)
+			return
+		}
+	}
+}
+
+func createScheduledTxKey(id uint64) []byte {
+	return append([]byte(scheduledTxPrefix), big.NewInt(0).SetUint64(id).Bytes()...)
+}
+
+func (s *scheduledTxSC) getScheduledTxByID(id uint64) (*ScheduledTx, error) {
+	marshaledData := s.eei.GetStorage(createScheduledTxKey(id))
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrScheduledTxNotFound
+	}
+
+	scheduledTx := &ScheduledTx{}
+	err := s.marshalizer.Unmarshal(scheduledTx, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledTx, nil
+}
+
+func (s *scheduledTxSC) removeScheduledTx(scheduledTx *ScheduledTx) {
+	s.eei.SetStorage(createScheduledTxKey(scheduledTx.ID), nil)
+
+	numPendingForSender := s.getNumPendingForSender(scheduledTx.Sender)
+	if numPendingForSender > 0 {
+		numPendingForSender--
+	}
+	s.setNumPendingForSender(scheduledTx.Sender, numPendingForSender)
+}
+
+func createPendingPerSenderKey(sender []byte) []byte {
+	return append([]byte(pendingPerSenderPrefix), sender...)
+}
+
+func (s *scheduledTxSC) getNumPendingForSender(sender []byte) uint64 {
+	return big.NewInt(0).SetBytes(s.eei.GetStorage(createPendingPerSenderKey(sender))).Uint64()
+}
+
+func (s *scheduledTxSC) setNumPendingForSender(sender []byte, numPending uint64) {
+	if numPending == 0 {
+		s.eei.SetStorage(createPendingPerSenderKey(sender), nil)
+		return
+	}
+
+	s.eei.SetStorage(createPendingPerSenderKey(sender), big.NewInt(0).SetUint64(numPending).Bytes())
+}
+
+func (s *scheduledTxSC) saveScheduledTx(scheduledTx *ScheduledTx) error {
+	marshaledData, err := s.marshalizer.Marshal(scheduledTx)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage(createScheduledTxKey(scheduledTx.ID), marshaledData)
+	return nil
+}
+
+func (s *scheduledTxSC) getQueue() (*ScheduledTxQueue, error) {
+	queue := &ScheduledTxQueue{Entries: make([]*ScheduledTxQueueEntry, 0)}
+	marshaledData := s.eei.GetStorage([]byte(scheduledTxQueueKey))
+	if len(marshaledData) == 0 {
+		return queue, nil
+	}
+
+	err := s.marshalizer.Unmarshal(queue, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (s *scheduledTxSC) saveQueue(queue *ScheduledTxQueue) error {
+	marshaledData, err := s.marshalizer.Marshal(queue)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage([]byte(scheduledTxQueueKey), marshaledData)
+	return nil
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (s *scheduledTxSC) SetNewGasCost(gasCost vm.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost
+	s.mutExecution.Unlock()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *scheduledTxSC) EpochConfirmed(epoch uint32) {
+	s.flagEnabled.Toggle(epoch >= s.enabledEpoch)
+	log.Debug("scheduledTxSC", "enabled", s.flagEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract can be used
+func (s *scheduledTxSC) CanUseContract() bool {
+	return s.flagEnabled.IsSet()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *scheduledTxSC) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,1400 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduledTx.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledTx struct {
+	ID           uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID"`
+	Sender       []byte        `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender"`
+	Destination  []byte        `protobuf:"bytes,3,opt,name=Destination,proto3" json:"Destination"`
+	Value        *math_big.Int `protobuf:"bytes,4,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Value"`
+	ExecutionFee *math_big.Int `protobuf:"bytes,5,opt,name=ExecutionFee,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ExecutionFee"`
+	GasLimit     uint64        `protobuf:"varint,6,opt,name=GasLimit,proto3" json:"GasLimit"`
+	TargetRound  uint64        `protobuf:"varint,7,opt,name=TargetRound,proto3" json:"TargetRound"`
+	CallData     []byte        `protobuf:"bytes,8,opt,name=CallData,proto3" json:"CallData"`
+	GasPrice     uint64        `protobuf:"varint,9,opt,name=GasPrice,proto3" json:"GasPrice"`
+	Deposit      *math_big.Int `protobuf:"bytes,10,opt,name=Deposit,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Deposit"`
+}
+
+func (m *ScheduledTx) Reset()      { *m = ScheduledTx{} }
+func (*ScheduledTx) ProtoMessage() {}
+func (*ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3412c9cfde89f1c8, []int{0}
+}
+func (m *ScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTx.Merge(m, src)
+}
+func (m *ScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTx proto.InternalMessageInfo
+
+func (m *ScheduledTx) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *ScheduledTx) GetDestination() []byte {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *ScheduledTx) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ScheduledTx) GetExecutionFee() *math_big.Int {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
+func (m *ScheduledTx) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetTargetRound() uint64 {
+	if m != nil {
+		return m.TargetRound
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetCallData() []byte {
+	if m != nil {
+		return m.CallData
+	}
+	return nil
+}
+
+func (m *ScheduledTx) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetDeposit() *math_big.Int {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+type ScheduledTxQueueEntry struct {
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID"`
+	TargetRound uint64 `protobuf:"varint,2,opt,name=TargetRound,proto3" json:"TargetRound"`
+}
+
+func (m *ScheduledTxQueueEntry) Reset()      { *m = ScheduledTxQueueEntry{} }
+func (*ScheduledTxQueueEntry) ProtoMessage() {}
+func (*ScheduledTxQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3412c9cfde89f1c8, []int{1}
+}
+func (m *ScheduledTxQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTxQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledTxQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTxQueueEntry.Merge(m, src)
+}
+func (m *ScheduledTxQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTxQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTxQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTxQueueEntry proto.InternalMessageInfo
+
+func (m *ScheduledTxQueueEntry) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ScheduledTxQueueEntry) GetTargetRound() uint64 {
+	if m != nil {
+		return m.TargetRound
+	}
+	return 0
+}
+
+type ScheduledTxQueue struct {
+	LastID  uint64                   `protobuf:"varint,1,opt,name=LastID,proto3" json:"LastID"`
+	Entries []*ScheduledTxQueueEntry `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries"`
+}
+
+func (m *ScheduledTxQueue) Reset()      { *m = ScheduledTxQueue{} }
+func (*ScheduledTxQueue) ProtoMessage() {}
+func (*ScheduledTxQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3412c9cfde89f1c8, []int{2}
+}
+func (m *ScheduledTxQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTxQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledTxQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTxQueue.Merge(m, src)
+}
+func (m *ScheduledTxQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTxQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTxQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTxQueue proto.InternalMessageInfo
+
+func (m *ScheduledTxQueue) GetLastID() uint64 {
+	if m != nil {
+		return m.LastID
+	}
+	return 0
+}
+
+func (m *ScheduledTxQueue) GetEntries() []*ScheduledTxQueueEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ScheduledTx)(nil), "proto.ScheduledTx")
+	proto.RegisterType((*ScheduledTxQueueEntry)(nil), "proto.ScheduledTxQueueEntry")
+	proto.RegisterType((*ScheduledTxQueue)(nil), "proto.ScheduledTxQueue")
+}
+
+func init() { proto.RegisterFile("scheduledTx.proto", fileDescriptor_3412c9cfde89f1c8) }
+
+var fileDescriptor_3412c9cfde89f1c8 = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x4c,
+	0x10, 0xc6, 0xbd, 0xbe, 0xfc, 0xb9, 0x77, 0x13, 0xe9, 0x05, 0x0b, 0xd0, 0x0a, 0xa1, 0x75, 0x94,
+	0x2a, 0xcd, 0x25, 0x02, 0x4a, 0x2a, 0x12, 0x07, 0x14, 0xe9, 0x14, 0x1d, 0x9b, 0x83, 0x82, 0x6e,
+	0x13, 0x2f, 0x8e, 0x21, 0xf1, 0x9e, 0xbc, 0x63, 0x71, 0x11, 0x0d, 0x1f, 0x81, 0x8f, 0x81, 0xf8,
+	0x24, 0x94, 0x29, 0x53, 0x19, 0xe2, 0x34, 0xc8, 0xd5, 0xd5, 0x54, 0xc8, 0x9b, 0xc4, 0x38, 0x27,
+	0x10, 0xcd, 0x55, 0x33, 0xf3, 0xd3, 0xf8, 0x99, 0xd9, 0x47, 0x63, 0x7c, 0x5b, 0x4d, 0xa6, 0xc2,
+	0x8d, 0x66, 0xc2, 0x3d, 0xbf, 0x6c, 0x5f, 0x84, 0x12, 0xa4, 0x55, 0xd6, 0xe1, 0xfe, 0x89, 0xe7,
+	0xc3, 0x34, 0x1a, 0xb7, 0x27, 0x72, 0xde, 0xf1, 0xa4, 0x27, 0x3b, 0x1a, 0x8f, 0xa3, 0x37, 0xba,
+	0xd2, 0x85, 0xce, 0xb6, 0x5f, 0x35, 0x7f, 0x96, 0x70, 0x6d, 0xf4, 0x5b, 0xcb, 0xba, 0x87, 0xcd,
+	0x81, 0x43, 0x50, 0x03, 0xb5, 0x4a, 0xdd, 0x4a, 0x1a, 0xdb, 0xe6, 0xc0, 0x61, 0xe6, 0xc0, 0xb1,
+	0x9a, 0xb8, 0x32, 0x12, 0x81, 0x2b, 0x42, 0x62, 0x36, 0x50, 0xab, 0xde, 0xc5, 0x69, 0x6c, 0xef,
+	0x08, 0xdb, 0x45, 0xeb, 0x21, 0xae, 0x39, 0x42, 0x81, 0x1f, 0x70, 0xf0, 0x65, 0x40, 0x8e, 0x74,
+	0xe3, 0xff, 0x69, 0x6c, 0x17, 0x31, 0x2b, 0x16, 0x96, 0x8b, 0xcb, 0xaf, 0xf8, 0x2c, 0x12, 0xa4,
+	0xa4, 0x9b, 0x87, 0x69, 0x6c, 0x6f, 0xc1, 0x97, 0x6f, 0xf6, 0xd3, 0x39, 0x87, 0x69, 0x67, 0xec,
+	0x7b, 0xed, 0x41, 0x00, 0x4f, 0x0a, 0xcf, 0xea, 0xcf, 0x42, 0x19, 0xb8, 0x43, 0x01, 0xef, 0x65,
+	0xf8, 0xae, 0x23, 0x74, 0x75, 0xe2, 0xc9, 0x8e, 0xcb, 0x81, 0xb7, 0xbb, 0xbe, 0x37, 0x08, 0xa0,
+	0xc7, 0x15, 0x88, 0x90, 0x6d, 0xb5, 0xac, 0x05, 0xae, 0xf7, 0x2f, 0xc5, 0x24, 0xca, 0x46, 0x3e,
+	0x13, 0x82, 0x94, 0xf5, 0xb0, 0x97, 0x69, 0x6c, 0x1f, 0xf0, 0x9b, 0x99, 0x79, 0x20, 0x69, 0xb5,
+	0xf0, 0xf1, 0x73, 0xae, 0x4e, 0xfd, 0xb9, 0x0f, 0xa4, 0xa2, 0x5d, 0xad, 0xa7, 0xb1, 0x9d, 0x33,
+	0x96, 0x67, 0x99, 0x7b, 0xe7, 0x3c, 0xf4, 0x04, 0x30, 0x19, 0x05, 0x2e, 0xa9, 0xea, 0x66, 0xed,
+	0x5e, 0x01, 0xb3, 0x62, 0x91, 0x89, 0xf7, 0xf8, 0x6c, 0xe6, 0x70, 0xe0, 0xe4, 0x58, 0xbf, 0x49,
+	0x8b, 0xef, 0x19, 0xcb, 0xb3, 0xdd, 0x1a, 0x67, 0xa1, 0x3f, 0x11, 0xe4, 0xbf, 0x83, 0x35, 0x34,
+	0x63, 0x79, 0x66, 0xbd, 0xc5, 0x55, 0x47, 0x5c, 0x48, 0xe5, 0x03, 0xc1, 0x5a, 0xf2, 0x2c, 0x8d,
+	0xed, 0x3d, 0xba, 0x19, 0x87, 0xf6, 0x6a, 0xcd, 0x31, 0xbe, 0x5b, 0xb8, 0xbd, 0x17, 0x91, 0x88,
+	0x44, 0x3f, 0x80, 0x70, 0xf1, 0xd7, 0x2b, 0xbc, 0xe6, 0x91, 0xf9, 0x6f, 0x8f, 0x9a, 0x1f, 0xf0,
+	0xad, 0xeb, 0x33, 0xb2, 0x63, 0x3e, 0xe5, 0x0a, 0xf2, 0x11, 0xfa, 0x98, 0xb7, 0x84, 0xed, 0xa2,
+	0xd5, 0xc3, 0xd5, 0x6c, 0x17, 0x5f, 0x28, 0x62, 0x36, 0x8e, 0x5a, 0xb5, 0x47, 0x0f, 0xb6, 0x7f,
+	0x4c, 0xfb, 0x8f, 0x1b, 0x77, 0x6b, 0x99, 0x4b, 0xbb, 0x0f, 0xd8, 0x3e, 0xe9, 0x0e, 0x97, 0x6b,
+	0x6a, 0xac, 0xd6, 0xd4, 0xb8, 0x5a, 0x53, 0xf4, 0x31, 0xa1, 0xe8, 0x73, 0x42, 0xd1, 0xd7, 0x84,
+	0xa2, 0x65, 0x42, 0xd1, 0x2a, 0xa1, 0xe8, 0x7b, 0x42, 0xd1, 0x8f, 0x84, 0x1a, 0x57, 0x09, 0x45,
+	0x9f, 0x36, 0xd4, 0x58, 0x6e, 0xa8, 0xb1, 0xda, 0x50, 0xe3, 0xf5, 0x1d, 0xb5, 0x50, 0x20, 0xe6,
+	0xa3, 0x39, 0x0f, 0xa1, 0x27, 0x03, 0x08, 0xf9, 0x04, 0xd4, 0xb8, 0xa2, 0x57, 0x78, 0xfc, 0x2b,
+	0x00, 0x00, 0xff, 0xff, 0x9a, 0x20, 0x3d, 0xa9, 0xff, 0x03, 0x00, 0x00,
+}
+
+func (this *ScheduledTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTx)
+	if !ok {
+		that2, ok := that.(ScheduledTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !bytes.Equal(this.Destination, that1.Destination) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.ExecutionFee, that1.ExecutionFee) {
+			return false
+		}
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.TargetRound != that1.TargetRound {
+		return false
+	}
+	if !bytes.Equal(this.CallData, that1.CallData) {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Deposit, that1.Deposit) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledTxQueueEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTxQueueEntry)
+	if !ok {
+		that2, ok := that.(ScheduledTxQueueEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.TargetRound != that1.TargetRound {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTxQueue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTxQueue)
+	if !ok {
+		that2, ok := that.(ScheduledTxQueue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LastID != that1.LastID {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledTx) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&systemSmartContracts.ScheduledTx{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "ExecutionFee: "+fmt.Sprintf("%#v", this.ExecutionFee)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "TargetRound: "+fmt.Sprintf("%#v", this.TargetRound)+",\n")
+	s = append(s, "CallData: "+fmt.Sprintf("%#v", this.CallData)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "Deposit: "+fmt.Sprintf("%#v", this.Deposit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTxQueueEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ScheduledTxQueueEntry{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "TargetRound: "+fmt.Sprintf("%#v", this.TargetRound)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTxQueue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ScheduledTxQueue{")
+	s = append(s, "LastID: "+fmt.Sprintf("%#v", this.LastID)+",\n")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduledTx(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Deposit)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Deposit, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduledTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.GasPrice != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CallData) > 0 {
+		i -= len(m.CallData)
+		copy(dAtA[i:], m.CallData)
+		i = encodeVarintScheduledTx(dAtA, i, uint64(len(m.CallData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TargetRound != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.TargetRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.ExecutionFee)
+		i -= size
+		if _, err := __caster.MarshalTo(m.ExecutionFee, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduledTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduledTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintScheduledTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintScheduledTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTxQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTxQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTxQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetRound != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.TargetRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTxQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTxQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTxQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduledTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LastID != 0 {
+		i = encodeVarintScheduledTx(dAtA, i, uint64(m.LastID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovScheduledTx(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.ExecutionFee)
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovScheduledTx(uint64(m.GasLimit))
+	}
+	if m.TargetRound != 0 {
+		n += 1 + sovScheduledTx(uint64(m.TargetRound))
+	}
+	l = len(m.CallData)
+	if l > 0 {
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovScheduledTx(uint64(m.GasPrice))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Deposit)
+		n += 1 + l + sovScheduledTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTxQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovScheduledTx(uint64(m.ID))
+	}
+	if m.TargetRound != 0 {
+		n += 1 + sovScheduledTx(uint64(m.TargetRound))
+	}
+	return n
+}
+
+func (m *ScheduledTxQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastID != 0 {
+		n += 1 + sovScheduledTx(uint64(m.LastID))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovScheduledTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovScheduledTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTx(x uint64) (n int) {
+	return sovScheduledTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTx) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTx{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ExecutionFee:` + fmt.Sprintf("%v", this.ExecutionFee) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`TargetRound:` + fmt.Sprintf("%v", this.TargetRound) + `,`,
+		`CallData:` + fmt.Sprintf("%v", this.CallData) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`Deposit:` + fmt.Sprintf("%v", this.Deposit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTxQueueEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTxQueueEntry{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`TargetRound:` + fmt.Sprintf("%v", this.TargetRound) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTxQueue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*ScheduledTxQueueEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "ScheduledTxQueueEntry", "ScheduledTxQueueEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&ScheduledTxQueue{`,
+		`LastID:` + fmt.Sprintf("%v", this.LastID) + `,`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTx(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.ExecutionFee = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRound", wireType)
+			}
+			m.TargetRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallData = append(m.CallData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallData == nil {
+				m.CallData = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Deposit = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTxQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTxQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTxQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRound", wireType)
+			}
+			m.TargetRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTxQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTxQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTxQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastID", wireType)
+			}
+			m.LastID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &ScheduledTxQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduledTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTx = fmt.Errorf("proto: unexpected end of group")
+)
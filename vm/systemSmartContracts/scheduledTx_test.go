@@ -0,0 +1,504 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scheduledTxSender = []byte("sender address for scheduled txs")
+var scheduledTxDestination = []byte("destination address scheduled tx")
+
+const scheduledTxGasPrice = 2
+const scheduledTxDeposit = 5
+
+func createMockArgumentsForScheduledTx() ArgsNewScheduledTxSC {
+	return ArgsNewScheduledTxSC{
+		ScheduledTxSCConfig: config.ScheduledTxSystemSCConfig{
+			EnabledEpoch:           0,
+			MaxPendingTxs:          4,
+			MaxPendingTxsPerSender: 3,
+			MaxRoundsInFuture:      100,
+			MaxExecutionsPerCall:   2,
+			DepositPerTx:           "5",
+		},
+		Eei:                  &mock.SystemEIStub{},
+		ScheduledTxSCAddress: vm.ScheduledTxSCAddress,
+		EndOfEpochAddress:    vm.EndOfEpochAddress,
+		GasCost:              vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{ScheduledTxOps: 10}},
+		Marshalizer:          &mock.MarshalizerMock{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
+	}
+}
+
+func createScheduledTxSCWithRound(t *testing.T, currentRound *uint64) (*scheduledTxSC, *vmContext) {
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentRoundCalled: func() uint64 {
+			return *currentRound
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress(vm.ScheduledTxSCAddress)
+
+	args := createMockArgumentsForScheduledTx()
+	args.Eei = eei
+
+	s, err := NewScheduledTxSystemSC(args)
+	require.Nil(t, err)
+
+	return s, eei
+}
+
+func getDefaultVmInputForScheduledTx(funcName string, args [][]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  scheduledTxSender,
+			Arguments:   args,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			GasPrice:    scheduledTxGasPrice,
+		},
+		RecipientAddr: vm.ScheduledTxSCAddress,
+		Function:      funcName,
+	}
+}
+
+func createScheduleInput(targetRound uint64, value int64, gasLimit uint64) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForScheduledTx("schedule", [][]byte{
+		scheduledTxDestination,
+		big.NewInt(0).SetUint64(targetRound).Bytes(),
+		big.NewInt(value).Bytes(),
+		big.NewInt(0).SetUint64(gasLimit).Bytes(),
+	})
+	vmInput.CallValue = big.NewInt(value + int64(gasLimit)*scheduledTxGasPrice + scheduledTxDeposit)
+
+	return vmInput
+}
+
+func createExecuteDueInput() *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForScheduledTx("executeDue", nil)
+	vmInput.CallerAddr = vm.EndOfEpochAddress
+
+	return vmInput
+}
+
+func resetScheduledTxCallOutput(eei *vmContext, gasProvided uint64) {
+	eei.output = make([][]byte, 0)
+	eei.returnMessage = ""
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	eei.SetGasProvided(gasProvided)
+}
+
+func scheduleAndGetID(t *testing.T, s *scheduledTxSC, eei *vmContext, vmInput *vmcommon.ContractCallInput) uint64 {
+	resetScheduledTxCallOutput(eei, vmInput.GasProvided)
+	retCode := s.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, retCode, eei.returnMessage)
+	require.Equal(t, 1, len(eei.output))
+
+	return big.NewInt(0).SetBytes(eei.output[0]).Uint64()
+}
+
+func TestNewScheduledTxSystemSC_NilEeiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.Eei = nil
+
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewScheduledTxSystemSC_InvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCAddress = nil
+
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.True(t, errors.Is(err, vm.ErrInvalidAddress))
+}
+
+func TestNewScheduledTxSystemSC_InvalidEndOfEpochAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.EndOfEpochAddress = nil
+
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.True(t, errors.Is(err, vm.ErrInvalidAddress))
+}
+
+func TestNewScheduledTxSystemSC_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.Marshalizer = nil
+
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+}
+
+func TestNewScheduledTxSystemSC_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.EpochNotifier = nil
+
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+}
+
+func TestNewScheduledTxSystemSC_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCConfig.MaxPendingTxs = 0
+	s, err := NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrInvalidMaxPendingScheduledTxs, err)
+
+	args = createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCConfig.MaxPendingTxsPerSender = 0
+	s, err = NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrInvalidMaxPendingScheduledTxsPerSender, err)
+
+	args = createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCConfig.MaxRoundsInFuture = 0
+	s, err = NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrInvalidMaxRoundsInFuture, err)
+
+	args = createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCConfig.MaxExecutionsPerCall = 0
+	s, err = NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrInvalidMaxExecutionsPerCall, err)
+
+	args = createMockArgumentsForScheduledTx()
+	args.ScheduledTxSCConfig.DepositPerTx = "-1"
+	s, err = NewScheduledTxSystemSC(args)
+	assert.Nil(t, s)
+	assert.Equal(t, vm.ErrInvalidScheduledTxDeposit, err)
+}
+
+func TestNewScheduledTxSystemSC_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewScheduledTxSystemSC(createMockArgumentsForScheduledTx())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(s))
+	assert.True(t, s.CanUseContract())
+}
+
+func TestScheduledTxSC_ExecuteNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	s.enabledEpoch = 5
+	s.EpochConfirmed(4)
+	assert.False(t, s.CanUseContract())
+
+	retCode := s.Execute(createScheduleInput(20, 100, 50))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "scheduled transactions contract is not enabled", eei.returnMessage)
+}
+
+func TestScheduledTxSC_ExecuteInvalidFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	retCode := s.Execute(getDefaultVmInputForScheduledTx("invalid", nil))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid function to call", eei.returnMessage)
+}
+
+func TestScheduledTxSC_ScheduleInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	vmInput := getDefaultVmInputForScheduledTx("schedule", [][]byte{scheduledTxDestination})
+	eei.SetGasProvided(vmInput.GasProvided)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, s.Execute(vmInput))
+
+	resetScheduledTxCallOutput(eei, 1000)
+	assert.Equal(t, vmcommon.UserError, s.Execute(createScheduleInput(10, 100, 50)))
+	assert.Equal(t, "target round must be in the future", eei.returnMessage)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	assert.Equal(t, vmcommon.UserError, s.Execute(createScheduleInput(111, 100, 50)))
+	assert.Equal(t, "target round is too far in the future", eei.returnMessage)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	vmInput = createScheduleInput(20, 100, 50)
+	vmInput.CallValue.Sub(vmInput.CallValue, big.NewInt(1))
+	assert.Equal(t, vmcommon.UserError, s.Execute(vmInput))
+	assert.Equal(t, "invalid call value, needs exactly 205", eei.returnMessage)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	assert.Equal(t, vmcommon.UserError, s.Execute(createScheduleInput(20, 100, 1001)))
+	assert.Equal(t, "gas limit can not exceed the gas provided for scheduling", eei.returnMessage)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	vmInput = createScheduleInput(20, 100, 50)
+	vmInput.Arguments[0] = vm.ScheduledTxSCAddress
+	assert.Equal(t, vmcommon.UserError, s.Execute(vmInput))
+	assert.Equal(t, "cannot schedule a transaction to a metachain smart contract", eei.returnMessage)
+}
+
+func TestScheduledTxSC_ScheduleNotEnoughGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	eei.SetGasProvided(1)
+	retCode := s.Execute(createScheduleInput(20, 100, 50))
+	assert.Equal(t, vmcommon.OutOfGas, retCode)
+}
+
+func TestScheduledTxSC_ScheduleShouldWorkAndKeepQueueOrdered(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	id1 := scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+	id2 := scheduleAndGetID(t, s, eei, createScheduleInput(20, 200, 60))
+	id3 := scheduleAndGetID(t, s, eei, createScheduleInput(30, 300, 70))
+	assert.Equal(t, uint64(1), id1)
+	assert.Equal(t, uint64(2), id2)
+	assert.Equal(t, uint64(3), id3)
+
+	queue, err := s.getQueue()
+	require.Nil(t, err)
+	require.Equal(t, 3, len(queue.Entries))
+	assert.Equal(t, uint64(3), queue.LastID)
+	assert.Equal(t, id2, queue.Entries[0].ID)
+	assert.Equal(t, id1, queue.Entries[1].ID)
+	assert.Equal(t, id3, queue.Entries[2].ID)
+
+	scheduledTx, err := s.getScheduledTxByID(id2)
+	require.Nil(t, err)
+	assert.Equal(t, scheduledTxSender, scheduledTx.Sender)
+	assert.Equal(t, scheduledTxDestination, scheduledTx.Destination)
+	assert.Equal(t, big.NewInt(200), scheduledTx.Value)
+	assert.Equal(t, big.NewInt(120), scheduledTx.ExecutionFee)
+	assert.Equal(t, uint64(scheduledTxGasPrice), scheduledTx.GasPrice)
+	assert.Equal(t, big.NewInt(scheduledTxDeposit), scheduledTx.Deposit)
+	assert.Equal(t, uint64(20), scheduledTx.TargetRound)
+	assert.Equal(t, uint64(3), s.getNumPendingForSender(scheduledTxSender))
+}
+
+func TestScheduledTxSC_ScheduleShouldLimitThePendingTxs(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	for i := 0; i < 3; i++ {
+		_ = scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+	}
+
+	resetScheduledTxCallOutput(eei, 1000)
+	retCode := s.Execute(createScheduleInput(40, 100, 50))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "too many pending scheduled transactions for sender", eei.returnMessage)
+
+	vmInput := createScheduleInput(40, 100, 50)
+	vmInput.CallerAddr = []byte("another address for scheduled tx")
+	_ = scheduleAndGetID(t, s, eei, vmInput)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	vmInput.CallerAddr = []byte("one more address for schedule tx")
+	retCode = s.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "too many pending scheduled transactions", eei.returnMessage)
+}
+
+func TestScheduledTxSC_CancelShouldRefundSender(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	id := scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+	idBytes := big.NewInt(0).SetUint64(id).Bytes()
+
+	resetScheduledTxCallOutput(eei, 1000)
+	vmInput := getDefaultVmInputForScheduledTx("cancel", [][]byte{idBytes})
+	vmInput.CallerAddr = []byte("another address for scheduled tx")
+	assert.Equal(t, vmcommon.UserError, s.Execute(vmInput))
+	assert.Equal(t, "only the sender can cancel a scheduled transaction", eei.returnMessage)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	vmInput = getDefaultVmInputForScheduledTx("cancel", [][]byte{idBytes})
+	retCode := s.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	refundAccount := eei.outputAccounts[string(scheduledTxSender)]
+	require.NotNil(t, refundAccount)
+	assert.Equal(t, big.NewInt(205), refundAccount.BalanceDelta)
+
+	_, err := s.getScheduledTxByID(id)
+	assert.Equal(t, vm.ErrScheduledTxNotFound, err)
+	queue, _ := s.getQueue()
+	assert.Equal(t, 0, len(queue.Entries))
+	assert.Equal(t, uint64(0), s.getNumPendingForSender(scheduledTxSender))
+
+	resetScheduledTxCallOutput(eei, 1000)
+	assert.Equal(t, vmcommon.UserError, s.Execute(vmInput))
+	assert.Equal(t, vm.ErrScheduledTxNotFound.Error(), eei.returnMessage)
+}
+
+func TestScheduledTxSC_ExecuteDueNotCalledByTheProtocolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	_ = scheduleAndGetID(t, s, eei, createScheduleInput(20, 100, 50))
+
+	currentRound = 20
+	resetScheduledTxCallOutput(eei, 1000)
+	retCode := s.Execute(getDefaultVmInputForScheduledTx("executeDue", nil))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "executeDue can only be called by the protocol", eei.returnMessage)
+
+	queue, _ := s.getQueue()
+	assert.Equal(t, 1, len(queue.Entries))
+}
+
+func TestScheduledTxSC_ExecuteDueNothingDueShouldReturnNothing(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	_ = scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+
+	resetScheduledTxCallOutput(eei, 1000)
+	retCode := s.Execute(createExecuteDueInput())
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+	assert.Equal(t, 0, len(eei.outputAccounts))
+}
+
+func TestScheduledTxSC_ExecuteDueShouldReleaseInOrderAndPayTheFeesToTheProtocol(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	id1 := scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+	id2 := scheduleAndGetID(t, s, eei, createScheduleInput(20, 200, 60))
+	id3 := scheduleAndGetID(t, s, eei, createScheduleInput(30, 300, 70))
+
+	currentRound = 30
+	resetScheduledTxCallOutput(eei, 0)
+	retCode := s.Execute(createExecuteDueInput())
+	require.Equal(t, vmcommon.Ok, retCode, eei.returnMessage)
+	require.Equal(t, 2, len(eei.output))
+	for i, expectedID := range []uint64{id2, id1} {
+		executedTx := &ScheduledTx{}
+		err := s.marshalizer.Unmarshal(executedTx, eei.output[i])
+		require.Nil(t, err)
+		assert.Equal(t, expectedID, executedTx.ID)
+	}
+
+	destAccount := eei.outputAccounts[string(scheduledTxDestination)]
+	require.NotNil(t, destAccount)
+	require.Equal(t, 2, len(destAccount.OutputTransfers))
+	assert.Equal(t, big.NewInt(200), destAccount.OutputTransfers[0].Value)
+	assert.Equal(t, uint64(60), destAccount.OutputTransfers[0].GasLimit)
+	assert.Equal(t, big.NewInt(100), destAccount.OutputTransfers[1].Value)
+	assert.Equal(t, uint64(50), destAccount.OutputTransfers[1].GasLimit)
+
+	senderAccount := eei.outputAccounts[string(scheduledTxSender)]
+	require.NotNil(t, senderAccount)
+	assert.Equal(t, big.NewInt(2*scheduledTxDeposit), senderAccount.BalanceDelta)
+
+	protocolAccount := eei.outputAccounts[string(vm.EndOfEpochAddress)]
+	require.NotNil(t, protocolAccount)
+	assert.Equal(t, big.NewInt(220), protocolAccount.BalanceDelta)
+
+	queue, _ := s.getQueue()
+	require.Equal(t, 1, len(queue.Entries))
+	assert.Equal(t, id3, queue.Entries[0].ID)
+	assert.Equal(t, uint64(1), s.getNumPendingForSender(scheduledTxSender))
+
+	resetScheduledTxCallOutput(eei, 0)
+	retCode = s.Execute(createExecuteDueInput())
+	require.Equal(t, vmcommon.Ok, retCode, eei.returnMessage)
+	assert.Equal(t, 1, len(eei.output))
+	assert.Equal(t, big.NewInt(140), eei.outputAccounts[string(vm.EndOfEpochAddress)].BalanceDelta)
+	assert.Equal(t, uint64(0), s.getNumPendingForSender(scheduledTxSender))
+}
+
+func TestScheduledTxSC_GettersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+	id := scheduleAndGetID(t, s, eei, createScheduleInput(30, 100, 50))
+
+	resetScheduledTxCallOutput(eei, 1000)
+	retCode := s.Execute(getDefaultVmInputForScheduledTx("getNumPending", nil))
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, eei.output)
+
+	resetScheduledTxCallOutput(eei, 1000)
+	retCode = s.Execute(getDefaultVmInputForScheduledTx("getScheduledTx", [][]byte{big.NewInt(0).SetUint64(id).Bytes()}))
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 9, len(eei.output))
+	assert.Equal(t, scheduledTxSender, eei.output[0])
+	assert.Equal(t, scheduledTxDestination, eei.output[1])
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.output[2])
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.output[3])
+	assert.Equal(t, big.NewInt(30).Bytes(), eei.output[5])
+	assert.Equal(t, big.NewInt(scheduledTxGasPrice).Bytes(), eei.output[7])
+	assert.Equal(t, big.NewInt(scheduledTxDeposit).Bytes(), eei.output[8])
+}
+
+func TestScheduledTxSC_SetNewGasCost(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewScheduledTxSystemSC(createMockArgumentsForScheduledTx())
+	s.SetNewGasCost(vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{ScheduledTxOps: 37}})
+	assert.Equal(t, uint64(37), s.gasCost.MetaChainSystemSCsCost.ScheduledTxOps)
+}
+
+func TestScheduledTxSC_InitShouldWork(t *testing.T) {
+	t.Parallel()
+
+	currentRound := uint64(10)
+	s, eei := createScheduledTxSCWithRound(t, &currentRound)
+
+	vmInput := getDefaultVmInputForScheduledTx(core.SCDeployInitFunctionName, nil)
+	vmInput.CallValue = big.NewInt(1)
+	assert.Equal(t, vmcommon.UserError, s.Execute(vmInput))
+	assert.Equal(t, vm.ErrCallValueMustBeZero.Error(), eei.returnMessage)
+
+	vmInput.CallValue = big.NewInt(0)
+	assert.Equal(t, vmcommon.Ok, s.Execute(vmInput))
+
+	s.enabledEpoch = 5
+	s.EpochConfirmed(0)
+	assert.Equal(t, vmcommon.Ok, s.Execute(vmInput))
+}
@@ -0,0 +1,177 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const stakeCheckpointsPrefix = "stakeCheckpoints"
+const openProposalSnapshotsKey = "openProposalSnapshots"
+
+func stakeCheckpointsKey(address []byte) []byte {
+	return append([]byte(stakeCheckpointsPrefix), address...)
+}
+
+// saveStakeCheckpoint records, in the storage of the executing system smart contract, the stake the address holds
+// after the current block. The first checkpoint of an address also records the stake held before, so that the stake
+// at any later nonce can be found. The checkpoints no open proposal can ask for are dropped and the stored bytes are
+// paid for with gas
+func saveStakeCheckpoint(
+	eei vm.SystemEI,
+	marshalizer marshal.Marshalizer,
+	gasCost vm.GasCost,
+	governanceSCAddress []byte,
+	address []byte,
+	oldValue *big.Int,
+	newValue *big.Int,
+) error {
+	if oldValue.Cmp(newValue) == 0 {
+		return nil
+	}
+
+	key := stakeCheckpointsKey(address)
+	checkpoints, err := unmarshalStakeCheckpoints(marshalizer, eei.GetStorage(key))
+	if err != nil {
+		return err
+	}
+
+	if len(checkpoints.Checkpoints) == 0 {
+		checkpoints.Checkpoints = append(checkpoints.Checkpoints, &StakeCheckpoint{
+			Nonce: 0,
+			Value: big.NewInt(0).Set(oldValue),
+		})
+	}
+
+	currentNonce := eei.BlockChainHook().CurrentNonce()
+	lastCheckpoint := checkpoints.Checkpoints[len(checkpoints.Checkpoints)-1]
+	if lastCheckpoint.Nonce == currentNonce {
+		lastCheckpoint.Value = big.NewInt(0).Set(newValue)
+	} else {
+		checkpoints.Checkpoints = append(checkpoints.Checkpoints, &StakeCheckpoint{
+			Nonce: currentNonce,
+			Value: big.NewInt(0).Set(newValue),
+		})
+	}
+
+	oldestSnapshotNonce, err := oldestOpenSnapshotNonce(eei, marshalizer, governanceSCAddress)
+	if err != nil {
+		return err
+	}
+	checkpoints.Checkpoints = pruneStakeCheckpoints(checkpoints.Checkpoints, oldestSnapshotNonce)
+
+	marshaledData, err := marshalizer.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	err = eei.UseGas(gasCost.BaseOperationCost.StorePerByte * uint64(len(marshaledData)))
+	if err != nil {
+		return err
+	}
+
+	eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+// pruneStakeCheckpoints drops the checkpoints older than the provided snapshot nonce, keeping the last of them as the
+// base entry holding the stake at that nonce
+func pruneStakeCheckpoints(checkpoints []*StakeCheckpoint, oldestSnapshotNonce uint64) []*StakeCheckpoint {
+	baseIndex := 0
+	for index, checkpoint := range checkpoints {
+		if checkpoint.Nonce >= oldestSnapshotNonce {
+			break
+		}
+		baseIndex = index
+	}
+
+	return checkpoints[baseIndex:]
+}
+
+// oldestOpenSnapshotNonce returns the smallest snapshot nonce of the stake weighted proposals that can still be voted,
+// as recorded by the governance system smart contract. Without such proposals, the current nonce is returned, as a
+// proposal created in the current block will snapshot the stake held at its start
+func oldestOpenSnapshotNonce(
+	eei vm.SystemEI,
+	marshalizer marshal.Marshalizer,
+	governanceSCAddress []byte,
+) (uint64, error) {
+	marshaledData := eei.GetStorageFromAddress(governanceSCAddress, []byte(openProposalSnapshotsKey))
+	snapshots, err := unmarshalOpenProposalSnapshots(marshalizer, marshaledData)
+	if err != nil {
+		return 0, err
+	}
+
+	currentNonce := eei.BlockChainHook().CurrentNonce()
+	oldestSnapshotNonce := currentNonce
+	for _, snapshot := range snapshots.Snapshots {
+		if snapshot.EndVoteNonce < currentNonce {
+			continue
+		}
+		if snapshot.SnapshotNonce < oldestSnapshotNonce {
+			oldestSnapshotNonce = snapshot.SnapshotNonce
+		}
+	}
+
+	return oldestSnapshotNonce, nil
+}
+
+// stakeAtNonce returns the stake the address held, in the provided system smart contract, at the start of the block
+// with the provided nonce. An address without checkpoints did not change its stake since they are recorded, so its
+// current stake is returned
+func stakeAtNonce(
+	eei vm.SystemEI,
+	marshalizer marshal.Marshalizer,
+	scAddress []byte,
+	address []byte,
+	nonce uint64,
+	currentValue *big.Int,
+) (*big.Int, error) {
+	marshaledData := eei.GetStorageFromAddress(scAddress, stakeCheckpointsKey(address))
+	checkpoints, err := unmarshalStakeCheckpoints(marshalizer, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if len(checkpoints.Checkpoints) == 0 {
+		return currentValue, nil
+	}
+
+	value := checkpoints.Checkpoints[0].Value
+	for _, checkpoint := range checkpoints.Checkpoints {
+		if checkpoint.Nonce >= nonce {
+			break
+		}
+		value = checkpoint.Value
+	}
+
+	return big.NewInt(0).Set(valueOrZero(value)), nil
+}
+
+func unmarshalStakeCheckpoints(marshalizer marshal.Marshalizer, marshaledData []byte) (*StakeCheckpoints, error) {
+	checkpoints := &StakeCheckpoints{}
+	if len(marshaledData) == 0 {
+		return checkpoints, nil
+	}
+
+	err := marshalizer.Unmarshal(checkpoints, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
+
+func unmarshalOpenProposalSnapshots(marshalizer marshal.Marshalizer, marshaledData []byte) (*OpenProposalSnapshots, error) {
+	snapshots := &OpenProposalSnapshots{}
+	if len(marshaledData) == 0 {
+		return snapshots, nil
+	}
+
+	err := marshalizer.Unmarshal(snapshots, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,163 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var governanceSCAddress = []byte("governanceSC")
+
+func setOpenProposalSnapshots(eei *vmContext, snapshots ...*OpenProposalSnapshot) {
+	marshaledData, _ := (&mock.MarshalizerMock{}).Marshal(&OpenProposalSnapshots{Snapshots: snapshots})
+	eei.SetStorageForAddress(governanceSCAddress, []byte(openProposalSnapshotsKey), marshaledData)
+}
+
+func createVmContextForStakeCheckpoints(scAddress []byte, currentNonce *uint64) *vmContext {
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return *currentNonce
+		},
+	}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress(scAddress)
+
+	return eei
+}
+
+func TestStakeAtNonce_WithoutCheckpointsShouldReturnTheCurrentValue(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(10)
+	scAddress := []byte("validatorSC")
+	eei := createVmContextForStakeCheckpoints(scAddress, &nonce)
+
+	value, err := stakeAtNonce(eei, &mock.MarshalizerMock{}, scAddress, []byte("staker"), 5, big.NewInt(100))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(100), value)
+}
+
+func TestStakeAtNonce_ShouldReturnTheStakeAtTheStartOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(10)
+	scAddress := []byte("validatorSC")
+	staker := []byte("staker")
+	marshalizer := &mock.MarshalizerMock{}
+	eei := createVmContextForStakeCheckpoints(scAddress, &nonce)
+	setOpenProposalSnapshots(eei, &OpenProposalSnapshot{SnapshotNonce: 1, EndVoteNonce: 30})
+
+	require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(100), big.NewInt(100)))
+	require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(100), big.NewInt(150)))
+	require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(150), big.NewInt(120)))
+	nonce = 20
+	require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(120), big.NewInt(0)))
+
+	checkpoints, _ := unmarshalStakeCheckpoints(marshalizer, eei.GetStorage(stakeCheckpointsKey(staker)))
+	require.Equal(t, 3, len(checkpoints.Checkpoints))
+
+	expectedValues := map[uint64]int64{
+		1:  100,
+		10: 100,
+		11: 120,
+		20: 120,
+		21: 0,
+	}
+	for snapshotNonce, expectedValue := range expectedValues {
+		value, err := stakeAtNonce(eei, marshalizer, scAddress, staker, snapshotNonce, big.NewInt(0))
+		require.Nil(t, err)
+		require.Equal(t, big.NewInt(expectedValue), value, "snapshot nonce %d", snapshotNonce)
+	}
+}
+
+func TestSaveStakeCheckpoint_WithoutOpenProposalsShouldKeepOnlyTheBaseEntry(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(10)
+	scAddress := []byte("validatorSC")
+	staker := []byte("staker")
+	marshalizer := &mock.MarshalizerMock{}
+	eei := createVmContextForStakeCheckpoints(scAddress, &nonce)
+
+	for i := int64(0); i < 10; i++ {
+		nonce++
+		require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(i), big.NewInt(i+1)))
+	}
+
+	checkpoints, _ := unmarshalStakeCheckpoints(marshalizer, eei.GetStorage(stakeCheckpointsKey(staker)))
+	require.Equal(t, 2, len(checkpoints.Checkpoints))
+
+	value, err := stakeAtNonce(eei, marshalizer, scAddress, staker, nonce, big.NewInt(0))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(9), value)
+	value, err = stakeAtNonce(eei, marshalizer, scAddress, staker, nonce+1, big.NewInt(0))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), value)
+}
+
+func TestSaveStakeCheckpoint_ShouldKeepTheCheckpointsOfTheOpenProposals(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(10)
+	scAddress := []byte("validatorSC")
+	staker := []byte("staker")
+	marshalizer := &mock.MarshalizerMock{}
+	eei := createVmContextForStakeCheckpoints(scAddress, &nonce)
+	setOpenProposalSnapshots(eei,
+		&OpenProposalSnapshot{SnapshotNonce: 12, EndVoteNonce: 13},
+		&OpenProposalSnapshot{SnapshotNonce: 15, EndVoteNonce: 100},
+		&OpenProposalSnapshot{SnapshotNonce: 17, EndVoteNonce: 100},
+	)
+
+	for i := int64(0); i < 10; i++ {
+		nonce++
+		require.Nil(t, saveStakeCheckpoint(eei, marshalizer, vm.GasCost{}, governanceSCAddress, staker, big.NewInt(i), big.NewInt(i+1)))
+	}
+
+	checkpoints, _ := unmarshalStakeCheckpoints(marshalizer, eei.GetStorage(stakeCheckpointsKey(staker)))
+	require.Equal(t, 7, len(checkpoints.Checkpoints))
+	require.Equal(t, uint64(14), checkpoints.Checkpoints[0].Nonce)
+
+	expectedValues := map[uint64]int64{
+		15: 4,
+		17: 6,
+		21: 10,
+	}
+	for snapshotNonce, expectedValue := range expectedValues {
+		value, err := stakeAtNonce(eei, marshalizer, scAddress, staker, snapshotNonce, big.NewInt(0))
+		require.Nil(t, err)
+		require.Equal(t, big.NewInt(expectedValue), value, "snapshot nonce %d", snapshotNonce)
+	}
+}
+
+func TestSaveStakeCheckpoint_ShouldChargeTheStoredBytes(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(10)
+	scAddress := []byte("validatorSC")
+	staker := []byte("staker")
+	marshalizer := &mock.MarshalizerMock{}
+	eei := createVmContextForStakeCheckpoints(scAddress, &nonce)
+	gasCost := vm.GasCost{BaseOperationCost: vm.BaseOperationCost{StorePerByte: 10}}
+
+	eei.SetGasProvided(1)
+	err := saveStakeCheckpoint(eei, marshalizer, gasCost, governanceSCAddress, staker, big.NewInt(0), big.NewInt(100))
+	require.Equal(t, vm.ErrNotEnoughGas, err)
+	require.Equal(t, 0, len(eei.GetStorage(stakeCheckpointsKey(staker))))
+
+	eei.SetGasProvided(100000)
+	err = saveStakeCheckpoint(eei, marshalizer, gasCost, governanceSCAddress, staker, big.NewInt(0), big.NewInt(100))
+	require.Nil(t, err)
+	storedBytes := uint64(len(eei.GetStorage(stakeCheckpointsKey(staker))))
+	require.Equal(t, 100000-storedBytes*10, eei.GasLeft())
+}
@@ -40,6 +40,7 @@ type validatorSC struct {
 	stakingV2Epoch            uint32
 	stakingSCAddress          []byte
 	validatorSCAddress        []byte
+	governanceSCAddress       []byte
 	walletAddressLen          int
 	enableStakingEpoch        uint32
 	enableDoubleKeyEpoch      uint32
@@ -57,6 +58,8 @@ type validatorSC struct {
 	delegationMgrSCAddress    []byte
 	flagDelegationMgr         atomic.Flag
 	flagUnbondTokensV2        atomic.Flag
+	stakeCheckpointsEpoch     uint32
+	flagStakeCheckpoints      atomic.Flag
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
@@ -67,6 +70,7 @@ type ArgsValidatorSmartContract struct {
 	SigVerifier              vm.MessageSignVerifier
 	StakingSCAddress         []byte
 	ValidatorSCAddress       []byte
+	GovernanceSCAddress      []byte
 	GasCost                  vm.GasCost
 	Marshalizer              marshal.Marshalizer
 	EpochNotifier            vm.EpochNotifier
@@ -74,6 +78,8 @@ type ArgsValidatorSmartContract struct {
 	MinDeposit               string
 	DelegationMgrSCAddress   []byte
 	DelegationMgrEnableEpoch uint32
+	// StakeCheckpointsEnableEpoch is the epoch from which the stake changes are recorded for the stake weighted voting
+	StakeCheckpointsEnableEpoch uint32
 }
 
 // NewValidatorSmartContract creates an validator smart contract
@@ -89,6 +95,9 @@ func NewValidatorSmartContract(
 	if len(args.ValidatorSCAddress) == 0 {
 		return nil, vm.ErrNilValidatorSmartContractAddress
 	}
+	if len(args.GovernanceSCAddress) == 0 {
+		return nil, vm.ErrNilGovernanceSmartContractAddress
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -148,6 +157,7 @@ func NewValidatorSmartContract(
 		enableStakingEpoch:        args.StakingSCConfig.StakeEnableEpoch,
 		stakingSCAddress:          args.StakingSCAddress,
 		validatorSCAddress:        args.ValidatorSCAddress,
+		governanceSCAddress:       args.GovernanceSCAddress,
 		gasCost:                   args.GasCost,
 		marshalizer:               args.Marshalizer,
 		minUnstakeTokensValue:     minUnstakeTokensValue,
@@ -158,6 +168,7 @@ func NewValidatorSmartContract(
 		enableDelegationMgrEpoch:  args.DelegationMgrEnableEpoch,
 		delegationMgrSCAddress:    args.DelegationMgrSCAddress,
 		enableUnbondTokensV2Epoch: args.StakingSCConfig.UnbondTokensV2EnableEpoch,
+		stakeCheckpointsEpoch:     args.StakeCheckpointsEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -219,6 +230,8 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "moveStakeTokens":
+		return v.moveStakeTokens(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -1340,7 +1353,11 @@ func (v *validatorSC) unBondV1(args *vmcommon.ContractCallInput) vmcommon.Return
 	}
 
 	if registrationData.LockedStake.Cmp(zero) == 0 && registrationData.TotalStakeValue.Cmp(zero) == 0 {
-		v.eei.SetStorage(args.CallerAddr, nil)
+		errDelete := v.deleteRegistrationData(args.CallerAddr)
+		if errDelete != nil {
+			v.eei.AddReturnMessage("cannot delete registration data: error " + errDelete.Error())
+			return vmcommon.UserError
+		}
 	} else {
 		v.deleteUnBondedKeys(registrationData, unBondedKeys)
 		errSave := v.saveRegistrationData(args.CallerAddr, registrationData)
@@ -1416,7 +1433,11 @@ func (v *validatorSC) updateRegistrationDataAfterUnBond(
 	shouldDeleteRegistrationData := registrationData.TotalStakeValue.Cmp(zero) == 0 && registrationData.LockedStake.Cmp(zero) == 0 &&
 		len(registrationData.BlsPubKeys) == 0 && len(registrationData.UnstakedInfo) == 0
 	if shouldDeleteRegistrationData {
-		v.eei.SetStorage(callerAddr, nil)
+		errDelete := v.deleteRegistrationData(callerAddr)
+		if errDelete != nil {
+			v.eei.AddReturnMessage("cannot delete registration data: error " + errDelete.Error())
+			return vmcommon.UserError
+		}
 	} else {
 		errSave := v.saveRegistrationData(callerAddr, registrationData)
 		if errSave != nil {
@@ -1533,6 +1554,87 @@ func (v *validatorSC) unStakeTokens(args *vmcommon.ContractCallInput) vmcommon.R
 	return vmcommon.Ok
 }
 
+// moveStakeTokens moves active stake between two delegation system smart contracts without passing through the
+// unBond period. The source has to remain with enough stake to cover all its active nodes.
+func (v *validatorSC) moveStakeTokens(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	registrationData, returnCode := v.basicCheckForUnStakeUnBond(args, args.CallerAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if v.isUnStakeUnBondPaused() {
+		v.eei.AddReturnMessage("unStake/unBond is paused as not enough total staked in protocol")
+		return vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.UnStakeTokens)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		v.eei.AddReturnMessage("should have specified the destination and the value to be moved")
+		return vmcommon.UserError
+	}
+
+	destination := args.Arguments[0]
+	if !v.isDelegationSystemSC(args.CallerAddr) || !v.isDelegationSystemSC(destination) {
+		v.eei.AddReturnMessage("stake can be moved only between delegation contracts")
+		return vmcommon.UserError
+	}
+	if bytes.Equal(destination, args.CallerAddr) {
+		v.eei.AddReturnMessage("cannot move stake to the same address")
+		return vmcommon.UserError
+	}
+
+	moveValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if moveValue.Cmp(zero) <= 0 || moveValue.Cmp(registrationData.TotalStakeValue) > 0 {
+		v.eei.AddReturnMessage("invalid value to move, maximum allowed is " + registrationData.TotalStakeValue.String())
+		return vmcommon.UserError
+	}
+
+	destinationData, err := v.getOrCreateRegistrationData(destination)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	if len(destinationData.RewardAddress) == 0 {
+		v.eei.AddReturnMessage("destination is not registered in validator sc")
+		return vmcommon.UserError
+	}
+
+	registrationData.TotalStakeValue.Sub(registrationData.TotalStakeValue, moveValue)
+	numActive, _, err := v.getNumStakedAndWaitingNodes(registrationData, make(map[string]struct{}), false)
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	validatorConfig := v.getConfig(v.eei.BlockChainHook().CurrentEpoch())
+	stakeForNodes := big.NewInt(0).Mul(validatorConfig.NodePrice, big.NewInt(0).SetUint64(numActive))
+	if registrationData.TotalStakeValue.Cmp(stakeForNodes) < 0 {
+		v.eei.AddReturnMessage("cannot move stake, the remaining stake would not cover the active nodes")
+		return vmcommon.UserError
+	}
+
+	destinationData.TotalStakeValue.Add(destinationData.TotalStakeValue, moveValue)
+
+	err = v.saveRegistrationData(args.CallerAddr, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	err = v.saveRegistrationData(destination, destinationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (v *validatorSC) isDelegationSystemSC(address []byte) bool {
+	marshaledData := v.eei.GetStorageFromAddress(address, []byte(core.DelegationSystemSCKey))
+	return len(marshaledData) > 0
+}
+
 func (v *validatorSC) getMinUnStakeTokensValue() (*big.Int, error) {
 	if v.flagDelegationMgr.IsSet() {
 		delegationManagement, err := getDelegationManagement(v.eei, v.marshalizer, v.delegationMgrSCAddress)
@@ -1918,6 +2020,9 @@ func (v *validatorSC) EpochConfirmed(epoch uint32) {
 
 	v.flagUnbondTokensV2.Toggle(epoch >= v.enableUnbondTokensV2Epoch)
 	log.Debug("validatorSC: unbond tokens v2", "enabled", v.flagUnbondTokensV2.IsSet())
+
+	v.flagStakeCheckpoints.Toggle(epoch >= v.stakeCheckpointsEpoch)
+	log.Debug("validatorSC: stake checkpoints", "enabled", v.flagStakeCheckpoints.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
@@ -111,6 +111,11 @@ func (v *validatorSC) getOrCreateRegistrationData(key []byte) (*ValidatorDataV2,
 }
 
 func (v *validatorSC) saveRegistrationData(key []byte, validator *ValidatorDataV2) error {
+	err := v.saveStakeCheckpoint(key, validator.TotalStakeValue)
+	if err != nil {
+		return err
+	}
+
 	if !v.flagEnableTopUp.IsSet() {
 		return v.saveRegistrationDataV1(key, validator)
 	}
@@ -151,6 +156,37 @@ func (v *validatorSC) saveRegistrationDataV1(key []byte, validator *ValidatorDat
 	return nil
 }
 
+func (v *validatorSC) deleteRegistrationData(key []byte) error {
+	err := v.saveStakeCheckpoint(key, zero)
+	if err != nil {
+		return err
+	}
+
+	v.eei.SetStorage(key, nil)
+	return nil
+}
+
+func (v *validatorSC) saveStakeCheckpoint(key []byte, totalStakeValue *big.Int) error {
+	if !v.flagStakeCheckpoints.IsSet() {
+		return nil
+	}
+
+	oldRegistrationData, err := v.getOrCreateRegistrationData(key)
+	if err != nil {
+		return err
+	}
+
+	return saveStakeCheckpoint(
+		v.eei,
+		v.marshalizer,
+		v.gasCost,
+		v.governanceSCAddress,
+		key,
+		valueOrZero(oldRegistrationData.TotalStakeValue),
+		valueOrZero(totalStakeValue),
+	)
+}
+
 func (v *validatorSC) getStakedData(key []byte) (*StakedDataV2_0, error) {
 	data := v.eei.GetStorageFromAddress(v.stakingSCAddress, key)
 	stakedData := &StakedDataV2_0{
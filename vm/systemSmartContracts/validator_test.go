@@ -27,11 +27,12 @@ import (
 
 func createMockArgumentsForValidatorSC() ArgsValidatorSmartContract {
 	args := ArgsValidatorSmartContract{
-		Eei:                &mock.SystemEIStub{},
-		SigVerifier:        &mock.MessageSignVerifierMock{},
-		ValidatorSCAddress: []byte("validator"),
-		StakingSCAddress:   []byte("staking"),
-		EndOfEpochAddress:  []byte("endOfEpoch"),
+		Eei:                 &mock.SystemEIStub{},
+		SigVerifier:         &mock.MessageSignVerifierMock{},
+		ValidatorSCAddress:  []byte("validator"),
+		StakingSCAddress:    []byte("staking"),
+		GovernanceSCAddress: []byte("governance"),
+		EndOfEpochAddress:   []byte("endOfEpoch"),
 		StakingSCConfig: config.StakingSystemSCConfig{
 			GenesisNodePrice:                     "1000",
 			UnJailValue:                          "10",
@@ -197,6 +198,17 @@ func TestNewStakingValidatorSmartContract_NilValidatorSmartContractAddress(t *te
 	require.Equal(t, vm.ErrNilValidatorSmartContractAddress, err)
 }
 
+func TestNewStakingValidatorSmartContract_NilGovernanceSmartContractAddress(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsForValidatorSC()
+	arguments.GovernanceSCAddress = nil
+
+	asc, err := NewValidatorSmartContract(arguments)
+	require.Nil(t, asc)
+	require.Equal(t, vm.ErrNilGovernanceSmartContractAddress, err)
+}
+
 func TestNewStakingValidatorSmartContract_NilSigVerifier(t *testing.T) {
 	t.Parallel()
 
@@ -3343,6 +3355,90 @@ func TestStakingValidatorSC_UnstakeTokensShouldWork(t *testing.T) {
 	assert.Equal(t, expected, recovered)
 }
 
+func createValidatorSCWithTwoDelegationContracts(t *testing.T) (*validatorSC, *vmContext, []byte, []byte) {
+	blockChainHook := &mock.BlockChainHookStub{}
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.StakingV2Epoch = 0
+	eei := createVmContextWithStakingSc(big.NewInt(1000), uint64(10), blockChainHook)
+	args.Eei = eei
+	sc, err := NewValidatorSmartContract(args)
+	require.Nil(t, err)
+
+	source := []byte("source")
+	destination := []byte("destination")
+	for _, address := range [][]byte{source, destination} {
+		eei.SetStorageForAddress(address, []byte(core.DelegationSystemSCKey), []byte("delegation"))
+		_ = sc.saveRegistrationData(
+			address,
+			&ValidatorDataV2{
+				RewardAddress:   address,
+				TotalStakeValue: big.NewInt(1010),
+				LockedStake:     big.NewInt(0),
+				MaxStakePerNode: big.NewInt(0),
+				TotalUnstaked:   big.NewInt(0),
+			},
+		)
+	}
+
+	return sc, eei, source, destination
+}
+
+func TestStakingValidatorSC_MoveStakeTokensNotFromDelegationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, eei, _, destination := createValidatorSCWithTwoDelegationContracts(t)
+	caller := []byte("caller")
+	_ = sc.saveRegistrationData(caller, &ValidatorDataV2{RewardAddress: caller, TotalStakeValue: big.NewInt(1010)})
+
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, caller, [][]byte{destination, big.NewInt(10).Bytes()}, zero, vmcommon.UserError)
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, "stake can be moved only between delegation contracts", vmOutput.ReturnMessage)
+}
+
+func TestStakingValidatorSC_MoveStakeTokensInvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, eei, source, destination := createValidatorSCWithTwoDelegationContracts(t)
+
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, source, [][]byte{destination, big.NewInt(1011).Bytes()}, zero, vmcommon.UserError)
+	vmOutput := eei.CreateVMOutput()
+	assert.True(t, strings.Contains(vmOutput.ReturnMessage, "invalid value to move"))
+
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, source, [][]byte{source, big.NewInt(10).Bytes()}, zero, vmcommon.UserError)
+	vmOutput = eei.CreateVMOutput()
+	assert.True(t, strings.Contains(vmOutput.ReturnMessage, "cannot move stake to the same address"))
+}
+
+func TestStakingValidatorSC_MoveStakeTokensWhilePausedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, eei, source, destination := createValidatorSCWithTwoDelegationContracts(t)
+	togglePauseUnStakeUnBond(t, sc, true)
+
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, source, [][]byte{destination, big.NewInt(10).Bytes()}, zero, vmcommon.UserError)
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, "unStake/unBond is paused as not enough total staked in protocol", vmOutput.ReturnMessage)
+
+	sourceData, _ := sc.getOrCreateRegistrationData(source)
+	assert.Equal(t, big.NewInt(1010), sourceData.TotalStakeValue)
+
+	togglePauseUnStakeUnBond(t, sc, false)
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, source, [][]byte{destination, big.NewInt(10).Bytes()}, zero, vmcommon.Ok)
+}
+
+func TestStakingValidatorSC_MoveStakeTokensShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sc, _, source, destination := createValidatorSCWithTwoDelegationContracts(t)
+
+	callFunctionAndCheckResult(t, "moveStakeTokens", sc, source, [][]byte{destination, big.NewInt(10).Bytes()}, zero, vmcommon.Ok)
+
+	sourceData, _ := sc.getOrCreateRegistrationData(source)
+	assert.Equal(t, big.NewInt(1000), sourceData.TotalStakeValue)
+	destinationData, _ := sc.getOrCreateRegistrationData(destination)
+	assert.Equal(t, big.NewInt(1020), destinationData.TotalStakeValue)
+}
+
 func TestStakingValidatorSC_UnstakeTokensHavingUnstakedShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -4707,3 +4803,41 @@ func callFunctionAndCheckResult(
 	retCode := asc.Execute(arguments)
 	assert.Equal(t, expectedCode, retCode)
 }
+
+func TestValidatorSC_SaveRegistrationDataShouldSaveStakeCheckpointsOnlyIfEnabled(t *testing.T) {
+	t.Parallel()
+
+	currentNonce := uint64(5)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return currentNonce
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("validator"))
+
+	args := createMockArgumentsForValidatorSC()
+	args.Eei = eei
+	args.GovernanceSCAddress = governanceSCAddress
+	args.StakeCheckpointsEnableEpoch = 1
+	sc, _ := NewValidatorSmartContract(args)
+	staker := []byte("staker")
+	setOpenProposalSnapshots(eei, &OpenProposalSnapshot{SnapshotNonce: 1, EndVoteNonce: 30})
+
+	_ = sc.saveRegistrationData(staker, &ValidatorDataV2{TotalStakeValue: big.NewInt(100)})
+	assert.Equal(t, 0, len(eei.GetStorage(stakeCheckpointsKey(staker))))
+
+	sc.EpochConfirmed(1)
+	currentNonce = 10
+	_ = sc.saveRegistrationData(staker, &ValidatorDataV2{TotalStakeValue: big.NewInt(300)})
+	currentNonce = 20
+	_ = sc.deleteRegistrationData(staker)
+
+	checkpoints, _ := unmarshalStakeCheckpoints(args.Marshalizer, eei.GetStorage(stakeCheckpointsKey(staker)))
+	require.Equal(t, []*StakeCheckpoint{
+		{Nonce: 0, Value: big.NewInt(100)},
+		{Nonce: 10, Value: big.NewInt(300)},
+		{Nonce: 20, Value: big.NewInt(0)},
+	}, checkpoints.Checkpoints)
+	assert.Equal(t, 0, len(eei.GetStorage(staker)))
+}